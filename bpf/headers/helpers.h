@@ -194,6 +194,24 @@ static inline int ipv6_equal(__u32 *a, __u32 *b)
     return a[0] == b[0] && a[1] == b[1] && a[2] == b[2] && a[3] == b[3];
 }
 
+// is_ipv4_mapped reports whether the IPv6 address is an IPv4-mapped address,
+// ::ffff:a.b.c.d (network order)
+static inline int is_ipv4_mapped(__u32 *ip)
+{
+    return ip[0] == 0 && ip[1] == 0 && ip[2] == bpf_htonl(0xffff);
+}
+
+// set_ip6_key copies the IPv6 address as a key of the maps, an IPv4-mapped
+// address is converted to the IPv4 address the maps are keyed by.
+static inline void set_ip6_key(__u32 *dst, __u32 *src)
+{
+    if (is_ipv4_mapped(src)) {
+        set_ipv4(dst, src[3]);
+    } else {
+        set_ipv6(dst, src);
+    }
+}
+
 static inline int is_port_listen_current_ns6(void *ctx, __u32 *ip, __u16 port)
 {
     struct bpf_sock_tuple tuple = {};
@@ -269,6 +287,30 @@ static inline int is_in_cidr(struct cidr *c, __u32 ip)
            bpf_htonl(ip) >> (32 - c->mask);
 }
 
+struct cidr6 {
+    __u32 net[4]; // network order
+    __u8 mask;
+    __u8 __pad[3];
+};
+
+static inline int is_in_cidr6(struct cidr6 *c, __u32 *ip)
+{
+    __u8 mask = c->mask;
+#pragma unroll
+    for (int i = 0; i < 4; i++) {
+        if (mask == 0) {
+            return 1;
+        }
+        __u8 bits = mask > 32 ? 32 : mask;
+        __u32 m = bits == 32 ? 0xffffffff : ~(0xffffffff >> bits);
+        if ((bpf_htonl(c->net[i]) & m) != (bpf_htonl(ip[i]) & m)) {
+            return 0;
+        }
+        mask -= bits;
+    }
+    return 1;
+}
+
 struct pod_config {
     __u16 status_port;
     __u16 __pad;
@@ -278,6 +320,14 @@ struct pod_config {
     __u16 include_out_ports[MAX_ITEM_LEN];
     __u16 exclude_in_ports[MAX_ITEM_LEN];
     __u16 exclude_out_ports[MAX_ITEM_LEN];
+    // IPv6 ranges, the list ends with the first range with a zero mask.
+    struct cidr6 exclude_out_ranges6[MAX_ITEM_LEN];
+    struct cidr6 include_out_ranges6[MAX_ITEM_LEN];
+    // outbound udp ports the traffic to is dropped.
+    __u16 deny_out_udp_ports[MAX_ITEM_LEN];
+    // whether the dns traffic of the pod is redirected to the local dns proxy.
+    __u8 capture_dns;
+    __u8 __pad2[3];
 };
 
 #define IS_EXCLUDE_PORT(ITEM, PORT, RET)                                       \
@@ -331,3 +381,29 @@ struct pod_config {
             *RET = 1;                                                          \
         }                                                                      \
     } while (0);
+
+#define IS_EXCLUDE_IPRANGES6(ITEM, IP, RET)                                    \
+    do {                                                                       \
+        *RET = 0;                                                              \
+        for (int i = 0; i < MAX_ITEM_LEN && ITEM[i].mask != 0; i++) {          \
+            if (is_in_cidr6(&ITEM[i], IP)) {                                   \
+                *RET = 1;                                                      \
+                break;                                                         \
+            }                                                                  \
+        }                                                                      \
+    } while (0);
+
+#define IS_INCLUDE_IPRANGES6(ITEM, IP, RET)                                    \
+    do {                                                                       \
+        *RET = 0;                                                              \
+        if (ITEM[0].mask != 0) {                                               \
+            for (int i = 0; i < MAX_ITEM_LEN && ITEM[i].mask != 0; i++) {      \
+                if (is_in_cidr6(&ITEM[i], IP)) {                               \
+                    *RET = 1;                                                  \
+                    break;                                                     \
+                }                                                              \
+            }                                                                  \
+        } else {                                                               \
+            *RET = 1;                                                          \
+        }                                                                      \
+    } while (0);
@@ -25,7 +25,7 @@ struct bpf_elf_map __section("maps") osm_pod_fib = {
 struct bpf_elf_map __section("maps") osm_proc_fib = {
     .type = BPF_MAP_TYPE_LRU_HASH,
     .size_key = sizeof(__u32),
-    .size_value = sizeof(__u32),
+    .size_value = sizeof(__u32) * 4,
     .max_elem = 1024,
 };
 
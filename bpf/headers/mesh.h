@@ -17,6 +17,17 @@
 #define DNS_CAPTURE_PORT 15053
 #endif
 
+#ifndef DNS_PORT
+#define DNS_PORT 53
+#endif
+
+#ifndef LOCAL_DNS_PROXY_PORT
+#define LOCAL_DNS_PROXY_PORT 5300
+#endif
+
+// 127.0.0.153 (network order), the address of the local dns proxy
+static const __u32 local_dns_proxy_ip = 127 + (153 << 24);
+
 // 127.0.0.6 (network order)
 static const __u32 sidecar_ip = 127 + (6 << 24);
 // ::6 (network order)
@@ -199,7 +199,7 @@ static inline int osm_cni_tcp_connect4(struct bpf_sock_addr *ctx)
             debugf("osm_cni_tcp_connect4 [Sidecar->Others]: pid: %d", pid);
             if (curr_ip) {
                 // sidecar to other sidecar
-                if (*(__u32 *)curr_ip != dst_ip) {
+                if (get_ipv4((__u32 *)curr_ip) != dst_ip) {
                     debugf("osm_cni_tcp_connect4 [Sidecar->Others{Sidecar}]: "
                            "rewrite dst port from %d to %d",
                            bpf_htons(ctx->user_port), IN_REDIRECT_PORT);
@@ -236,16 +236,317 @@ static inline int osm_cni_tcp_connect4(struct bpf_sock_addr *ctx)
     return 1;
 }
 
+static inline int osm_cni_tcp_connect6(struct bpf_sock_addr *ctx)
+{
+    struct cgroup_info cg_info;
+    if (!get_current_cgroup_info(ctx, &cg_info)) {
+        return 1;
+    }
+    if (!cg_info.is_in_mesh) {
+        // bypass normal traffic. we only deal pod's traffic managed by mesh.
+        return 1;
+    }
+    __u32 curr_pod_ip[4];
+    set_ipv6(curr_pod_ip, cg_info.cgroup_ip);
+    // the ip of the pod may be recorded as an ipv4 address in a dual-stack
+    // pod, it can only be bound or compared with ipv6 addresses when it is an
+    // ipv6 address.
+    int curr_pod_ip6 = curr_pod_ip[0] || curr_pod_ip[1] || curr_pod_ip[2];
+
+    __u64 uid = bpf_get_current_uid_gid() & 0xffffffff;
+    __u32 dst_ip[4];
+    set_ipv6(dst_ip, ctx->user_ip6);
+    // an ipv4-mapped destination is connected over ipv4, the maps are keyed
+    // by its ipv4 address.
+    int mapped = is_ipv4_mapped(dst_ip);
+    __u32 dst_key[4];
+    set_ip6_key(dst_key, dst_ip);
+    debugf("osm_cni_tcp_connect6 uid: %d cur pod ip: %pI6c dst ip: %pI6c", uid,
+           curr_pod_ip, dst_ip);
+    if (uid != SIDECAR_USER_ID) {
+        if (ipv6_equal(dst_ip, (__u32 *)localhost6) ||
+            (mapped && (dst_ip[3] & 0xff) == 0x7f)) {
+            debugf("osm_cni_tcp_connect6 [App->Local]: bypass");
+            // app call local, bypass.
+            return 1;
+        }
+        __u64 cookie = bpf_get_socket_cookie_addr(ctx);
+        // app call other app
+        debugf("osm_cni_tcp_connect6 [App->App]: dst ip: %pI6c dst port: %d",
+               dst_ip, bpf_htons(ctx->user_port));
+
+        // we need redirect it to sidecar.
+        struct origin_info origin;
+        memset(&origin, 0, sizeof(origin));
+        set_ipv6(origin.ip, dst_key);
+        origin.port = ctx->user_port;
+        origin.flags = 1;
+        if (bpf_map_update_elem(&osm_cki_fib, &cookie, &origin, BPF_ANY)) {
+            debugf("osm_cni_tcp_connect6 write osm_cki_fib failed");
+            return 0;
+        }
+        struct pod_config *pod = bpf_map_lookup_elem(&osm_pod_fib, curr_pod_ip);
+        if (pod) {
+            int exclude = 0;
+            IS_EXCLUDE_PORT(pod->exclude_out_ports, ctx->user_port, &exclude);
+            if (exclude) {
+                debugf("osm_cni_tcp_connect6 ignored dst port by "
+                       "exclude_out_ports, port: %d",
+                       bpf_htons(ctx->user_port));
+                return 1;
+            }
+            int include = 0;
+            IS_INCLUDE_PORT(pod->include_out_ports, ctx->user_port, &include);
+            if (!include) {
+                debugf("osm_cni_tcp_connect6 dest port %d not in "
+                       "include_out_ports, ignored.",
+                       bpf_htons(ctx->user_port));
+                return 1;
+            }
+            if (mapped) {
+                IS_EXCLUDE_IPRANGES(pod->exclude_out_ranges, dst_key[3],
+                                    &exclude);
+                IS_INCLUDE_IPRANGES(pod->include_out_ranges, dst_key[3],
+                                    &include);
+            } else {
+                IS_EXCLUDE_IPRANGES6(pod->exclude_out_ranges6, dst_ip,
+                                     &exclude);
+                if (pod->include_out_ranges6[0].mask == 0 &&
+                    pod->include_out_ranges[0].net != 0) {
+                    // only ipv4 ranges are included.
+                    include = 0;
+                } else {
+                    IS_INCLUDE_IPRANGES6(pod->include_out_ranges6, dst_ip,
+                                         &include);
+                }
+            }
+            if (exclude) {
+                debugf("osm_cni_tcp_connect6 ignored dest ranges by "
+                       "exclude_out_ranges, ip: %pI6c",
+                       dst_ip);
+                return 1;
+            }
+            if (!include) {
+                debugf("osm_cni_tcp_connect6 dest %pI6c not in "
+                       "include_out_ranges, ignored.",
+                       dst_ip);
+                return 1;
+            }
+        } else {
+            debugf("osm_cni_tcp_connect6 can not find pod_info of current pod "
+                   "ip(%pI6c) from osm_pod_fib",
+                   curr_pod_ip);
+        }
+        if (mapped) {
+            // ::ffff:127.0.0.1, the sidecar listens on ipv4.
+            ctx->user_ip6[0] = 0;
+            ctx->user_ip6[1] = 0;
+            ctx->user_ip6[2] = bpf_htonl(0xffff);
+            ctx->user_ip6[3] = localhost;
+        } else {
+            if (curr_pod_ip6) {
+                // bind the pod's ip as the source ip to avoid quaternions
+                // conflict of different pods.
+                struct sockaddr_in6 addr = {
+                    .sin6_port = 0,
+                    .sin6_family = 10,
+                };
+                set_ipv6(addr.sin6_addr.in6_u.u6_addr32, curr_pod_ip);
+                if (bpf_bind(ctx, (struct sockaddr_in *)&addr,
+                             sizeof(struct sockaddr_in6))) {
+                    debugf("osm_cni_tcp_connect6 bind %pI6c error",
+                           curr_pod_ip);
+                }
+            }
+            ctx->user_ip6[0] = localhost6[0];
+            ctx->user_ip6[1] = localhost6[1];
+            ctx->user_ip6[2] = localhost6[2];
+            ctx->user_ip6[3] = localhost6[3];
+        }
+        ctx->user_port = bpf_htons(OUT_REDIRECT_PORT);
+        debugf("osm_cni_tcp_connect6 [App->Sidecar]: redirect dst port: %d",
+               bpf_htons(ctx->user_port));
+    } else {
+        // from sidecar to others
+        struct pod_config *pod = bpf_map_lookup_elem(&osm_pod_fib, dst_key);
+        if (!pod) {
+            // bypass
+            debugf("osm_cni_tcp_connect6 [Sidecar->Others]: dst ip: %pI6c dst "
+                   "port: %d bypass",
+                   dst_ip, bpf_htons(ctx->user_port));
+            return 1;
+        }
+
+        // dst ip is in this node, but not the current pod,
+        // it is sidecar to sidecar connecting.
+        struct origin_info origin;
+        memset(&origin, 0, sizeof(origin));
+        set_ipv6(origin.ip, dst_key);
+        origin.port = ctx->user_port;
+
+        if (curr_pod_ip6 && !mapped) {
+            if (!ipv6_equal(curr_pod_ip, dst_key)) {
+                // call other pod, need redirect port.
+                int exclude = 0;
+                IS_EXCLUDE_PORT(pod->exclude_in_ports, ctx->user_port,
+                                &exclude);
+                if (exclude) {
+                    debugf("osm_cni_tcp_connect6 [Sidecar->Others]: ignored "
+                           "dst port by exclude_in_ports, ip: %pI6c, port: %d",
+                           dst_ip, bpf_htons(ctx->user_port));
+                    return 1;
+                }
+                int include = 0;
+                IS_INCLUDE_PORT(pod->include_in_ports, ctx->user_port,
+                                &include);
+                if (!include) {
+                    debugf("osm_cni_tcp_connect6 [Sidecar->Others]: ignored "
+                           "dst port by include_in_ports, ip: %pI6c, port: %d",
+                           dst_ip, bpf_htons(ctx->user_port));
+                    return 1;
+                }
+                ctx->user_port = bpf_htons(IN_REDIRECT_PORT);
+            } else {
+                debugf("osm_cni_tcp_connect6 [Sidecar->Others{Self}]");
+            }
+            origin.flags |= 1;
+        } else {
+            // the current pod ip of this family is unknown, we use the legacy
+            // mode, see osm_cni_tcp_connect4.
+            __u32 pid = bpf_get_current_pid_tgid() >> 32; // tgid
+            void *curr_ip = bpf_map_lookup_elem(&osm_proc_fib, &pid);
+            debugf("osm_cni_tcp_connect6 [Sidecar->Others]: pid: %d", pid);
+            if (curr_ip) {
+                if (!ipv6_equal((__u32 *)curr_ip, dst_key)) {
+                    ctx->user_port = bpf_htons(IN_REDIRECT_PORT);
+                } else {
+                    debugf("osm_cni_tcp_connect6 [Sidecar->Others{Self}]");
+                }
+            } else {
+                ctx->user_port = bpf_htons(IN_REDIRECT_PORT);
+            }
+            origin.flags = 0;
+            origin.pid = pid;
+        }
+        __u64 cookie = bpf_get_socket_cookie_addr(ctx);
+        if (bpf_map_update_elem(&osm_cki_fib, &cookie, &origin, BPF_NOEXIST)) {
+            printk("osm_cni_tcp_connect6 update cookie origin failed");
+            return 0;
+        }
+    }
+
+    return 1;
+}
+
+// osm_cni_udp_redirect4 drops the udp traffic of the app to the denied ports,
+// and redirects its dns traffic to the local dns proxy of the sidecar if the dns
+// traffic of the pod is captured. It is called on connect for connected udp
+// sockets and on sendmsg for unconnected ones.
+static inline int osm_cni_udp_redirect4(struct bpf_sock_addr *ctx)
+{
+    struct cgroup_info cg_info;
+    if (!get_current_cgroup_info(ctx, &cg_info)) {
+        return 1;
+    }
+    if (!cg_info.is_in_mesh) {
+        // bypass normal traffic. we only deal pod's traffic managed by mesh.
+        return 1;
+    }
+    __u64 uid = bpf_get_current_uid_gid() & 0xffffffff;
+    if (uid == SIDECAR_USER_ID) {
+        // the sidecar resolves and forwards the traffic itself.
+        return 1;
+    }
+    __u32 dst_ip = ctx->user_ip4;
+    if ((dst_ip & 0xff) == 0x7f) {
+        // app call local, bypass.
+        return 1;
+    }
+    __u32 curr_pod_ip[4];
+    set_ipv6(curr_pod_ip, cg_info.cgroup_ip);
+    struct pod_config *pod = bpf_map_lookup_elem(&osm_pod_fib, curr_pod_ip);
+    if (!pod) {
+        return 1;
+    }
+    int deny = 0;
+    IS_EXCLUDE_PORT(pod->deny_out_udp_ports, ctx->user_port, &deny);
+    if (deny) {
+        debugf("osm_cni_udp_redirect4 [App->App]: dropped by "
+               "deny_out_udp_ports, dst ip: %pI4, port: %d",
+               &dst_ip, bpf_htons(ctx->user_port));
+        return 0;
+    }
+    if (!pod->capture_dns || ctx->user_port != bpf_htons(DNS_PORT)) {
+        return 1;
+    }
+
+    // the original destination is restored on recvmsg as the source of the
+    // responses of the local dns proxy.
+    __u64 cookie = bpf_get_socket_cookie_addr(ctx);
+    struct origin_info origin;
+    memset(&origin, 0, sizeof(origin));
+    set_ipv4(origin.ip, dst_ip);
+    origin.port = ctx->user_port;
+    origin.flags = 1;
+    if (bpf_map_update_elem(&osm_cki_fib, &cookie, &origin, BPF_ANY)) {
+        debugf("osm_cni_udp_redirect4 write osm_cki_fib failed");
+        return 1;
+    }
+    ctx->user_ip4 = local_dns_proxy_ip;
+    ctx->user_port = bpf_htons(LOCAL_DNS_PROXY_PORT);
+    debugf("osm_cni_udp_redirect4 [App->DNS]: redirect dns query to %pI4 "
+           "to the local dns proxy",
+           &dst_ip);
+    return 1;
+}
+
 __section("cgroup/connect4") int osm_cni_group_connect4(
     struct bpf_sock_addr *ctx)
 {
     switch (ctx->protocol) {
     case IPPROTO_TCP:
         return osm_cni_tcp_connect4(ctx);
+    case IPPROTO_UDP:
+        return osm_cni_udp_redirect4(ctx);
     default:
         return 1;
     }
 }
 
+__section("cgroup/connect6") int osm_cni_group_connect6(
+    struct bpf_sock_addr *ctx)
+{
+    switch (ctx->protocol) {
+    case IPPROTO_TCP:
+        return osm_cni_tcp_connect6(ctx);
+    default:
+        return 1;
+    }
+}
+
+__section("cgroup/sendmsg4") int osm_cni_group_sendmsg4(
+    struct bpf_sock_addr *ctx)
+{
+    return osm_cni_udp_redirect4(ctx);
+}
+
+__section("cgroup/recvmsg4") int osm_cni_group_recvmsg4(
+    struct bpf_sock_addr *ctx)
+{
+    if (ctx->user_ip4 != local_dns_proxy_ip ||
+        ctx->user_port != bpf_htons(LOCAL_DNS_PROXY_PORT)) {
+        return 1;
+    }
+    // restore the original destination of the dns query as the source of the
+    // response.
+    __u64 cookie = bpf_get_socket_cookie_addr(ctx);
+    struct origin_info *origin = bpf_map_lookup_elem(&osm_cki_fib, &cookie);
+    if (origin) {
+        ctx->user_ip4 = get_ipv4(origin->ip);
+        ctx->user_port = origin->port;
+    }
+    return 1;
+}
+
 char ____license[] __section("license") = "GPL";
 int _version __section("version") = 1;
@@ -15,6 +15,16 @@ __section("sk_msg") int osm_cni_msg_redirect(struct sk_msg_md *msg)
         set_ipv4(p.dip, msg->local_ip4);
         set_ipv4(p.sip, msg->remote_ip4);
         break;
+    case 10: {
+        // ipv6
+        __u32 local_ip6[4];
+        __u32 remote_ip6[4];
+        set_ipv6(local_ip6, msg->local_ip6);
+        set_ipv6(remote_ip6, msg->remote_ip6);
+        set_ip6_key(p.dip, local_ip6);
+        set_ip6_key(p.sip, remote_ip6);
+        break;
+    }
     }
 
 #ifdef DEBUG
@@ -22,22 +22,24 @@ static inline int osm_cni_sockops_ipv4(struct bpf_sock_ops *skops)
             if (skops->local_ip4 == sidecar_ip ||
                 skops->local_ip4 == skops->remote_ip4) {
                 // sidecar to local
-                __u32 ip = skops->remote_ip4;
+                __u32 ip[4];
+                set_ipv4(ip, skops->remote_ip4);
                 debugf("osm_cni_sockops_ipv4 [Sidecar->Local] detected process "
                        "%d's ip is %pI4",
-                       pid, &ip);
-                bpf_map_update_elem(&osm_proc_fib, &pid, &ip, BPF_ANY);
+                       pid, &ip[3]);
+                bpf_map_update_elem(&osm_proc_fib, &pid, ip, BPF_ANY);
                 if (skops->remote_port >> 16 == bpf_htons(IN_REDIRECT_PORT)) {
                     printk("incorrect connection: cookie=%d", cookie);
                     return 1;
                 }
             } else {
                 // sidecar to sidecar
-                __u32 ip = skops->local_ip4;
-                bpf_map_update_elem(&osm_proc_fib, &pid, &ip, BPF_ANY);
+                __u32 ip[4];
+                set_ipv4(ip, skops->local_ip4);
+                bpf_map_update_elem(&osm_proc_fib, &pid, ip, BPF_ANY);
                 debugf("osm_cni_sockops_ipv4 [Sidecar->Sidecar] detected "
                        "process %d's ip is %pI4",
-                       pid, &ip);
+                       pid, &ip[3]);
             }
         }
 #ifdef DEBUG
@@ -72,6 +74,68 @@ static inline int osm_cni_sockops_ipv4(struct bpf_sock_ops *skops)
     return 0;
 }
 
+static inline int osm_cni_sockops_ipv6(struct bpf_sock_ops *skops)
+{
+    __u32 local_ip6[4];
+    __u32 remote_ip6[4];
+    set_ipv6(local_ip6, skops->local_ip6);
+    set_ipv6(remote_ip6, skops->remote_ip6);
+
+    struct pair p;
+    memset(&p, 0, sizeof(p));
+    set_ip6_key(p.sip, local_ip6);
+    p.sport = bpf_htons(skops->local_port);
+    set_ip6_key(p.dip, remote_ip6);
+    p.dport = skops->remote_port >> 16;
+
+    __u64 cookie = bpf_get_socket_cookie_ops(skops);
+    struct origin_info *dst = bpf_map_lookup_elem(&osm_cki_fib, &cookie);
+    if (dst) {
+        struct origin_info dd = *dst;
+        if (!(dd.flags & 1)) {
+            __u32 pid = dd.pid;
+            // process ip not detected
+            if (ipv6_equal(local_ip6, (__u32 *)sidecar_ip6) ||
+                ipv6_equal(local_ip6, remote_ip6)) {
+                // sidecar to local
+                debugf("osm_cni_sockops_ipv6 [Sidecar->Local] detected process "
+                       "%d's ip is %pI6c",
+                       pid, remote_ip6);
+                bpf_map_update_elem(&osm_proc_fib, &pid, p.dip, BPF_ANY);
+                if (skops->remote_port >> 16 == bpf_htons(IN_REDIRECT_PORT)) {
+                    printk("incorrect connection: cookie=%d", cookie);
+                    return 1;
+                }
+            } else {
+                // sidecar to sidecar
+                bpf_map_update_elem(&osm_proc_fib, &pid, p.sip, BPF_ANY);
+                debugf("osm_cni_sockops_ipv6 [Sidecar->Sidecar] detected "
+                       "process %d's ip is %pI6c",
+                       pid, local_ip6);
+            }
+        }
+        debugf("osm_cni_sockops_ipv6 [established] remote_ip6: %pI6c -> "
+               "local_ip6: %pI6c",
+               remote_ip6, local_ip6);
+        debugf("osm_cni_sockops_ipv6 [established] remote_port: %d -> "
+               "local_port: %d",
+               bpf_htons(p.dport), skops->local_port);
+        bpf_map_update_elem(&osm_nat_fib, &p, &dd, BPF_ANY);
+        bpf_sock_hash_update(skops, &osm_sock_fib, &p, BPF_NOEXIST);
+    } else if (skops->local_port == OUT_REDIRECT_PORT ||
+               skops->local_port == IN_REDIRECT_PORT ||
+               ipv6_equal(remote_ip6, (__u32 *)sidecar_ip6)) {
+        debugf("osm_cni_sockops_ipv6 [established] remote_ip6: %pI6c -> "
+               "local_ip6: %pI6c",
+               remote_ip6, local_ip6);
+        debugf("osm_cni_sockops_ipv6 [established] remote_port: %d -> "
+               "local_port: %d",
+               bpf_htons(p.dport), skops->local_port);
+        bpf_sock_hash_update(skops, &osm_sock_fib, &p, BPF_NOEXIST);
+    }
+    return 0;
+}
+
 __section("sockops") int osm_cni_sock_ops(struct bpf_sock_ops *skops)
 {
     switch (skops->op) {
@@ -82,6 +146,9 @@ __section("sockops") int osm_cni_sock_ops(struct bpf_sock_ops *skops)
             // AF_INET, we don't include socket.h, because it may
             // cause an import error.
             return osm_cni_sockops_ipv4(skops);
+        case 10:
+            // AF_INET6
+            return osm_cni_sockops_ipv6(skops);
         }
         return 0;
     }
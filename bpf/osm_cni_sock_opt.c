@@ -64,6 +64,47 @@ __section("cgroup/getsockopt") int osm_cni_sock_opt(struct bpf_sockopt *ctx)
             debugf("osm_cni_sock_opt osm_nat_fib:NOT FOUND");
         }
         break;
+    case 10: { // ipv6
+        __u32 src_ip6[4];
+        __u32 dst_ip6[4];
+        set_ipv6(src_ip6, ctx->sk->src_ip6);
+        set_ipv6(dst_ip6, ctx->sk->dst_ip6);
+        set_ip6_key(p.dip, src_ip6);
+        set_ip6_key(p.sip, dst_ip6);
+        debugf("osm_cni_sock_opt src ip6: %pI6c src port: %d", dst_ip6,
+               bpf_htons(p.sport));
+        debugf("osm_cni_sock_opt dst ip6: %pI6c dst port: %d", src_ip6,
+               bpf_htons(p.dport));
+        origin = bpf_map_lookup_elem(&osm_nat_fib, &p);
+        if (origin) {
+            // rewrite original_dst
+            ctx->optlen = (__s32)sizeof(struct sockaddr_in6);
+            if ((void *)((struct sockaddr_in6 *)ctx->optval + 1) >
+                ctx->optval_end) {
+                printk("optname: %d: invalid getsockopt optval", ctx->optname);
+                return 1;
+            }
+            ctx->retval = 0;
+            struct sockaddr_in6 sa = {
+                .sin6_family = ctx->sk->family,
+                .sin6_port = origin->port,
+            };
+            if (origin->ip[0] == 0 && origin->ip[1] == 0 &&
+                origin->ip[2] == 0) {
+                // ipv4 origin of an ipv4-mapped connection
+                sa.sin6_addr.in6_u.u6_addr32[2] = bpf_htonl(0xffff);
+                sa.sin6_addr.in6_u.u6_addr32[3] = origin->ip[3];
+            } else {
+                set_ipv6(sa.sin6_addr.in6_u.u6_addr32, origin->ip);
+            }
+            *(struct sockaddr_in6 *)ctx->optval = sa;
+            debugf("osm_cni_sock_opt origin dst ip6: %pI6c origin dst port: %d",
+                   origin->ip, bpf_htons(origin->port));
+        } else {
+            debugf("osm_cni_sock_opt osm_nat_fib:NOT FOUND");
+        }
+        break;
+    }
     }
     return 1;
 }
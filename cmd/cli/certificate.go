@@ -0,0 +1,27 @@
+package main
+
+import (
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+const certificateDescription = `
+This command consists of multiple subcommands related to managing the root
+certificates of the mesh, configured with MeshRootCertificate resources, and
+revoking the certificates of compromised workloads.
+`
+
+func newCertificateCmd(out io.Writer) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "certificate",
+		Short: "manage the certificates of the mesh",
+		Long:  certificateDescription,
+		Args:  cobra.NoArgs,
+	}
+	cmd.AddCommand(newCertificateStatusCmd(out))
+	cmd.AddCommand(newCertificateRotateCmd(out))
+	cmd.AddCommand(newCertificateRevokeCmd(out))
+
+	return cmd
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	osmConfigClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+const certificateRevokeDescription = `
+This command revokes the certificates issued by the control plane with the
+given serial numbers, and those issued for the given service identities, by
+creating a CertificateRevocation resource with the given name in the namespace
+of the control plane.
+
+The control plane immediately reissues the revoked certificates, and the
+proxies reject the connections presenting them until they expire. The revoked
+certificates are listed in the status of the CertificateRevocation.
+`
+
+const certificateRevokeExample = `
+# Revoke the certificates of the service identity 'bookbuyer.bookbuyer'
+osm certificate revoke compromised-bookbuyer --identity bookbuyer.bookbuyer --reason "leaked private key"
+
+# Revoke the certificates with the given serial numbers
+osm certificate revoke compromised-certs --serial-number 123456789 --serial-number 987654321
+`
+
+const defaultCertificateRevokeTimeout = time.Minute
+
+type certificateRevokeCmd struct {
+	out               io.Writer
+	name              string
+	namespace         string
+	serialNumbers     []string
+	serviceIdentities []string
+	reason            string
+	timeout           time.Duration
+	pollInterval      time.Duration
+	configClient      osmConfigClient.Interface
+}
+
+func newCertificateRevokeCmd(out io.Writer) *cobra.Command {
+	certificateRevoke := &certificateRevokeCmd{
+		out:          out,
+		pollInterval: time.Second,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "revoke NAME",
+		Short:   "revoke the certificates of compromised workloads",
+		Long:    certificateRevokeDescription,
+		Example: certificateRevokeExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			certificateRevoke.name = args[0]
+
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			configClient, err := osmConfigClient.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access OSM config resources: %w", err)
+			}
+			certificateRevoke.configClient = configClient
+			certificateRevoke.namespace = settings.Namespace()
+			return certificateRevoke.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.StringSliceVar(&certificateRevoke.serialNumbers, "serial-number", nil, "Serial number of a certificate to revoke, can be repeated")
+	f.StringSliceVar(&certificateRevoke.serviceIdentities, "identity", nil, "Service identity whose certificates are revoked, in the <service-account>.<namespace> format, can be repeated")
+	f.StringVar(&certificateRevoke.reason, "reason", "", "Reason the certificates are revoked")
+	f.DurationVar(&certificateRevoke.timeout, "timeout", defaultCertificateRevokeTimeout, "Time to wait for the control plane to revoke the certificates")
+
+	return cmd
+}
+
+func (r *certificateRevokeCmd) run() error {
+	if len(r.serialNumbers) == 0 && len(r.serviceIdentities) == 0 {
+		return errors.New("At least one of --serial-number or --identity must be specified")
+	}
+
+	ctx := context.Background()
+	cr := &v1alpha2.CertificateRevocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      r.name,
+			Namespace: r.namespace,
+		},
+		Spec: v1alpha2.CertificateRevocationSpec{
+			SerialNumbers:     r.serialNumbers,
+			ServiceIdentities: r.serviceIdentities,
+			Reason:            r.reason,
+		},
+	}
+	if _, err := r.configClient.ConfigV1alpha2().CertificateRevocations(r.namespace).Create(ctx, cr, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("Could not create CertificateRevocation [%s] in namespace [%s]: %w", r.name, r.namespace, err)
+	}
+	fmt.Fprintf(r.out, "Created CertificateRevocation [%s] in namespace [%s]\n", r.name, r.namespace)
+
+	// Wait for the control plane to record the revoked certificates for the created generation
+	var revoked []v1alpha2.RevokedCertificate
+	err := wait.PollImmediate(r.pollInterval, r.timeout, func() (bool, error) {
+		cr, err := r.configClient.ConfigV1alpha2().CertificateRevocations(r.namespace).Get(ctx, r.name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		revoked = cr.Status.RevokedCertificates
+		return cr.Status.ObservedGeneration != 0 && cr.Status.ObservedGeneration >= cr.Generation, nil
+	})
+	if err != nil {
+		return fmt.Errorf("Timed out waiting for the control plane to revoke the certificates of CertificateRevocation [%s]: %w", r.name, err)
+	}
+
+	fmt.Fprintf(r.out, "Revoked %d certificates, which are denied by the proxies until they expire\n", len(revoked))
+	for _, rc := range revoked {
+		fmt.Fprintf(r.out, "  SerialNumber=%s CommonName=%s Expiration=%s\n", rc.SerialNumber, rc.CommonName, rc.Expiration.UTC().Format(time.RFC3339))
+	}
+	return nil
+}
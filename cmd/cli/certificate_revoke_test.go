@@ -0,0 +1,101 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	fakeConfigClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func TestCertificateRevoke(t *testing.T) {
+	tests := []struct {
+		name              string
+		serialNumbers     []string
+		serviceIdentities []string
+		revoke            bool
+		expectErr         bool
+		expectCreated     bool
+	}{
+		{
+			name:              "revoke the certificates of an identity",
+			serviceIdentities: []string{"sa.ns"},
+			revoke:            true,
+			expectCreated:     true,
+		},
+		{
+			name:          "revoke the certificates with serial numbers",
+			serialNumbers: []string{"1234"},
+			revoke:        true,
+			expectCreated: true,
+		},
+		{
+			name:      "no certificate to revoke",
+			expectErr: true,
+		},
+		{
+			name:              "control plane does not revoke the certificates",
+			serviceIdentities: []string{"sa.ns"},
+			expectErr:         true,
+			expectCreated:     true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			configClient := fakeConfigClientset.NewSimpleClientset()
+			if test.revoke {
+				// Mimic the control plane recording the revoked certificates
+				configClient.PrependReactor("create", "certificaterevocations", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					cr := action.(k8stesting.CreateAction).GetObject().(*v1alpha2.CertificateRevocation)
+					cr.Generation = 1
+					cr.Status.ObservedGeneration = 1
+					cr.Status.RevokedCertificates = []v1alpha2.RevokedCertificate{
+						{SerialNumber: "1234", CommonName: "sa.ns.cluster.local", Expiration: metav1.Now()},
+					}
+					return false, nil, nil
+				})
+			}
+
+			out := new(bytes.Buffer)
+			cmd := &certificateRevokeCmd{
+				out:               out,
+				name:              "compromised",
+				namespace:         testNamespace,
+				serialNumbers:     test.serialNumbers,
+				serviceIdentities: test.serviceIdentities,
+				reason:            "leaked private key",
+				timeout:           100 * time.Millisecond,
+				pollInterval:      10 * time.Millisecond,
+				configClient:      configClient,
+			}
+
+			err := cmd.run()
+			if test.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+				assert.Contains(out.String(), "Revoked 1 certificates")
+			}
+
+			cr, err := configClient.ConfigV1alpha2().CertificateRevocations(testNamespace).Get(context.TODO(), "compromised", metav1.GetOptions{})
+			if !test.expectCreated {
+				assert.Error(err)
+				return
+			}
+			assert.NoError(err)
+			assert.Equal(test.serialNumbers, cr.Spec.SerialNumbers)
+			assert.Equal(test.serviceIdentities, cr.Spec.ServiceIdentities)
+			assert.Equal("leaked private key", cr.Spec.Reason)
+		})
+	}
+}
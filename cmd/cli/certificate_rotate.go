@@ -0,0 +1,216 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	osmConfigClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+const certificateRotateDescription = `
+This command rotates the root certificate of the mesh to the given
+MeshRootCertificate, which must exist in the namespace of the control plane.
+
+The rotation consists of the following stages, each of them waiting for the
+control plane to apply the intents of the MeshRootCertificates and for the
+certificates of the proxies to be reissued before moving on to the next stage:
+  1. the new MeshRootCertificate is made passive, so that it is trusted
+  2. the currently active MeshRootCertificate is made passive and the new one
+     active, so that certificates are issued from the new root certificate
+     while the old one is still trusted
+  3. the old MeshRootCertificate is made inactive, so that it is no longer trusted
+`
+
+const certificateRotateExample = `
+# Rotate the root certificate of the mesh to the MeshRootCertificate 'osm-mesh-root-certificate-2'
+osm certificate rotate osm-mesh-root-certificate-2
+
+# Wait for 5 minutes between each stage of the rotation
+osm certificate rotate osm-mesh-root-certificate-2 --stage-delay 5m
+`
+
+const (
+	defaultCertificateRotateStageDelay = time.Minute
+	defaultCertificateRotateTimeout    = 5 * time.Minute
+)
+
+type certificateRotateCmd struct {
+	out          io.Writer
+	newMRCName   string
+	namespace    string
+	stageDelay   time.Duration
+	timeout      time.Duration
+	pollInterval time.Duration
+	configClient osmConfigClient.Interface
+}
+
+func newCertificateRotateCmd(out io.Writer) *cobra.Command {
+	certificateRotate := &certificateRotateCmd{
+		out:          out,
+		pollInterval: time.Second,
+	}
+
+	cmd := &cobra.Command{
+		Use:     "rotate NEW_MESH_ROOT_CERTIFICATE",
+		Short:   "rotate the root certificate of the mesh",
+		Long:    certificateRotateDescription,
+		Example: certificateRotateExample,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			certificateRotate.newMRCName = args[0]
+
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			configClient, err := osmConfigClient.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access OSM config resources: %w", err)
+			}
+			certificateRotate.configClient = configClient
+			certificateRotate.namespace = settings.Namespace()
+			return certificateRotate.run()
+		},
+	}
+
+	f := cmd.Flags()
+	f.DurationVar(&certificateRotate.stageDelay, "stage-delay", defaultCertificateRotateStageDelay, "Time to wait after each stage of the rotation for the certificates of the proxies to be reissued")
+	f.DurationVar(&certificateRotate.timeout, "timeout", defaultCertificateRotateTimeout, "Time to wait for the control plane to apply each stage of the rotation")
+
+	return cmd
+}
+
+func (r *certificateRotateCmd) run() error {
+	ctx := context.Background()
+	mrcList, err := r.configClient.ConfigV1alpha2().MeshRootCertificates(r.namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Could not list MeshRootCertificates in namespace [%s]: %w", r.namespace, err)
+	}
+
+	var newMRC *v1alpha2.MeshRootCertificate
+	var activeMRCs []string
+	for i := range mrcList.Items {
+		mrc := &mrcList.Items[i]
+		if mrc.Name == r.newMRCName {
+			newMRC = mrc
+			continue
+		}
+		if certificate.GetMRCIntent(mrc) == v1alpha2.MeshRootCertificateIntentActive {
+			activeMRCs = append(activeMRCs, mrc.Name)
+		}
+	}
+
+	if newMRC == nil {
+		return fmt.Errorf("MeshRootCertificate [%s] not found in namespace [%s]", r.newMRCName, r.namespace)
+	}
+	if len(activeMRCs) == 0 && certificate.GetMRCIntent(newMRC) == v1alpha2.MeshRootCertificateIntentActive {
+		fmt.Fprintf(r.out, "MeshRootCertificate [%s] is already active\n", r.newMRCName)
+		return nil
+	}
+	if len(activeMRCs) != 1 {
+		return fmt.Errorf("Expected exactly 1 active MeshRootCertificate other than [%s] in namespace [%s], found %d", r.newMRCName, r.namespace, len(activeMRCs))
+	}
+	oldMRCName := activeMRCs[0]
+
+	fmt.Fprintf(r.out, "Rotating the root certificate from MeshRootCertificate [%s] to [%s]\n", oldMRCName, r.newMRCName)
+
+	stages := []struct {
+		description string
+		intents     []mrcIntent
+	}{
+		{
+			description: fmt.Sprintf("trusting MeshRootCertificate [%s]", r.newMRCName),
+			intents: []mrcIntent{
+				{name: r.newMRCName, intent: v1alpha2.MeshRootCertificateIntentPassive},
+			},
+		},
+		{
+			description: fmt.Sprintf("issuing certificates from MeshRootCertificate [%s]", r.newMRCName),
+			intents: []mrcIntent{
+				// The control plane keeps issuing certificates from the old root certificate
+				// until the intents of both MeshRootCertificates are updated
+				{name: oldMRCName, intent: v1alpha2.MeshRootCertificateIntentPassive},
+				{name: r.newMRCName, intent: v1alpha2.MeshRootCertificateIntentActive},
+			},
+		},
+		{
+			description: fmt.Sprintf("no longer trusting MeshRootCertificate [%s]", oldMRCName),
+			intents: []mrcIntent{
+				{name: oldMRCName, intent: v1alpha2.MeshRootCertificateIntentInactive},
+			},
+		},
+	}
+
+	for i, stage := range stages {
+		fmt.Fprintf(r.out, "Stage %d/%d: %s\n", i+1, len(stages), stage.description)
+		for _, in := range stage.intents {
+			if err := r.setIntent(ctx, in); err != nil {
+				return err
+			}
+		}
+		for _, in := range stage.intents {
+			if err := r.waitForReady(ctx, in.name); err != nil {
+				return err
+			}
+		}
+		if i < len(stages)-1 {
+			time.Sleep(r.stageDelay)
+		}
+	}
+
+	fmt.Fprintf(r.out, "Successfully rotated the root certificate to MeshRootCertificate [%s]\n", r.newMRCName)
+	return nil
+}
+
+type mrcIntent struct {
+	name   string
+	intent v1alpha2.MeshRootCertificateIntent
+}
+
+func (r *certificateRotateCmd) setIntent(ctx context.Context, in mrcIntent) error {
+	mrc, err := r.configClient.ConfigV1alpha2().MeshRootCertificates(r.namespace).Get(ctx, in.name, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("Could not get MeshRootCertificate [%s]: %w", in.name, err)
+	}
+	if certificate.GetMRCIntent(mrc) == in.intent {
+		return nil
+	}
+
+	mrc.Spec.Intent = in.intent
+	if _, err := r.configClient.ConfigV1alpha2().MeshRootCertificates(r.namespace).Update(ctx, mrc, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("Could not set the intent of MeshRootCertificate [%s] to %s: %w", in.name, in.intent, err)
+	}
+	return nil
+}
+
+// waitForReady waits for the control plane to report the MRC as ready for its current generation
+func (r *certificateRotateCmd) waitForReady(ctx context.Context, name string) error {
+	var message string
+	err := wait.PollImmediate(r.pollInterval, r.timeout, func() (bool, error) {
+		mrc, err := r.configClient.ConfigV1alpha2().MeshRootCertificates(r.namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		ready := meta.FindStatusCondition(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionReady)
+		if ready == nil || ready.ObservedGeneration != mrc.Generation {
+			return false, nil
+		}
+		message = ready.Message
+		return ready.Status == metav1.ConditionTrue, nil
+	})
+	if errors.Is(err, wait.ErrWaitTimeout) {
+		return fmt.Errorf("Timed out waiting for MeshRootCertificate [%s] to be ready: %s", name, message)
+	}
+	return err
+}
@@ -0,0 +1,176 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/constants"
+	fakeConfigClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func newTestMRC(name string, intent v1alpha2.MeshRootCertificateIntent, ready bool) *v1alpha2.MeshRootCertificate {
+	mrc := &v1alpha2.MeshRootCertificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: testNamespace,
+		},
+		Spec: v1alpha2.MeshRootCertificateSpec{
+			Intent: intent,
+		},
+	}
+	if ready {
+		meta.SetStatusCondition(&mrc.Status.Conditions, metav1.Condition{
+			Type:    v1alpha2.MeshRootCertificateConditionReady,
+			Status:  metav1.ConditionTrue,
+			Reason:  "IntentApplied",
+			Message: "intent applied",
+		})
+	}
+	return mrc
+}
+
+// newTestMRCWithState returns a ready MRC without intent, its intent is derived from its state
+func newTestMRCWithState(name string, state string) *v1alpha2.MeshRootCertificate {
+	mrc := newTestMRC(name, "", true)
+	mrc.Status.State = state
+	return mrc
+}
+
+func TestCertificateRotate(t *testing.T) {
+	tests := []struct {
+		name          string
+		mrcs          []runtime.Object
+		applyIntents  bool
+		newMRCName    string
+		expectErr     bool
+		expectIntents map[string]v1alpha2.MeshRootCertificateIntent
+	}{
+		{
+			name: "rotate to the new MRC",
+			mrcs: []runtime.Object{
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentActive, true),
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentInactive, false),
+			},
+			applyIntents: true,
+			newMRCName:   "new",
+			expectIntents: map[string]v1alpha2.MeshRootCertificateIntent{
+				"old": v1alpha2.MeshRootCertificateIntentInactive,
+				"new": v1alpha2.MeshRootCertificateIntentActive,
+			},
+		},
+		{
+			name: "rotate from the MRC active by its state",
+			mrcs: []runtime.Object{
+				newTestMRCWithState("old", constants.MRCStateActive),
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentInactive, false),
+			},
+			applyIntents: true,
+			newMRCName:   "new",
+			expectIntents: map[string]v1alpha2.MeshRootCertificateIntent{
+				"old": v1alpha2.MeshRootCertificateIntentInactive,
+				"new": v1alpha2.MeshRootCertificateIntentActive,
+			},
+		},
+		{
+			name: "new MRC already active",
+			mrcs: []runtime.Object{
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentInactive, true),
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentActive, true),
+			},
+			newMRCName: "new",
+			expectIntents: map[string]v1alpha2.MeshRootCertificateIntent{
+				"old": v1alpha2.MeshRootCertificateIntentInactive,
+				"new": v1alpha2.MeshRootCertificateIntentActive,
+			},
+		},
+		{
+			name: "new MRC not found",
+			mrcs: []runtime.Object{
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentActive, true),
+			},
+			newMRCName: "new",
+			expectErr:  true,
+		},
+		{
+			name: "no active MRC",
+			mrcs: []runtime.Object{
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentPassive, true),
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentInactive, false),
+			},
+			newMRCName: "new",
+			expectErr:  true,
+		},
+		{
+			name: "control plane does not apply the intents",
+			mrcs: []runtime.Object{
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentActive, true),
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentInactive, false),
+			},
+			newMRCName: "new",
+			expectErr:  true,
+			expectIntents: map[string]v1alpha2.MeshRootCertificateIntent{
+				"old": v1alpha2.MeshRootCertificateIntentActive,
+				"new": v1alpha2.MeshRootCertificateIntentPassive,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			configClient := fakeConfigClientset.NewSimpleClientset(test.mrcs...)
+			if test.applyIntents {
+				// Mimic the control plane reporting the MRC as ready once its intent is updated
+				configClient.PrependReactor("update", "meshrootcertificates", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					mrc := action.(k8stesting.UpdateAction).GetObject().(*v1alpha2.MeshRootCertificate)
+					meta.SetStatusCondition(&mrc.Status.Conditions, metav1.Condition{
+						Type:   v1alpha2.MeshRootCertificateConditionReady,
+						Status: metav1.ConditionTrue,
+						Reason: "IntentApplied",
+					})
+					return false, nil, nil
+				})
+			} else {
+				// Mimic the control plane not having applied the intent yet
+				configClient.PrependReactor("update", "meshrootcertificates", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					mrc := action.(k8stesting.UpdateAction).GetObject().(*v1alpha2.MeshRootCertificate)
+					mrc.Status.Conditions = nil
+					return false, nil, nil
+				})
+			}
+
+			out := new(bytes.Buffer)
+			cmd := &certificateRotateCmd{
+				out:          out,
+				newMRCName:   test.newMRCName,
+				namespace:    testNamespace,
+				timeout:      100 * time.Millisecond,
+				pollInterval: 10 * time.Millisecond,
+				configClient: configClient,
+			}
+
+			err := cmd.run()
+			if test.expectErr {
+				assert.Error(err)
+			} else {
+				assert.NoError(err)
+			}
+
+			for name, intent := range test.expectIntents {
+				mrc, err := configClient.ConfigV1alpha2().MeshRootCertificates(testNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+				assert.NoError(err)
+				assert.Equal(intent, mrc.Spec.Intent, name)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	osmConfigClient "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+)
+
+const certificateStatusDescription = `
+This command lists the MeshRootCertificates of the mesh along with their intent
+and the status reported by the control plane. A MeshRootCertificate is ready
+once the control plane has applied its intent, and is issuing or validating
+certificates depending on its intent.
+`
+
+type certificateStatusCmd struct {
+	out          io.Writer
+	namespace    string
+	configClient osmConfigClient.Interface
+}
+
+func newCertificateStatusCmd(out io.Writer) *cobra.Command {
+	certificateStatus := &certificateStatusCmd{
+		out: out,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "show the status of the root certificates",
+		Long:  certificateStatusDescription,
+		Args:  cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			config, err := settings.RESTClientGetter().ToRESTConfig()
+			if err != nil {
+				return fmt.Errorf("Error fetching kubeconfig: %w", err)
+			}
+
+			configClient, err := osmConfigClient.NewForConfig(config)
+			if err != nil {
+				return fmt.Errorf("Could not access OSM config resources: %w", err)
+			}
+			certificateStatus.configClient = configClient
+			certificateStatus.namespace = settings.Namespace()
+			return certificateStatus.run()
+		},
+	}
+
+	return cmd
+}
+
+func (s *certificateStatusCmd) run() error {
+	mrcList, err := s.configClient.ConfigV1alpha2().MeshRootCertificates(s.namespace).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("Could not list MeshRootCertificates in namespace [%s]: %w", s.namespace, err)
+	}
+
+	if len(mrcList.Items) == 0 {
+		fmt.Fprintf(s.out, "No MeshRootCertificates in namespace [%s]\n", s.namespace)
+		return nil
+	}
+
+	w := newTabWriter(s.out)
+	fmt.Fprintln(w, "NAME\tINTENT\tREADY\tISSUING\tVALIDATING\tMESSAGE")
+	for i := range mrcList.Items {
+		mrc := &mrcList.Items[i]
+		message := "-"
+		if ready := meta.FindStatusCondition(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionReady); ready != nil {
+			message = ready.Message
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", mrc.Name, mrc.Spec.Intent,
+			getMRCConditionStatus(mrc, v1alpha2.MeshRootCertificateConditionReady),
+			getMRCConditionStatus(mrc, v1alpha2.MeshRootCertificateConditionIssuing),
+			getMRCConditionStatus(mrc, v1alpha2.MeshRootCertificateConditionValidating),
+			message)
+	}
+	_ = w.Flush()
+
+	return nil
+}
+
+// getMRCConditionStatus returns the status of the given condition, or '-' if the condition is
+// not reported for the current generation of the MRC.
+func getMRCConditionStatus(mrc *v1alpha2.MeshRootCertificate, conditionType string) string {
+	condition := meta.FindStatusCondition(mrc.Status.Conditions, conditionType)
+	if condition == nil || condition.ObservedGeneration != mrc.Generation {
+		return "-"
+	}
+	return string(condition.Status)
+}
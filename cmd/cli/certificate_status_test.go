@@ -0,0 +1,51 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	fakeConfigClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+)
+
+func TestCertificateStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		mrcs     []runtime.Object
+		expected string
+	}{
+		{
+			name:     "no MRCs",
+			expected: "No MeshRootCertificates in namespace [namespace]\n",
+		},
+		{
+			name: "MRCs with and without conditions",
+			mrcs: []runtime.Object{
+				newTestMRC("new", v1alpha2.MeshRootCertificateIntentPassive, false),
+				newTestMRC("old", v1alpha2.MeshRootCertificateIntentActive, true),
+			},
+			expected: "NAME   INTENT    READY   ISSUING   VALIDATING   MESSAGE\n" +
+				"new    passive   -       -         -            -\n" +
+				"old    active    True    -         -            intent applied\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			out := new(bytes.Buffer)
+			cmd := &certificateStatusCmd{
+				out:          out,
+				namespace:    testNamespace,
+				configClient: fakeConfigClientset.NewSimpleClientset(test.mrcs...),
+			}
+
+			assert.NoError(cmd.run())
+			assert.Equal(test.expected, out.String())
+		})
+	}
+}
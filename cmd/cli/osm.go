@@ -40,6 +40,7 @@ func newRootCmd(config *action.Configuration, stdin io.Reader, stdout io.Writer,
 		newMeshCmd(config, stdin, stdout),
 		newEnvCmd(stdout, stderr),
 		newNamespaceCmd(stdout),
+		newCertificateCmd(stdout),
 		newMetricsCmd(stdout),
 		newVersionCmd(stdout),
 		newProxyCmd(config, stdout),
@@ -289,11 +289,18 @@ func (d *uninstallMeshCmd) uninstallClusterResources() []string {
 func (d *uninstallMeshCmd) uninstallCustomResourceDefinitions() error {
 	crds := []string{
 		"egresses.policy.openservicemesh.io",
+		"externalservices.policy.openservicemesh.io",
 		"ingressbackends.policy.openservicemesh.io",
 		"meshconfigs.config.openservicemesh.io",
 		"meshRootCertificate.config.openservicemesh.io",
+		"meshconfigoverrides.config.openservicemesh.io",
+		"certificaterevocations.config.openservicemesh.io",
 		"upstreamtrafficsettings.policy.openservicemesh.io",
 		"retries.policy.openservicemesh.io",
+		"faultinjections.policy.openservicemesh.io",
+		"trafficmirrors.policy.openservicemesh.io",
+		"requestauthentications.policy.openservicemesh.io",
+		"authorizationpolicies.policy.openservicemesh.io",
 		"httproutegroups.specs.smi-spec.io",
 		"tcproutes.specs.smi-spec.io",
 		"trafficsplits.split.smi-spec.io",
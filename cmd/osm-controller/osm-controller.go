@@ -26,6 +26,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
+	gatewayAPIClientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
 
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 
@@ -41,11 +42,13 @@ import (
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/certificate/providers"
+	"github.com/openservicemesh/osm/pkg/certificate/revocation"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/debugger"
 	"github.com/openservicemesh/osm/pkg/endpoint"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/health"
 	"github.com/openservicemesh/osm/pkg/httpserver"
 	"github.com/openservicemesh/osm/pkg/ingress"
@@ -56,8 +59,10 @@ import (
 	"github.com/openservicemesh/osm/pkg/messaging"
 	"github.com/openservicemesh/osm/pkg/metricsstore"
 	"github.com/openservicemesh/osm/pkg/multicluster"
+	"github.com/openservicemesh/osm/pkg/multicluster/discovery"
 	"github.com/openservicemesh/osm/pkg/plugin"
 	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/providers/external"
 	"github.com/openservicemesh/osm/pkg/providers/fsm"
 	"github.com/openservicemesh/osm/pkg/providers/kube"
 	"github.com/openservicemesh/osm/pkg/reconciler"
@@ -87,14 +92,20 @@ var (
 
 	certProviderKind          string
 	enableMeshRootCertificate bool
+	spiffeEnabled             bool
 
 	tresorOptions      providers.TresorOptions
 	vaultOptions       providers.VaultOptions
 	certManagerOptions providers.CertManagerOptions
+	spireOptions       providers.SpireOptions
+	externalOptions    providers.ExternalOptions
 
 	enableReconciler      bool
 	validateTrafficTarget bool
 
+	enableMulticlusterDiscovery bool
+	multiclusterClusterKey      string
+
 	scheme = runtime.NewScheme()
 )
 
@@ -119,6 +130,7 @@ func init() {
 
 	// TODO (#4502): Remove when we add full MRC support
 	flags.StringVar(&trustDomain, "trust-domain", "cluster.local", "The trust domain to use as part of the common name when requesting new certificates")
+	flags.BoolVar(&spiffeEnabled, "spiffe-enabled", false, "Issue service certificates carrying the SPIFFE ID of their service identity when the MeshRootCertificate is not enabled")
 
 	// Vault certificate manager/provider options
 	flags.StringVar(&vaultOptions.VaultProtocol, "vault-protocol", "http", "Host name of the Hashi Vault")
@@ -134,10 +146,23 @@ func init() {
 	flags.StringVar(&certManagerOptions.IssuerKind, "cert-manager-issuer-kind", "Issuer", "cert-manager issuer kind")
 	flags.StringVar(&certManagerOptions.IssuerGroup, "cert-manager-issuer-group", "cert-manager.io", "cert-manager issuer group")
 
+	// SPIRE certificate manager/provider options
+	flags.StringVar(&spireOptions.ServerAddress, "spire-server-address", "spire-server.spire.svc.cluster.local:8081", "Address of the SPIRE server API")
+	flags.StringVar(&spireOptions.AgentSocketPath, "spire-agent-socket-path", "/run/spire/sockets/agent.sock", "Path of the SPIRE agent Workload API socket")
+	flags.StringVar(&spireOptions.ServerSpiffeID, "spire-server-spiffe-id", "", "SPIFFE ID of the SPIRE server, any SPIFFE ID in the trust domain is accepted when empty")
+
+	// External issuer certificate manager/provider options
+	flags.StringVar(&externalOptions.Address, "external-issuer-address", "unix:///var/run/osm/issuer.sock", "Address of the external issuer, either unix:///path/to/socket or host:port")
+	flags.StringToStringVar(&externalOptions.Parameters, "external-issuer-parameters", nil, "Parameters passed to the external issuer on each request, as key=value pairs")
+
 	// Reconciler options
 	flags.BoolVar(&enableReconciler, "enable-reconciler", false, "Enable reconciler for CDRs, mutating webhook and validating webhook")
 	flags.BoolVar(&validateTrafficTarget, "validate-traffic-target", true, "Enable traffic target validation")
 
+	// Multicluster options
+	flags.BoolVar(&enableMulticlusterDiscovery, "enable-multicluster-discovery", false, "Enable the import of the services exported by the peer clusters configured by Secrets in the OSM namespace")
+	flags.StringVar(&multiclusterClusterKey, "multicluster-cluster-key", "", "Key of the local cluster, matched against the target clusters of the services exported by the peer clusters")
+
 	_ = clientgoscheme.AddToScheme(scheme)
 	_ = admissionv1.AddToScheme(scheme)
 }
@@ -153,6 +178,10 @@ func getCertOptions() (providers.Options, error) {
 		return vaultOptions, nil
 	case providers.CertManagerKind:
 		return certManagerOptions, nil
+	case providers.SpireKind:
+		return spireOptions, nil
+	case providers.ExternalKind:
+		return externalOptions, nil
 	}
 	return nil, fmt.Errorf("unknown certificate provider kind: %s", certProviderKind)
 }
@@ -178,6 +207,8 @@ func main() {
 	configClient := configClientset.NewForConfigOrDie(kubeConfig)
 	multiclusterClient := multiclusterClientset.NewForConfigOrDie(kubeConfig)
 	networkingClient := networkingClientset.NewForConfigOrDie(kubeConfig)
+	gatewayAPIClient := gatewayAPIClientset.NewForConfigOrDie(kubeConfig)
+	clientset := extensionsClientset.NewForConfigOrDie(kubeConfig)
 
 	k8s.SetTrustDomain(trustDomain)
 
@@ -223,6 +254,7 @@ func main() {
 		informers.WithPluginClient(pluginClient),
 		informers.WithMultiClusterClient(multiclusterClient),
 		informers.WithNetworkingClient(networkingClient),
+		informers.WithGatewayAPIClient(gatewayAPIClient, getInstalledGatewayAPIRoutes(clientset)...),
 	)
 	if err != nil {
 		events.GenericEventRecorder().FatalEvent(err, events.InitializationError, "Error creating informer collection")
@@ -248,14 +280,14 @@ func main() {
 	var certManager *certificate.Manager
 	if enableMeshRootCertificate {
 		certManager, err = providers.NewCertificateManagerFromMRC(ctx, kubeClient, kubeConfig, cfg, osmNamespace,
-			certOpts, msgBroker, informerCollection, 5*time.Second)
+			certOpts, msgBroker, informerCollection, configClient, 5*time.Second)
 		if err != nil {
 			events.GenericEventRecorder().FatalEvent(err, events.InvalidCertificateManager,
 				"Error fetching certificate manager of kind %s from MRC", certProviderKind)
 		}
 	} else {
 		certManager, err = providers.NewCertificateManager(ctx, kubeClient, kubeConfig, cfg, osmNamespace,
-			certOpts, msgBroker, 5*time.Second, trustDomain)
+			certOpts, msgBroker, 5*time.Second, trustDomain, spiffeEnabled)
 		if err != nil {
 			events.GenericEventRecorder().FatalEvent(err, events.InvalidCertificateManager,
 				"Error fetching certificate manager of kind %s", certProviderKind)
@@ -265,12 +297,17 @@ func main() {
 	policyController := policy.NewPolicyController(informerCollection, kubeClient, k8sClient, msgBroker)
 	pluginController := plugin.NewPluginController(informerCollection, kubeClient, k8sClient, msgBroker)
 	multiclusterController := multicluster.NewMultiClusterController(informerCollection, kubeClient, k8sClient, msgBroker)
+	if enableMulticlusterDiscovery {
+		go discovery.NewController(kubeClient, multiclusterClient, osmNamespace, multiclusterClusterKey, nil).Run(stop)
+	}
+	gatewayAPIController := gatewayapi.NewGatewayAPIController(informerCollection, k8sClient, msgBroker)
 
 	kubeProvider := kube.NewClient(k8sClient, cfg)
 	multiclusterProvider := fsm.NewClient(multiclusterController, cfg)
+	externalProvider := external.NewClient(policyController, msgBroker, stop)
 
-	endpointsProviders := []endpoint.Provider{kubeProvider, multiclusterProvider}
-	serviceProviders := []service.Provider{kubeProvider, multiclusterProvider}
+	endpointsProviders := []endpoint.Provider{kubeProvider, multiclusterProvider, externalProvider}
+	serviceProviders := []service.Provider{kubeProvider, multiclusterProvider, externalProvider}
 
 	if err := ingress.Initialize(kubeClient, k8sClient, stop, cfg, certManager, msgBroker); err != nil {
 		events.GenericEventRecorder().FatalEvent(err, events.InitializationError, "Error creating Ingress client")
@@ -283,6 +320,7 @@ func main() {
 		policyController,
 		pluginController,
 		multiclusterController,
+		gatewayAPIController,
 		stop,
 		cfg,
 		serviceProviders,
@@ -308,8 +346,6 @@ func main() {
 		events.GenericEventRecorder().FatalEvent(err, events.InitializationError, "Error initializing proxy control server")
 	}
 
-	clientset := extensionsClientset.NewForConfigOrDie(kubeConfig)
-
 	if err = validator.NewValidatingWebhook(ctx, cfg, validatorWebhookConfigName, osmNamespace, osmVersion, meshName, enableReconciler, validateTrafficTarget, certManager, kubeClient, k8sClient, policyController); err != nil {
 		events.GenericEventRecorder().FatalEvent(err, events.InitializationError, "Error starting the validating webhook server")
 	}
@@ -328,6 +364,8 @@ func main() {
 	httpServer.AddHandler(constants.MetricsPath, metricsstore.DefaultMetricsStore.Handler())
 	// Version
 	httpServer.AddHandler(constants.VersionPath, version.GetVersionHandler())
+	// Expose the SPIFFE trust bundle of the mesh so that workloads outside of the mesh can trust its identities
+	httpServer.AddHandler(constants.OSMControllerSpiffeBundlePath, certManager.SpiffeBundleHandler())
 	// Supported SMI Versions
 	httpServer.AddHandler(constants.OSMControllerSMIVersionPath, smi.GetSmiClientVersionHTTPHandler())
 
@@ -346,6 +384,8 @@ func main() {
 	go k8s.WatchAndUpdateProxyBootstrapSecret(kubeClient, msgBroker, stop)
 	// Start the global log level watcher that updates the log level dynamically
 	go k8s.WatchAndUpdateLogLevel(msgBroker, stop)
+	// Start the certificate revocation watcher that revokes and reissues the compromised certificates
+	go revocation.WatchAndRevokeCertificates(certManager, configClient, msgBroker, stop)
 
 	if enableReconciler {
 		log.Info().Msgf("OSM reconciler enabled for validating webhook")
@@ -369,6 +409,8 @@ func startMetricsStore() {
 		metricsstore.DefaultMetricsStore.ProxyReconnectCount,
 		metricsstore.DefaultMetricsStore.ProxyConfigUpdateTime,
 		metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount,
+		metricsstore.DefaultMetricsStore.ProxyTargetedEventCount,
+		metricsstore.DefaultMetricsStore.ProxyUpdateFanOutRatio,
 		metricsstore.DefaultMetricsStore.ProxyResponseSendSuccessCount,
 		metricsstore.DefaultMetricsStore.ProxyResponseSendErrorCount,
 		metricsstore.DefaultMetricsStore.ErrCodeCounter,
@@ -415,3 +457,26 @@ func getOSMControllerPod(kubeClient kubernetes.Interface) (*corev1.Pod, error) {
 
 	return pod, nil
 }
+
+// getInstalledGatewayAPIRoutes returns the informer keys of the Gateway API route resources whose CRDs are installed
+// in the cluster. The Gateway API CRDs are not installed by OSM, and a resource is only watched if its CRD exists.
+func getInstalledGatewayAPIRoutes(clientset extensionsClientset.Interface) []informers.InformerKey {
+	crds := []struct {
+		name string
+		key  informers.InformerKey
+	}{
+		{name: "httproutes.gateway.networking.k8s.io", key: informers.InformerKeyGatewayAPIHTTPRoute},
+		{name: "grpcroutes.gateway.networking.k8s.io", key: informers.InformerKeyGatewayAPIGRPCRoute},
+		{name: "tcproutes.gateway.networking.k8s.io", key: informers.InformerKeyGatewayAPITCPRoute},
+	}
+
+	var keys []informers.InformerKey
+	for _, crd := range crds {
+		if _, err := clientset.ApiextensionsV1().CustomResourceDefinitions().Get(context.Background(), crd.name, metav1.GetOptions{}); err != nil {
+			log.Info().Msgf("CRD %s not found, Gateway API routes of this kind will be ignored: %s", crd.name, err)
+			continue
+		}
+		keys = append(keys, crd.key)
+	}
+	return keys
+}
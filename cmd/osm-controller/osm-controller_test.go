@@ -4,6 +4,11 @@ import (
 	"testing"
 
 	tassert "github.com/stretchr/testify/assert"
+	apiv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiservertestclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
 )
 
 func TestJoinURL(t *testing.T) {
@@ -25,3 +30,17 @@ func TestJoinURL(t *testing.T) {
 		assert.Equal(result, ju.expectedOutput)
 	}
 }
+
+func TestGetInstalledGatewayAPIRoutes(t *testing.T) {
+	assert := tassert.New(t)
+
+	clientset := apiservertestclient.NewSimpleClientset()
+	assert.Empty(getInstalledGatewayAPIRoutes(clientset))
+
+	clientset = apiservertestclient.NewSimpleClientset(
+		&apiv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "httproutes.gateway.networking.k8s.io"}},
+		&apiv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "tcproutes.gateway.networking.k8s.io"}},
+	)
+	assert.Equal([]informers.InformerKey{informers.InformerKeyGatewayAPIHTTPRoute, informers.InformerKeyGatewayAPITCPRoute},
+		getInstalledGatewayAPIRoutes(clientset))
+}
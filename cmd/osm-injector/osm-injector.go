@@ -65,12 +65,15 @@ var (
 
 	certProviderKind          string
 	enableMeshRootCertificate bool
+	spiffeEnabled             bool
 
 	enableReconciler bool
 
 	tresorOptions      providers.TresorOptions
 	vaultOptions       providers.VaultOptions
 	certManagerOptions providers.CertManagerOptions
+	spireOptions       providers.SpireOptions
+	externalOptions    providers.ExternalOptions
 
 	osmContainerPullPolicy string
 
@@ -99,6 +102,7 @@ func init() {
 
 	// TODO (#4502): Remove when we add full MRC support
 	flags.StringVar(&trustDomain, "trust-domain", "cluster.local", "The trust domain to use as part of the common name when requesting new certificates")
+	flags.BoolVar(&spiffeEnabled, "spiffe-enabled", false, "Issue service certificates carrying the SPIFFE ID of their service identity when the MeshRootCertificate is not enabled")
 
 	// Vault certificate manager/provider options
 	flags.StringVar(&vaultOptions.VaultProtocol, "vault-protocol", "http", "Host name of the Hashi Vault")
@@ -114,6 +118,15 @@ func init() {
 	flags.StringVar(&certManagerOptions.IssuerKind, "cert-manager-issuer-kind", "Issuer", "cert-manager issuer kind")
 	flags.StringVar(&certManagerOptions.IssuerGroup, "cert-manager-issuer-group", "cert-manager.io", "cert-manager issuer group")
 
+	// SPIRE certificate manager/provider options
+	flags.StringVar(&spireOptions.ServerAddress, "spire-server-address", "spire-server.spire.svc.cluster.local:8081", "Address of the SPIRE server API")
+	flags.StringVar(&spireOptions.AgentSocketPath, "spire-agent-socket-path", "/run/spire/sockets/agent.sock", "Path of the SPIRE agent Workload API socket")
+	flags.StringVar(&spireOptions.ServerSpiffeID, "spire-server-spiffe-id", "", "SPIFFE ID of the SPIRE server, any SPIFFE ID in the trust domain is accepted when empty")
+
+	// External issuer certificate manager/provider options
+	flags.StringVar(&externalOptions.Address, "external-issuer-address", "unix:///var/run/osm/issuer.sock", "Address of the external issuer, either unix:///path/to/socket or host:port")
+	flags.StringToStringVar(&externalOptions.Parameters, "external-issuer-parameters", nil, "Parameters passed to the external issuer on each request, as key=value pairs")
+
 	// Reconciler options
 	flags.BoolVar(&enableReconciler, "enable-reconciler", false, "Enable reconciler for CDRs, mutating webhook and validating webhook")
 
@@ -134,6 +147,10 @@ func getCertOptions() (providers.Options, error) {
 		return vaultOptions, nil
 	case providers.CertManagerKind:
 		return certManagerOptions, nil
+	case providers.SpireKind:
+		return spireOptions, nil
+	case providers.ExternalKind:
+		return externalOptions, nil
 	}
 	return nil, fmt.Errorf("unknown certificate provider kind: %s", certProviderKind)
 }
@@ -224,14 +241,14 @@ func main() {
 	var certManager *certificate.Manager
 	if enableMeshRootCertificate {
 		certManager, err = providers.NewCertificateManagerFromMRC(ctx, kubeClient, kubeConfig, cfg, osmNamespace,
-			certOpts, msgBroker, informerCollection, 5*time.Second)
+			certOpts, msgBroker, informerCollection, nil, 5*time.Second)
 		if err != nil {
 			events.GenericEventRecorder().FatalEvent(err, events.InvalidCertificateManager,
 				"Error initializing certificate manager of kind %s from MRC", certProviderKind)
 		}
 	} else {
 		certManager, err = providers.NewCertificateManager(ctx, kubeClient, kubeConfig, cfg, osmNamespace,
-			certOpts, msgBroker, 5*time.Second, trustDomain)
+			certOpts, msgBroker, 5*time.Second, trustDomain, spiffeEnabled)
 		if err != nil {
 			events.GenericEventRecorder().FatalEvent(err, events.InvalidCertificateManager,
 				"Error initializing certificate manager of kind %s", certProviderKind)
@@ -0,0 +1,89 @@
+// package main implements a reference external issuer, to which OSM delegates the signing of its certificates
+// when configured with the external certificate provider.
+package main
+
+import (
+	"flag"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+	externalv1 "github.com/openservicemesh/osm/pkg/certificate/providers/external/api/v1"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/external/signer"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/tresor"
+	"github.com/openservicemesh/osm/pkg/logger"
+)
+
+const (
+	unixAddressPrefix = "unix://"
+)
+
+var (
+	log        = logger.NewPretty("external-issuer")
+	logLevel   = flag.String("logLevel", "info", "Log output level")
+	address    = flag.String("address", "unix:///var/run/osm/issuer.sock", "Address to serve the external issuer on, either unix:///<path> or <host>:<port>")
+	caCertFile = flag.String("ca-cert-file", "", "PEM encoded root certificate signing the certificates, a new root certificate is generated when empty")
+	caKeyFile  = flag.String("ca-key-file", "", "PEM encoded private key of the root certificate")
+)
+
+func main() {
+	flag.Parse()
+	err := logger.SetLogLevel(*logLevel)
+	if err != nil {
+		log.Fatal().Msgf("Unknown log level: %s", *logLevel)
+	}
+
+	ca, err := getCA()
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error loading the root certificate")
+	}
+
+	s, err := signer.New(ca)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Error creating the signer")
+	}
+
+	network, listenAddr := "tcp", *address
+	if strings.HasPrefix(listenAddr, unixAddressPrefix) {
+		network, listenAddr = "unix", strings.TrimPrefix(listenAddr, unixAddressPrefix)
+		_ = os.Remove(listenAddr)
+	}
+	listener, err := net.Listen(network, listenAddr)
+	if err != nil {
+		log.Fatal().Err(err).Msgf("Error creating listener on address %q", *address)
+	}
+
+	grpcServer := grpc.NewServer()
+	externalv1.RegisterIssuerServer(grpcServer, s)
+	log.Info().Msgf("External issuer listening on address %q", *address)
+	if err := grpcServer.Serve(listener); err != nil {
+		log.Fatal().Err(err).Msg("Error serving the external issuer")
+	}
+}
+
+// getCA returns the root certificate signing the certificates
+func getCA() (*certificate.Certificate, error) {
+	if *caCertFile == "" {
+		log.Warn().Msg("No root certificate provided, generating a new one")
+		return tresor.NewCA("osm-external-issuer", 10*365*24*time.Hour, "US", "CA", "Open Service Mesh External Issuer")
+	}
+
+	certPEM, err := os.ReadFile(*caCertFile)
+	if err != nil {
+		return nil, err
+	}
+	keyPEM, err := os.ReadFile(*caKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &certificate.Certificate{
+		CertChain:  pem.Certificate(certPEM),
+		PrivateKey: pem.PrivateKey(keyPEM),
+		IssuingCA:  pem.RootCertificate(certPEM),
+	}, nil
+}
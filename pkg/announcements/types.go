@@ -13,6 +13,10 @@ const (
 	// ProxyUpdate is the event kind used to trigger an update to subscribed proxies
 	ProxyUpdate Kind = "proxy-update"
 
+	// ProxyTargetedUpdate is the event kind used to trigger an update to the subset of proxies whose
+	// configuration depends on the resources that changed
+	ProxyTargetedUpdate Kind = "proxy-targeted-update"
+
 	// PodAdded is the type of announcement emitted when we observe an addition of a Kubernetes Pod
 	PodAdded Kind = "pod-added"
 
@@ -68,6 +72,17 @@ const (
 
 	// ---
 
+	// SecretAdded is the type of announcement emitted when we observe an addition of a Kubernetes Secret
+	SecretAdded Kind = "secret-added"
+
+	// SecretDeleted the type of announcement emitted when we observe the deletion of a Kubernetes Secret
+	SecretDeleted Kind = "secret-deleted"
+
+	// SecretUpdated is the type of announcement emitted when we observe an update to a Kubernetes Secret
+	SecretUpdated Kind = "secret-updated"
+
+	// ---
+
 	// TrafficSplitAdded is the type of announcement emitted when we observe an addition of a Kubernetes TrafficSplit
 	TrafficSplitAdded Kind = "trafficsplit-added"
 
@@ -101,6 +116,39 @@ const (
 
 	// ---
 
+	// GatewayAPIHTTPRouteAdded is the type of announcement emitted when we observe an addition of a Gateway API HTTPRoute
+	GatewayAPIHTTPRouteAdded Kind = "gatewayapi-httproute-added"
+
+	// GatewayAPIHTTPRouteDeleted the type of announcement emitted when we observe the deletion of a Gateway API HTTPRoute
+	GatewayAPIHTTPRouteDeleted Kind = "gatewayapi-httproute-deleted"
+
+	// GatewayAPIHTTPRouteUpdated is the type of announcement emitted when we observe an update to a Gateway API HTTPRoute
+	GatewayAPIHTTPRouteUpdated Kind = "gatewayapi-httproute-updated"
+
+	// ---
+
+	// GatewayAPIGRPCRouteAdded is the type of announcement emitted when we observe an addition of a Gateway API GRPCRoute
+	GatewayAPIGRPCRouteAdded Kind = "gatewayapi-grpcroute-added"
+
+	// GatewayAPIGRPCRouteDeleted the type of announcement emitted when we observe the deletion of a Gateway API GRPCRoute
+	GatewayAPIGRPCRouteDeleted Kind = "gatewayapi-grpcroute-deleted"
+
+	// GatewayAPIGRPCRouteUpdated is the type of announcement emitted when we observe an update to a Gateway API GRPCRoute
+	GatewayAPIGRPCRouteUpdated Kind = "gatewayapi-grpcroute-updated"
+
+	// ---
+
+	// GatewayAPITCPRouteAdded is the type of announcement emitted when we observe an addition of a Gateway API TCPRoute
+	GatewayAPITCPRouteAdded Kind = "gatewayapi-tcproute-added"
+
+	// GatewayAPITCPRouteDeleted the type of announcement emitted when we observe the deletion of a Gateway API TCPRoute
+	GatewayAPITCPRouteDeleted Kind = "gatewayapi-tcproute-deleted"
+
+	// GatewayAPITCPRouteUpdated is the type of announcement emitted when we observe an update to a Gateway API TCPRoute
+	GatewayAPITCPRouteUpdated Kind = "gatewayapi-tcproute-updated"
+
+	// ---
+
 	// TrafficTargetAdded is the type of announcement emitted when we observe an addition of a Kubernetes TrafficTarget
 	TrafficTargetAdded Kind = "traffictarget-added"
 
@@ -157,6 +205,24 @@ const (
 	// MeshRootCertificateUpdated is the type of announcement emitted when we observe an update to a Kubernetes MeshRootCertificate
 	MeshRootCertificateUpdated Kind = "meshrootcertificate-updated"
 
+	// MeshConfigOverrideAdded is the type of announcement emitted when we observe an addition of a Kubernetes MeshConfigOverride
+	MeshConfigOverrideAdded Kind = "meshconfigoverride-added"
+
+	// MeshConfigOverrideDeleted the type of announcement emitted when we observe the deletion of a Kubernetes MeshConfigOverride
+	MeshConfigOverrideDeleted Kind = "meshconfigoverride-deleted"
+
+	// MeshConfigOverrideUpdated is the type of announcement emitted when we observe an update to a Kubernetes MeshConfigOverride
+	MeshConfigOverrideUpdated Kind = "meshconfigoverride-updated"
+
+	// CertificateRevocationAdded is the type of announcement emitted when we observe an addition of a Kubernetes CertificateRevocation
+	CertificateRevocationAdded Kind = "certificaterevocation-added"
+
+	// CertificateRevocationDeleted the type of announcement emitted when we observe the deletion of a Kubernetes CertificateRevocation
+	CertificateRevocationDeleted Kind = "certificaterevocation-deleted"
+
+	// CertificateRevocationUpdated is the type of announcement emitted when we observe an update to a Kubernetes CertificateRevocation
+	CertificateRevocationUpdated Kind = "certificaterevocation-updated"
+
 	// --- policy.openservicemesh.io API events
 
 	// EgressAdded is the type of announcement emitted when we observe an addition of egresses.policy.openservicemesh.io
@@ -177,6 +243,15 @@ const (
 	// EgressGatewayUpdated is the type of announcement emitted when we observe an update to egressgateways.policy.openservicemesh.io
 	EgressGatewayUpdated Kind = "egressgateway-updated"
 
+	// ExternalServiceAdded is the type of announcement emitted when we observe an addition of externalservices.policy.openservicemesh.io
+	ExternalServiceAdded Kind = "externalservice-added"
+
+	// ExternalServiceDeleted the type of announcement emitted when we observe a deletion of externalservices.policy.openservicemesh.io
+	ExternalServiceDeleted Kind = "externalservice-deleted"
+
+	// ExternalServiceUpdated is the type of announcement emitted when we observe an update to externalservices.policy.openservicemesh.io
+	ExternalServiceUpdated Kind = "externalservice-updated"
+
 	// IngressBackendAdded is the type of announcement emitted when we observe an addition of ingressbackends.policy.openservicemesh.io
 	IngressBackendAdded Kind = "ingressbackend-added"
 
@@ -240,6 +315,42 @@ const (
 	// RetryPolicyUpdated is the type of announcement emitted when we observe an update to retries.policy.openservicemesh.io
 	RetryPolicyUpdated Kind = "retry-updated"
 
+	// FaultInjectionAdded is the type of announcement emitted when we observe an addition of faultinjections.policy.openservicemesh.io
+	FaultInjectionAdded Kind = "faultinjection-added"
+
+	// FaultInjectionDeleted the type of announcement emitted when we observe a deletion of faultinjections.policy.openservicemesh.io
+	FaultInjectionDeleted Kind = "faultinjection-deleted"
+
+	// FaultInjectionUpdated is the type of announcement emitted when we observe an update to faultinjections.policy.openservicemesh.io
+	FaultInjectionUpdated Kind = "faultinjection-updated"
+
+	// TrafficMirrorAdded is the type of announcement emitted when we observe an addition of trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorAdded Kind = "trafficmirror-added"
+
+	// TrafficMirrorDeleted the type of announcement emitted when we observe a deletion of trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorDeleted Kind = "trafficmirror-deleted"
+
+	// TrafficMirrorUpdated is the type of announcement emitted when we observe an update to trafficmirrors.policy.openservicemesh.io
+	TrafficMirrorUpdated Kind = "trafficmirror-updated"
+
+	// RequestAuthenticationAdded is the type of announcement emitted when we observe an addition of requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationAdded Kind = "requestauthentication-added"
+
+	// RequestAuthenticationDeleted the type of announcement emitted when we observe a deletion of requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationDeleted Kind = "requestauthentication-deleted"
+
+	// RequestAuthenticationUpdated is the type of announcement emitted when we observe an update to requestauthentications.policy.openservicemesh.io
+	RequestAuthenticationUpdated Kind = "requestauthentication-updated"
+
+	// AuthorizationPolicyAdded is the type of announcement emitted when we observe an addition of authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyAdded Kind = "authorizationpolicy-added"
+
+	// AuthorizationPolicyDeleted the type of announcement emitted when we observe a deletion of authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyDeleted Kind = "authorizationpolicy-deleted"
+
+	// AuthorizationPolicyUpdated is the type of announcement emitted when we observe an update to authorizationpolicies.policy.openservicemesh.io
+	AuthorizationPolicyUpdated Kind = "authorizationpolicy-updated"
+
 	// UpstreamTrafficSettingAdded is the type of announcement emitted when we observe an addition of upstreamtrafficsettings.policy.openservicemesh.io
 	UpstreamTrafficSettingAdded Kind = "upstreamtrafficsetting-added"
 
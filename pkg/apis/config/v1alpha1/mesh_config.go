@@ -129,6 +129,9 @@ type ObservabilitySpec struct {
 
 	// RemoteLogging defines OSM's remot logging configuration.
 	RemoteLogging RemoteLoggingSpec `json:"remoteLogging,omitempty"`
+
+	// AccessLogging defines the configuration of the access logs written by the sidecars.
+	AccessLogging AccessLoggingSpec `json:"accessLogging,omitempty"`
 }
 
 // TracingSpec is the type to represent OSM's tracing configuration.
@@ -147,8 +150,36 @@ type TracingSpec struct {
 
 	// SampledFraction defines the sampled fraction.
 	SampledFraction *float32 `json:"sampledFraction,omitempty"`
+
+	// Protocol defines the protocol used to export the spans to the collector. Acceptable values are [`zipkin`, `otlp-grpc`, `otlp-http`]. The default is `zipkin`
+	// Envoy sidecars only support `otlp-grpc` and Pipy sidecars only `otlp-http` to export over OTLP.
+	Protocol TelemetryProtocol `json:"protocol,omitempty"`
 }
 
+// TelemetryProtocol is a type alias representing the protocol used by the sidecars to export telemetry to a collector
+type TelemetryProtocol string
+
+const (
+	// TelemetryProtocolZipkin indicates that the spans are exported to a Zipkin collector as JSON over HTTP
+	TelemetryProtocolZipkin TelemetryProtocol = "zipkin"
+	// TelemetryProtocolHTTP indicates that the access logs are exported as JSON over HTTP
+	TelemetryProtocolHTTP TelemetryProtocol = "http"
+	// TelemetryProtocolOTLPGRPC indicates that the telemetry is exported to an OpenTelemetry collector using OTLP over gRPC
+	TelemetryProtocolOTLPGRPC TelemetryProtocol = "otlp-grpc"
+	// TelemetryProtocolOTLPHTTP indicates that the telemetry is exported to an OpenTelemetry collector using OTLP over HTTP
+	TelemetryProtocolOTLPHTTP TelemetryProtocol = "otlp-http"
+)
+
+// AccessLogFormat is a type alias representing the format of the access logs written by the sidecars
+type AccessLogFormat string
+
+const (
+	// AccessLogFormatJSON indicates that the access logs are written as JSON objects
+	AccessLogFormatJSON AccessLogFormat = "json"
+	// AccessLogFormatText indicates that the access logs are written as plain text lines
+	AccessLogFormatText AccessLogFormat = "text"
+)
+
 // RemoteLoggingSpec is the type to represent OSM's remote logging configuration.
 type RemoteLoggingSpec struct {
 	// Enable defines a boolean indicating if the sidecars are enabled for remote logging.
@@ -171,6 +202,50 @@ type RemoteLoggingSpec struct {
 
 	// SampledFraction defines the sampled fraction.
 	SampledFraction *float32 `json:"sampledFraction,omitempty"`
+
+	// Protocol defines the protocol used to export the access logs to the collector. Acceptable values are [`http`, `otlp-grpc`, `otlp-http`]. The default is `http`
+	// Envoy sidecars only support `otlp-grpc` and Pipy sidecars only `otlp-http` to export over OTLP.
+	Protocol TelemetryProtocol `json:"protocol,omitempty"`
+}
+
+// AccessLoggingSpec is the type to represent the configuration of the access logs written by the sidecars.
+type AccessLoggingSpec struct {
+	// Inbound defines a boolean indicating if the sidecars write access logs for the inbound traffic. The default is true.
+	// +optional
+	Inbound *bool `json:"inbound,omitempty"`
+
+	// Outbound defines a boolean indicating if the sidecars write access logs for the outbound traffic. The default is true.
+	// +optional
+	Outbound *bool `json:"outbound,omitempty"`
+
+	// Format defines the format of the access logs. Acceptable values are [`json`, `text`]. The default is `json`
+	// +optional
+	Format AccessLogFormat `json:"format,omitempty"`
+
+	// TextFormat defines the format string of the access logs in the `text` format, using the Envoy command operators.
+	// The default is the Envoy default access log format.
+	// +optional
+	TextFormat string `json:"textFormat,omitempty"`
+
+	// Fields defines additional fields of the access logs in the `json` format, mapped to their value using the Envoy
+	// command operators. A field overrides the default field with the same name.
+	// +optional
+	Fields map[string]string `json:"fields,omitempty"`
+
+	// Filter defines the requests the access logs are written for. All the requests are logged by default.
+	// +optional
+	Filter AccessLogFilterSpec `json:"filter,omitempty"`
+}
+
+// AccessLogFilterSpec is the type to represent the filters applied to the access logs.
+type AccessLogFilterSpec struct {
+	// MinStatusCode defines the minimum response status code of the requests that are logged.
+	// +optional
+	MinStatusCode *uint32 `json:"minStatusCode,omitempty"`
+
+	// SampledFraction defines the fraction of the requests that are logged, between 0 and 1.
+	// +optional
+	SampledFraction *string `json:"sampledFraction,omitempty"`
 }
 
 // ExternalAuthzSpec is a type to represent external authorization configuration.
@@ -241,6 +316,9 @@ type FeatureFlags struct {
 	// EnableSnapshotCacheMode defines if XDS server starts with snapshot cache.
 	EnableSnapshotCacheMode bool `json:"enableSnapshotCacheMode"`
 
+	// EnableDeltaXDS defines if Sidecars are bootstrapped to use incremental (Delta) xDS.
+	EnableDeltaXDS bool `json:"enableDeltaXDS"`
+
 	//EnableAsyncProxyServiceMapping defines if OSM will map proxies to services asynchronously.
 	EnableAsyncProxyServiceMapping bool `json:"enableAsyncProxyServiceMapping"`
 
@@ -262,6 +340,21 @@ type FeatureFlags struct {
 	// EnableRetryPolicy defines if retry policy is enabled.
 	EnableRetryPolicy bool `json:"enableRetryPolicy"`
 
+	// EnableFaultInjectionPolicy defines if fault injection policy is enabled.
+	EnableFaultInjectionPolicy bool `json:"enableFaultInjectionPolicy"`
+
+	// EnableTrafficMirrorPolicy defines if traffic mirror policy is enabled.
+	EnableTrafficMirrorPolicy bool `json:"enableTrafficMirrorPolicy"`
+
+	// EnableRequestAuthenticationPolicy defines if request authentication policy is enabled.
+	EnableRequestAuthenticationPolicy bool `json:"enableRequestAuthenticationPolicy"`
+
+	// EnableAuthorizationPolicy defines if authorization policy is enabled.
+	EnableAuthorizationPolicy bool `json:"enableAuthorizationPolicy"`
+
+	// EnableGatewayAPI defines if Gateway API routes attached to services configure routing within the mesh.
+	EnableGatewayAPI bool `json:"enableGatewayAPI"`
+
 	// EnablePluginPolicy defines if plugin policy is enabled.
 	EnablePluginPolicy bool `json:"enablePluginPolicy"`
 }
@@ -22,6 +22,66 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFilterSpec) DeepCopyInto(out *AccessLogFilterSpec) {
+	*out = *in
+	if in.MinStatusCode != nil {
+		in, out := &in.MinStatusCode, &out.MinStatusCode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SampledFraction != nil {
+		in, out := &in.SampledFraction, &out.SampledFraction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFilterSpec.
+func (in *AccessLogFilterSpec) DeepCopy() *AccessLogFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLoggingSpec) DeepCopyInto(out *AccessLoggingSpec) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Filter.DeepCopyInto(&out.Filter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLoggingSpec.
+func (in *AccessLoggingSpec) DeepCopy() *AccessLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
@@ -223,6 +283,7 @@ func (in *ObservabilitySpec) DeepCopyInto(out *ObservabilitySpec) {
 	*out = *in
 	in.Tracing.DeepCopyInto(&out.Tracing)
 	in.RemoteLogging.DeepCopyInto(&out.RemoteLogging)
+	in.AccessLogging.DeepCopyInto(&out.AccessLogging)
 	return
 }
 
@@ -0,0 +1,83 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CertificateRevocation revokes the certificates issued by the mesh control plane for
+// compromised workloads. The revoked certificates are immediately reissued, and the
+// connections presenting them are rejected by the sidecars until they expire.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type CertificateRevocation struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the CertificateRevocation specification
+	// +optional
+	Spec CertificateRevocationSpec `json:"spec,omitempty"`
+
+	// Status of the CertificateRevocation resource
+	// +optional
+	Status CertificateRevocationStatus `json:"status,omitempty"`
+}
+
+// CertificateRevocationSpec defines the certificates to revoke. The certificates are
+// revoked when the resource is created and each time its spec changes.
+type CertificateRevocationSpec struct {
+	// SerialNumbers specifies the serial numbers of the certificates to revoke, as reported by the certificate provider.
+	// +optional
+	SerialNumbers []string `json:"serialNumbers,omitempty"`
+
+	// ServiceIdentities specifies the service identities whose certificates are revoked,
+	// in the <service-account>.<namespace> format.
+	// +optional
+	ServiceIdentities []string `json:"serviceIdentities,omitempty"`
+
+	// Reason specifies why the certificates are revoked.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// CertificateRevocationStatus defines the status of the CertificateRevocation resource
+type CertificateRevocationStatus struct {
+	// ObservedGeneration is the generation of the spec the certificates were last revoked for
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// RevokedCertificates lists the certificates revoked by the control plane, which are denied
+	// by the sidecars until they expire
+	// +optional
+	RevokedCertificates []RevokedCertificate `json:"revokedCertificates,omitempty"`
+}
+
+// RevokedCertificate describes a certificate revoked by the control plane
+type RevokedCertificate struct {
+	// SerialNumber is the serial number of the certificate
+	SerialNumber string `json:"serialNumber"`
+
+	// CommonName is the common name of the certificate
+	CommonName string `json:"commonName"`
+
+	// Fingerprint is the hex encoded SHA-256 digest of the DER encoded certificate
+	Fingerprint string `json:"fingerprint"`
+
+	// NotBefore is the time the certificate is valid from
+	NotBefore metav1.Time `json:"notBefore"`
+
+	// Expiration is the time the certificate expires, after which it is no longer denied
+	Expiration metav1.Time `json:"expiration"`
+}
+
+// CertificateRevocationList defines the list of CertificateRevocation objects
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type CertificateRevocationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CertificateRevocation `json:"items"`
+}
@@ -0,0 +1,99 @@
+package v1alpha2
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MeshConfigOverride overrides a subset of the MeshConfig settings for the pods
+// in its namespace, optionally narrowed down by a pod label selector.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MeshConfigOverride struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the MeshConfigOverride specification
+	// +optional
+	Spec MeshConfigOverrideSpec `json:"spec,omitempty"`
+}
+
+// MeshConfigOverrideSpec is the type used to represent the MeshConfigOverride specification.
+// Unset fields do not override the corresponding MeshConfig settings.
+type MeshConfigOverrideSpec struct {
+	// Selector selects the pods whose settings are overridden.
+	// If unspecified, the settings of all the pods in the namespace are overridden.
+	// When several overrides apply to a pod, the ones with a selector take precedence
+	// over the ones without, and ties are broken by the alphabetical order of their names,
+	// the last one taking precedence.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// Sidecar overrides the sidecar settings.
+	// +optional
+	Sidecar SidecarOverrideSpec `json:"sidecar,omitempty"`
+
+	// Traffic overrides the traffic settings.
+	// +optional
+	Traffic TrafficOverrideSpec `json:"traffic,omitempty"`
+
+	// Observability overrides the observability settings.
+	// +optional
+	Observability ObservabilityOverrideSpec `json:"observability,omitempty"`
+}
+
+// SidecarOverrideSpec is the type used to override the sidecar settings of a MeshConfig.
+type SidecarOverrideSpec struct {
+	// LogLevel overrides the sidecar log level.
+	// +optional
+	LogLevel string `json:"logLevel,omitempty"`
+
+	// SidecarTimeout overrides the sidecar connect/idle/read/write timeout, in seconds.
+	// +optional
+	SidecarTimeout *int `json:"sidecarTimeout,omitempty"`
+
+	// LocalProxyMode overrides the network interface the proxy uses to send traffic to the backend service application.
+	// +optional
+	LocalProxyMode LocalProxyMode `json:"localProxyMode,omitempty"`
+}
+
+// TrafficOverrideSpec is the type used to override the traffic settings of a MeshConfig.
+type TrafficOverrideSpec struct {
+	// HTTP1PerRequestLoadBalancing overrides whether load balancing based on request is enabled for http1.
+	// +optional
+	HTTP1PerRequestLoadBalancing *bool `json:"http1PerRequestLoadBalancing,omitempty"`
+
+	// HTTP2PerRequestLoadBalancing overrides whether load balancing based on request is enabled for http2.
+	// +optional
+	HTTP2PerRequestLoadBalancing *bool `json:"http2PerRequestLoadBalancing,omitempty"`
+}
+
+// ObservabilityOverrideSpec is the type used to override the observability settings of a MeshConfig.
+type ObservabilityOverrideSpec struct {
+	// Tracing overrides the tracing settings.
+	// +optional
+	Tracing TracingOverrideSpec `json:"tracing,omitempty"`
+
+	// AccessLogging overrides the access logging settings, replacing them as a whole.
+	// +optional
+	AccessLogging *AccessLoggingSpec `json:"accessLogging,omitempty"`
+}
+
+// TracingOverrideSpec is the type used to override the tracing settings of a MeshConfig.
+type TracingOverrideSpec struct {
+	// SampledFraction overrides the sampled fraction of the traces.
+	// +optional
+	SampledFraction *string `json:"sampledFraction,omitempty"`
+}
+
+// MeshConfigOverrideList lists the MeshConfigOverride objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type MeshConfigOverrideList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []MeshConfigOverride `json:"items"`
+}
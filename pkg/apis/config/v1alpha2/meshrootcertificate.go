@@ -33,8 +33,43 @@ type MeshRootCertificateSpec struct {
 
 	// TrustDomain is the trust domain to use as a suffix in Common Names for new certificates.
 	TrustDomain string `json:"trustDomain"`
+
+	// Intent specifies the role of the root certificate in the mesh: the active root certificate issues the
+	// certificates and is trusted, a passive one is only trusted and an inactive one is neither.
+	// Rotating the root certificate consists in adding the new one as passive, making it active and the
+	// old one passive, and eventually making the old one inactive.
+	// +optional
+	Intent MeshRootCertificateIntent `json:"intent,omitempty"`
+
+	// SpiffeEnabled specifies whether the service certificates carry the SPIFFE ID of their service identity,
+	// spiffe://<trust-domain>/ns/<namespace>/sa/<service-account>, as a URI SAN, in which case the SPIFFE IDs
+	// are used to authorize the traffic.
+	// +optional
+	SpiffeEnabled bool `json:"spiffeEnabled,omitempty"`
 }
 
+// MeshRootCertificateIntent is a type alias representing the intent of a MeshRootCertificate
+type MeshRootCertificateIntent string
+
+const (
+	// MeshRootCertificateIntentActive indicates that the root certificate issues the certificates and is trusted
+	MeshRootCertificateIntentActive MeshRootCertificateIntent = "active"
+	// MeshRootCertificateIntentPassive indicates that the root certificate is only trusted
+	MeshRootCertificateIntentPassive MeshRootCertificateIntent = "passive"
+	// MeshRootCertificateIntentInactive indicates that the root certificate is neither issuing certificates nor trusted
+	MeshRootCertificateIntentInactive MeshRootCertificateIntent = "inactive"
+)
+
+const (
+	// MeshRootCertificateConditionReady is the condition type indicating whether the intents of the
+	// MeshRootCertificates are applied by the control plane
+	MeshRootCertificateConditionReady = "Ready"
+	// MeshRootCertificateConditionIssuing is the condition type indicating whether the root certificate issues the certificates
+	MeshRootCertificateConditionIssuing = "Issuing"
+	// MeshRootCertificateConditionValidating is the condition type indicating whether the root certificate is trusted
+	MeshRootCertificateConditionValidating = "Validating"
+)
+
 // ProviderSpec defines the certificate provider used by the mesh control plane
 type ProviderSpec struct {
 	// CertManager specifies the cert-manager provider configuration
@@ -48,6 +83,14 @@ type ProviderSpec struct {
 	// Tresor specifies the Tresor provider configuration
 	// +optional
 	Tresor *TresorProviderSpec `json:"tresor,omitempty"`
+
+	// Spire specifies the SPIRE provider configuration
+	// +optional
+	Spire *SpireProviderSpec `json:"spire,omitempty"`
+
+	// External specifies the external issuer provider configuration
+	// +optional
+	External *ExternalProviderSpec `json:"external,omitempty"`
 }
 
 // CertManagerProviderSpec defines the configuration of the cert-manager provider
@@ -111,11 +154,43 @@ type TresorCASpec struct {
 	SecretRef corev1.SecretReference `json:"secretRef"`
 }
 
+// SpireProviderSpec defines the configuration of the SPIRE provider
+type SpireProviderSpec struct {
+	// ServerAddress specifies the address of the SPIRE server API, in the host:port format
+	ServerAddress string `json:"serverAddress"`
+
+	// AgentSocketPath specifies the path of the SPIRE agent Workload API socket, from which the mesh
+	// control plane obtains the X.509-SVID authenticating it to the SPIRE server
+	AgentSocketPath string `json:"agentSocketPath"`
+
+	// ServerSpiffeID specifies the SPIFFE ID of the SPIRE server. Any SPIFFE ID in the trust domain
+	// is accepted when unset.
+	// +optional
+	ServerSpiffeID string `json:"serverSpiffeID,omitempty"`
+}
+
+// ExternalProviderSpec defines the configuration of an external issuer, which signs the certificates
+// requested by the mesh control plane over the gRPC protocol defined in
+// pkg/certificate/providers/external/api/v1
+type ExternalProviderSpec struct {
+	// Address specifies the address of the external issuer, either unix:///path/to/socket or host:port
+	Address string `json:"address"`
+
+	// Parameters specifies the opaque parameters passed to the external issuer on each request,
+	// such as the ARN of an AWS ACM Private CA or the directory URL of an ACME server
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
 // MeshRootCertificateStatus defines the status of the MeshRootCertificate resource
 type MeshRootCertificateStatus struct {
 	// State specifies the state of the certificate provider
 	// All states are specified in constants.go
 	State string `json:"state"`
+
+	// Conditions specify the progress of the root certificate rotation, as reported by the control plane
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 // MeshRootCertificateList defines the list of MeshRootCertificate objects
@@ -41,6 +41,10 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&MeshConfigList{},
 		&MeshRootCertificate{},
 		&MeshRootCertificateList{},
+		&MeshConfigOverride{},
+		&MeshConfigOverrideList{},
+		&CertificateRevocation{},
+		&CertificateRevocationList{},
 	)
 
 	metav1.AddToGroupVersion(
@@ -19,9 +19,70 @@ limitations under the License.
 package v1alpha2
 
 import (
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogFilterSpec) DeepCopyInto(out *AccessLogFilterSpec) {
+	*out = *in
+	if in.MinStatusCode != nil {
+		in, out := &in.MinStatusCode, &out.MinStatusCode
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SampledFraction != nil {
+		in, out := &in.SampledFraction, &out.SampledFraction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogFilterSpec.
+func (in *AccessLogFilterSpec) DeepCopy() *AccessLogFilterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogFilterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLoggingSpec) DeepCopyInto(out *AccessLoggingSpec) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Fields != nil {
+		in, out := &in.Fields, &out.Fields
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Filter.DeepCopyInto(&out.Filter)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLoggingSpec.
+func (in *AccessLoggingSpec) DeepCopy() *AccessLoggingSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLoggingSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertManagerProviderSpec) DeepCopyInto(out *CertManagerProviderSpec) {
 	*out = *in
@@ -38,6 +99,116 @@ func (in *CertManagerProviderSpec) DeepCopy() *CertManagerProviderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRevocation) DeepCopyInto(out *CertificateRevocation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRevocation.
+func (in *CertificateRevocation) DeepCopy() *CertificateRevocation {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRevocation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRevocation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRevocationList) DeepCopyInto(out *CertificateRevocationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CertificateRevocation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRevocationList.
+func (in *CertificateRevocationList) DeepCopy() *CertificateRevocationList {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRevocationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CertificateRevocationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRevocationSpec) DeepCopyInto(out *CertificateRevocationSpec) {
+	*out = *in
+	if in.SerialNumbers != nil {
+		in, out := &in.SerialNumbers, &out.SerialNumbers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceIdentities != nil {
+		in, out := &in.ServiceIdentities, &out.ServiceIdentities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRevocationSpec.
+func (in *CertificateRevocationSpec) DeepCopy() *CertificateRevocationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRevocationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertificateRevocationStatus) DeepCopyInto(out *CertificateRevocationStatus) {
+	*out = *in
+	if in.RevokedCertificates != nil {
+		in, out := &in.RevokedCertificates, &out.RevokedCertificates
+		*out = make([]RevokedCertificate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertificateRevocationStatus.
+func (in *CertificateRevocationStatus) DeepCopy() *CertificateRevocationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CertificateRevocationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CertificateSpec) DeepCopyInto(out *CertificateSpec) {
 	*out = *in
@@ -112,6 +283,29 @@ func (in *ExternalAuthzSpec) DeepCopy() *ExternalAuthzSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalProviderSpec) DeepCopyInto(out *ExternalProviderSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalProviderSpec.
+func (in *ExternalProviderSpec) DeepCopy() *ExternalProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FeatureFlags) DeepCopyInto(out *FeatureFlags) {
 	*out = *in
@@ -226,6 +420,90 @@ func (in *MeshConfigList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshConfigOverride) DeepCopyInto(out *MeshConfigOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshConfigOverride.
+func (in *MeshConfigOverride) DeepCopy() *MeshConfigOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshConfigOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshConfigOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshConfigOverrideList) DeepCopyInto(out *MeshConfigOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MeshConfigOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshConfigOverrideList.
+func (in *MeshConfigOverrideList) DeepCopy() *MeshConfigOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshConfigOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MeshConfigOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MeshConfigOverrideSpec) DeepCopyInto(out *MeshConfigOverrideSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Sidecar.DeepCopyInto(&out.Sidecar)
+	in.Traffic.DeepCopyInto(&out.Traffic)
+	in.Observability.DeepCopyInto(&out.Observability)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MeshConfigOverrideSpec.
+func (in *MeshConfigOverrideSpec) DeepCopy() *MeshConfigOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MeshConfigOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshConfigSpec) DeepCopyInto(out *MeshConfigSpec) {
 	*out = *in
@@ -256,7 +534,7 @@ func (in *MeshRootCertificate) DeepCopyInto(out *MeshRootCertificate) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 	return
 }
 
@@ -331,6 +609,13 @@ func (in *MeshRootCertificateSpec) DeepCopy() *MeshRootCertificateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MeshRootCertificateStatus) DeepCopyInto(out *MeshRootCertificateStatus) {
 	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -344,11 +629,34 @@ func (in *MeshRootCertificateStatus) DeepCopy() *MeshRootCertificateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObservabilityOverrideSpec) DeepCopyInto(out *ObservabilityOverrideSpec) {
+	*out = *in
+	in.Tracing.DeepCopyInto(&out.Tracing)
+	if in.AccessLogging != nil {
+		in, out := &in.AccessLogging, &out.AccessLogging
+		*out = new(AccessLoggingSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObservabilityOverrideSpec.
+func (in *ObservabilityOverrideSpec) DeepCopy() *ObservabilityOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ObservabilityOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObservabilitySpec) DeepCopyInto(out *ObservabilitySpec) {
 	*out = *in
 	in.Tracing.DeepCopyInto(&out.Tracing)
 	in.RemoteLogging.DeepCopyInto(&out.RemoteLogging)
+	in.AccessLogging.DeepCopyInto(&out.AccessLogging)
 	return
 }
 
@@ -456,6 +764,16 @@ func (in *ProviderSpec) DeepCopyInto(out *ProviderSpec) {
 		*out = new(TresorProviderSpec)
 		**out = **in
 	}
+	if in.Spire != nil {
+		in, out := &in.Spire, &out.Spire
+		*out = new(SpireProviderSpec)
+		**out = **in
+	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(ExternalProviderSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -506,6 +824,24 @@ func (in *RepoServerSpec) DeepCopy() *RepoServerSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RevokedCertificate) DeepCopyInto(out *RevokedCertificate) {
+	*out = *in
+	in.NotBefore.DeepCopyInto(&out.NotBefore)
+	in.Expiration.DeepCopyInto(&out.Expiration)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RevokedCertificate.
+func (in *RevokedCertificate) DeepCopy() *RevokedCertificate {
+	if in == nil {
+		return nil
+	}
+	out := new(RevokedCertificate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretKeyReferenceSpec) DeepCopyInto(out *SecretKeyReferenceSpec) {
 	*out = *in
@@ -538,6 +874,27 @@ func (in *SidecarDriverSpec) DeepCopy() *SidecarDriverSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarOverrideSpec) DeepCopyInto(out *SidecarOverrideSpec) {
+	*out = *in
+	if in.SidecarTimeout != nil {
+		in, out := &in.SidecarTimeout, &out.SidecarTimeout
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarOverrideSpec.
+func (in *SidecarOverrideSpec) DeepCopy() *SidecarOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarSpec) DeepCopyInto(out *SidecarSpec) {
 	*out = *in
@@ -571,6 +928,27 @@ func (in *SidecarSpec) DeepCopy() *SidecarSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TracingOverrideSpec) DeepCopyInto(out *TracingOverrideSpec) {
+	*out = *in
+	if in.SampledFraction != nil {
+		in, out := &in.SampledFraction, &out.SampledFraction
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TracingOverrideSpec.
+func (in *TracingOverrideSpec) DeepCopy() *TracingOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TracingOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TracingSpec) DeepCopyInto(out *TracingSpec) {
 	*out = *in
@@ -592,6 +970,32 @@ func (in *TracingSpec) DeepCopy() *TracingSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficOverrideSpec) DeepCopyInto(out *TrafficOverrideSpec) {
+	*out = *in
+	if in.HTTP1PerRequestLoadBalancing != nil {
+		in, out := &in.HTTP1PerRequestLoadBalancing, &out.HTTP1PerRequestLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HTTP2PerRequestLoadBalancing != nil {
+		in, out := &in.HTTP2PerRequestLoadBalancing, &out.HTTP2PerRequestLoadBalancing
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficOverrideSpec.
+func (in *TrafficOverrideSpec) DeepCopy() *TrafficOverrideSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficOverrideSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TrafficSpec) DeepCopyInto(out *TrafficSpec) {
 	*out = *in
@@ -621,6 +1025,7 @@ func (in *TrafficSpec) DeepCopyInto(out *TrafficSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	in.UDPInterception.DeepCopyInto(&out.UDPInterception)
 	return
 }
 
@@ -634,6 +1039,22 @@ func (in *TrafficSpec) DeepCopy() *TrafficSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SpireProviderSpec) DeepCopyInto(out *SpireProviderSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SpireProviderSpec.
+func (in *SpireProviderSpec) DeepCopy() *SpireProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SpireProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TresorCASpec) DeepCopyInto(out *TresorCASpec) {
 	*out = *in
@@ -668,6 +1089,43 @@ func (in *TresorProviderSpec) DeepCopy() *TresorProviderSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPInterceptionSpec) DeepCopyInto(out *UDPInterceptionSpec) {
+	*out = *in
+	if in.PortPolicies != nil {
+		in, out := &in.PortPolicies, &out.PortPolicies
+		*out = make([]UDPPortPolicySpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPInterceptionSpec.
+func (in *UDPInterceptionSpec) DeepCopy() *UDPInterceptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPInterceptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UDPPortPolicySpec) DeepCopyInto(out *UDPPortPolicySpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UDPPortPolicySpec.
+func (in *UDPPortPolicySpec) DeepCopy() *UDPPortPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(UDPPortPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VaultProviderSpec) DeepCopyInto(out *VaultProviderSpec) {
 	*out = *in
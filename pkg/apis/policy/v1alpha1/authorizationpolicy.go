@@ -0,0 +1,178 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AuthorizationPolicy is the type used to represent an AuthorizationPolicy policy.
+// An AuthorizationPolicy policy allows, denies or audits the inbound traffic received by the
+// sidecars of a destination ServiceAccount, based on the source of the traffic and on the
+// operation performed by it. AuthorizationPolicy policies are evaluated in addition to the
+// SMI TrafficTarget policies: a request is denied if it matches a DENY policy, and when ALLOW
+// policies apply to a destination, a request must also match one of them.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AuthorizationPolicy struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the AuthorizationPolicy policy specification
+	// +optional
+	Spec AuthorizationPolicySpec `json:"spec,omitempty"`
+}
+
+// AuthorizationPolicyAction is the type used to represent the action of an AuthorizationPolicy policy.
+type AuthorizationPolicyAction string
+
+const (
+	// AuthorizationPolicyActionAllow allows the traffic matching the policy
+	AuthorizationPolicyActionAllow AuthorizationPolicyAction = "ALLOW"
+
+	// AuthorizationPolicyActionDeny denies the traffic matching the policy
+	AuthorizationPolicyActionDeny AuthorizationPolicyAction = "DENY"
+
+	// AuthorizationPolicyActionAudit logs the traffic matching the policy without affecting whether it is allowed
+	AuthorizationPolicyActionAudit AuthorizationPolicyAction = "AUDIT"
+)
+
+// AuthorizationPolicySpec is the type used to represent the AuthorizationPolicy policy specification.
+type AuthorizationPolicySpec struct {
+	// Destination defines the destination ServiceAccount whose inbound traffic is authorized.
+	// The destination must belong to the same namespace as the AuthorizationPolicy policy.
+	// If unspecified, the policy applies to all the ServiceAccounts in the namespace of the policy.
+	// +optional
+	Destination *AuthorizationPolicyDestinationSpec `json:"destination,omitempty"`
+
+	// Action defines the action applied to the traffic matching the policy, one of ALLOW, DENY or AUDIT.
+	// Defaults to ALLOW if unspecified.
+	// +optional
+	Action AuthorizationPolicyAction `json:"action,omitempty"`
+
+	// Rules defines the list of rules matched against the traffic.
+	// The policy matches the traffic if any of its rules matches it, and
+	// a policy without rules does not match any traffic.
+	// +optional
+	Rules []AuthorizationRuleSpec `json:"rules,omitempty"`
+}
+
+// AuthorizationPolicyDestinationSpec is the type used to represent the destination of an AuthorizationPolicy policy.
+type AuthorizationPolicyDestinationSpec struct {
+	// Kind defines the kind of the destination, must be ServiceAccount.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the destination.
+	Name string `json:"name"`
+}
+
+// AuthorizationRuleSpec is the type used to represent a rule of an AuthorizationPolicy policy.
+// A rule matches the traffic if any of its sources and any of its operations match the traffic.
+// An empty rule matches all the traffic.
+type AuthorizationRuleSpec struct {
+	// From defines the list of sources matched by the rule.
+	// If unspecified, the rule matches traffic from any source.
+	// +optional
+	From []AuthorizationSourceSpec `json:"from,omitempty"`
+
+	// To defines the list of operations matched by the rule.
+	// If unspecified, the rule matches any operation.
+	// +optional
+	To []AuthorizationOperationSpec `json:"to,omitempty"`
+}
+
+// AuthorizationSourceSpec is the type used to represent the source of the traffic matched by a rule.
+// A source matches the traffic if all of its specified fields match it, and a field
+// matches the traffic if any of its values matches it.
+type AuthorizationSourceSpec struct {
+	// ServiceAccounts defines the list of ServiceAccounts the traffic originates from.
+	// +optional
+	ServiceAccounts []AuthorizationServiceAccountSpec `json:"serviceAccounts,omitempty"`
+
+	// Namespaces defines the list of namespaces the traffic originates from.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// IPBlocks defines the list of source IP ranges of the traffic, in CIDR notation.
+	// +optional
+	IPBlocks []string `json:"ipBlocks,omitempty"`
+}
+
+// AuthorizationServiceAccountSpec is the type used to represent a ServiceAccount matched by a source.
+type AuthorizationServiceAccountSpec struct {
+	// Name defines the name of the ServiceAccount.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace of the ServiceAccount.
+	// Defaults to the namespace of the AuthorizationPolicy policy if unspecified.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// AuthorizationOperationSpec is the type used to represent the operation performed by the traffic matched by a rule.
+// An operation matches the traffic if all of its specified fields match it, and a field
+// matches the traffic if any of its values matches it.
+// Methods, Paths, GRPCMethods and Headers are HTTP conditions. For TCP traffic, an ALLOW rule is not matched
+// by an operation with HTTP conditions, while the HTTP conditions of a DENY rule are ignored.
+type AuthorizationOperationSpec struct {
+	// Ports defines the list of destination ports of the traffic.
+	// +optional
+	Ports []uint16 `json:"ports,omitempty"`
+
+	// ServerNames defines the list of server names (SNI) requested by the downstream during the TLS handshake.
+	// +optional
+	ServerNames []string `json:"serverNames,omitempty"`
+
+	// Methods defines the list of HTTP methods of the request.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+
+	// Paths defines the list of HTTP paths of the request.
+	// A path ending with '*' matches the paths starting with the preceding prefix,
+	// other paths are matched exactly.
+	// +optional
+	Paths []string `json:"paths,omitempty"`
+
+	// GRPCMethods defines the list of gRPC methods of the request.
+	// Paths and GRPCMethods are mutually exclusive.
+	// +optional
+	GRPCMethods []AuthorizationGRPCMethodSpec `json:"grpcMethods,omitempty"`
+
+	// Headers defines the list of HTTP headers the request must carry.
+	// +optional
+	Headers []AuthorizationHeaderSpec `json:"headers,omitempty"`
+}
+
+// AuthorizationGRPCMethodSpec is the type used to represent a gRPC method matched by an operation.
+// It is matched against the path '/<service>/<method>' of the request.
+type AuthorizationGRPCMethodSpec struct {
+	// Service defines the fully qualified name of the gRPC service, e.g. 'helloworld.Greeter'.
+	Service string `json:"service"`
+
+	// Method defines the name of the gRPC method.
+	// If unspecified, all the methods of the service are matched.
+	// +optional
+	Method string `json:"method,omitempty"`
+}
+
+// AuthorizationHeaderSpec is the type used to represent an HTTP header matched by an operation.
+type AuthorizationHeaderSpec struct {
+	// Name defines the name of the header.
+	Name string `json:"name"`
+
+	// Values defines the list of values matched exactly against the value of the header.
+	// If unspecified, the header only needs to be present.
+	// +optional
+	Values []string `json:"values,omitempty"`
+}
+
+// AuthorizationPolicyList defines the list of AuthorizationPolicy objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type AuthorizationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AuthorizationPolicy `json:"items"`
+}
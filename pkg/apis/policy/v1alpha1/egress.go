@@ -102,6 +102,51 @@ type PortSpec struct {
 
 	// Protocol defines the protocol served by the port.
 	Protocol string `json:"protocol"`
+
+	// TLS defines the TLS origination to the external hosts on this port, in which case the
+	// sidecar originates TLS to the hosts for the plain HTTP traffic sent by the sources.
+	// Only applicable to ports with the 'http' protocol.
+	// +optional
+	TLS *EgressTLSSpec `json:"tls,omitempty"`
+}
+
+// EgressTLSSpec is the type used to represent the TLS origination to the external hosts of an Egress policy.
+type EgressTLSSpec struct {
+	// TargetPort defines the port of the external hosts TLS is originated to.
+	// Defaults to the port number when unspecified.
+	// +optional
+	TargetPort int `json:"targetPort,omitempty"`
+
+	// SNI defines the server name indicated in the TLS handshake.
+	// Defaults to the host when unspecified.
+	// +optional
+	SNI string `json:"sni,omitempty"`
+
+	// MinProtocolVersion defines the minimum TLS protocol version used to originate TLS.
+	// Valid TLS protocol versions are TLS_AUTO, TLSv1_0, TLSv1_1, TLSv1_2 and TLSv1_3.
+	// +optional
+	MinProtocolVersion string `json:"minProtocolVersion,omitempty"`
+
+	// CASecret defines the secret whose 'ca.crt' key stores the CA bundle the certificates of
+	// the external hosts are validated against. The certificates are not validated when unspecified.
+	// The namespace of the secret defaults to the namespace of the Egress policy.
+	// Changes to the secret only update the proxies when the secret is labeled with
+	// 'openservicemesh.io/monitored-by: <mesh-name>'.
+	// +optional
+	CASecret *corev1.SecretReference `json:"caSecret,omitempty"`
+
+	// SubjectAltNames defines the Subject Alternative Names the certificates of the external hosts
+	// must match one of when they are validated. Defaults to the SNI when unspecified.
+	// +optional
+	SubjectAltNames []string `json:"subjectAltNames,omitempty"`
+
+	// ClientCertSecret defines the secret whose 'tls.crt' and 'tls.key' keys store the client
+	// certificate presented to the external hosts.
+	// The namespace of the secret defaults to the namespace of the Egress policy.
+	// Changes to the secret only update the proxies when the secret is labeled with
+	// 'openservicemesh.io/monitored-by: <mesh-name>'.
+	// +optional
+	ClientCertSecret *corev1.SecretReference `json:"clientCertSecret,omitempty"`
 }
 
 // EgressList defines the list of Egress objects.
@@ -0,0 +1,92 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ExternalService is the type used to represent a service external to the mesh.
+// An ExternalService declares the hostnames, ports and endpoints of an external
+// service, so that it can be accessed by applications in the mesh as any other
+// service in the mesh, with load balancing, retries, circuit breaking and metrics.
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ExternalService struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the ExternalService specification
+	// +optional
+	Spec ExternalServiceSpec `json:"spec,omitempty"`
+}
+
+// ExternalServiceSpec is the type used to represent the ExternalService specification.
+type ExternalServiceSpec struct {
+	// Hosts defines the list of hostnames the applications use to access the external service.
+	Hosts []string `json:"hosts"`
+
+	// Resolution defines how the endpoints of the external service are resolved.
+	// Defaults to DNS when unspecified.
+	// +optional
+	Resolution ExternalServiceResolution `json:"resolution,omitempty"`
+
+	// Ports defines the list of ports served by the external service.
+	Ports []ExternalServicePortSpec `json:"ports"`
+
+	// Endpoints defines the list of endpoints of the external service.
+	// With DNS resolution, the endpoint addresses are hostnames resolved using DNS
+	// and default to the hosts when unspecified.
+	// With Static resolution, the endpoint addresses are IP addresses and must be specified.
+	// +optional
+	Endpoints []ExternalServiceEndpointSpec `json:"endpoints,omitempty"`
+}
+
+// ExternalServiceResolution is the type used to represent how the endpoints of an external service are resolved.
+type ExternalServiceResolution string
+
+const (
+	// ExternalServiceResolutionDNS indicates the endpoint addresses are hostnames resolved using DNS
+	ExternalServiceResolutionDNS ExternalServiceResolution = "DNS"
+
+	// ExternalServiceResolutionStatic indicates the endpoint addresses are static IP addresses
+	ExternalServiceResolutionStatic ExternalServiceResolution = "Static"
+)
+
+// ExternalServicePortSpec is the type used to represent a port served by an external service.
+type ExternalServicePortSpec struct {
+	// Number defines the port number the applications use to access the external service.
+	Number int `json:"number"`
+
+	// TargetPort defines the port number of the endpoints of the external service.
+	// Defaults to the port number when unspecified.
+	// +optional
+	TargetPort int `json:"targetPort,omitempty"`
+
+	// Protocol defines the protocol served by the port.
+	// Must be one of: http, grpc, tcp, tcp-server-first
+	Protocol string `json:"protocol"`
+}
+
+// ExternalServiceEndpointSpec is the type used to represent an endpoint of an external service.
+type ExternalServiceEndpointSpec struct {
+	// Address defines the hostname or IP address of the endpoint.
+	Address string `json:"address"`
+
+	// Weight defines the load balancing weight of the endpoint.
+	// Only applicable to Static resolution, and defaults to 1 when unspecified.
+	// +optional
+	Weight int `json:"weight,omitempty"`
+}
+
+// ExternalServiceList defines the list of ExternalService objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ExternalServiceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []ExternalService `json:"items"`
+}
@@ -0,0 +1,127 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FaultInjection is the type used to represent a FaultInjection policy.
+// A FaultInjection policy injects delays and aborts into outbound HTTP traffic
+// from one or more service sources to a destination service.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FaultInjection struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the FaultInjection policy specification
+	// +optional
+	Spec FaultInjectionSpec `json:"spec,omitempty"`
+}
+
+// FaultInjectionSpec is the type used to represent the FaultInjection policy specification.
+type FaultInjectionSpec struct {
+	// Sources defines the list of sources the FaultInjection policy applies to.
+	// If unspecified, faults are injected into the traffic from all sources.
+	// +optional
+	Sources []FaultInjectionSrcDstSpec `json:"sources,omitempty"`
+
+	// Destination defines the destination the FaultInjection policy applies to.
+	Destination FaultInjectionSrcDstSpec `json:"destination"`
+
+	// Matches defines the list of HTTP request matches the faults are injected into.
+	// If unspecified, faults are injected into all HTTP requests to the destination.
+	// Policies with matches take precedence over the policies without matches for the
+	// matching requests, and inherit the delay or abort they do not specify from them.
+	// +optional
+	Matches []FaultInjectionHTTPMatchSpec `json:"matches,omitempty"`
+
+	// Delay defines the delay injected before forwarding requests to the destination.
+	// +optional
+	Delay *FaultDelaySpec `json:"delay,omitempty"`
+
+	// Abort defines the abort injected in place of forwarding requests to the destination.
+	// +optional
+	Abort *FaultAbortSpec `json:"abort,omitempty"`
+}
+
+// FaultInjectionSrcDstSpec is the type used to represent the Destination and the Sources
+// specified in the FaultInjection policy specification.
+type FaultInjectionSrcDstSpec struct {
+	// Kind defines the kind for the Src/Dst in the FaultInjection policy.
+	// Sources must be of kind ServiceAccount and the destination of kind Service.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the Src/Dst for the given Kind.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace for the given Src/Dst.
+	// Defaults to the namespace of the FaultInjection policy if unspecified.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+const (
+	// PathMatchTypeRegex matches the request path against a regular expression
+	PathMatchTypeRegex = "Regex"
+
+	// PathMatchTypePrefix matches the request path by prefix
+	PathMatchTypePrefix = "Prefix"
+
+	// PathMatchTypeExact matches the request path exactly
+	PathMatchTypeExact = "Exact"
+)
+
+// FaultInjectionHTTPMatchSpec is the type used to represent an HTTP request match
+// specified in the FaultInjection policy specification.
+type FaultInjectionHTTPMatchSpec struct {
+	// Path defines the path of the HTTP requests to match.
+	// If unspecified, requests for all paths are matched.
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// PathMatchType defines how Path is matched. Must be one of Regex, Prefix or Exact.
+	// Defaults to Regex if unspecified.
+	// +optional
+	PathMatchType string `json:"pathMatchType,omitempty"`
+
+	// Methods defines the list of HTTP methods to match.
+	// If unspecified, requests with any method are matched.
+	// +optional
+	Methods []string `json:"methods,omitempty"`
+
+	// Headers defines the HTTP headers to match, as a map of header names to regular expressions
+	// matching the header values.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// FaultDelaySpec is the type used to represent the delay specified in the FaultInjection policy specification.
+type FaultDelaySpec struct {
+	// Percentage defines the percentage of matching requests to delay, in the range 0-100.
+	Percentage uint32 `json:"percentage"`
+
+	// FixedDelay defines the duration requests are delayed by.
+	FixedDelay metav1.Duration `json:"fixedDelay"`
+}
+
+// FaultAbortSpec is the type used to represent the abort specified in the FaultInjection policy specification.
+type FaultAbortSpec struct {
+	// Percentage defines the percentage of matching requests to abort, in the range 0-100.
+	Percentage uint32 `json:"percentage"`
+
+	// HTTPStatus defines the HTTP status code returned for aborted requests.
+	HTTPStatus uint32 `json:"httpStatus"`
+}
+
+// FaultInjectionList defines the list of FaultInjection objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type FaultInjectionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []FaultInjection `json:"items"`
+}
@@ -41,6 +41,8 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&EgressList{},
 		&EgressGateway{},
 		&EgressGatewayList{},
+		&ExternalService{},
+		&ExternalServiceList{},
 		&IngressBackend{},
 		&IngressBackendList{},
 		&AccessControl{},
@@ -49,6 +51,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&AccessCertList{},
 		&Retry{},
 		&RetryList{},
+		&FaultInjection{},
+		&FaultInjectionList{},
+		&TrafficMirror{},
+		&TrafficMirrorList{},
+		&RequestAuthentication{},
+		&RequestAuthenticationList{},
+		&AuthorizationPolicy{},
+		&AuthorizationPolicyList{},
 		&UpstreamTrafficSetting{},
 		&UpstreamTrafficSettingList{},
 	)
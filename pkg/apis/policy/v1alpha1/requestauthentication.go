@@ -0,0 +1,128 @@
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// RequestAuthentication is the type used to represent a RequestAuthentication policy.
+// A RequestAuthentication policy requires the HTTP requests received by the inbound
+// sidecar of a destination service to carry a valid JSON Web Token (JWT), and optionally
+// authorizes the requests based on the claims in the token.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RequestAuthentication struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the RequestAuthentication policy specification
+	// +optional
+	Spec RequestAuthenticationSpec `json:"spec,omitempty"`
+}
+
+// RequestAuthenticationSpec is the type used to represent the RequestAuthentication policy specification.
+type RequestAuthenticationSpec struct {
+	// Destination defines the destination service whose inbound requests are authenticated.
+	// The destination service must belong to the same namespace as the RequestAuthentication policy.
+	Destination RequestAuthenticationDestinationSpec `json:"destination"`
+
+	// JWTRules defines the list of JWT issuers trusted by the destination.
+	// A request is authenticated when its token is validated by any of the rules.
+	JWTRules []JWTRuleSpec `json:"jwtRules"`
+
+	// Rules defines the list of claim based authorization rules.
+	// An authenticated request is authorized when it matches any of the rules.
+	// If unspecified, all the authenticated requests are authorized.
+	// +optional
+	Rules []JWTAuthorizationRuleSpec `json:"rules,omitempty"`
+}
+
+// RequestAuthenticationDestinationSpec is the type used to represent the destination
+// specified in the RequestAuthentication policy specification.
+type RequestAuthenticationDestinationSpec struct {
+	// Kind defines the kind of the destination, must be Service.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the destination service.
+	Name string `json:"name"`
+}
+
+// JWTRuleSpec is the type used to represent a JWT issuer specified in the
+// RequestAuthentication policy specification.
+type JWTRuleSpec struct {
+	// Issuer defines the expected value of the 'iss' claim of the token.
+	Issuer string `json:"issuer"`
+
+	// Audiences defines the list of accepted values of the 'aud' claim of the token.
+	// If unspecified, the audience of the token is not checked.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+
+	// JWKS defines where the JSON Web Key Set used to verify the token is fetched from.
+	JWKS JWKSSpec `json:"jwks"`
+
+	// ForwardOriginalToken defines whether the original token is forwarded to the destination.
+	// +optional
+	ForwardOriginalToken bool `json:"forwardOriginalToken,omitempty"`
+
+	// OutputClaimToHeaders defines the claims of the token copied to request headers
+	// forwarded to the destination.
+	// +optional
+	OutputClaimToHeaders []ClaimToHeaderSpec `json:"outputClaimToHeaders,omitempty"`
+}
+
+// JWKSSpec is the type used to represent the source of a JSON Web Key Set.
+// Exactly one of SecretRef or URI must be specified.
+type JWKSSpec struct {
+	// SecretRef defines the secret the JSON Web Key Set is stored in.
+	// +optional
+	SecretRef *corev1.SecretReference `json:"secretRef,omitempty"`
+
+	// SecretKey defines the key in the secret the JSON Web Key Set is stored under.
+	// Defaults to 'jwks' if unspecified.
+	// +optional
+	SecretKey string `json:"secretKey,omitempty"`
+
+	// URI defines the HTTP(S) URI the JSON Web Key Set is fetched from.
+	// +optional
+	URI string `json:"uri,omitempty"`
+}
+
+// ClaimToHeaderSpec is the type used to represent a claim copied to a request header.
+type ClaimToHeaderSpec struct {
+	// Claim defines the name of the claim.
+	Claim string `json:"claim"`
+
+	// Header defines the name of the request header the claim is copied to.
+	Header string `json:"header"`
+}
+
+// JWTAuthorizationRuleSpec is the type used to represent a claim based authorization rule.
+// A request matches the rule when it matches all of the claims in the rule.
+type JWTAuthorizationRuleSpec struct {
+	// Claims defines the list of claims the token must match.
+	Claims []JWTClaimMatchSpec `json:"claims"`
+}
+
+// JWTClaimMatchSpec is the type used to represent a claim matched against a list of values.
+type JWTClaimMatchSpec struct {
+	// Name defines the name of the claim.
+	Name string `json:"name"`
+
+	// Values defines the list of accepted values of the claim.
+	// A claim whose value is a list matches when any of its items is accepted.
+	Values []string `json:"values"`
+}
+
+// RequestAuthenticationList defines the list of RequestAuthentication objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type RequestAuthenticationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []RequestAuthentication `json:"items"`
+}
@@ -50,6 +50,8 @@ type RetrySrcDstSpec struct {
 // RetryPolicySpec is the type used to represent the retry policy specified in the Retry policy specification.
 type RetryPolicySpec struct {
 	// RetryOn defines the policies to retry on, delimited by comma.
+	// gRPC calls can be retried on the gRPC statuses cancelled, deadline-exceeded,
+	// internal, resource-exhausted and unavailable.
 	RetryOn string `json:"retryOn"`
 
 	// PerTryTimeout defines the time allowed for a retry before it's considered a failed attempt.
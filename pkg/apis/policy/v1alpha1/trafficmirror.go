@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TrafficMirror is the type used to represent a TrafficMirror policy.
+// A TrafficMirror policy mirrors a percentage of the HTTP requests from one or
+// more service sources to a destination service onto shadow backend services.
+// Responses from the shadow backends are discarded.
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TrafficMirror struct {
+	// Object's type metadata
+	metav1.TypeMeta `json:",inline"`
+
+	// Object's metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the TrafficMirror policy specification
+	// +optional
+	Spec TrafficMirrorSpec `json:"spec,omitempty"`
+}
+
+// TrafficMirrorSpec is the type used to represent the TrafficMirror policy specification.
+type TrafficMirrorSpec struct {
+	// Sources defines the list of sources the TrafficMirror policy applies to.
+	// If unspecified, the requests from all sources are mirrored.
+	// +optional
+	Sources []TrafficMirrorSrcDstSpec `json:"sources,omitempty"`
+
+	// Destination defines the destination service whose requests are mirrored.
+	// The destination may be the apex service of a TrafficSplit.
+	Destination TrafficMirrorSrcDstSpec `json:"destination"`
+
+	// Backends defines the list of shadow backends the requests are mirrored to.
+	Backends []TrafficMirrorBackendSpec `json:"backends"`
+}
+
+// TrafficMirrorSrcDstSpec is the type used to represent the Destination and the Sources
+// specified in the TrafficMirror policy specification.
+type TrafficMirrorSrcDstSpec struct {
+	// Kind defines the kind for the Src/Dst in the TrafficMirror policy.
+	// Sources must be of kind ServiceAccount and the destination of kind Service.
+	Kind string `json:"kind"`
+
+	// Name defines the name of the Src/Dst for the given Kind.
+	Name string `json:"name"`
+
+	// Namespace defines the namespace for the given Src/Dst.
+	// Defaults to the namespace of the TrafficMirror policy if unspecified.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// TrafficMirrorBackendSpec is the type used to represent a shadow backend
+// specified in the TrafficMirror policy specification.
+type TrafficMirrorBackendSpec struct {
+	// Service defines the name of the shadow backend service.
+	// The backend belongs to the same namespace as the destination service.
+	Service string `json:"service"`
+
+	// Percentage defines the percentage of requests mirrored to the backend, in the range 0-100.
+	Percentage uint32 `json:"percentage"`
+}
+
+// TrafficMirrorList defines the list of TrafficMirror objects.
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type TrafficMirrorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []TrafficMirror `json:"items"`
+}
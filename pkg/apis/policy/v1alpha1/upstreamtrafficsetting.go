@@ -39,6 +39,13 @@ type UpstreamTrafficSettingSpec struct {
 	// +optional
 	ConnectionSettings *ConnectionSettingsSpec `json:"connectionSettings,omitempty"`
 
+	// LoadBalancer specifies the load balancing settings used to
+	// select an upstream endpoint for the traffic directed to the
+	// upstream host.
+	// Defaults to round robin load balancing if not specified.
+	// +optional
+	LoadBalancer *LoadBalancerSpec `json:"loadBalancer,omitempty"`
+
 	// RateLimit specifies the rate limit settings for the traffic
 	// directed to the upstream host.
 	// If HTTP rate limiting is specified, the rate limiting is applied
@@ -65,6 +72,11 @@ type ConnectionSettingsSpec struct {
 	// HTTP specifies the HTTP level connection settings.
 	// +optional
 	HTTP *HTTPConnectionSettings `json:"http,omitempty"`
+
+	// OutlierDetection specifies the outlier detection settings used to
+	// passively eject failing upstream hosts from load balancing.
+	// +optional
+	OutlierDetection *OutlierDetectionSpec `json:"outlierDetection,omitempty"`
 }
 
 // TCPConnectionSettings defines the TCP connection settings for an
@@ -150,6 +162,109 @@ type HTTPConnectionSettings struct {
 	CircuitBreaking *HTTPCircuitBreaking `json:"circuitBreaking,omitempty"`
 }
 
+// OutlierDetectionSpec defines the outlier detection settings for an
+// upstream host.
+type OutlierDetectionSpec struct {
+	// ConsecutiveErrors specifies the number of consecutive errors
+	// (5xx responses or connection failures) before an upstream host
+	// is ejected from load balancing.
+	// Defaults to 5 if not specified.
+	// +optional
+	ConsecutiveErrors *uint32 `json:"consecutiveErrors,omitempty"`
+
+	// Interval specifies the time interval between ejection analysis sweeps.
+	// Defaults to 10s if not specified.
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty"`
+
+	// BaseEjectionTime specifies the base duration an upstream host is
+	// ejected for. The actual duration is the base ejection time multiplied
+	// by the number of times the host has been ejected.
+	// Defaults to 30s if not specified.
+	// +optional
+	BaseEjectionTime *metav1.Duration `json:"baseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent specifies the maximum percentage of upstream hosts
+	// that can be ejected at the same time.
+	// Defaults to 10 if not specified.
+	// +optional
+	MaxEjectionPercent *uint32 `json:"maxEjectionPercent,omitempty"`
+}
+
+// LoadBalancerType defines the type of load balancer used to select
+// an upstream endpoint.
+type LoadBalancerType string
+
+const (
+	// RoundRobinLoadBalancer selects the upstream endpoints in a weighted round robin order.
+	RoundRobinLoadBalancer LoadBalancerType = "RoundRobin"
+
+	// LeastRequestLoadBalancer selects the upstream endpoint with the fewest active requests.
+	LeastRequestLoadBalancer LoadBalancerType = "LeastRequest"
+
+	// RingHashLoadBalancer selects the upstream endpoint by consistent hashing on a ring.
+	RingHashLoadBalancer LoadBalancerType = "RingHash"
+
+	// MaglevLoadBalancer selects the upstream endpoint by consistent hashing using Maglev.
+	MaglevLoadBalancer LoadBalancerType = "Maglev"
+
+	// RandomLoadBalancer selects a random upstream endpoint.
+	RandomLoadBalancer LoadBalancerType = "Random"
+)
+
+// LoadBalancerSpec defines the load balancing settings for an
+// upstream host.
+type LoadBalancerSpec struct {
+	// Type specifies the type of load balancer.
+	// Defaults to RoundRobin if not specified, or to RingHash if
+	// SessionAffinity is specified.
+	// +optional
+	Type LoadBalancerType `json:"type,omitempty"`
+
+	// ConsistentHash specifies the key the requests are hashed on
+	// by the RingHash and Maglev load balancers.
+	// Requests are hashed on the source IP if not specified.
+	// +optional
+	ConsistentHash *ConsistentHashSpec `json:"consistentHash,omitempty"`
+
+	// SessionAffinity specifies the session affinity settings pinning
+	// an HTTP client to the same upstream endpoint via a cookie.
+	// Requires a consistent hashing load balancer type.
+	// +optional
+	SessionAffinity *SessionAffinitySpec `json:"sessionAffinity,omitempty"`
+}
+
+// ConsistentHashSpec defines the key the requests are hashed on.
+// Exactly one of the fields must be specified.
+type ConsistentHashSpec struct {
+	// Header specifies the name of the HTTP request header to hash on.
+	// +optional
+	Header string `json:"header,omitempty"`
+
+	// Cookie specifies the name of the HTTP cookie to hash on.
+	// +optional
+	Cookie string `json:"cookie,omitempty"`
+
+	// SourceIP specifies whether to hash on the source IP address.
+	// +optional
+	SourceIP bool `json:"sourceIP,omitempty"`
+}
+
+// SessionAffinitySpec defines the cookie based session affinity
+// settings for an upstream host.
+type SessionAffinitySpec struct {
+	// CookieName specifies the name of the cookie generated by the
+	// sidecar to pin the client to an upstream endpoint.
+	// Defaults to "osm-session-affinity" if not specified.
+	// +optional
+	CookieName string `json:"cookieName,omitempty"`
+
+	// TTL specifies the lifetime of the generated cookie.
+	// Defaults to 1h if not specified.
+	// +optional
+	TTL *metav1.Duration `json:"ttl,omitempty"`
+}
+
 // RateLimitSpec defines the rate limiting specification for
 // the upstream host.
 type RateLimitSpec struct {
@@ -160,6 +275,14 @@ type RateLimitSpec struct {
 	// This is applied as a token bucket rate limiter.
 	// +optional
 	Local *LocalRateLimitSpec `json:"local,omitempty"`
+
+	// Global specifies the global rate limiting specification
+	// for the upstream host.
+	// Global rate limiting is enforced by an external rate limiting
+	// service implementing the Envoy rate limit service (RLS) protocol,
+	// so that the limit is shared by all replicas of the upstream host.
+	// +optional
+	Global *GlobalRateLimitSpec `json:"global,omitempty"`
 }
 
 // LocalRateLimitSpec defines the local rate limiting specification
@@ -230,6 +353,168 @@ type HTTPLocalRateLimitSpec struct {
 	ResponseHeadersToAdd []HTTPHeaderValue `json:"responseHeadersToAdd,omitempty"`
 }
 
+// GlobalRateLimitSpec defines the global rate limiting specification
+// for the upstream host.
+type GlobalRateLimitSpec struct {
+	// TCP defines the global rate limiting specification at the network
+	// level. Each new connection results in a request to the rate limit
+	// service with the configured descriptors. If the rate limit service
+	// responds with an over limit decision, the connection is closed.
+	// +optional
+	TCP *TCPGlobalRateLimitSpec `json:"tcp,omitempty"`
+
+	// HTTP defines the global rate limiting specification for HTTP traffic.
+	// Each request results in a request to the rate limit service with
+	// the descriptors derived from the request. If the rate limit service
+	// responds with an over limit decision, the request will receive a
+	// 429 (Too Many Requests) response.
+	// +optional
+	HTTP *HTTPGlobalRateLimitSpec `json:"http,omitempty"`
+}
+
+// RateLimitServiceSpec defines the external rate limit service
+// used to enforce global rate limits.
+type RateLimitServiceSpec struct {
+	// Host defines the FQDN or IP address of the rate limit service.
+	Host string `json:"host"`
+
+	// Port defines the port of the gRPC endpoint of the rate limit service.
+	Port uint16 `json:"port"`
+
+	// JSONPort defines the port of the HTTP/JSON endpoint of the rate limit
+	// service. It is used by sidecars that do not speak the gRPC protocol
+	// natively. Defaults to Port if not specified.
+	// +optional
+	JSONPort uint16 `json:"jsonPort,omitempty"`
+
+	// Timeout defines the timeout for calls to the rate limit service.
+	// Defaults to 20ms if not specified.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// FailureModeDeny defines whether traffic should be denied when the
+	// rate limit service cannot be reached or returns an error.
+	// Defaults to false, i.e. traffic is allowed on failure.
+	// +optional
+	FailureModeDeny bool `json:"failureModeDeny,omitempty"`
+}
+
+// TCPGlobalRateLimitSpec defines the global rate limiting specification
+// for the upstream host at the TCP level.
+type TCPGlobalRateLimitSpec struct {
+	// RateLimitService defines the rate limit service to query.
+	RateLimitService RateLimitServiceSpec `json:"rateLimitService"`
+
+	// Domain defines the rate limit domain configured on the rate limit service.
+	Domain string `json:"domain"`
+
+	// Descriptors defines the list of descriptors sent to the rate limit
+	// service for each new connection.
+	Descriptors []TCPRateLimitDescriptor `json:"descriptors"`
+}
+
+// TCPRateLimitDescriptor defines a rate limit descriptor composed of
+// static key/value entries.
+type TCPRateLimitDescriptor struct {
+	// Entries defines the list of key/value entries of the descriptor.
+	Entries []TCPRateLimitDescriptorEntry `json:"entries"`
+}
+
+// TCPRateLimitDescriptorEntry defines a static key/value entry of a
+// rate limit descriptor.
+type TCPRateLimitDescriptorEntry struct {
+	// Key defines the key of the descriptor entry.
+	Key string `json:"key"`
+
+	// Value defines the value of the descriptor entry.
+	Value string `json:"value"`
+}
+
+// HTTPGlobalRateLimitSpec defines the global rate limiting specification
+// for the upstream host at the HTTP level.
+type HTTPGlobalRateLimitSpec struct {
+	// RateLimitService defines the rate limit service to query.
+	RateLimitService RateLimitServiceSpec `json:"rateLimitService"`
+
+	// Domain defines the rate limit domain configured on the rate limit service.
+	Domain string `json:"domain"`
+
+	// Descriptors defines the list of descriptors derived from each request
+	// to the upstream host and sent to the rate limit service.
+	// +optional
+	Descriptors []HTTPRateLimitDescriptor `json:"descriptors,omitempty"`
+}
+
+// HTTPRateLimitDescriptor defines a rate limit descriptor derived from
+// an HTTP request. A descriptor is only sent to the rate limit service
+// if all of its entries can be derived from the request.
+type HTTPRateLimitDescriptor struct {
+	// Entries defines the list of entries of the descriptor.
+	Entries []HTTPRateLimitDescriptorEntry `json:"entries"`
+}
+
+// HTTPRateLimitDescriptorEntry defines an entry of an HTTP rate limit
+// descriptor. Exactly one of the fields must be set.
+type HTTPRateLimitDescriptorEntry struct {
+	// GenericKey defines a static key/value entry.
+	// +optional
+	GenericKey *GenericKeyDescriptorEntry `json:"genericKey,omitempty"`
+
+	// RequestHeader defines an entry whose value is the value of the
+	// given request header. The entry is absent if the header is absent.
+	// +optional
+	RequestHeader *RequestHeaderDescriptorEntry `json:"requestHeader,omitempty"`
+
+	// RequestPath defines an entry whose value is the request path.
+	// +optional
+	RequestPath *RequestPathDescriptorEntry `json:"requestPath,omitempty"`
+
+	// SourceIdentity defines an entry whose value is the service identity
+	// of the downstream client, in the form <service-account>.<namespace>.
+	// The entry is absent if the downstream client is not part of the mesh.
+	// +optional
+	SourceIdentity *SourceIdentityDescriptorEntry `json:"sourceIdentity,omitempty"`
+}
+
+// GenericKeyDescriptorEntry defines a static key/value descriptor entry.
+type GenericKeyDescriptorEntry struct {
+	// Key defines the key of the descriptor entry.
+	// Defaults to "generic_key" if not specified.
+	// +optional
+	Key string `json:"key,omitempty"`
+
+	// Value defines the value of the descriptor entry.
+	Value string `json:"value"`
+}
+
+// RequestHeaderDescriptorEntry defines a descriptor entry derived
+// from a request header.
+type RequestHeaderDescriptorEntry struct {
+	// Name defines the name of the request header.
+	Name string `json:"name"`
+
+	// Key defines the key of the descriptor entry.
+	Key string `json:"key"`
+}
+
+// RequestPathDescriptorEntry defines a descriptor entry derived
+// from the request path.
+type RequestPathDescriptorEntry struct {
+	// Key defines the key of the descriptor entry.
+	// Defaults to "path" if not specified.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// SourceIdentityDescriptorEntry defines a descriptor entry derived
+// from the service identity of the downstream client.
+type SourceIdentityDescriptorEntry struct {
+	// Key defines the key of the descriptor entry.
+	// Defaults to "source_identity" if not specified.
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
 // HTTPHeaderValue defines an HTTP header name/value pair
 type HTTPHeaderValue struct {
 	// Name defines the name of the HTTP header.
@@ -247,6 +532,78 @@ type HTTPRouteSpec struct {
 	// RateLimit defines the HTTP rate limiting specification for
 	// the specified HTTP route.
 	RateLimit *HTTPPerRouteRateLimitSpec `json:"rateLimit,omitempty"`
+
+	// RequestHeaders defines the modifications of the headers of the
+	// requests matching the specified HTTP route, applied before
+	// forwarding them to the upstream host.
+	// +optional
+	RequestHeaders *HTTPHeaderModifierSpec `json:"requestHeaders,omitempty"`
+
+	// ResponseHeaders defines the modifications of the headers of the
+	// responses to the requests matching the specified HTTP route.
+	// +optional
+	ResponseHeaders *HTTPHeaderModifierSpec `json:"responseHeaders,omitempty"`
+
+	// Rewrite defines the rewrite of the path and Host header of the
+	// requests matching the specified HTTP route, applied before
+	// forwarding them to the upstream host.
+	// +optional
+	Rewrite *HTTPRewriteSpec `json:"rewrite,omitempty"`
+}
+
+// HTTPHeaderModifierSpec defines the modifications of HTTP headers
+type HTTPHeaderModifierSpec struct {
+	// Set defines the headers to set, overwriting their existing values.
+	// +optional
+	Set []HTTPHeaderValue `json:"set,omitempty"`
+
+	// Append defines the headers to append the values to, the headers
+	// being added if not present.
+	// +optional
+	Append []HTTPHeaderValue `json:"append,omitempty"`
+
+	// Remove defines the names of the headers to remove.
+	// +optional
+	Remove []string `json:"remove,omitempty"`
+}
+
+// HTTPRewriteSpec defines the rewrite of HTTP requests.
+// At most one of Prefix and Regex can be specified.
+type HTTPRewriteSpec struct {
+	// Prefix defines the rewrite of a path prefix.
+	// +optional
+	Prefix *HTTPPrefixRewriteSpec `json:"prefix,omitempty"`
+
+	// Regex defines the rewrite of the parts of the path matching a
+	// regular expression.
+	// +optional
+	Regex *HTTPRegexRewriteSpec `json:"regex,omitempty"`
+
+	// Host defines the value the Host header is rewritten to.
+	// +optional
+	Host string `json:"host,omitempty"`
+}
+
+// HTTPPrefixRewriteSpec defines the rewrite of a path prefix
+type HTTPPrefixRewriteSpec struct {
+	// Match defines the path prefix to replace. The path of the
+	// requests not starting with this prefix is not rewritten.
+	Match string `json:"match"`
+
+	// Replacement defines the value replacing the prefix.
+	Replacement string `json:"replacement"`
+}
+
+// HTTPRegexRewriteSpec defines the rewrite of the parts of a path
+// matching a regular expression
+type HTTPRegexRewriteSpec struct {
+	// Pattern defines the RE2 regular expression matching the parts
+	// of the path to replace.
+	Pattern string `json:"pattern"`
+
+	// Substitution defines the value replacing the matching parts of
+	// the path. Capture groups can be referred to as \1, \2, etc.
+	Substitution string `json:"substitution"`
 }
 
 // HTTPPerRouteRateLimitSpec defines the rate limiting specification
@@ -255,6 +612,21 @@ type HTTPPerRouteRateLimitSpec struct {
 	// Local defines the local rate limiting specification
 	// applied per HTTP route.
 	Local *HTTPLocalRateLimitSpec `json:"local,omitempty"`
+
+	// Global defines the global rate limiting specification
+	// applied per HTTP route. The rate limit service and domain
+	// are inherited from the host level global HTTP rate limiting
+	// specification, which must be set.
+	// +optional
+	Global *HTTPGlobalPerRouteRateLimitSpec `json:"global,omitempty"`
+}
+
+// HTTPGlobalPerRouteRateLimitSpec defines the global rate limiting
+// specification applied per HTTP route.
+type HTTPGlobalPerRouteRateLimitSpec struct {
+	// Descriptors defines the list of descriptors derived from each request
+	// matching the route and sent to the rate limit service.
+	Descriptors []HTTPRateLimitDescriptor `json:"descriptors"`
 }
 
 // UpstreamTrafficSettingStatus defines the status of an UpstreamTrafficSetting resource.
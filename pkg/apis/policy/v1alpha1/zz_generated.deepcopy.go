@@ -273,6 +273,272 @@ func (in *AccessControlStatus) DeepCopy() *AccessControlStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationGRPCMethodSpec) DeepCopyInto(out *AuthorizationGRPCMethodSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationGRPCMethodSpec.
+func (in *AuthorizationGRPCMethodSpec) DeepCopy() *AuthorizationGRPCMethodSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationGRPCMethodSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationHeaderSpec) DeepCopyInto(out *AuthorizationHeaderSpec) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationHeaderSpec.
+func (in *AuthorizationHeaderSpec) DeepCopy() *AuthorizationHeaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationHeaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationOperationSpec) DeepCopyInto(out *AuthorizationOperationSpec) {
+	*out = *in
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]uint16, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCMethods != nil {
+		in, out := &in.GRPCMethods, &out.GRPCMethods
+		*out = make([]AuthorizationGRPCMethodSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthorizationHeaderSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationOperationSpec.
+func (in *AuthorizationOperationSpec) DeepCopy() *AuthorizationOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicy) DeepCopyInto(out *AuthorizationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicy.
+func (in *AuthorizationPolicy) DeepCopy() *AuthorizationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicyDestinationSpec) DeepCopyInto(out *AuthorizationPolicyDestinationSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicyDestinationSpec.
+func (in *AuthorizationPolicyDestinationSpec) DeepCopy() *AuthorizationPolicyDestinationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicyDestinationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicyList) DeepCopyInto(out *AuthorizationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AuthorizationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicyList.
+func (in *AuthorizationPolicyList) DeepCopy() *AuthorizationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AuthorizationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationPolicySpec) DeepCopyInto(out *AuthorizationPolicySpec) {
+	*out = *in
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AuthorizationPolicyDestinationSpec)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AuthorizationRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationPolicySpec.
+func (in *AuthorizationPolicySpec) DeepCopy() *AuthorizationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationRuleSpec) DeepCopyInto(out *AuthorizationRuleSpec) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]AuthorizationSourceSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = make([]AuthorizationOperationSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRuleSpec.
+func (in *AuthorizationRuleSpec) DeepCopy() *AuthorizationRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationServiceAccountSpec) DeepCopyInto(out *AuthorizationServiceAccountSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationServiceAccountSpec.
+func (in *AuthorizationServiceAccountSpec) DeepCopy() *AuthorizationServiceAccountSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationServiceAccountSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationSourceSpec) DeepCopyInto(out *AuthorizationSourceSpec) {
+	*out = *in
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]AuthorizationServiceAccountSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.IPBlocks != nil {
+		in, out := &in.IPBlocks, &out.IPBlocks
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationSourceSpec.
+func (in *AuthorizationSourceSpec) DeepCopy() *AuthorizationSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BackendSpec) DeepCopyInto(out *BackendSpec) {
 	*out = *in
@@ -295,6 +561,22 @@ func (in *BackendSpec) DeepCopy() *BackendSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClaimToHeaderSpec) DeepCopyInto(out *ClaimToHeaderSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClaimToHeaderSpec.
+func (in *ClaimToHeaderSpec) DeepCopy() *ClaimToHeaderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClaimToHeaderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionSettingsSpec) DeepCopyInto(out *ConnectionSettingsSpec) {
 	*out = *in
@@ -308,6 +590,11 @@ func (in *ConnectionSettingsSpec) DeepCopyInto(out *ConnectionSettingsSpec) {
 		*out = new(HTTPConnectionSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OutlierDetection != nil {
+		in, out := &in.OutlierDetection, &out.OutlierDetection
+		*out = new(OutlierDetectionSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -321,6 +608,22 @@ func (in *ConnectionSettingsSpec) DeepCopy() *ConnectionSettingsSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConsistentHashSpec) DeepCopyInto(out *ConsistentHashSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConsistentHashSpec.
+func (in *ConsistentHashSpec) DeepCopy() *ConsistentHashSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ConsistentHashSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Egress) DeepCopyInto(out *Egress) {
 	*out = *in
@@ -609,7 +912,9 @@ func (in *EgressSpec) DeepCopyInto(out *EgressSpec) {
 	if in.Ports != nil {
 		in, out := &in.Ports, &out.Ports
 		*out = make([]PortSpec, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Matches != nil {
 		in, out := &in.Matches, &out.Matches
@@ -632,229 +937,213 @@ func (in *EgressSpec) DeepCopy() *EgressSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GatewayBindingSubject) DeepCopyInto(out *GatewayBindingSubject) {
+func (in *EgressTLSSpec) DeepCopyInto(out *EgressTLSSpec) {
 	*out = *in
-	if in.Weight != nil {
-		in, out := &in.Weight, &out.Weight
-		*out = new(int)
+	if in.CASecret != nil {
+		in, out := &in.CASecret, &out.CASecret
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	if in.SubjectAltNames != nil {
+		in, out := &in.SubjectAltNames, &out.SubjectAltNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClientCertSecret != nil {
+		in, out := &in.ClientCertSecret, &out.ClientCertSecret
+		*out = new(v1.SecretReference)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayBindingSubject.
-func (in *GatewayBindingSubject) DeepCopy() *GatewayBindingSubject {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EgressTLSSpec.
+func (in *EgressTLSSpec) DeepCopy() *EgressTLSSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(GatewayBindingSubject)
+	out := new(EgressTLSSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPCircuitBreaking) DeepCopyInto(out *HTTPCircuitBreaking) {
+func (in *ExternalService) DeepCopyInto(out *ExternalService) {
 	*out = *in
-	if in.StatTimeWindow != nil {
-		in, out := &in.StatTimeWindow, &out.StatTimeWindow
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.DegradedTimeWindow != nil {
-		in, out := &in.DegradedTimeWindow, &out.DegradedTimeWindow
-		*out = new(metav1.Duration)
-		**out = **in
-	}
-	if in.SlowTimeThreshold != nil {
-		in, out := &in.SlowTimeThreshold, &out.SlowTimeThreshold
-		*out = new(metav1.Duration)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalService.
+func (in *ExternalService) DeepCopy() *ExternalService {
+	if in == nil {
+		return nil
 	}
-	if in.SlowAmountThreshold != nil {
-		in, out := &in.SlowAmountThreshold, &out.SlowAmountThreshold
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.SlowRatioThreshold != nil {
-		in, out := &in.SlowRatioThreshold, &out.SlowRatioThreshold
-		*out = new(float32)
-		**out = **in
-	}
-	if in.ErrorAmountThreshold != nil {
-		in, out := &in.ErrorAmountThreshold, &out.ErrorAmountThreshold
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.ErrorRatioThreshold != nil {
-		in, out := &in.ErrorRatioThreshold, &out.ErrorRatioThreshold
-		*out = new(float32)
-		**out = **in
-	}
-	if in.DegradedStatusCode != nil {
-		in, out := &in.DegradedStatusCode, &out.DegradedStatusCode
-		*out = new(int32)
-		**out = **in
-	}
-	if in.DegradedResponseContent != nil {
-		in, out := &in.DegradedResponseContent, &out.DegradedResponseContent
-		*out = new(string)
-		**out = **in
+	out := new(ExternalService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalService) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalServiceEndpointSpec) DeepCopyInto(out *ExternalServiceEndpointSpec) {
+	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCircuitBreaking.
-func (in *HTTPCircuitBreaking) DeepCopy() *HTTPCircuitBreaking {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalServiceEndpointSpec.
+func (in *ExternalServiceEndpointSpec) DeepCopy() *ExternalServiceEndpointSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPCircuitBreaking)
+	out := new(ExternalServiceEndpointSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPConnectionSettings) DeepCopyInto(out *HTTPConnectionSettings) {
+func (in *ExternalServiceList) DeepCopyInto(out *ExternalServiceList) {
 	*out = *in
-	if in.MaxRequests != nil {
-		in, out := &in.MaxRequests, &out.MaxRequests
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MaxRequestsPerConnection != nil {
-		in, out := &in.MaxRequestsPerConnection, &out.MaxRequestsPerConnection
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MaxPendingRequests != nil {
-		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.MaxRetries != nil {
-		in, out := &in.MaxRetries, &out.MaxRetries
-		*out = new(uint32)
-		**out = **in
-	}
-	if in.CircuitBreaking != nil {
-		in, out := &in.CircuitBreaking, &out.CircuitBreaking
-		*out = new(HTTPCircuitBreaking)
-		(*in).DeepCopyInto(*out)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalService, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPConnectionSettings.
-func (in *HTTPConnectionSettings) DeepCopy() *HTTPConnectionSettings {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalServiceList.
+func (in *ExternalServiceList) DeepCopy() *ExternalServiceList {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPConnectionSettings)
+	out := new(ExternalServiceList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalServiceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPHeaderValue) DeepCopyInto(out *HTTPHeaderValue) {
+func (in *ExternalServicePortSpec) DeepCopyInto(out *ExternalServicePortSpec) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderValue.
-func (in *HTTPHeaderValue) DeepCopy() *HTTPHeaderValue {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalServicePortSpec.
+func (in *ExternalServicePortSpec) DeepCopy() *ExternalServicePortSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPHeaderValue)
+	out := new(ExternalServicePortSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPLocalRateLimitSpec) DeepCopyInto(out *HTTPLocalRateLimitSpec) {
+func (in *ExternalServiceSpec) DeepCopyInto(out *ExternalServiceSpec) {
 	*out = *in
-	if in.ResponseHeadersToAdd != nil {
-		in, out := &in.ResponseHeadersToAdd, &out.ResponseHeadersToAdd
-		*out = make([]HTTPHeaderValue, len(*in))
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ports != nil {
+		in, out := &in.Ports, &out.Ports
+		*out = make([]ExternalServicePortSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]ExternalServiceEndpointSpec, len(*in))
 		copy(*out, *in)
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLocalRateLimitSpec.
-func (in *HTTPLocalRateLimitSpec) DeepCopy() *HTTPLocalRateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalServiceSpec.
+func (in *ExternalServiceSpec) DeepCopy() *ExternalServiceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPLocalRateLimitSpec)
+	out := new(ExternalServiceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPPerRouteRateLimitSpec) DeepCopyInto(out *HTTPPerRouteRateLimitSpec) {
+func (in *FaultAbortSpec) DeepCopyInto(out *FaultAbortSpec) {
 	*out = *in
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
-		*out = new(HTTPLocalRateLimitSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPerRouteRateLimitSpec.
-func (in *HTTPPerRouteRateLimitSpec) DeepCopy() *HTTPPerRouteRateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultAbortSpec.
+func (in *FaultAbortSpec) DeepCopy() *FaultAbortSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPPerRouteRateLimitSpec)
+	out := new(FaultAbortSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
+func (in *FaultDelaySpec) DeepCopyInto(out *FaultDelaySpec) {
 	*out = *in
-	if in.RateLimit != nil {
-		in, out := &in.RateLimit, &out.RateLimit
-		*out = new(HTTPPerRouteRateLimitSpec)
-		(*in).DeepCopyInto(*out)
-	}
+	out.FixedDelay = in.FixedDelay
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
-func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultDelaySpec.
+func (in *FaultDelaySpec) DeepCopy() *FaultDelaySpec {
 	if in == nil {
 		return nil
 	}
-	out := new(HTTPRouteSpec)
+	out := new(FaultDelaySpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
+func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackend.
-func (in *IngressBackend) DeepCopy() *IngressBackend {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjection.
+func (in *FaultInjection) DeepCopy() *FaultInjection {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackend)
+	out := new(FaultInjection)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressBackend) DeepCopyObject() runtime.Object {
+func (in *FaultInjection) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -862,32 +1151,977 @@ func (in *IngressBackend) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendList) DeepCopyInto(out *IngressBackendList) {
+func (in *FaultInjectionHTTPMatchSpec) DeepCopyInto(out *FaultInjectionHTTPMatchSpec) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionHTTPMatchSpec.
+func (in *FaultInjectionHTTPMatchSpec) DeepCopy() *FaultInjectionHTTPMatchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionHTTPMatchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionList) DeepCopyInto(out *FaultInjectionList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]IngressBackend, len(*in))
+		*out = make([]FaultInjection, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionList.
+func (in *FaultInjectionList) DeepCopy() *FaultInjectionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FaultInjectionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionSpec) DeepCopyInto(out *FaultInjectionSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]FaultInjectionSrcDstSpec, len(*in))
+		copy(*out, *in)
+	}
+	out.Destination = in.Destination
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]FaultInjectionHTTPMatchSpec, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Delay != nil {
+		in, out := &in.Delay, &out.Delay
+		*out = new(FaultDelaySpec)
+		**out = **in
+	}
+	if in.Abort != nil {
+		in, out := &in.Abort, &out.Abort
+		*out = new(FaultAbortSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionSpec.
+func (in *FaultInjectionSpec) DeepCopy() *FaultInjectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FaultInjectionSrcDstSpec) DeepCopyInto(out *FaultInjectionSrcDstSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FaultInjectionSrcDstSpec.
+func (in *FaultInjectionSrcDstSpec) DeepCopy() *FaultInjectionSrcDstSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FaultInjectionSrcDstSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GatewayBindingSubject) DeepCopyInto(out *GatewayBindingSubject) {
+	*out = *in
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GatewayBindingSubject.
+func (in *GatewayBindingSubject) DeepCopy() *GatewayBindingSubject {
+	if in == nil {
+		return nil
+	}
+	out := new(GatewayBindingSubject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GenericKeyDescriptorEntry) DeepCopyInto(out *GenericKeyDescriptorEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GenericKeyDescriptorEntry.
+func (in *GenericKeyDescriptorEntry) DeepCopy() *GenericKeyDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(GenericKeyDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimitSpec) DeepCopyInto(out *GlobalRateLimitSpec) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPGlobalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPGlobalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimitSpec.
+func (in *GlobalRateLimitSpec) DeepCopy() *GlobalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCircuitBreaking) DeepCopyInto(out *HTTPCircuitBreaking) {
+	*out = *in
+	if in.StatTimeWindow != nil {
+		in, out := &in.StatTimeWindow, &out.StatTimeWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.DegradedTimeWindow != nil {
+		in, out := &in.DegradedTimeWindow, &out.DegradedTimeWindow
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SlowTimeThreshold != nil {
+		in, out := &in.SlowTimeThreshold, &out.SlowTimeThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.SlowAmountThreshold != nil {
+		in, out := &in.SlowAmountThreshold, &out.SlowAmountThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SlowRatioThreshold != nil {
+		in, out := &in.SlowRatioThreshold, &out.SlowRatioThreshold
+		*out = new(float32)
+		**out = **in
+	}
+	if in.ErrorAmountThreshold != nil {
+		in, out := &in.ErrorAmountThreshold, &out.ErrorAmountThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ErrorRatioThreshold != nil {
+		in, out := &in.ErrorRatioThreshold, &out.ErrorRatioThreshold
+		*out = new(float32)
+		**out = **in
+	}
+	if in.DegradedStatusCode != nil {
+		in, out := &in.DegradedStatusCode, &out.DegradedStatusCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DegradedResponseContent != nil {
+		in, out := &in.DegradedResponseContent, &out.DegradedResponseContent
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCircuitBreaking.
+func (in *HTTPCircuitBreaking) DeepCopy() *HTTPCircuitBreaking {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCircuitBreaking)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPConnectionSettings) DeepCopyInto(out *HTTPConnectionSettings) {
+	*out = *in
+	if in.MaxRequests != nil {
+		in, out := &in.MaxRequests, &out.MaxRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRequestsPerConnection != nil {
+		in, out := &in.MaxRequestsPerConnection, &out.MaxRequestsPerConnection
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxPendingRequests != nil {
+		in, out := &in.MaxPendingRequests, &out.MaxPendingRequests
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRetries != nil {
+		in, out := &in.MaxRetries, &out.MaxRetries
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.CircuitBreaking != nil {
+		in, out := &in.CircuitBreaking, &out.CircuitBreaking
+		*out = new(HTTPCircuitBreaking)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPConnectionSettings.
+func (in *HTTPConnectionSettings) DeepCopy() *HTTPConnectionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPConnectionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGlobalPerRouteRateLimitSpec) DeepCopyInto(out *HTTPGlobalPerRouteRateLimitSpec) {
+	*out = *in
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]HTTPRateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGlobalPerRouteRateLimitSpec.
+func (in *HTTPGlobalPerRouteRateLimitSpec) DeepCopy() *HTTPGlobalPerRouteRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGlobalPerRouteRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPGlobalRateLimitSpec) DeepCopyInto(out *HTTPGlobalRateLimitSpec) {
+	*out = *in
+	in.RateLimitService.DeepCopyInto(&out.RateLimitService)
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]HTTPRateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPGlobalRateLimitSpec.
+func (in *HTTPGlobalRateLimitSpec) DeepCopy() *HTTPGlobalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPGlobalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderModifierSpec) DeepCopyInto(out *HTTPHeaderModifierSpec) {
+	*out = *in
+	if in.Set != nil {
+		in, out := &in.Set, &out.Set
+		*out = make([]HTTPHeaderValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.Append != nil {
+		in, out := &in.Append, &out.Append
+		*out = make([]HTTPHeaderValue, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remove != nil {
+		in, out := &in.Remove, &out.Remove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderModifierSpec.
+func (in *HTTPHeaderModifierSpec) DeepCopy() *HTTPHeaderModifierSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderModifierSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHeaderValue) DeepCopyInto(out *HTTPHeaderValue) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHeaderValue.
+func (in *HTTPHeaderValue) DeepCopy() *HTTPHeaderValue {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHeaderValue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPLocalRateLimitSpec) DeepCopyInto(out *HTTPLocalRateLimitSpec) {
+	*out = *in
+	if in.ResponseHeadersToAdd != nil {
+		in, out := &in.ResponseHeadersToAdd, &out.ResponseHeadersToAdd
+		*out = make([]HTTPHeaderValue, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPLocalRateLimitSpec.
+func (in *HTTPLocalRateLimitSpec) DeepCopy() *HTTPLocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPLocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPerRouteRateLimitSpec) DeepCopyInto(out *HTTPPerRouteRateLimitSpec) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(HTTPLocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(HTTPGlobalPerRouteRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPerRouteRateLimitSpec.
+func (in *HTTPPerRouteRateLimitSpec) DeepCopy() *HTTPPerRouteRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPerRouteRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPrefixRewriteSpec) DeepCopyInto(out *HTTPPrefixRewriteSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPrefixRewriteSpec.
+func (in *HTTPPrefixRewriteSpec) DeepCopy() *HTTPPrefixRewriteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPrefixRewriteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRateLimitDescriptor) DeepCopyInto(out *HTTPRateLimitDescriptor) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]HTTPRateLimitDescriptorEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRateLimitDescriptor.
+func (in *HTTPRateLimitDescriptor) DeepCopy() *HTTPRateLimitDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRateLimitDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRateLimitDescriptorEntry) DeepCopyInto(out *HTTPRateLimitDescriptorEntry) {
+	*out = *in
+	if in.GenericKey != nil {
+		in, out := &in.GenericKey, &out.GenericKey
+		*out = new(GenericKeyDescriptorEntry)
+		**out = **in
+	}
+	if in.RequestHeader != nil {
+		in, out := &in.RequestHeader, &out.RequestHeader
+		*out = new(RequestHeaderDescriptorEntry)
+		**out = **in
+	}
+	if in.RequestPath != nil {
+		in, out := &in.RequestPath, &out.RequestPath
+		*out = new(RequestPathDescriptorEntry)
+		**out = **in
+	}
+	if in.SourceIdentity != nil {
+		in, out := &in.SourceIdentity, &out.SourceIdentity
+		*out = new(SourceIdentityDescriptorEntry)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRateLimitDescriptorEntry.
+func (in *HTTPRateLimitDescriptorEntry) DeepCopy() *HTTPRateLimitDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRateLimitDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRegexRewriteSpec) DeepCopyInto(out *HTTPRegexRewriteSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRegexRewriteSpec.
+func (in *HTTPRegexRewriteSpec) DeepCopy() *HTTPRegexRewriteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRegexRewriteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRewriteSpec) DeepCopyInto(out *HTTPRewriteSpec) {
+	*out = *in
+	if in.Prefix != nil {
+		in, out := &in.Prefix, &out.Prefix
+		*out = new(HTTPPrefixRewriteSpec)
+		**out = **in
+	}
+	if in.Regex != nil {
+		in, out := &in.Regex, &out.Regex
+		*out = new(HTTPRegexRewriteSpec)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRewriteSpec.
+func (in *HTTPRewriteSpec) DeepCopy() *HTTPRewriteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRewriteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRouteSpec) DeepCopyInto(out *HTTPRouteSpec) {
+	*out = *in
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(HTTPPerRouteRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestHeaders != nil {
+		in, out := &in.RequestHeaders, &out.RequestHeaders
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseHeaders != nil {
+		in, out := &in.ResponseHeaders, &out.ResponseHeaders
+		*out = new(HTTPHeaderModifierSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rewrite != nil {
+		in, out := &in.Rewrite, &out.Rewrite
+		*out = new(HTTPRewriteSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteSpec.
+func (in *HTTPRouteSpec) DeepCopy() *HTTPRouteSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRouteSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackend) DeepCopyInto(out *IngressBackend) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackend.
+func (in *IngressBackend) DeepCopy() *IngressBackend {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackend)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressBackend) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendList) DeepCopyInto(out *IngressBackendList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IngressBackend, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendList.
+func (in *IngressBackendList) DeepCopy() *IngressBackendList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressBackendList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendSpec) DeepCopyInto(out *IngressBackendSpec) {
+	*out = *in
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]BackendSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]IngressSourceSpec, len(*in))
+		copy(*out, *in)
+	}
+	if in.Matches != nil {
+		in, out := &in.Matches, &out.Matches
+		*out = make([]v1.TypedLocalObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendSpec.
+func (in *IngressBackendSpec) DeepCopy() *IngressBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressBackendStatus) DeepCopyInto(out *IngressBackendStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendStatus.
+func (in *IngressBackendStatus) DeepCopy() *IngressBackendStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressBackendStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSourceSpec) DeepCopyInto(out *IngressSourceSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSourceSpec.
+func (in *IngressSourceSpec) DeepCopy() *IngressSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWKSSpec) DeepCopyInto(out *JWKSSpec) {
+	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(v1.SecretReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWKSSpec.
+func (in *JWKSSpec) DeepCopy() *JWKSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWKSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTAuthorizationRuleSpec) DeepCopyInto(out *JWTAuthorizationRuleSpec) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]JWTClaimMatchSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTAuthorizationRuleSpec.
+func (in *JWTAuthorizationRuleSpec) DeepCopy() *JWTAuthorizationRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTAuthorizationRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimMatchSpec) DeepCopyInto(out *JWTClaimMatchSpec) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimMatchSpec.
+func (in *JWTClaimMatchSpec) DeepCopy() *JWTClaimMatchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimMatchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTRuleSpec) DeepCopyInto(out *JWTRuleSpec) {
+	*out = *in
+	if in.Audiences != nil {
+		in, out := &in.Audiences, &out.Audiences
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.JWKS.DeepCopyInto(&out.JWKS)
+	if in.OutputClaimToHeaders != nil {
+		in, out := &in.OutputClaimToHeaders, &out.OutputClaimToHeaders
+		*out = make([]ClaimToHeaderSpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRuleSpec.
+func (in *JWTRuleSpec) DeepCopy() *JWTRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.ConsistentHash != nil {
+		in, out := &in.ConsistentHash, &out.ConsistentHash
+		*out = new(ConsistentHashSpec)
+		**out = **in
+	}
+	if in.SessionAffinity != nil {
+		in, out := &in.SessionAffinity, &out.SessionAffinity
+		*out = new(SessionAffinitySpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalRateLimitSpec) DeepCopyInto(out *LocalRateLimitSpec) {
+	*out = *in
+	if in.TCP != nil {
+		in, out := &in.TCP, &out.TCP
+		*out = new(TCPLocalRateLimitSpec)
+		**out = **in
+	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPLocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitSpec.
+func (in *LocalRateLimitSpec) DeepCopy() *LocalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OutlierDetectionSpec) DeepCopyInto(out *OutlierDetectionSpec) {
+	*out = *in
+	if in.ConsecutiveErrors != nil {
+		in, out := &in.ConsecutiveErrors, &out.ConsecutiveErrors
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.BaseEjectionTime != nil {
+		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxEjectionPercent != nil {
+		in, out := &in.MaxEjectionPercent, &out.MaxEjectionPercent
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OutlierDetectionSpec.
+func (in *OutlierDetectionSpec) DeepCopy() *OutlierDetectionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OutlierDetectionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(EgressTLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
+func (in *PortSpec) DeepCopy() *PortSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PortSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitServiceSpec) DeepCopyInto(out *RateLimitServiceSpec) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitServiceSpec.
+func (in *RateLimitServiceSpec) DeepCopy() *RateLimitServiceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitServiceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+	*out = *in
+	if in.Local != nil {
+		in, out := &in.Local, &out.Local
+		*out = new(LocalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalRateLimitSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
+func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestAuthentication) DeepCopyInto(out *RequestAuthentication) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendList.
-func (in *IngressBackendList) DeepCopy() *IngressBackendList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthentication.
+func (in *RequestAuthentication) DeepCopy() *RequestAuthentication {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendList)
+	out := new(RequestAuthentication)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *IngressBackendList) DeepCopyObject() runtime.Object {
+func (in *RequestAuthentication) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -895,131 +2129,113 @@ func (in *IngressBackendList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendSpec) DeepCopyInto(out *IngressBackendSpec) {
+func (in *RequestAuthenticationDestinationSpec) DeepCopyInto(out *RequestAuthenticationDestinationSpec) {
 	*out = *in
-	if in.Backends != nil {
-		in, out := &in.Backends, &out.Backends
-		*out = make([]BackendSpec, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Sources != nil {
-		in, out := &in.Sources, &out.Sources
-		*out = make([]IngressSourceSpec, len(*in))
-		copy(*out, *in)
-	}
-	if in.Matches != nil {
-		in, out := &in.Matches, &out.Matches
-		*out = make([]v1.TypedLocalObjectReference, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendSpec.
-func (in *IngressBackendSpec) DeepCopy() *IngressBackendSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationDestinationSpec.
+func (in *RequestAuthenticationDestinationSpec) DeepCopy() *RequestAuthenticationDestinationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendSpec)
+	out := new(RequestAuthenticationDestinationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressBackendStatus) DeepCopyInto(out *IngressBackendStatus) {
+func (in *RequestAuthenticationList) DeepCopyInto(out *RequestAuthenticationList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RequestAuthentication, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressBackendStatus.
-func (in *IngressBackendStatus) DeepCopy() *IngressBackendStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationList.
+func (in *RequestAuthenticationList) DeepCopy() *RequestAuthenticationList {
 	if in == nil {
 		return nil
 	}
-	out := new(IngressBackendStatus)
+	out := new(RequestAuthenticationList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *IngressSourceSpec) DeepCopyInto(out *IngressSourceSpec) {
-	*out = *in
-	return
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSourceSpec.
-func (in *IngressSourceSpec) DeepCopy() *IngressSourceSpec {
-	if in == nil {
-		return nil
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RequestAuthenticationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	out := new(IngressSourceSpec)
-	in.DeepCopyInto(out)
-	return out
+	return nil
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LocalRateLimitSpec) DeepCopyInto(out *LocalRateLimitSpec) {
+func (in *RequestAuthenticationSpec) DeepCopyInto(out *RequestAuthenticationSpec) {
 	*out = *in
-	if in.TCP != nil {
-		in, out := &in.TCP, &out.TCP
-		*out = new(TCPLocalRateLimitSpec)
-		**out = **in
+	out.Destination = in.Destination
+	if in.JWTRules != nil {
+		in, out := &in.JWTRules, &out.JWTRules
+		*out = make([]JWTRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
-	if in.HTTP != nil {
-		in, out := &in.HTTP, &out.HTTP
-		*out = new(HTTPLocalRateLimitSpec)
-		(*in).DeepCopyInto(*out)
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]JWTAuthorizationRuleSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalRateLimitSpec.
-func (in *LocalRateLimitSpec) DeepCopy() *LocalRateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestAuthenticationSpec.
+func (in *RequestAuthenticationSpec) DeepCopy() *RequestAuthenticationSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(LocalRateLimitSpec)
+	out := new(RequestAuthenticationSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *PortSpec) DeepCopyInto(out *PortSpec) {
+func (in *RequestHeaderDescriptorEntry) DeepCopyInto(out *RequestHeaderDescriptorEntry) {
 	*out = *in
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
-func (in *PortSpec) DeepCopy() *PortSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestHeaderDescriptorEntry.
+func (in *RequestHeaderDescriptorEntry) DeepCopy() *RequestHeaderDescriptorEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(PortSpec)
+	out := new(RequestHeaderDescriptorEntry)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
+func (in *RequestPathDescriptorEntry) DeepCopyInto(out *RequestPathDescriptorEntry) {
 	*out = *in
-	if in.Local != nil {
-		in, out := &in.Local, &out.Local
-		*out = new(LocalRateLimitSpec)
-		(*in).DeepCopyInto(*out)
-	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
-func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestPathDescriptorEntry.
+func (in *RequestPathDescriptorEntry) DeepCopy() *RequestPathDescriptorEntry {
 	if in == nil {
 		return nil
 	}
-	out := new(RateLimitSpec)
+	out := new(RequestPathDescriptorEntry)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1154,6 +2370,43 @@ func (in *RetrySrcDstSpec) DeepCopy() *RetrySrcDstSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SessionAffinitySpec) DeepCopyInto(out *SessionAffinitySpec) {
+	*out = *in
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SessionAffinitySpec.
+func (in *SessionAffinitySpec) DeepCopy() *SessionAffinitySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SessionAffinitySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIdentityDescriptorEntry) DeepCopyInto(out *SourceIdentityDescriptorEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIdentityDescriptorEntry.
+func (in *SourceIdentityDescriptorEntry) DeepCopy() *SourceIdentityDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIdentityDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPConnectionSettings) DeepCopyInto(out *TCPConnectionSettings) {
 	*out = *in
@@ -1180,6 +2433,30 @@ func (in *TCPConnectionSettings) DeepCopy() *TCPConnectionSettings {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPGlobalRateLimitSpec) DeepCopyInto(out *TCPGlobalRateLimitSpec) {
+	*out = *in
+	in.RateLimitService.DeepCopyInto(&out.RateLimitService)
+	if in.Descriptors != nil {
+		in, out := &in.Descriptors, &out.Descriptors
+		*out = make([]TCPRateLimitDescriptor, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPGlobalRateLimitSpec.
+func (in *TCPGlobalRateLimitSpec) DeepCopy() *TCPGlobalRateLimitSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPGlobalRateLimitSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TCPLocalRateLimitSpec) DeepCopyInto(out *TCPLocalRateLimitSpec) {
 	*out = *in
@@ -1196,6 +2473,43 @@ func (in *TCPLocalRateLimitSpec) DeepCopy() *TCPLocalRateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimitDescriptor) DeepCopyInto(out *TCPRateLimitDescriptor) {
+	*out = *in
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]TCPRateLimitDescriptorEntry, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimitDescriptor.
+func (in *TCPRateLimitDescriptor) DeepCopy() *TCPRateLimitDescriptor {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimitDescriptor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TCPRateLimitDescriptorEntry) DeepCopyInto(out *TCPRateLimitDescriptorEntry) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TCPRateLimitDescriptorEntry.
+func (in *TCPRateLimitDescriptorEntry) DeepCopy() *TCPRateLimitDescriptorEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(TCPRateLimitDescriptorEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
 	*out = *in
@@ -1217,6 +2531,125 @@ func (in *TLSSpec) DeepCopy() *TLSSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirror) DeepCopyInto(out *TrafficMirror) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirror.
+func (in *TrafficMirror) DeepCopy() *TrafficMirror {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirror)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirror) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorBackendSpec) DeepCopyInto(out *TrafficMirrorBackendSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorBackendSpec.
+func (in *TrafficMirrorBackendSpec) DeepCopy() *TrafficMirrorBackendSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorBackendSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorList) DeepCopyInto(out *TrafficMirrorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TrafficMirror, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorList.
+func (in *TrafficMirrorList) DeepCopy() *TrafficMirrorList {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TrafficMirrorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSpec) DeepCopyInto(out *TrafficMirrorSpec) {
+	*out = *in
+	if in.Sources != nil {
+		in, out := &in.Sources, &out.Sources
+		*out = make([]TrafficMirrorSrcDstSpec, len(*in))
+		copy(*out, *in)
+	}
+	out.Destination = in.Destination
+	if in.Backends != nil {
+		in, out := &in.Backends, &out.Backends
+		*out = make([]TrafficMirrorBackendSpec, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSpec.
+func (in *TrafficMirrorSpec) DeepCopy() *TrafficMirrorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficMirrorSrcDstSpec) DeepCopyInto(out *TrafficMirrorSrcDstSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficMirrorSrcDstSpec.
+func (in *TrafficMirrorSrcDstSpec) DeepCopy() *TrafficMirrorSrcDstSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficMirrorSrcDstSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamTrafficSetting) DeepCopyInto(out *UpstreamTrafficSetting) {
 	*out = *in
@@ -1286,6 +2719,11 @@ func (in *UpstreamTrafficSettingSpec) DeepCopyInto(out *UpstreamTrafficSettingSp
 		*out = new(ConnectionSettingsSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.RateLimit != nil {
 		in, out := &in.RateLimit, &out.RateLimit
 		*out = new(RateLimitSpec)
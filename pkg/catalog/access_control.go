@@ -14,6 +14,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
 // GetAccessControlTrafficPolicy returns the access control traffic policy for the given mesh service
@@ -79,7 +80,7 @@ func (mc *MeshCatalog) GetAccessControlTrafficPolicy(svc service.MeshService) (*
 				}
 
 				for _, ep := range endpoints {
-					sourceCIDR := ep.IP.String() + singeIPPrefixLen
+					sourceCIDR := cidr.SingleIPCIDR(ep.IP)
 					if sourceIPSet.Add(sourceCIDR) {
 						sourceIPRanges = append(sourceIPRanges, sourceCIDR)
 					}
@@ -0,0 +1,83 @@
+package catalog
+
+import (
+	"fmt"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// getAuthorizationTrafficTargets returns the traffic targets derived from the AuthorizationPolicy policies
+// applied to the given destination service identity
+func (mc *MeshCatalog) getAuthorizationTrafficTargets(upstream identity.ServiceIdentity) []trafficpolicy.TrafficTargetWithRoutes {
+	if !mc.configurator.GetFeatureFlags().EnableAuthorizationPolicy {
+		log.Trace().Msgf("AuthorizationPolicy flag not enabled")
+		return nil
+	}
+
+	permissiveMode := mc.configurator.IsPermissiveTrafficPolicyMode()
+
+	var trafficTargets []trafficpolicy.TrafficTargetWithRoutes
+	for _, authorizationPolicy := range mc.policyController.ListAuthorizationPolicies(upstream.ToK8sServiceAccount()) {
+		action := authorizationPolicy.Spec.Action
+		if action == "" {
+			action = policyv1alpha1.AuthorizationPolicyActionAllow
+		}
+		// All the traffic is allowed in permissive traffic policy mode, only DENY and AUDIT policies apply
+		if permissiveMode && action == policyv1alpha1.AuthorizationPolicyActionAllow {
+			continue
+		}
+
+		trafficTarget := trafficpolicy.TrafficTargetWithRoutes{
+			Name:        fmt.Sprintf("%s/%s", authorizationPolicy.Namespace, authorizationPolicy.Name),
+			Destination: upstream,
+			Action:      action,
+		}
+		for _, rule := range authorizationPolicy.Spec.Rules {
+			authorizationRule := trafficpolicy.AuthorizationRule{
+				To: getAuthorizationOperations(rule.To),
+			}
+			for _, from := range rule.From {
+				source := trafficpolicy.AuthorizationSource{
+					Namespaces: from.Namespaces,
+					IPBlocks:   from.IPBlocks,
+				}
+				for _, sa := range from.ServiceAccounts {
+					namespace := sa.Namespace
+					if namespace == "" {
+						namespace = authorizationPolicy.Namespace
+					}
+					source.Identities = append(source.Identities, identity.New(sa.Name, namespace))
+				}
+				authorizationRule.From = append(authorizationRule.From, source)
+			}
+			trafficTarget.AuthorizationRules = append(trafficTarget.AuthorizationRules, authorizationRule)
+		}
+		trafficTargets = append(trafficTargets, trafficTarget)
+	}
+
+	return trafficTargets
+}
+
+// getAuthorizationOperations returns the given operations with their gRPC methods converted
+// to the paths '/<service>/<method>' of the gRPC requests, so that the sidecar providers
+// only need to match HTTP paths
+func getAuthorizationOperations(operations []policyv1alpha1.AuthorizationOperationSpec) []policyv1alpha1.AuthorizationOperationSpec {
+	var authorizationOperations []policyv1alpha1.AuthorizationOperationSpec
+	for _, operation := range operations {
+		if len(operation.GRPCMethods) > 0 {
+			operation = *operation.DeepCopy()
+			for _, grpcMethod := range operation.GRPCMethods {
+				method := grpcMethod.Method
+				if method == "" {
+					method = "*"
+				}
+				operation.Paths = append(operation.Paths, fmt.Sprintf("/%s/%s", grpcMethod.Service, method))
+			}
+			operation.GRPCMethods = nil
+		}
+		authorizationOperations = append(authorizationOperations, operation)
+	}
+	return authorizationOperations
+}
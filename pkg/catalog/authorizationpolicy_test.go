@@ -0,0 +1,162 @@
+package catalog
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetAuthorizationTrafficTargets(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:     mockCfg,
+		policyController: mockPolicyController,
+	}
+	upstream := identity.New("sa-1", "ns-1")
+	operations := []policyV1alpha1.AuthorizationOperationSpec{
+		{Methods: []string{"GET"}, Paths: []string{"/api/*"}},
+	}
+	authorizationPolicies := []*policyV1alpha1.AuthorizationPolicy{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "allow", Namespace: "ns-1"},
+			Spec: policyV1alpha1.AuthorizationPolicySpec{
+				Rules: []policyV1alpha1.AuthorizationRuleSpec{
+					{
+						From: []policyV1alpha1.AuthorizationSourceSpec{
+							{
+								ServiceAccounts: []policyV1alpha1.AuthorizationServiceAccountSpec{
+									{Name: "sa-2"},
+									{Name: "sa-3", Namespace: "ns-3"},
+								},
+								IPBlocks: []string{"10.0.0.0/8"},
+							},
+						},
+						To: operations,
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "deny", Namespace: "ns-1"},
+			Spec: policyV1alpha1.AuthorizationPolicySpec{
+				Action: policyV1alpha1.AuthorizationPolicyActionDeny,
+				Rules: []policyV1alpha1.AuthorizationRuleSpec{
+					{
+						From: []policyV1alpha1.AuthorizationSourceSpec{{Namespaces: []string{"untrusted"}}},
+					},
+				},
+			},
+		},
+	}
+	allowTrafficTarget := trafficpolicy.TrafficTargetWithRoutes{
+		Name:        "ns-1/allow",
+		Destination: upstream,
+		Action:      policyV1alpha1.AuthorizationPolicyActionAllow,
+		AuthorizationRules: []trafficpolicy.AuthorizationRule{
+			{
+				From: []trafficpolicy.AuthorizationSource{
+					{
+						Identities: []identity.ServiceIdentity{"sa-2.ns-1", "sa-3.ns-3"},
+						IPBlocks:   []string{"10.0.0.0/8"},
+					},
+				},
+				To: operations,
+			},
+		},
+	}
+	denyTrafficTarget := trafficpolicy.TrafficTargetWithRoutes{
+		Name:        "ns-1/deny",
+		Destination: upstream,
+		Action:      policyV1alpha1.AuthorizationPolicyActionDeny,
+		AuthorizationRules: []trafficpolicy.AuthorizationRule{
+			{
+				From: []trafficpolicy.AuthorizationSource{{Namespaces: []string{"untrusted"}}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		authorizationFlag      bool
+		permissiveMode         bool
+		expectedTrafficTargets []trafficpolicy.TrafficTargetWithRoutes
+	}{
+		{
+			name:                   "feature flag disabled",
+			authorizationFlag:      false,
+			expectedTrafficTargets: nil,
+		},
+		{
+			name:                   "ALLOW and DENY policies",
+			authorizationFlag:      true,
+			expectedTrafficTargets: []trafficpolicy.TrafficTargetWithRoutes{allowTrafficTarget, denyTrafficTarget},
+		},
+		{
+			name:                   "ALLOW policies are ignored in permissive mode",
+			authorizationFlag:      true,
+			permissiveMode:         true,
+			expectedTrafficTargets: []trafficpolicy.TrafficTargetWithRoutes{denyTrafficTarget},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableAuthorizationPolicy: tc.authorizationFlag}).Times(1)
+			if tc.authorizationFlag {
+				mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
+				mockPolicyController.EXPECT().ListAuthorizationPolicies(upstream.ToK8sServiceAccount()).Return(authorizationPolicies).Times(1)
+			}
+
+			actual := mc.getAuthorizationTrafficTargets(upstream)
+			assert.Equal(tc.expectedTrafficTargets, actual)
+		})
+	}
+}
+
+func TestGetAuthorizationOperations(t *testing.T) {
+	assert := tassert.New(t)
+
+	operations := []policyV1alpha1.AuthorizationOperationSpec{
+		{
+			Ports: []uint16{8080},
+			Paths: []string{"/health"},
+		},
+		{
+			Ports: []uint16{9090},
+			GRPCMethods: []policyV1alpha1.AuthorizationGRPCMethodSpec{
+				{Service: "helloworld.Greeter", Method: "SayHello"},
+				{Service: "grpc.health.v1.Health"},
+			},
+		},
+	}
+
+	actual := getAuthorizationOperations(operations)
+	assert.Equal([]policyV1alpha1.AuthorizationOperationSpec{
+		{
+			Ports: []uint16{8080},
+			Paths: []string{"/health"},
+		},
+		{
+			Ports: []uint16{9090},
+			Paths: []string{"/helloworld.Greeter/SayHello", "/grpc.health.v1.Health/*"},
+		},
+	}, actual)
+	// The operations of the policy are left unchanged
+	assert.Len(operations[1].GRPCMethods, 2)
+	assert.Empty(operations[1].Paths)
+}
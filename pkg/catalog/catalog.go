@@ -6,6 +6,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/messaging"
 	"github.com/openservicemesh/osm/pkg/multicluster"
@@ -23,6 +24,7 @@ func NewMeshCatalog(kubeController k8s.Controller,
 	policyController policy.Controller,
 	pluginController plugin.Controller,
 	multiclusterController multicluster.Controller,
+	gatewayAPIController gatewayapi.Controller,
 	stop <-chan struct{},
 	cfg configurator.Configurator,
 	serviceProviders []service.Provider,
@@ -35,6 +37,7 @@ func NewMeshCatalog(kubeController k8s.Controller,
 		policyController:       policyController,
 		pluginController:       pluginController,
 		multiclusterController: multiclusterController,
+		gatewayAPIController:   gatewayAPIController,
 		configurator:           cfg,
 		certManager:            certManager,
 		kubeController:         kubeController,
@@ -58,3 +61,8 @@ func (mc *MeshCatalog) GetKubeController() k8s.Controller {
 func (mc *MeshCatalog) GetTrustDomain() string {
 	return mc.certManager.GetTrustDomain()
 }
+
+// GetSpiffeEnabled returns whether the service identities are authenticated by their SPIFFE IDs
+func (mc *MeshCatalog) GetSpiffeEnabled() bool {
+	return mc.certManager.GetSpiffeEnabled()
+}
@@ -0,0 +1,109 @@
+package catalog
+
+import (
+	mapset "github.com/deckarep/golang-set"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// ListProxyDependencies returns the services and policies the configuration of a proxy with the given
+// identity and services depends on. A change to a resource that is not listed does not need to update
+// the configuration of the proxy, unless the change is broadcast to all the proxies.
+// The outbound mesh traffic policy computed to generate the configuration of the proxy is reused,
+// it is only computed if nil.
+func (mc *MeshCatalog) ListProxyDependencies(proxyIdentity identity.ServiceIdentity, proxyServices []service.MeshService,
+	outboundPolicy *trafficpolicy.OutboundMeshTrafficPolicy) []messaging.ProxyDependency {
+	dependencySet := mapset.NewSet()
+	addService := func(namespace, name string) {
+		dependencySet.Add(messaging.NewProxyDependency(messaging.ServiceDependency, namespace, name))
+	}
+	addUpstreamTrafficSetting := func(upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting) {
+		if upstreamTrafficSetting != nil {
+			dependencySet.Add(messaging.NewProxyDependency(messaging.UpstreamTrafficSettingDependency,
+				upstreamTrafficSetting.Namespace, upstreamTrafficSetting.Name))
+		}
+	}
+
+	// Outbound: the upstream services, including the services without endpoints that are not yet
+	// programmed as clusters, and the backends of the traffic splits and routes to these services
+	for _, svc := range mc.ListOutboundServicesForIdentity(proxyIdentity) {
+		addService(svc.Namespace, svc.Name)
+	}
+	if outboundPolicy == nil {
+		outboundPolicy = mc.GetOutboundMeshTrafficPolicy(proxyIdentity)
+	}
+	if outboundPolicy != nil {
+		for _, clusterConfig := range outboundPolicy.ClustersConfigs {
+			addService(clusterConfig.Service.Namespace, clusterConfig.Service.Name)
+			addUpstreamTrafficSetting(clusterConfig.UpstreamTrafficSetting)
+		}
+	}
+
+	// Inbound: the services of the proxy, and the source services allowed to access them,
+	// including the services of the source identities of the traffic targets whose endpoints are allowed
+	if !mc.configurator.IsPermissiveTrafficPolicyMode() {
+		for _, sourceIdentity := range mc.ListInboundServiceIdentities(proxyIdentity) {
+			for _, svc := range mc.getServicesForServiceIdentity(sourceIdentity) {
+				addService(svc.Namespace, svc.Name)
+			}
+		}
+	}
+	for _, svc := range proxyServices {
+		svc := svc // To prevent loop variable memory aliasing in for loop
+		addService(svc.Namespace, svc.Name)
+		addUpstreamTrafficSetting(mc.policyController.GetUpstreamTrafficSetting(policy.UpstreamTrafficSettingGetOpt{MeshService: &svc}))
+
+		if ingressBackend := mc.policyController.GetIngressBackendPolicy(svc); ingressBackend != nil {
+			for _, source := range ingressBackend.Spec.Sources {
+				if source.Kind == policyv1alpha1.KindService {
+					addService(source.Namespace, source.Name)
+				}
+			}
+		}
+		if accessControl := mc.policyController.GetAccessControlPolicy(svc); accessControl != nil {
+			for _, source := range accessControl.Spec.Sources {
+				if source.Kind == policyv1alpha1.KindService {
+					addService(source.Namespace, source.Name)
+				}
+			}
+		}
+		if exportedRule, err := mc.multiclusterController.GetExportedRule(svc); err == nil && exportedRule != nil {
+			for _, controllerService := range mc.multiclusterController.GetIngressControllerServices() {
+				addService(controllerService.Namespace, controllerService.Name)
+			}
+		}
+	}
+
+	// Egress: the policies and secrets referenced by the Egress policies of the proxy, and the egress gateways
+	if mc.configurator.GetFeatureFlags().EnableEgressPolicy {
+		for _, egress := range mc.policyController.ListEgressPoliciesForSourceIdentity(proxyIdentity.ToK8sServiceAccount()) {
+			if upstreamTrafficSetting, err := mc.getUpstreamTrafficSettingForEgress(egress); err == nil {
+				addUpstreamTrafficSetting(upstreamTrafficSetting)
+			}
+			for _, secretReference := range policy.GetEgressSecretReferences(egress) {
+				dependencySet.Add(messaging.NewProxyDependency(messaging.SecretDependency, secretReference.Namespace, secretReference.Name))
+			}
+		}
+	}
+	for _, egressGateway := range mc.policyController.ListEgressGateways() {
+		for _, globalGateway := range egressGateway.Spec.GlobalEgressGateways {
+			addService(globalGateway.Namespace, globalGateway.Service)
+		}
+		for _, rule := range egressGateway.Spec.EgressPolicyGatewayRules {
+			for _, gateway := range rule.EgressGateways {
+				addService(gateway.Namespace, gateway.Service)
+			}
+		}
+	}
+
+	var dependencies []messaging.ProxyDependency
+	for dependency := range dependencySet.Iter() {
+		dependencies = append(dependencies, dependency.(messaging.ProxyDependency))
+	}
+	return dependencies
+}
@@ -57,7 +57,7 @@ func (mc *MeshCatalog) GetEgressTrafficPolicy(serviceIdentity identity.ServiceId
 			case constants.ProtocolHTTP:
 				// ---
 				// Build the HTTP route configs for the given Egress policy
-				httpRouteConfigs, httpClusterConfigs := mc.buildHTTPRouteConfigs(egress, portSpec.Number, upstreamTrafficSetting, sourceMTLS)
+				httpRouteConfigs, httpClusterConfigs := mc.buildHTTPRouteConfigs(egress, portSpec.Number, upstreamTrafficSetting, sourceMTLS, portSpec.TLS)
 				portToRouteConfigMap[portSpec.Number] = append(portToRouteConfigMap[portSpec.Number], httpRouteConfigs...)
 				clusterConfigs = append(clusterConfigs, httpClusterConfigs...)
 
@@ -180,7 +180,8 @@ func (mc *MeshCatalog) getUpstreamTrafficSettingForEgress(egressPolicy *policyv1
 
 func (mc *MeshCatalog) buildHTTPRouteConfigs(egressPolicy *policyv1alpha1.Egress, port int,
 	upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting,
-	sourceMTLS *policyv1alpha1.EgressSourceMTLSSpec) ([]*trafficpolicy.EgressHTTPRouteConfig, []*trafficpolicy.EgressClusterConfig) {
+	sourceMTLS *policyv1alpha1.EgressSourceMTLSSpec,
+	tlsSpec *policyv1alpha1.EgressTLSSpec) ([]*trafficpolicy.EgressHTTPRouteConfig, []*trafficpolicy.EgressClusterConfig) {
 	if egressPolicy == nil {
 		return nil, nil
 	}
@@ -251,6 +252,19 @@ func (mc *MeshCatalog) buildHTTPRouteConfigs(egressPolicy *policyv1alpha1.Egress
 			UpstreamTrafficSetting: upstreamTrafficSetting,
 			SourceMTLS:             sourceMTLS,
 		}
+		if tlsSpec != nil {
+			tlsConfig, err := mc.getEgressTLSConfig(egressPolicy, tlsSpec, host)
+			if err != nil {
+				// Skip the host instead of proxying the traffic to it in plain text
+				log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrFetchingEgressTLSSecret)).
+					Msgf("Error configuring TLS origination to host %s in egress policy %s/%s; will be skipped", host, egressPolicy.Namespace, egressPolicy.Name)
+				continue
+			}
+			clusterConfig.TLS = tlsConfig
+			if tlsSpec.TargetPort != 0 {
+				clusterConfig.Port = tlsSpec.TargetPort
+			}
+		}
 		clusterConfigs = append(clusterConfigs, clusterConfig)
 
 		// Build egress routing rules from the given HTTP route matches and allowed destination attributes
@@ -282,6 +296,57 @@ func (mc *MeshCatalog) buildHTTPRouteConfigs(egressPolicy *policyv1alpha1.Egress
 	return routeConfigs, clusterConfigs
 }
 
+// getEgressTLSConfig returns the TLS origination config to the given host, resolving the secrets referenced
+// by the given TLS spec. Secrets without a namespace are looked up in the namespace of the Egress policy.
+func (mc *MeshCatalog) getEgressTLSConfig(egressPolicy *policyv1alpha1.Egress, tlsSpec *policyv1alpha1.EgressTLSSpec, host string) (*trafficpolicy.EgressTLSConfig, error) {
+	tlsConfig := &trafficpolicy.EgressTLSConfig{
+		SNI:                tlsSpec.SNI,
+		MinProtocolVersion: tlsSpec.MinProtocolVersion,
+		SubjectAltNames:    tlsSpec.SubjectAltNames,
+	}
+	if tlsConfig.SNI == "" {
+		tlsConfig.SNI = host
+	}
+	if len(tlsConfig.SubjectAltNames) == 0 {
+		tlsConfig.SubjectAltNames = []string{tlsConfig.SNI}
+	}
+
+	if tlsSpec.CASecret != nil {
+		secret, err := mc.getEgressTLSSecret(egressPolicy, *tlsSpec.CASecret)
+		if err != nil {
+			return nil, err
+		}
+		if tlsConfig.TrustedCA = secret.Data[constants.KubernetesOpaqueSecretCAKey]; len(tlsConfig.TrustedCA) == 0 {
+			return nil, fmt.Errorf("secret %s/%s is missing the %s key", secret.Namespace, secret.Name, constants.KubernetesOpaqueSecretCAKey)
+		}
+	}
+
+	if tlsSpec.ClientCertSecret != nil {
+		secret, err := mc.getEgressTLSSecret(egressPolicy, *tlsSpec.ClientCertSecret)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCertificate = secret.Data[corev1.TLSCertKey]
+		tlsConfig.ClientPrivateKey = secret.Data[corev1.TLSPrivateKeyKey]
+		if len(tlsConfig.ClientCertificate) == 0 || len(tlsConfig.ClientPrivateKey) == 0 {
+			return nil, fmt.Errorf("secret %s/%s is missing the %s or %s key", secret.Namespace, secret.Name, corev1.TLSCertKey, corev1.TLSPrivateKeyKey)
+		}
+	}
+
+	return tlsConfig, nil
+}
+
+func (mc *MeshCatalog) getEgressTLSSecret(egressPolicy *policyv1alpha1.Egress, secretReference corev1.SecretReference) (*corev1.Secret, error) {
+	if secretReference.Namespace == "" {
+		secretReference.Namespace = egressPolicy.Namespace
+	}
+	secret, err := mc.policyController.GetEgressSourceSecret(secretReference)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching secret %s/%s: %w", secretReference.Namespace, secretReference.Name, err)
+	}
+	return secret, nil
+}
+
 func getHTTPRouteMatchesFromHTTPRouteGroup(httpRouteGroup *smiSpecs.HTTPRouteGroup) []trafficpolicy.HTTPRouteMatch {
 	if httpRouteGroup == nil {
 		return nil
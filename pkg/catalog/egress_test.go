@@ -841,13 +841,134 @@ func TestBuildHTTPRouteConfigs(t *testing.T) {
 				meshSpec: mockMeshSpec,
 			}
 
-			routeConfigs, clusterConfigs := mc.buildHTTPRouteConfigs(tc.egressPolicy, tc.egressPort, tc.upstreamTrafficSetting, nil)
+			routeConfigs, clusterConfigs := mc.buildHTTPRouteConfigs(tc.egressPolicy, tc.egressPort, tc.upstreamTrafficSetting, nil, nil)
 			assert.ElementsMatch(tc.expectedRouteConfigs, routeConfigs)
 			assert.ElementsMatch(tc.expectedClusterConfigs, clusterConfigs)
 		})
 	}
 }
 
+func TestBuildHTTPRouteConfigsWithTLS(t *testing.T) {
+	egressPolicy := &policyv1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "egress-1",
+			Namespace: "ns1",
+		},
+		Spec: policyv1alpha1.EgressSpec{
+			Hosts: []string{"foo.com"},
+		},
+	}
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: "ns1",
+		},
+		Data: map[string][]byte{
+			"ca.crt": []byte("ca"),
+		},
+	}
+	clientCertSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client",
+			Namespace: "ns2",
+		},
+		Data: map[string][]byte{
+			"tls.crt": []byte("cert"),
+			"tls.key": []byte("key"),
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		tlsSpec                *policyv1alpha1.EgressTLSSpec
+		secrets                []*corev1.Secret
+		expectedClusterConfigs []*trafficpolicy.EgressClusterConfig
+	}{
+		{
+			name:    "TLS origination with defaults",
+			tlsSpec: &policyv1alpha1.EgressTLSSpec{},
+			expectedClusterConfigs: []*trafficpolicy.EgressClusterConfig{
+				{
+					Name: "foo.com:80",
+					Host: "foo.com",
+					Port: 80,
+					TLS: &trafficpolicy.EgressTLSConfig{
+						SNI:             "foo.com",
+						SubjectAltNames: []string{"foo.com"},
+					},
+				},
+			},
+		},
+		{
+			name: "TLS origination with CA and client certificate secrets",
+			tlsSpec: &policyv1alpha1.EgressTLSSpec{
+				TargetPort:         443,
+				SNI:                "api.foo.com",
+				MinProtocolVersion: "TLSv1_2",
+				SubjectAltNames:    []string{"*.foo.com"},
+				CASecret:           &corev1.SecretReference{Name: "ca"},
+				ClientCertSecret:   &corev1.SecretReference{Name: "client", Namespace: "ns2"},
+			},
+			secrets: []*corev1.Secret{caSecret, clientCertSecret},
+			expectedClusterConfigs: []*trafficpolicy.EgressClusterConfig{
+				{
+					Name: "foo.com:80",
+					Host: "foo.com",
+					Port: 443,
+					TLS: &trafficpolicy.EgressTLSConfig{
+						SNI:                "api.foo.com",
+						MinProtocolVersion: "TLSv1_2",
+						SubjectAltNames:    []string{"*.foo.com"},
+						TrustedCA:          []byte("ca"),
+						ClientCertificate:  []byte("cert"),
+						ClientPrivateKey:   []byte("key"),
+					},
+				},
+			},
+		},
+		{
+			name: "host is skipped when the CA secret is not found",
+			tlsSpec: &policyv1alpha1.EgressTLSSpec{
+				CASecret: &corev1.SecretReference{Name: "ca"},
+			},
+			expectedClusterConfigs: nil,
+		},
+		{
+			name: "host is skipped when the client certificate secret is missing keys",
+			tlsSpec: &policyv1alpha1.EgressTLSSpec{
+				ClientCertSecret: &corev1.SecretReference{Name: "ca"},
+			},
+			secrets:                []*corev1.Secret{caSecret},
+			expectedClusterConfigs: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+
+			mockPolicyController.EXPECT().GetEgressSourceSecret(gomock.Any()).DoAndReturn(func(ref corev1.SecretReference) (*corev1.Secret, error) {
+				for _, secret := range tc.secrets {
+					if secret.Name == ref.Name && secret.Namespace == ref.Namespace {
+						return secret, nil
+					}
+				}
+				return nil, fmt.Errorf("secret %s/%s not found", ref.Namespace, ref.Name)
+			}).AnyTimes()
+
+			mc := &MeshCatalog{
+				policyController: mockPolicyController,
+			}
+
+			routeConfigs, clusterConfigs := mc.buildHTTPRouteConfigs(egressPolicy, 80, nil, nil, tc.tlsSpec)
+			assert.Equal(tc.expectedClusterConfigs, clusterConfigs)
+			assert.Len(routeConfigs, len(tc.expectedClusterConfigs))
+		})
+	}
+}
+
 func TestGetHTTPRouteMatchesFromHTTPRouteGroup(t *testing.T) {
 	assert := tassert.New(t)
 
@@ -42,6 +42,11 @@ func (mc *MeshCatalog) ListAllowedUpstreamEndpointsForService(downstreamIdentity
 		return outboundEndpoints
 	}
 
+	// The endpoints of services external to the mesh are not associated with a service account
+	if mc.policyController.GetExternalService(upstreamSvc) != nil {
+		return outboundEndpoints
+	}
+
 	// In SMI mode, the endpoints for an upstream service must be filtered based on the service account
 	// associated with the endpoint. Only endpoints associated with authorized service accounts as referenced
 	// in SMI TrafficTarget resources should be returned.
@@ -13,11 +13,13 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	testclient "k8s.io/client-go/kubernetes/fake"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/smi"
 	"github.com/openservicemesh/osm/pkg/tests"
@@ -142,15 +144,19 @@ func TestListAllowedUpstreamEndpointsForService(t *testing.T) {
 			mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
 			mockServiceProvider := service.NewMockProvider(mockCtrl)
 			mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+			mockPolicyController := policy.NewMockController(mockCtrl)
 
 			mc := MeshCatalog{
 				kubeController:     mockKubeController,
 				meshSpec:           mockMeshSpec,
+				policyController:   mockPolicyController,
 				endpointsProviders: []endpoint.Provider{mockEndpointProvider},
 				serviceProviders:   []service.Provider{mockServiceProvider},
 				configurator:       mockConfigurator,
 			}
 
+			mockPolicyController.EXPECT().GetExternalService(gomock.Any()).Return(nil).AnyTimes()
+
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).AnyTimes()
 
 			for svc, endpoints := range tc.outboundServiceEndpoints {
@@ -211,3 +217,31 @@ func TestListAllowedUpstreamEndpointsForService(t *testing.T) {
 		})
 	}
 }
+
+func TestListAllowedUpstreamEndpointsForExternalService(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	mockEndpointProvider := endpoint.NewMockProvider(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+
+	mc := MeshCatalog{
+		policyController:   mockPolicyController,
+		endpointsProviders: []endpoint.Provider{mockEndpointProvider},
+		configurator:       mockConfigurator,
+	}
+
+	upstreamSvc := service.MeshService{Name: "db", Namespace: "test", Port: 5432, TargetPort: 5432, Protocol: "tcp"}
+	endpoints := []endpoint.Endpoint{{IP: net.ParseIP("10.0.0.1"), Port: 5432, Weight: 1}}
+
+	mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false)
+	mockEndpointProvider.EXPECT().ListEndpointsForService(upstreamSvc).Return(endpoints)
+	mockPolicyController.EXPECT().GetExternalService(upstreamSvc).Return(&policyv1alpha1.ExternalService{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test"},
+	})
+
+	// The endpoints of an external service are not filtered by service identity in SMI mode
+	actual := mc.ListAllowedUpstreamEndpointsForService(tests.BookbuyerServiceIdentity, upstreamSvc)
+	assert.Equal(endpoints, actual)
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/k8s/informers"
@@ -38,6 +39,7 @@ func NewFakeMeshCatalog(kubeClient kubernetes.Interface, meshConfigClient config
 	mockPolicyController := policy.NewMockController(mockCtrl)
 	mockPluginController := plugin.NewMockController(mockCtrl)
 	mockMultiClusterController := multicluster.NewMockController(mockCtrl)
+	mockGatewayAPIController := gatewayapi.NewMockController(mockCtrl)
 
 	meshSpec := smiFake.NewFakeMeshSpecClient()
 
@@ -121,6 +123,8 @@ func NewFakeMeshCatalog(kubeClient kubernetes.Interface, meshConfigClient config
 	mockPolicyController.EXPECT().ListEgressPoliciesForSourceIdentity(gomock.Any()).Return(nil).AnyTimes()
 	mockPolicyController.EXPECT().GetIngressBackendPolicy(gomock.Any()).Return(nil).AnyTimes()
 	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().ListExternalServices().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetExternalService(gomock.Any()).Return(nil).AnyTimes()
 
 	mockKubeController.EXPECT().GetTargetPortForServicePort(gomock.Any(), gomock.Any()).DoAndReturn(
 		func(namespacedSvc types.NamespacedName, port uint16) (uint16, error) {
@@ -132,5 +136,5 @@ func NewFakeMeshCatalog(kubeClient kubernetes.Interface, meshConfigClient config
 		}).AnyTimes()
 
 	return catalog.NewMeshCatalog(mockKubeController, meshSpec, certManager,
-		mockPolicyController, mockPluginController, mockMultiClusterController, stop, cfg, serviceProviders, endpointProviders, messaging.NewBroker(stop))
+		mockPolicyController, mockPluginController, mockMultiClusterController, mockGatewayAPIController, stop, cfg, serviceProviders, endpointProviders, messaging.NewBroker(stop))
 }
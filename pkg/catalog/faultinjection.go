@@ -0,0 +1,39 @@
+package catalog
+
+import (
+	"github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// GetFaultInjectionPolicies returns the FaultInjectionSpecs for the given downstream identity and upstream service
+func (mc *MeshCatalog) GetFaultInjectionPolicies(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) []*v1alpha1.FaultInjectionSpec {
+	if !mc.configurator.GetFeatureFlags().EnableFaultInjectionPolicy {
+		log.Trace().Msgf("FaultInjection policy flag not enabled")
+		return nil
+	}
+	src := downstreamIdentity.ToK8sServiceAccount()
+
+	var faultInjections []*v1alpha1.FaultInjectionSpec
+	for _, faultInjectionCRD := range mc.policyController.ListFaultInjectionPolicies(src) {
+		dest := faultInjectionCRD.Spec.Destination
+		if dest.Kind != "Service" {
+			log.Error().Msgf("FaultInjection policy destination must be a service: %s is a %s", dest.Name, dest.Kind)
+			continue
+		}
+		if faultInjectionCRD.Spec.Delay == nil && faultInjectionCRD.Spec.Abort == nil {
+			continue
+		}
+		namespace := dest.Namespace
+		if namespace == "" {
+			namespace = faultInjectionCRD.Namespace
+		}
+		destMeshSvc := service.MeshService{Name: dest.Name, Namespace: namespace}
+		// we want all statefulset replicas to have the same faults injected regardless of how they're accessed
+		if upstreamSvc.SiblingTo(destMeshSvc) {
+			faultInjections = append(faultInjections, &faultInjectionCRD.Spec)
+		}
+	}
+
+	return faultInjections
+}
@@ -0,0 +1,148 @@
+package catalog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func TestGetFaultInjectionPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:     mockCfg,
+		policyController: mockPolicyController,
+	}
+	src := identity.ServiceIdentity("sa1.ns")
+
+	delay := &policyV1alpha1.FaultDelaySpec{
+		Percentage: 50,
+		FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+	}
+	abort := &policyV1alpha1.FaultAbortSpec{
+		Percentage: 10,
+		HTTPStatus: 503,
+	}
+
+	testcases := []struct {
+		name                    string
+		faultInjectionFlag      bool
+		faultInjectionCRDs      []*policyV1alpha1.FaultInjection
+		destSvc                 service.MeshService
+		expectedFaultInjections []*policyV1alpha1.FaultInjectionSpec
+	}{
+		{
+			name:                    "feature flag disabled",
+			faultInjectionFlag:      false,
+			destSvc:                 service.MeshService{Name: "s1", Namespace: "b"},
+			expectedFaultInjections: nil,
+		},
+		{
+			name:                    "no fault injection policies",
+			faultInjectionFlag:      true,
+			faultInjectionCRDs:      nil,
+			destSvc:                 service.MeshService{Name: "s1", Namespace: "b"},
+			expectedFaultInjections: nil,
+		},
+		{
+			name:               "policy matching the destination service",
+			faultInjectionFlag: true,
+			faultInjectionCRDs: []*policyV1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fi1", Namespace: "b"},
+					Spec: policyV1alpha1.FaultInjectionSpec{
+						Destination: policyV1alpha1.FaultInjectionSrcDstSpec{
+							Kind: "Service",
+							Name: "s1",
+						},
+						Delay: delay,
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fi2", Namespace: "b"},
+					Spec: policyV1alpha1.FaultInjectionSpec{
+						Destination: policyV1alpha1.FaultInjectionSrcDstSpec{
+							Kind:      "Service",
+							Name:      "s2",
+							Namespace: "b",
+						},
+						Abort: abort,
+					},
+				},
+			},
+			destSvc: service.MeshService{Name: "s1", Namespace: "b"},
+			expectedFaultInjections: []*policyV1alpha1.FaultInjectionSpec{
+				{
+					Destination: policyV1alpha1.FaultInjectionSrcDstSpec{
+						Kind: "Service",
+						Name: "s1",
+					},
+					Delay: delay,
+				},
+			},
+		},
+		{
+			name:               "policy with a destination that is not a service",
+			faultInjectionFlag: true,
+			faultInjectionCRDs: []*policyV1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fi1", Namespace: "b"},
+					Spec: policyV1alpha1.FaultInjectionSpec{
+						Destination: policyV1alpha1.FaultInjectionSrcDstSpec{
+							Kind: "ServiceAccount",
+							Name: "s1",
+						},
+						Abort: abort,
+					},
+				},
+			},
+			destSvc:                 service.MeshService{Name: "s1", Namespace: "b"},
+			expectedFaultInjections: nil,
+		},
+		{
+			name:               "policy for a service in another namespace",
+			faultInjectionFlag: true,
+			faultInjectionCRDs: []*policyV1alpha1.FaultInjection{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "fi1", Namespace: "c"},
+					Spec: policyV1alpha1.FaultInjectionSpec{
+						Destination: policyV1alpha1.FaultInjectionSrcDstSpec{
+							Kind: "Service",
+							Name: "s1",
+						},
+						Abort: abort,
+					},
+				},
+			},
+			destSvc:                 service.MeshService{Name: "s1", Namespace: "b"},
+			expectedFaultInjections: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableFaultInjectionPolicy: tc.faultInjectionFlag}).Times(1)
+			if tc.faultInjectionFlag {
+				mockPolicyController.EXPECT().ListFaultInjectionPolicies(gomock.Any()).Return(tc.faultInjectionCRDs).Times(1)
+			}
+
+			res := mc.GetFaultInjectionPolicies(src, tc.destSvc)
+			assert.Equal(tc.expectedFaultInjections, res)
+		})
+	}
+}
@@ -0,0 +1,542 @@
+package catalog
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	mapset "github.com/deckarep/golang-set"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// gatewayAPIDefaultWeight is the weight of a backend reference without a weight
+	gatewayAPIDefaultWeight = 1
+
+	// gatewayAPIDefaultPathPrefix is the path prefix matched by a route match without a path
+	gatewayAPIDefaultPathPrefix = "/"
+)
+
+// getGatewayAPIHTTPRoutes returns the routes derived from the Gateway API HTTPRoute and GRPCRoute resources attached to the
+// given upstream service that apply to the given downstream identity, in order of precedence.
+//
+// Routes in the namespace of the service (producer routes) apply to all the downstream clients, while routes in another
+// namespace (consumer routes) only apply to the downstream clients in their namespace, and take precedence over the
+// producer routes for those clients.
+func (mc *MeshCatalog) getGatewayAPIHTTPRoutes(downstreamIdentity identity.ServiceIdentity, meshSvc service.MeshService) []*trafficpolicy.RouteWeightedClusters {
+	if !mc.configurator.GetFeatureFlags().EnableGatewayAPI {
+		return nil
+	}
+
+	httpRoutes := mc.gatewayAPIController.ListHTTPRoutes(gatewayapi.WithParentService(meshSvc))
+	grpcRoutes := mc.gatewayAPIController.ListGRPCRoutes(gatewayapi.WithParentService(meshSvc))
+
+	var namespaces []string
+	for _, route := range httpRoutes {
+		namespaces = append(namespaces, route.Namespace)
+	}
+	for _, route := range grpcRoutes {
+		namespaces = append(namespaces, route.Namespace)
+	}
+	routeNamespace, ok := getGatewayAPIRouteNamespace(namespaces, downstreamIdentity.ToK8sServiceAccount().Namespace, meshSvc.Namespace)
+	if !ok {
+		return nil
+	}
+
+	var routes []*trafficpolicy.RouteWeightedClusters
+	for _, httpRoute := range httpRoutes {
+		if httpRoute.Namespace != routeNamespace {
+			continue
+		}
+		for _, rule := range httpRoute.Spec.Rules {
+			var backendRefs []gwv1beta1.BackendRef
+			for _, backendRef := range rule.BackendRefs {
+				if len(backendRef.Filters) > 0 {
+					log.Warn().Msgf("Ignoring the filters of the backends of HTTPRoute %s/%s, backend filters are not supported", httpRoute.Namespace, httpRoute.Name)
+				}
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+
+			var matches []trafficpolicy.HTTPRouteMatch
+			for _, match := range rule.Matches {
+				if len(match.QueryParams) > 0 {
+					log.Warn().Msgf("Ignoring a match of HTTPRoute %s/%s, query parameter matches are not supported", httpRoute.Namespace, httpRoute.Name)
+					continue
+				}
+				matches = append(matches, getGatewayAPIHTTPRouteMatch(match))
+			}
+			if len(rule.Matches) == 0 {
+				matches = append(matches, getGatewayAPIHTTPRouteMatch(gwv1beta1.HTTPRouteMatch{}))
+			}
+
+			routes = append(routes, mc.buildGatewayAPIRoutes(httpRoute.Namespace, httpRoute.Name, meshSvc, matches, rule.Filters, backendRefs)...)
+		}
+	}
+	for _, grpcRoute := range grpcRoutes {
+		if grpcRoute.Namespace != routeNamespace {
+			continue
+		}
+		for _, rule := range grpcRoute.Spec.Rules {
+			var backendRefs []gwv1beta1.BackendRef
+			for _, backendRef := range rule.BackendRefs {
+				if len(backendRef.Filters) > 0 {
+					log.Warn().Msgf("Ignoring the filters of the backends of GRPCRoute %s/%s, backend filters are not supported", grpcRoute.Namespace, grpcRoute.Name)
+				}
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+
+			var matches []trafficpolicy.HTTPRouteMatch
+			for _, match := range rule.Matches {
+				matches = append(matches, getGatewayAPIGRPCRouteMatch(match))
+			}
+			if len(rule.Matches) == 0 {
+				matches = append(matches, getGatewayAPIGRPCRouteMatch(gwv1alpha2.GRPCRouteMatch{}))
+			}
+
+			var filters []gwv1beta1.HTTPRouteFilter
+			for _, filter := range rule.Filters {
+				filters = append(filters, gwv1beta1.HTTPRouteFilter{
+					Type:                   gwv1beta1.HTTPRouteFilterType(filter.Type),
+					RequestHeaderModifier:  filter.RequestHeaderModifier,
+					ResponseHeaderModifier: filter.ResponseHeaderModifier,
+					RequestMirror:          filter.RequestMirror,
+					ExtensionRef:           filter.ExtensionRef,
+				})
+			}
+
+			routes = append(routes, mc.buildGatewayAPIRoutes(grpcRoute.Namespace, grpcRoute.Name, meshSvc, matches, filters, backendRefs)...)
+		}
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		return isGatewayAPIRouteMatchPreferred(routes[i].HTTPRouteMatch, routes[j].HTTPRouteMatch)
+	})
+	return routes
+}
+
+// getGatewayAPITCPRouteUpstreamClusters returns the upstream clusters of the Gateway API TCPRoute resource attached to the
+// given upstream service that applies to the given downstream identity, following the same precedence rules as the HTTP routes.
+// Since the rules of a TCPRoute cannot be told apart, only the first rule of the first route is honored.
+func (mc *MeshCatalog) getGatewayAPITCPRouteUpstreamClusters(downstreamIdentity identity.ServiceIdentity, meshSvc service.MeshService) []service.WeightedCluster {
+	if !mc.configurator.GetFeatureFlags().EnableGatewayAPI {
+		return nil
+	}
+
+	tcpRoutes := mc.gatewayAPIController.ListTCPRoutes(gatewayapi.WithParentService(meshSvc))
+
+	var namespaces []string
+	for _, route := range tcpRoutes {
+		namespaces = append(namespaces, route.Namespace)
+	}
+	routeNamespace, ok := getGatewayAPIRouteNamespace(namespaces, downstreamIdentity.ToK8sServiceAccount().Namespace, meshSvc.Namespace)
+	if !ok {
+		return nil
+	}
+
+	for _, tcpRoute := range tcpRoutes {
+		if tcpRoute.Namespace != routeNamespace || len(tcpRoute.Spec.Rules) == 0 {
+			continue
+		}
+		if len(tcpRoute.Spec.Rules) > 1 {
+			log.Warn().Msgf("Ignoring all the rules but the first one of TCPRoute %s/%s", tcpRoute.Namespace, tcpRoute.Name)
+		}
+		return mc.getGatewayAPIUpstreamClusters(tcpRoute.Namespace, tcpRoute.Name, meshSvc, tcpRoute.Spec.Rules[0].BackendRefs)
+	}
+	return nil
+}
+
+// getGatewayAPIRouteNamespace returns the namespace of the routes, among the routes in the given namespaces, that apply
+// to the downstream clients in the given namespace, false if none of the routes apply.
+func getGatewayAPIRouteNamespace(routeNamespaces []string, downstreamNamespace string, upstreamNamespace string) (string, bool) {
+	hasProducerRoutes := false
+	for _, namespace := range routeNamespaces {
+		if namespace == downstreamNamespace {
+			// Consumer routes take precedence over producer routes
+			return namespace, true
+		}
+		if namespace == upstreamNamespace {
+			hasProducerRoutes = true
+		}
+	}
+	return upstreamNamespace, hasProducerRoutes
+}
+
+// buildGatewayAPIRoutes returns the routes for the given matches of a route rule, forwarding the requests to the given backends
+func (mc *MeshCatalog) buildGatewayAPIRoutes(routeNamespace, routeName string, meshSvc service.MeshService, matches []trafficpolicy.HTTPRouteMatch,
+	filters []gwv1beta1.HTTPRouteFilter, backendRefs []gwv1beta1.BackendRef) []*trafficpolicy.RouteWeightedClusters {
+	routeFilters, mirrorClusters := mc.getGatewayAPIRouteFilters(routeNamespace, routeName, meshSvc, filters)
+
+	weightedClusters := mapset.NewSet()
+	for _, upstreamCluster := range mc.getGatewayAPIUpstreamClusters(routeNamespace, routeName, meshSvc, backendRefs) {
+		weightedClusters.Add(upstreamCluster)
+	}
+	if weightedClusters.Cardinality() == 0 && (routeFilters == nil || routeFilters.Redirect == nil) {
+		log.Warn().Msgf("Ignoring a rule of route %s/%s attached to service %s, the rule has no valid backend", routeNamespace, routeName, meshSvc)
+		return nil
+	}
+
+	var routes []*trafficpolicy.RouteWeightedClusters
+	for _, match := range matches {
+		routes = append(routes, &trafficpolicy.RouteWeightedClusters{
+			HTTPRouteMatch:   match,
+			WeightedClusters: weightedClusters,
+			MirrorClusters:   mirrorClusters,
+			OutboundMatch:    true,
+			Filters:          routeFilters,
+		})
+	}
+	return routes
+}
+
+// getGatewayAPIUpstreamClusters returns the upstream clusters corresponding to the given backend references of a route,
+// skipping the backends with a zero weight
+func (mc *MeshCatalog) getGatewayAPIUpstreamClusters(routeNamespace, routeName string, meshSvc service.MeshService, backendRefs []gwv1beta1.BackendRef) []service.WeightedCluster {
+	var upstreamClusters []service.WeightedCluster
+	for _, backendRef := range backendRefs {
+		weight := gatewayAPIDefaultWeight
+		if backendRef.Weight != nil {
+			weight = int(*backendRef.Weight)
+		}
+		if weight == 0 {
+			continue
+		}
+
+		backendSvc, err := mc.getGatewayAPIBackendService(routeNamespace, meshSvc, backendRef.BackendObjectReference)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Ignoring a backend of route %s/%s attached to service %s", routeNamespace, routeName, meshSvc)
+			continue
+		}
+		upstreamClusters = append(upstreamClusters, service.WeightedCluster{
+			ClusterName: service.ClusterName(backendSvc.SidecarClusterName()),
+			Weight:      weight,
+		})
+	}
+	return upstreamClusters
+}
+
+// getGatewayAPIBackendService returns the MeshService the given backend reference of a route attached to the given
+// service refers to. Only the services in the namespace of the service the route is attached to are supported as backends.
+func (mc *MeshCatalog) getGatewayAPIBackendService(routeNamespace string, meshSvc service.MeshService, backendRef gwv1beta1.BackendObjectReference) (service.MeshService, error) {
+	svc, ok := gatewayapi.GetBackendService(routeNamespace, backendRef)
+	if !ok {
+		return service.MeshService{}, fmt.Errorf("backend %s is not a service", backendRef.Name)
+	}
+	if svc.Namespace != meshSvc.Namespace {
+		return service.MeshService{}, fmt.Errorf("backend service %s is not in the namespace of service %s", svc, meshSvc)
+	}
+
+	backendSvc := service.MeshService{
+		Namespace: svc.Namespace,
+		Name:      svc.Name,
+		Port:      meshSvc.Port,
+		Protocol:  meshSvc.Protocol,
+	}
+	if backendRef.Port != nil {
+		backendSvc.Port = uint16(*backendRef.Port)
+	}
+
+	targetPort, err := mc.kubeController.GetTargetPortForServicePort(
+		types.NamespacedName{Namespace: backendSvc.Namespace, Name: backendSvc.Name}, backendSvc.Port)
+	if err != nil {
+		return service.MeshService{}, err
+	}
+	backendSvc.TargetPort = targetPort
+	return backendSvc, nil
+}
+
+// getGatewayAPIRouteFilters returns the modifications and the mirror clusters corresponding to the given filters of a route rule
+func (mc *MeshCatalog) getGatewayAPIRouteFilters(routeNamespace, routeName string, meshSvc service.MeshService,
+	filters []gwv1beta1.HTTPRouteFilter) (*trafficpolicy.HTTPRouteFilters, []*trafficpolicy.MirrorCluster) {
+	if len(filters) == 0 {
+		return nil, nil
+	}
+
+	routeFilters := new(trafficpolicy.HTTPRouteFilters)
+	var mirrorClusters []*trafficpolicy.MirrorCluster
+	for _, filter := range filters {
+		switch filter.Type {
+		case gwv1beta1.HTTPRouteFilterRequestHeaderModifier:
+			routeFilters.RequestHeaders = getGatewayAPIHeaderModifier(filter.RequestHeaderModifier)
+
+		case gwv1beta1.HTTPRouteFilterResponseHeaderModifier:
+			routeFilters.ResponseHeaders = getGatewayAPIHeaderModifier(filter.ResponseHeaderModifier)
+
+		case gwv1beta1.HTTPRouteFilterURLRewrite:
+			if filter.URLRewrite == nil {
+				continue
+			}
+			routeFilters.URLRewrite = &trafficpolicy.HTTPURLRewrite{
+				Path: getGatewayAPIPathModifier(filter.URLRewrite.Path),
+			}
+			if filter.URLRewrite.Hostname != nil {
+				routeFilters.URLRewrite.Hostname = string(*filter.URLRewrite.Hostname)
+			}
+
+		case gwv1beta1.HTTPRouteFilterRequestRedirect:
+			if filter.RequestRedirect == nil {
+				continue
+			}
+			redirect := &trafficpolicy.HTTPRequestRedirect{
+				Path: getGatewayAPIPathModifier(filter.RequestRedirect.Path),
+			}
+			if filter.RequestRedirect.Scheme != nil {
+				redirect.Scheme = *filter.RequestRedirect.Scheme
+			}
+			if filter.RequestRedirect.Hostname != nil {
+				redirect.Hostname = string(*filter.RequestRedirect.Hostname)
+			}
+			if filter.RequestRedirect.Port != nil {
+				redirect.Port = int(*filter.RequestRedirect.Port)
+			}
+			if filter.RequestRedirect.StatusCode != nil {
+				redirect.StatusCode = *filter.RequestRedirect.StatusCode
+			}
+			routeFilters.Redirect = redirect
+
+		case gwv1beta1.HTTPRouteFilterRequestMirror:
+			if filter.RequestMirror == nil {
+				continue
+			}
+			mirrorSvc, err := mc.getGatewayAPIBackendService(routeNamespace, meshSvc, filter.RequestMirror.BackendRef)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Ignoring a mirror backend of route %s/%s attached to service %s", routeNamespace, routeName, meshSvc)
+				continue
+			}
+			mirrorClusters = append(mirrorClusters, &trafficpolicy.MirrorCluster{
+				ClusterName: service.ClusterName(mirrorSvc.SidecarClusterName()),
+				Percentage:  100,
+			})
+
+		default:
+			log.Warn().Msgf("Ignoring filter of type %s of route %s/%s, the filter type is not supported", filter.Type, routeNamespace, routeName)
+		}
+	}
+
+	if *routeFilters == (trafficpolicy.HTTPRouteFilters{}) {
+		return nil, mirrorClusters
+	}
+	return routeFilters, mirrorClusters
+}
+
+func getGatewayAPIHeaderModifier(filter *gwv1beta1.HTTPHeaderFilter) *trafficpolicy.HTTPHeaderModifier {
+	if filter == nil {
+		return nil
+	}
+
+	modifier := new(trafficpolicy.HTTPHeaderModifier)
+	for _, header := range filter.Set {
+		if modifier.Set == nil {
+			modifier.Set = make(map[string]string)
+		}
+		modifier.Set[strings.ToLower(string(header.Name))] = header.Value
+	}
+	for _, header := range filter.Add {
+		if modifier.Add == nil {
+			modifier.Add = make(map[string]string)
+		}
+		modifier.Add[strings.ToLower(string(header.Name))] = header.Value
+	}
+	for _, name := range filter.Remove {
+		modifier.Remove = append(modifier.Remove, strings.ToLower(name))
+	}
+	return modifier
+}
+
+func getGatewayAPIPathModifier(modifier *gwv1beta1.HTTPPathModifier) *trafficpolicy.HTTPPathModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	switch modifier.Type {
+	case gwv1beta1.FullPathHTTPPathModifier:
+		return &trafficpolicy.HTTPPathModifier{ReplaceFullPath: modifier.ReplaceFullPath}
+	case gwv1beta1.PrefixMatchHTTPPathModifier:
+		return &trafficpolicy.HTTPPathModifier{ReplacePrefixMatch: modifier.ReplacePrefixMatch}
+	}
+	return nil
+}
+
+// getGatewayAPIHTTPRouteMatch returns the route match corresponding to the given HTTPRoute match
+func getGatewayAPIHTTPRouteMatch(match gwv1beta1.HTTPRouteMatch) trafficpolicy.HTTPRouteMatch {
+	routeMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          gatewayAPIDefaultPathPrefix,
+		PathMatchType: trafficpolicy.PathMatchPrefix,
+		Methods:       []string{constants.WildcardHTTPMethod},
+		Headers:       getGatewayAPIHeaderMatches(match.Headers),
+	}
+
+	if match.Path != nil {
+		if match.Path.Value != nil {
+			routeMatch.Path = *match.Path.Value
+		}
+		if match.Path.Type != nil {
+			switch *match.Path.Type {
+			case gwv1beta1.PathMatchExact:
+				routeMatch.PathMatchType = trafficpolicy.PathMatchExact
+			case gwv1beta1.PathMatchRegularExpression:
+				routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+			}
+		}
+	}
+	if match.Method != nil {
+		routeMatch.Methods = []string{string(*match.Method)}
+	}
+	return routeMatch
+}
+
+// getGatewayAPIGRPCRouteMatch returns the route match corresponding to the given GRPCRoute match, gRPC methods being
+// matched on the request path of the form /<service>/<method>
+func getGatewayAPIGRPCRouteMatch(match gwv1alpha2.GRPCRouteMatch) trafficpolicy.HTTPRouteMatch {
+	var headers []gwv1beta1.HTTPHeaderMatch
+	for _, header := range match.Headers {
+		headers = append(headers, gwv1beta1.HTTPHeaderMatch{
+			Type:  header.Type,
+			Name:  gwv1beta1.HTTPHeaderName(header.Name),
+			Value: header.Value,
+		})
+	}
+
+	routeMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          gatewayAPIDefaultPathPrefix,
+		PathMatchType: trafficpolicy.PathMatchPrefix,
+		Methods:       []string{constants.WildcardHTTPMethod},
+		Headers:       getGatewayAPIHeaderMatches(headers),
+		GRPC:          true,
+	}
+
+	if match.Method == nil || (match.Method.Service == nil && match.Method.Method == nil) {
+		return routeMatch
+	}
+
+	if match.Method.Type != nil && *match.Method.Type == gwv1alpha2.GRPCMethodMatchRegularExpression {
+		svc, method := "[^/]+", "[^/]+"
+		if match.Method.Service != nil {
+			svc = *match.Method.Service
+		}
+		if match.Method.Method != nil {
+			method = *match.Method.Method
+		}
+		routeMatch.Path = fmt.Sprintf("^/%s/%s$", svc, method)
+		routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+		return routeMatch
+	}
+
+	switch {
+	case match.Method.Service != nil && match.Method.Method != nil:
+		routeMatch.Path = fmt.Sprintf("/%s/%s", *match.Method.Service, *match.Method.Method)
+		routeMatch.PathMatchType = trafficpolicy.PathMatchExact
+	case match.Method.Service != nil:
+		routeMatch.Path = fmt.Sprintf("/%s/", *match.Method.Service)
+	default:
+		routeMatch.Path = fmt.Sprintf("^/[^/]+/%s$", regexp.QuoteMeta(*match.Method.Method))
+		routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+	}
+	return routeMatch
+}
+
+// getGatewayAPIHeaderMatches returns the regular expressions matching the given header matches, keyed by header name.
+// Only the first match of a header name is considered, as required by the Gateway API.
+func getGatewayAPIHeaderMatches(matches []gwv1beta1.HTTPHeaderMatch) map[string]string {
+	if len(matches) == 0 {
+		return nil
+	}
+
+	headers := make(map[string]string)
+	for _, match := range matches {
+		name := strings.ToLower(string(match.Name))
+		if _, ok := headers[name]; ok {
+			continue
+		}
+		value := fmt.Sprintf("^%s$", regexp.QuoteMeta(match.Value))
+		if match.Type != nil && *match.Type == gwv1beta1.HeaderMatchRegularExpression {
+			value = match.Value
+		}
+		headers[name] = value
+	}
+	return headers
+}
+
+// isGatewayAPIRouteMatchPreferred returns true if the route match a takes precedence over the route match b,
+// following the precedence rules of the Gateway API: exact path matches first, then prefix path matches from the
+// longest to the shortest prefix, then method matches, then gRPC matches, then the matches with the largest number of
+// header matches. Regex path matches are given the lowest precedence.
+func isGatewayAPIRouteMatchPreferred(a, b trafficpolicy.HTTPRouteMatch) bool {
+	rank := func(match trafficpolicy.HTTPRouteMatch) int {
+		switch match.PathMatchType {
+		case trafficpolicy.PathMatchExact:
+			return 0
+		case trafficpolicy.PathMatchPrefix:
+			return 1
+		default:
+			return 2
+		}
+	}
+	if rank(a) != rank(b) {
+		return rank(a) < rank(b)
+	}
+	if a.PathMatchType != trafficpolicy.PathMatchRegex && len(a.Path) != len(b.Path) {
+		return len(a.Path) > len(b.Path)
+	}
+
+	hasMethod := func(match trafficpolicy.HTTPRouteMatch) bool {
+		return len(match.Methods) > 0 && match.Methods[0] != constants.WildcardHTTPMethod
+	}
+	if hasMethod(a) != hasMethod(b) {
+		return hasMethod(a)
+	}
+	if a.GRPC != b.GRPC {
+		return a.GRPC
+	}
+	return len(a.Headers) > len(b.Headers)
+}
+
+// isGatewayAPICatchAllRouteMatch returns true if the given route match matches all the requests
+func isGatewayAPICatchAllRouteMatch(match trafficpolicy.HTTPRouteMatch) bool {
+	return match.PathMatchType == trafficpolicy.PathMatchPrefix && match.Path == gatewayAPIDefaultPathPrefix && !match.GRPC &&
+		len(match.Headers) == 0 && len(match.Methods) == 1 && match.Methods[0] == constants.WildcardHTTPMethod
+}
+
+// getGatewayAPIApexServices returns the services the Gateway API HTTPRoute, GRPCRoute and TCPRoute resources referring
+// to the given service as a backend are attached to, in the namespace of the given service
+func (mc *MeshCatalog) getGatewayAPIApexServices(svc service.MeshService) []service.MeshService {
+	if !mc.configurator.GetFeatureFlags().EnableGatewayAPI {
+		return nil
+	}
+
+	var apexServices []service.MeshService
+	addApexServices := func(routeNamespace string, parentRefs []gwv1beta1.ParentReference) {
+		for _, parentRef := range parentRefs {
+			parentSvc, ok := gatewayapi.GetParentService(routeNamespace, parentRef)
+			if !ok || parentSvc.Namespace != svc.Namespace || parentSvc.Name == svc.Name {
+				continue
+			}
+			apexServices = append(apexServices, service.MeshService{
+				Namespace:  svc.Namespace,
+				Name:       parentSvc.Name,
+				Port:       svc.Port,
+				TargetPort: svc.TargetPort,
+				Protocol:   svc.Protocol,
+			})
+		}
+	}
+
+	for _, route := range mc.gatewayAPIController.ListHTTPRoutes(gatewayapi.WithBackendService(svc)) {
+		addApexServices(route.Namespace, route.Spec.ParentRefs)
+	}
+	for _, route := range mc.gatewayAPIController.ListGRPCRoutes(gatewayapi.WithBackendService(svc)) {
+		addApexServices(route.Namespace, route.Spec.ParentRefs)
+	}
+	for _, route := range mc.gatewayAPIController.ListTCPRoutes(gatewayapi.WithBackendService(svc)) {
+		addApexServices(route.Namespace, route.Spec.ParentRefs)
+	}
+	return apexServices
+}
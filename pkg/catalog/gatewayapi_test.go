@@ -0,0 +1,562 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func newGatewayAPIServiceParentRef(name string) gwv1beta1.ParentReference {
+	kind := gwv1beta1.Kind("Service")
+	group := gwv1beta1.Group("")
+	ns := gwv1beta1.Namespace("ns-1")
+	return gwv1beta1.ParentReference{
+		Group:     &group,
+		Kind:      &kind,
+		Namespace: &ns,
+		Name:      gwv1beta1.ObjectName(name),
+	}
+}
+
+func newGatewayAPIBackendRef(name string, weight int32) gwv1beta1.BackendRef {
+	return gwv1beta1.BackendRef{
+		BackendObjectReference: gwv1beta1.BackendObjectReference{Name: gwv1beta1.ObjectName(name)},
+		Weight:                 &weight,
+	}
+}
+
+func TestGetGatewayAPIHTTPRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockGatewayAPIController := gatewayapi.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:         mockCfg,
+		kubeController:       mockKubeController,
+		gatewayAPIController: mockGatewayAPIController,
+	}
+
+	meshSvc := service.MeshService{Namespace: "ns-1", Name: "foo", Port: 80, TargetPort: 8080, Protocol: constants.ProtocolHTTP}
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "foo-v1"}, uint16(80)).Return(uint16(8080), nil).AnyTimes()
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "foo-v2"}, uint16(80)).Return(uint16(8080), nil).AnyTimes()
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "unknown"}, uint16(80)).Return(uint16(0), errors.New("not found")).AnyTimes()
+
+	exactMatch := gwv1beta1.PathMatchExact
+	headerMatch := gwv1beta1.HeaderMatchExact
+	methodPost := gwv1beta1.HTTPMethodPost
+	loginPath := "/login"
+	producerRoute := &gwv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "producer"},
+		Spec: gwv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+				ParentRefs: []gwv1beta1.ParentReference{newGatewayAPIServiceParentRef("foo")},
+			},
+			Rules: []gwv1beta1.HTTPRouteRule{
+				{
+					// Catch-all rule splitting the traffic between the 2 versions
+					BackendRefs: []gwv1beta1.HTTPBackendRef{
+						{BackendRef: newGatewayAPIBackendRef("foo-v1", 90)},
+						{BackendRef: newGatewayAPIBackendRef("foo-v2", 10)},
+						{BackendRef: newGatewayAPIBackendRef("unknown", 10)},
+					},
+				},
+				{
+					Matches: []gwv1beta1.HTTPRouteMatch{
+						{
+							Path:    &gwv1beta1.HTTPPathMatch{Type: &exactMatch, Value: &loginPath},
+							Method:  &methodPost,
+							Headers: []gwv1beta1.HTTPHeaderMatch{{Type: &headerMatch, Name: "X-Version", Value: "v2.0"}},
+						},
+					},
+					BackendRefs: []gwv1beta1.HTTPBackendRef{{BackendRef: newGatewayAPIBackendRef("foo-v2", 1)}},
+				},
+				{
+					// Rule without valid backends
+					BackendRefs: []gwv1beta1.HTTPBackendRef{{BackendRef: newGatewayAPIBackendRef("unknown", 1)}},
+				},
+			},
+		},
+	}
+	// The backends of a consumer route are in the namespace of the service
+	consumerBackendRef := newGatewayAPIBackendRef("foo-v2", 1)
+	serviceNs := gwv1beta1.Namespace("ns-1")
+	consumerBackendRef.Namespace = &serviceNs
+	consumerRoute := &gwv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "consumer"},
+		Spec: gwv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+				ParentRefs: []gwv1beta1.ParentReference{newGatewayAPIServiceParentRef("foo")},
+			},
+			Rules: []gwv1beta1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1beta1.HTTPBackendRef{{BackendRef: consumerBackendRef}},
+				},
+			},
+		},
+	}
+	grpcService := "helloworld.Greeter"
+	grpcRoute := &gwv1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "grpc"},
+		Spec: gwv1alpha2.GRPCRouteSpec{
+			CommonRouteSpec: gwv1alpha2.CommonRouteSpec{
+				ParentRefs: []gwv1alpha2.ParentReference{newGatewayAPIServiceParentRef("foo")},
+			},
+			Rules: []gwv1alpha2.GRPCRouteRule{
+				{
+					Matches: []gwv1alpha2.GRPCRouteMatch{{Method: &gwv1alpha2.GRPCMethodMatch{Service: &grpcService}}},
+					BackendRefs: []gwv1alpha2.GRPCBackendRef{
+						{BackendRef: newGatewayAPIBackendRef("foo-v1", 1)},
+					},
+				},
+			},
+		},
+	}
+
+	catchAllRoute := &trafficpolicy.RouteWeightedClusters{
+		HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+			Path:          "/",
+			PathMatchType: trafficpolicy.PathMatchPrefix,
+			Methods:       []string{constants.WildcardHTTPMethod},
+		},
+		WeightedClusters: mapset.NewSet(
+			service.WeightedCluster{ClusterName: "ns-1/foo-v1|8080", Weight: 90},
+			service.WeightedCluster{ClusterName: "ns-1/foo-v2|8080", Weight: 10},
+		),
+		OutboundMatch: true,
+	}
+	loginRoute := &trafficpolicy.RouteWeightedClusters{
+		HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+			Path:          "/login",
+			PathMatchType: trafficpolicy.PathMatchExact,
+			Methods:       []string{"POST"},
+			Headers:       map[string]string{"x-version": `^v2\.0$`},
+		},
+		WeightedClusters: mapset.NewSet(service.WeightedCluster{ClusterName: "ns-1/foo-v2|8080", Weight: 1}),
+		OutboundMatch:    true,
+	}
+	grpcServiceRoute := &trafficpolicy.RouteWeightedClusters{
+		HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+			Path:          "/helloworld.Greeter/",
+			PathMatchType: trafficpolicy.PathMatchPrefix,
+			Methods:       []string{constants.WildcardHTTPMethod},
+			GRPC:          true,
+		},
+		WeightedClusters: mapset.NewSet(service.WeightedCluster{ClusterName: "ns-1/foo-v1|8080", Weight: 1}),
+		OutboundMatch:    true,
+	}
+	consumerCatchAllRoute := &trafficpolicy.RouteWeightedClusters{
+		HTTPRouteMatch:   catchAllRoute.HTTPRouteMatch,
+		WeightedClusters: mapset.NewSet(service.WeightedCluster{ClusterName: "ns-1/foo-v2|8080", Weight: 1}),
+		OutboundMatch:    true,
+	}
+
+	testCases := []struct {
+		name           string
+		gatewayAPIFlag bool
+		downstream     identity.ServiceIdentity
+		httpRoutes     []*gwv1beta1.HTTPRoute
+		grpcRoutes     []*gwv1alpha2.GRPCRoute
+		expectedRoutes []*trafficpolicy.RouteWeightedClusters
+	}{
+		{
+			name:           "feature flag disabled",
+			gatewayAPIFlag: false,
+			downstream:     identity.New("sa-2", "ns-3"),
+			expectedRoutes: nil,
+		},
+		{
+			name:           "producer routes sorted by precedence",
+			gatewayAPIFlag: true,
+			downstream:     identity.New("sa-2", "ns-3"),
+			httpRoutes:     []*gwv1beta1.HTTPRoute{consumerRoute, producerRoute},
+			grpcRoutes:     []*gwv1alpha2.GRPCRoute{grpcRoute},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{loginRoute, grpcServiceRoute, catchAllRoute},
+		},
+		{
+			name:           "consumer routes take precedence over producer routes",
+			gatewayAPIFlag: true,
+			downstream:     identity.New("sa-2", "ns-2"),
+			httpRoutes:     []*gwv1beta1.HTTPRoute{consumerRoute, producerRoute},
+			grpcRoutes:     []*gwv1alpha2.GRPCRoute{grpcRoute},
+			expectedRoutes: []*trafficpolicy.RouteWeightedClusters{consumerCatchAllRoute},
+		},
+		{
+			name:           "consumer routes do not apply to other namespaces",
+			gatewayAPIFlag: true,
+			downstream:     identity.New("sa-2", "ns-3"),
+			httpRoutes:     []*gwv1beta1.HTTPRoute{consumerRoute},
+			expectedRoutes: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableGatewayAPI: tc.gatewayAPIFlag}).Times(1)
+			if tc.gatewayAPIFlag {
+				mockGatewayAPIController.EXPECT().ListHTTPRoutes(gomock.Any()).Return(tc.httpRoutes).Times(1)
+				mockGatewayAPIController.EXPECT().ListGRPCRoutes(gomock.Any()).Return(tc.grpcRoutes).Times(1)
+			}
+
+			actual := mc.getGatewayAPIHTTPRoutes(tc.downstream, meshSvc)
+			assert.Len(actual, len(tc.expectedRoutes))
+			for i, expected := range tc.expectedRoutes {
+				assert.Equal(expected.HTTPRouteMatch, actual[i].HTTPRouteMatch)
+				assert.True(expected.WeightedClusters.Equal(actual[i].WeightedClusters))
+				assert.Equal(expected.OutboundMatch, actual[i].OutboundMatch)
+				assert.Nil(actual[i].Filters)
+			}
+		})
+	}
+}
+
+func TestGetGatewayAPITCPRouteUpstreamClusters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockGatewayAPIController := gatewayapi.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:         mockCfg,
+		kubeController:       mockKubeController,
+		gatewayAPIController: mockGatewayAPIController,
+	}
+
+	meshSvc := service.MeshService{Namespace: "ns-1", Name: "foo", Port: 3306, TargetPort: 3306, Protocol: constants.ProtocolTCP}
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "foo-v1"}, uint16(3306)).Return(uint16(3306), nil).AnyTimes()
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "foo-v2"}, uint16(3306)).Return(uint16(3306), nil).AnyTimes()
+
+	tcpRoute := &gwv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "tcp"},
+		Spec: gwv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gwv1alpha2.CommonRouteSpec{
+				ParentRefs: []gwv1alpha2.ParentReference{newGatewayAPIServiceParentRef("foo")},
+			},
+			Rules: []gwv1alpha2.TCPRouteRule{
+				{
+					BackendRefs: []gwv1alpha2.BackendRef{
+						newGatewayAPIBackendRef("foo-v1", 50),
+						newGatewayAPIBackendRef("foo-v2", 50),
+						newGatewayAPIBackendRef("foo-v3", 0),
+					},
+				},
+				{
+					BackendRefs: []gwv1alpha2.BackendRef{newGatewayAPIBackendRef("foo-v2", 1)},
+				},
+			},
+		},
+	}
+
+	mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableGatewayAPI: false}).Times(1)
+	tassert.Nil(t, mc.getGatewayAPITCPRouteUpstreamClusters(identity.New("sa-2", "ns-2"), meshSvc))
+
+	mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableGatewayAPI: true}).Times(1)
+	mockGatewayAPIController.EXPECT().ListTCPRoutes(gomock.Any()).Return([]*gwv1alpha2.TCPRoute{tcpRoute}).Times(1)
+	tassert.Equal(t, []service.WeightedCluster{
+		{ClusterName: "ns-1/foo-v1|3306", Weight: 50},
+		{ClusterName: "ns-1/foo-v2|3306", Weight: 50},
+	}, mc.getGatewayAPITCPRouteUpstreamClusters(identity.New("sa-2", "ns-2"), meshSvc))
+}
+
+func TestGetGatewayAPIRouteFilters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		kubeController: mockKubeController,
+	}
+
+	meshSvc := service.MeshService{Namespace: "ns-1", Name: "foo", Port: 80, TargetPort: 8080, Protocol: constants.ProtocolHTTP}
+	mockKubeController.EXPECT().GetTargetPortForServicePort(types.NamespacedName{Namespace: "ns-1", Name: "foo-shadow"}, uint16(80)).Return(uint16(8080), nil).AnyTimes()
+
+	otherNs := gwv1beta1.Namespace("ns-2")
+	hostname := gwv1beta1.PreciseHostname("bar.ns-1")
+	scheme := "https"
+	statusCode := 301
+	prefix := "/v2"
+
+	testCases := []struct {
+		name            string
+		filters         []gwv1beta1.HTTPRouteFilter
+		expectedFilters *trafficpolicy.HTTPRouteFilters
+		expectedMirrors []*trafficpolicy.MirrorCluster
+	}{
+		{
+			name:            "no filters",
+			expectedFilters: nil,
+		},
+		{
+			name: "header modifiers and URL rewrite",
+			filters: []gwv1beta1.HTTPRouteFilter{
+				{
+					Type: gwv1beta1.HTTPRouteFilterRequestHeaderModifier,
+					RequestHeaderModifier: &gwv1beta1.HTTPHeaderFilter{
+						Set:    []gwv1beta1.HTTPHeader{{Name: "X-Set", Value: "a"}},
+						Add:    []gwv1beta1.HTTPHeader{{Name: "X-Add", Value: "b"}},
+						Remove: []string{"X-Remove"},
+					},
+				},
+				{
+					Type:                   gwv1beta1.HTTPRouteFilterResponseHeaderModifier,
+					ResponseHeaderModifier: &gwv1beta1.HTTPHeaderFilter{Remove: []string{"Server"}},
+				},
+				{
+					Type: gwv1beta1.HTTPRouteFilterURLRewrite,
+					URLRewrite: &gwv1beta1.HTTPURLRewriteFilter{
+						Hostname: &hostname,
+						Path:     &gwv1beta1.HTTPPathModifier{Type: gwv1beta1.PrefixMatchHTTPPathModifier, ReplacePrefixMatch: &prefix},
+					},
+				},
+			},
+			expectedFilters: &trafficpolicy.HTTPRouteFilters{
+				RequestHeaders: &trafficpolicy.HTTPHeaderModifier{
+					Set:    map[string]string{"x-set": "a"},
+					Add:    map[string]string{"x-add": "b"},
+					Remove: []string{"x-remove"},
+				},
+				ResponseHeaders: &trafficpolicy.HTTPHeaderModifier{Remove: []string{"server"}},
+				URLRewrite: &trafficpolicy.HTTPURLRewrite{
+					Hostname: "bar.ns-1",
+					Path:     &trafficpolicy.HTTPPathModifier{ReplacePrefixMatch: &prefix},
+				},
+			},
+		},
+		{
+			name: "redirect",
+			filters: []gwv1beta1.HTTPRouteFilter{
+				{
+					Type: gwv1beta1.HTTPRouteFilterRequestRedirect,
+					RequestRedirect: &gwv1beta1.HTTPRequestRedirectFilter{
+						Scheme:     &scheme,
+						Hostname:   &hostname,
+						StatusCode: &statusCode,
+						Path:       &gwv1beta1.HTTPPathModifier{Type: gwv1beta1.FullPathHTTPPathModifier, ReplaceFullPath: &prefix},
+					},
+				},
+			},
+			expectedFilters: &trafficpolicy.HTTPRouteFilters{
+				Redirect: &trafficpolicy.HTTPRequestRedirect{
+					Scheme:     "https",
+					Hostname:   "bar.ns-1",
+					Path:       &trafficpolicy.HTTPPathModifier{ReplaceFullPath: &prefix},
+					StatusCode: 301,
+				},
+			},
+		},
+		{
+			name: "mirrors and unsupported filters",
+			filters: []gwv1beta1.HTTPRouteFilter{
+				{
+					Type: gwv1beta1.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gwv1beta1.HTTPRequestMirrorFilter{
+						BackendRef: gwv1beta1.BackendObjectReference{Name: "foo-shadow"},
+					},
+				},
+				{
+					Type: gwv1beta1.HTTPRouteFilterRequestMirror,
+					RequestMirror: &gwv1beta1.HTTPRequestMirrorFilter{
+						BackendRef: gwv1beta1.BackendObjectReference{Name: "foo-shadow", Namespace: &otherNs},
+					},
+				},
+				{
+					Type: gwv1beta1.HTTPRouteFilterExtensionRef,
+				},
+			},
+			expectedFilters: nil,
+			expectedMirrors: []*trafficpolicy.MirrorCluster{{ClusterName: "ns-1/foo-shadow|8080", Percentage: 100}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			filters, mirrors := mc.getGatewayAPIRouteFilters("ns-1", "route", meshSvc, tc.filters)
+			assert.Equal(tc.expectedFilters, filters)
+			assert.Equal(tc.expectedMirrors, mirrors)
+		})
+	}
+}
+
+func TestGetGatewayAPIGRPCRouteMatch(t *testing.T) {
+	grpcService := "helloworld.Greeter"
+	grpcMethod := "SayHello"
+	regexMatch := gwv1alpha2.GRPCMethodMatchRegularExpression
+
+	testCases := []struct {
+		name              string
+		match             gwv1alpha2.GRPCRouteMatch
+		expectedPath      string
+		expectedMatchType trafficpolicy.PathMatchType
+	}{
+		{
+			name:              "no method match",
+			match:             gwv1alpha2.GRPCRouteMatch{},
+			expectedPath:      "/",
+			expectedMatchType: trafficpolicy.PathMatchPrefix,
+		},
+		{
+			name:              "service and method",
+			match:             gwv1alpha2.GRPCRouteMatch{Method: &gwv1alpha2.GRPCMethodMatch{Service: &grpcService, Method: &grpcMethod}},
+			expectedPath:      "/helloworld.Greeter/SayHello",
+			expectedMatchType: trafficpolicy.PathMatchExact,
+		},
+		{
+			name:              "service only",
+			match:             gwv1alpha2.GRPCRouteMatch{Method: &gwv1alpha2.GRPCMethodMatch{Service: &grpcService}},
+			expectedPath:      "/helloworld.Greeter/",
+			expectedMatchType: trafficpolicy.PathMatchPrefix,
+		},
+		{
+			name:              "method only",
+			match:             gwv1alpha2.GRPCRouteMatch{Method: &gwv1alpha2.GRPCMethodMatch{Method: &grpcMethod}},
+			expectedPath:      "^/[^/]+/SayHello$",
+			expectedMatchType: trafficpolicy.PathMatchRegex,
+		},
+		{
+			name:              "regular expression",
+			match:             gwv1alpha2.GRPCRouteMatch{Method: &gwv1alpha2.GRPCMethodMatch{Type: &regexMatch, Method: &grpcMethod}},
+			expectedPath:      "^/[^/]+/SayHello$",
+			expectedMatchType: trafficpolicy.PathMatchRegex,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getGatewayAPIGRPCRouteMatch(tc.match)
+			assert.Equal(tc.expectedPath, actual.Path)
+			assert.Equal(tc.expectedMatchType, actual.PathMatchType)
+			assert.Equal([]string{constants.WildcardHTTPMethod}, actual.Methods)
+			assert.True(actual.GRPC)
+		})
+	}
+}
+
+func TestGetGatewayAPIHeaderMatches(t *testing.T) {
+	regexMatch := gwv1beta1.HeaderMatchRegularExpression
+
+	testCases := []struct {
+		name     string
+		matches  []gwv1beta1.HTTPHeaderMatch
+		expected map[string]string
+	}{
+		{
+			name:     "no header match",
+			expected: nil,
+		},
+		{
+			name: "exact and regular expression",
+			matches: []gwv1beta1.HTTPHeaderMatch{
+				{Name: "X-Version", Value: "v2.0"},
+				{Type: &regexMatch, Name: "X-User", Value: "^user-[0-9]+$"},
+			},
+			expected: map[string]string{"x-version": `^v2\.0$`, "x-user": "^user-[0-9]+$"},
+		},
+		{
+			name: "repeated header names",
+			matches: []gwv1beta1.HTTPHeaderMatch{
+				{Name: "X-Version", Value: "v1"},
+				{Name: "x-version", Value: "v2"},
+				{Type: &regexMatch, Name: "X-VERSION", Value: "v.*"},
+			},
+			expected: map[string]string{"x-version": "^v1$"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, getGatewayAPIHeaderMatches(tc.matches))
+		})
+	}
+}
+
+func TestGetGatewayAPIApexServices(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockGatewayAPIController := gatewayapi.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:         mockCfg,
+		gatewayAPIController: mockGatewayAPIController,
+	}
+
+	svc := service.MeshService{Namespace: "ns-1", Name: "foo-v1", Port: 80, TargetPort: 8080, Protocol: constants.ProtocolHTTP}
+	httpRoute := &gwv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "consumer"},
+		Spec: gwv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+				ParentRefs: []gwv1beta1.ParentReference{newGatewayAPIServiceParentRef("foo"), {Name: "gateway"}},
+			},
+		},
+	}
+	tcpRoute := &gwv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns-1", Name: "self"},
+		Spec: gwv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gwv1alpha2.CommonRouteSpec{
+				ParentRefs: []gwv1alpha2.ParentReference{newGatewayAPIServiceParentRef("foo-v1")},
+			},
+		},
+	}
+
+	mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableGatewayAPI: false}).Times(1)
+	tassert.Nil(t, mc.getGatewayAPIApexServices(svc))
+
+	mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableGatewayAPI: true}).Times(1)
+	mockGatewayAPIController.EXPECT().ListHTTPRoutes(gomock.Any()).Return([]*gwv1beta1.HTTPRoute{httpRoute}).Times(1)
+	mockGatewayAPIController.EXPECT().ListGRPCRoutes(gomock.Any()).Return(nil).Times(1)
+	mockGatewayAPIController.EXPECT().ListTCPRoutes(gomock.Any()).Return([]*gwv1alpha2.TCPRoute{tcpRoute}).Times(1)
+	tassert.Equal(t, []service.MeshService{
+		{Namespace: "ns-1", Name: "foo", Port: 80, TargetPort: 8080, Protocol: constants.ProtocolHTTP},
+	}, mc.getGatewayAPIApexServices(svc))
+}
+
+func TestIsGatewayAPIRouteMatchPreferred(t *testing.T) {
+	exact := trafficpolicy.HTTPRouteMatch{Path: "/a", PathMatchType: trafficpolicy.PathMatchExact, Methods: []string{constants.WildcardHTTPMethod}}
+	longPrefix := trafficpolicy.HTTPRouteMatch{Path: "/abc", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod}}
+	shortPrefix := trafficpolicy.HTTPRouteMatch{Path: "/a", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod}}
+	shortPrefixWithMethod := trafficpolicy.HTTPRouteMatch{Path: "/a", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{"GET"}}
+	shortPrefixWithHeaders := trafficpolicy.HTTPRouteMatch{Path: "/a", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod},
+		Headers: map[string]string{"x-a": "^a$"}}
+	regex := trafficpolicy.HTTPRouteMatch{Path: "^/a.*$", PathMatchType: trafficpolicy.PathMatchRegex, Methods: []string{constants.WildcardHTTPMethod}}
+
+	assert := tassert.New(t)
+	assert.True(isGatewayAPIRouteMatchPreferred(exact, longPrefix))
+	assert.True(isGatewayAPIRouteMatchPreferred(longPrefix, shortPrefix))
+	assert.True(isGatewayAPIRouteMatchPreferred(shortPrefixWithMethod, shortPrefixWithHeaders))
+	assert.True(isGatewayAPIRouteMatchPreferred(shortPrefixWithHeaders, shortPrefix))
+	assert.True(isGatewayAPIRouteMatchPreferred(shortPrefix, regex))
+	assert.False(isGatewayAPIRouteMatchPreferred(regex, exact))
+	assert.False(isGatewayAPIRouteMatchPreferred(shortPrefix, shortPrefix))
+	shortPrefixGRPC := shortPrefix
+	shortPrefixGRPC.GRPC = true
+	assert.True(isGatewayAPIRouteMatchPreferred(shortPrefixGRPC, shortPrefix))
+
+	assert.True(isGatewayAPICatchAllRouteMatch(trafficpolicy.HTTPRouteMatch{Path: "/", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod}}))
+	assert.False(isGatewayAPICatchAllRouteMatch(trafficpolicy.HTTPRouteMatch{Path: "/", PathMatchType: trafficpolicy.PathMatchPrefix, Methods: []string{constants.WildcardHTTPMethod}, GRPC: true}))
+	assert.False(isGatewayAPICatchAllRouteMatch(shortPrefix))
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/messaging"
 	"github.com/openservicemesh/osm/pkg/multicluster"
@@ -43,7 +44,10 @@ func newFakeMeshCatalogForRoutes(t *testing.T, testParams testParams) *MeshCatal
 	mockPolicyController := policy.NewMockController(mockCtrl)
 	mockPluginController := plugin.NewMockController(mockCtrl)
 	mockMulticlusterController := multicluster.NewMockController(mockCtrl)
+	mockGatewayAPIController := gatewayapi.NewMockController(mockCtrl)
 	mockConfigurator.EXPECT().GetOSMNamespace().Return("osm-system").AnyTimes()
+	mockPolicyController.EXPECT().ListExternalServices().Return(nil).AnyTimes()
+	mockPolicyController.EXPECT().GetExternalService(gomock.Any()).Return(nil).AnyTimes()
 
 	provider := kubeFake.NewFakeProvider()
 	endpointProviders := []endpoint.Provider{
@@ -144,5 +148,5 @@ func newFakeMeshCatalogForRoutes(t *testing.T, testParams testParams) *MeshCatal
 	mockMeshSpec.EXPECT().ListTrafficSplits().Return([]*split.TrafficSplit{}).AnyTimes()
 
 	return NewMeshCatalog(mockKubeController, mockMeshSpec, certManager,
-		mockPolicyController, mockPluginController, mockMulticlusterController, stop, mockConfigurator, serviceProviders, endpointProviders, messaging.NewBroker(stop))
+		mockPolicyController, mockPluginController, mockMulticlusterController, mockGatewayAPIController, stop, mockConfigurator, serviceProviders, endpointProviders, messaging.NewBroker(stop))
 }
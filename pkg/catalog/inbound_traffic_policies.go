@@ -91,12 +91,13 @@ func (mc *MeshCatalog) GetInboundMeshTrafficPolicy(upstreamIdentity identity.Ser
 			if upstreamTrafficSetting != nil {
 				trafficMatchForUpstreamSvc.RateLimit = upstreamTrafficSetting.Spec.RateLimit
 			}
+			trafficMatchForUpstreamSvc.JWTAuthentication = mc.getJWTAuthentication(upstreamSvc)
 			trafficMatches = append(trafficMatches, trafficMatchForUpstreamSvc)
 		}
 
 		// Build the HTTP route configs for this service and port combination.
-		// If the port's protocol corresponds to TCP, we can skip this step
-		if upstreamSvc.Protocol == constants.ProtocolTCP || upstreamSvc.Protocol == constants.ProtocolTCPServerFirst {
+		// If the port's protocol corresponds to TCP or UDP, we can skip this step
+		if upstreamSvc.Protocol == constants.ProtocolTCP || upstreamSvc.Protocol == constants.ProtocolTCPServerFirst || upstreamSvc.Protocol == constants.ProtocolUDP {
 			continue
 		}
 		// ---
@@ -179,9 +180,10 @@ func (mc *MeshCatalog) getRoutingRulesFromTrafficTarget(trafficTarget access.Tra
 
 	// Compute the allowed downstream service identities for the given TrafficTarget object
 	trustDomain := mc.GetTrustDomain()
+	spiffeEnabled := mc.GetSpiffeEnabled()
 	allowedDownstreamPrincipals := mapset.NewSet()
 	for _, source := range trafficTarget.Spec.Sources {
-		allowedDownstreamPrincipals.Add(trafficTargetIdentityToSvcAccount(source).AsPrincipal(trustDomain))
+		allowedDownstreamPrincipals.Add(trafficTargetIdentityToSvcAccount(source).AsPrincipal(trustDomain, spiffeEnabled))
 	}
 
 	var routingRules []*trafficpolicy.Rule
@@ -269,7 +271,7 @@ func getTrafficSpecName(trafficSpecKind string, trafficSpecNamespace string, tra
 
 // getUpstreamServicesIncludeApex returns a list of all upstream services associated with the given list
 // of services. An upstream service is associated with another service if it is a backend for an apex/root service
-// in a TrafficSplit config. This function returns a list consisting of the given upstream services and all apex
+// in a TrafficSplit config, or in a Gateway API route attached to the apex/root service. This function returns a list consisting of the given upstream services and all apex
 // services associated with each of those services.
 func (mc *MeshCatalog) getUpstreamServicesIncludeApex(upstreamServices []service.MeshService) []service.MeshService {
 	svcSet := mapset.NewSet()
@@ -301,6 +303,12 @@ func (mc *MeshCatalog) getUpstreamServicesIncludeApex(upstreamServices []service
 				allServices = append(allServices, apexMeshService)
 			}
 		}
+
+		for _, apexMeshService := range mc.getGatewayAPIApexServices(svc) {
+			if newlyAdded := svcSet.Add(apexMeshService); newlyAdded {
+				allServices = append(allServices, apexMeshService)
+			}
+		}
 	}
 
 	return allServices
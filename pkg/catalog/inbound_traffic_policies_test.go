@@ -15,6 +15,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	tresorFake "github.com/openservicemesh/osm/pkg/certificate/providers/tresor/fake"
 
@@ -173,7 +174,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -212,7 +213,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -478,7 +479,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 								{
 									Route: trafficpolicy.RouteWeightedClusters{
@@ -498,7 +499,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -537,7 +538,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 								{
 									Route: trafficpolicy.RouteWeightedClusters{
@@ -557,7 +558,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -726,7 +727,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -763,7 +764,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -802,7 +803,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1290,11 +1291,11 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 										identity.K8sServiceAccount{
 											Name:      "sa2",
 											Namespace: "ns2",
-										}.AsPrincipal("cluster.local"),
+										}.AsPrincipal("cluster.local", false),
 										identity.K8sServiceAccount{
 											Name:      "sa3",
 											Namespace: "ns3",
-										}.AsPrincipal("cluster.local")),
+										}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1334,11 +1335,11 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 										identity.K8sServiceAccount{
 											Name:      "sa2",
 											Namespace: "ns2",
-										}.AsPrincipal("cluster.local"),
+										}.AsPrincipal("cluster.local", false),
 										identity.K8sServiceAccount{
 											Name:      "sa3",
 											Namespace: "ns3",
-										}.AsPrincipal("cluster.local")),
+										}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1505,7 +1506,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1544,7 +1545,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1827,7 +1828,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -1868,7 +1869,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 									AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 										Name:      "sa2",
 										Namespace: "ns2",
-									}.AsPrincipal("cluster.local")),
+									}.AsPrincipal("cluster.local", false)),
 								},
 							},
 						},
@@ -2033,6 +2034,7 @@ func TestGetInboundMeshTrafficPolicy(t *testing.T) {
 
 			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).Return(tc.upstreamTrafficSetting).AnyTimes()
 			mockCfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode)
+			mockCfg.EXPECT().GetFeatureFlags().Return(configv1alpha2.FeatureFlags{}).AnyTimes()
 			mockMeshSpec.EXPECT().ListTrafficTargets(gomock.Any()).Return(tc.trafficTargets).AnyTimes()
 			mockMeshSpec.EXPECT().ListHTTPTrafficSpecs().Return(tc.httpRouteGroups).AnyTimes()
 			tc.prepare(mockMeshSpec, tc.trafficSplits)
@@ -14,11 +14,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/policy"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
-)
-
-const (
-	// singeIPPrefixLen is the IP prefix length for a single IP address
-	singeIPPrefixLen = "/32"
+	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
 // GetIngressTrafficPolicy returns the ingress traffic policy for the given mesh service
@@ -90,7 +86,7 @@ func (mc *MeshCatalog) GetIngressTrafficPolicy(svc service.MeshService) (*traffi
 				}
 
 				for _, ep := range endpoints {
-					sourceCIDR := ep.IP.String() + singeIPPrefixLen
+					sourceCIDR := cidr.SingleIPCIDR(ep.IP)
 					if sourceIPSet.Add(sourceCIDR) {
 						sourceIPRanges = append(sourceIPRanges, sourceCIDR)
 					}
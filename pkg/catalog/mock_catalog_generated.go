@@ -9,9 +9,11 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	v1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	configurator "github.com/openservicemesh/osm/pkg/configurator"
 	endpoint "github.com/openservicemesh/osm/pkg/endpoint"
 	identity "github.com/openservicemesh/osm/pkg/identity"
 	k8s "github.com/openservicemesh/osm/pkg/k8s"
+	messaging "github.com/openservicemesh/osm/pkg/messaging"
 	service "github.com/openservicemesh/osm/pkg/service"
 	trafficpolicy "github.com/openservicemesh/osm/pkg/trafficpolicy"
 	v1 "k8s.io/api/core/v1"
@@ -55,6 +57,20 @@ func (mr *MockMeshCatalogerMockRecorder) GetAccessControlTrafficPolicy(arg0 inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccessControlTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetAccessControlTrafficPolicy), arg0)
 }
 
+// GetConfigurator mocks base method.
+func (m *MockMeshCataloger) GetConfigurator() *configurator.Configurator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConfigurator")
+	ret0, _ := ret[0].(*configurator.Configurator)
+	return ret0
+}
+
+// GetConfigurator indicates an expected call of GetConfigurator.
+func (mr *MockMeshCatalogerMockRecorder) GetConfigurator() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConfigurator", reflect.TypeOf((*MockMeshCataloger)(nil).GetConfigurator))
+}
+
 // GetEgressGatewayPolicy mocks base method.
 func (m *MockMeshCataloger) GetEgressGatewayPolicy() (*trafficpolicy.EgressGatewayPolicy, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +131,20 @@ func (mr *MockMeshCatalogerMockRecorder) GetExportTrafficPolicy(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExportTrafficPolicy", reflect.TypeOf((*MockMeshCataloger)(nil).GetExportTrafficPolicy), arg0)
 }
 
+// GetFaultInjectionPolicies mocks base method.
+func (m *MockMeshCataloger) GetFaultInjectionPolicies(arg0 identity.ServiceIdentity, arg1 service.MeshService) []*v1alpha1.FaultInjectionSpec {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFaultInjectionPolicies", arg0, arg1)
+	ret0, _ := ret[0].([]*v1alpha1.FaultInjectionSpec)
+	return ret0
+}
+
+// GetFaultInjectionPolicies indicates an expected call of GetFaultInjectionPolicies.
+func (mr *MockMeshCatalogerMockRecorder) GetFaultInjectionPolicies(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFaultInjectionPolicies", reflect.TypeOf((*MockMeshCataloger)(nil).GetFaultInjectionPolicies), arg0, arg1)
+}
+
 // GetInboundMeshTrafficPolicy mocks base method.
 func (m *MockMeshCataloger) GetInboundMeshTrafficPolicy(arg0 identity.ServiceIdentity, arg1 []service.MeshService) *trafficpolicy.InboundMeshTrafficPolicy {
 	m.ctrl.T.Helper()
@@ -242,6 +272,20 @@ func (mr *MockMeshCatalogerMockRecorder) ListAllowedUpstreamEndpointsForService(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllowedUpstreamEndpointsForService", reflect.TypeOf((*MockMeshCataloger)(nil).ListAllowedUpstreamEndpointsForService), arg0, arg1)
 }
 
+// ListEndpointsForServiceIdentity mocks base method.
+func (m *MockMeshCataloger) ListEndpointsForServiceIdentity(arg0 identity.ServiceIdentity) []endpoint.Endpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEndpointsForServiceIdentity", arg0)
+	ret0, _ := ret[0].([]endpoint.Endpoint)
+	return ret0
+}
+
+// ListEndpointsForServiceIdentity indicates an expected call of ListEndpointsForServiceIdentity.
+func (mr *MockMeshCatalogerMockRecorder) ListEndpointsForServiceIdentity(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEndpointsForServiceIdentity", reflect.TypeOf((*MockMeshCataloger)(nil).ListEndpointsForServiceIdentity), arg0)
+}
+
 // ListInboundServiceIdentities mocks base method.
 func (m *MockMeshCataloger) ListInboundServiceIdentities(arg0 identity.ServiceIdentity) []identity.ServiceIdentity {
 	m.ctrl.T.Helper()
@@ -299,6 +343,20 @@ func (mr *MockMeshCatalogerMockRecorder) ListOutboundServicesForIdentity(arg0 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutboundServicesForIdentity", reflect.TypeOf((*MockMeshCataloger)(nil).ListOutboundServicesForIdentity), arg0)
 }
 
+// ListProxyDependencies mocks base method.
+func (m *MockMeshCataloger) ListProxyDependencies(arg0 identity.ServiceIdentity, arg1 []service.MeshService, arg2 *trafficpolicy.OutboundMeshTrafficPolicy) []messaging.ProxyDependency {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProxyDependencies", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]messaging.ProxyDependency)
+	return ret0
+}
+
+// ListProxyDependencies indicates an expected call of ListProxyDependencies.
+func (mr *MockMeshCatalogerMockRecorder) ListProxyDependencies(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProxyDependencies", reflect.TypeOf((*MockMeshCataloger)(nil).ListProxyDependencies), arg0, arg1, arg2)
+}
+
 // ListServiceIdentitiesForService mocks base method.
 func (m *MockMeshCataloger) ListServiceIdentitiesForService(arg0 service.MeshService) []identity.ServiceIdentity {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
 // GetExportTrafficPolicy returns the export policy for the given mesh service
@@ -36,7 +37,7 @@ func (mc *MeshCatalog) GetExportTrafficPolicy(svc service.MeshService) (*traffic
 		for _, controllerService := range controllerServices {
 			if endpoints := mc.listEndpointsForService(controllerService); len(endpoints) > 0 {
 				for _, ep := range endpoints {
-					sourceCIDR := ep.IP.String() + singeIPPrefixLen
+					sourceCIDR := cidr.SingleIPCIDR(ep.IP)
 					if sourceIPSet.Add(sourceCIDR) {
 						trafficMatch.SourceIPRanges = append(trafficMatch.SourceIPRanges, sourceCIDR)
 					}
@@ -1,18 +1,25 @@
 package catalog
 
 import (
+	"fmt"
+	"net"
+	"sort"
+
 	mapset "github.com/deckarep/golang-set"
 	split "github.com/servicemeshinterface/smi-sdk-go/pkg/apis/split/v1alpha4"
 	"k8s.io/apimachinery/pkg/types"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/providers/external"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/smi"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
 // GetOutboundMeshTrafficPolicy returns the outbound mesh traffic policy for the given downstream identity
@@ -24,6 +31,8 @@ import (
 //     to every upstream service account that this downstream is authorized to access using SMI TrafficTarget
 //     policies.
 //  3. Process TraficSplit policies and update the weights for the upstream services based on the policies.
+//  4. When enabled, process the Gateway API routes attached to the upstream services, which take precedence over
+//     the TrafficSplit policies.
 //
 // The route configurations are consolidated per port, such that upstream services using the same port are a part
 // of the same route configuration. This is required to avoid route conflicts that can occur when the same hostname
@@ -39,9 +48,16 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 	var egressPolicyGetted bool
 	var egressEnabled bool
 
+	// The services are sorted so that the traffic policies, and hence the resources built from them,
+	// do not change as long as the services do not change
+	meshServices := mc.ListOutboundServicesForIdentity(downstreamIdentity)
+	sort.Slice(meshServices, func(i, j int) bool {
+		return meshServices[i].String() < meshServices[j].String()
+	})
+
 	// For each service, build the traffic policies required to access it.
 	// It is important to aggregate HTTP route configs by the service's port.
-	for _, meshSvc := range mc.ListOutboundServicesForIdentity(downstreamIdentity) {
+	for _, meshSvc := range meshServices {
 		meshSvc := meshSvc // To prevent loop variable memory aliasing in for loop
 
 		egressEnabled, egressPolicyGetted, egressPolicy = mc.enableEgressSrviceForIdentity(downstreamIdentity, egressPolicyGetted, egressPolicy, meshSvc)
@@ -54,7 +70,7 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 		destinationIPSet := mapset.NewSet()
 		endpoints := mc.getDNSResolvableServiceEndpoints(meshSvc)
 		for _, endp := range endpoints {
-			ipCIDR := endp.IP.String() + "/32"
+			ipCIDR := cidr.SingleIPCIDR(endp.IP)
 			if added := destinationIPSet.Add(ipCIDR); added {
 				destinationIPRanges = append(destinationIPRanges, ipCIDR)
 			}
@@ -77,22 +93,55 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 
 		// ---
 		// Create the cluster config for this upstream service
+		externalService := mc.policyController.GetExternalService(meshSvc)
+		if externalService != nil {
+			addExternalServiceResolvableHosts(servicesResolvableSet, externalService)
+		}
 		clusterConfigForServicePort := &trafficpolicy.MeshClusterConfig{
 			Name:                            meshSvc.SidecarClusterName(),
 			Service:                         meshSvc,
 			EnableSidecarActiveHealthChecks: mc.configurator.GetFeatureFlags().EnableSidecarActiveHealthChecks,
-			UpstreamTrafficSetting: mc.policyController.GetUpstreamTrafficSetting(
-				policy.UpstreamTrafficSettingGetOpt{MeshService: &meshSvc}),
+			UpstreamTrafficSetting:          mc.getUpstreamTrafficSettingForService(meshSvc, externalService),
+			ExternalService:                 externalService,
 		}
 		clusterConfigs = append(clusterConfigs, clusterConfigForServicePort)
 
 		hasTrafficSplitWildCard := false
 		var routeMatches []*trafficpolicy.HTTPRouteMatchWithWeightedClusters
-		// Check if there is a traffic split corresponding to this service.
-		// The upstream clusters are to be derived from the traffic split backends
-		// in that case.
+		var gatewayAPIRoutes []*trafficpolicy.RouteWeightedClusters
+		var gatewayAPITCPUpstreamClusters []service.WeightedCluster
+		if meshSvc.Protocol == constants.ProtocolTCP || meshSvc.Protocol == constants.ProtocolTCPServerFirst {
+			gatewayAPITCPUpstreamClusters = mc.getGatewayAPITCPRouteUpstreamClusters(downstreamIdentity, meshSvc)
+		} else if meshSvc.Protocol != constants.ProtocolUDP {
+			gatewayAPIRoutes = mc.getGatewayAPIHTTPRoutes(downstreamIdentity, meshSvc)
+		}
+
+		// Check if there is a Gateway API route or a traffic split corresponding
+		// to this service. The upstream clusters are to be derived from the route
+		// or traffic split backends in that case.
 		trafficSplits := mc.meshSpec.ListTrafficSplits(smi.WithTrafficSplitApexService(meshSvc))
-		if len(trafficSplits) > 0 {
+		if len(gatewayAPITCPUpstreamClusters) > 0 {
+			routeMatch := new(trafficpolicy.HTTPRouteMatchWithWeightedClusters)
+			routeMatch.UpstreamClusters = gatewayAPITCPUpstreamClusters
+			routeMatches = append(routeMatches, routeMatch)
+		} else if len(gatewayAPIRoutes) > 0 {
+			// The traffic match forwards to the backends of the catch-all route
+			routeMatch := new(trafficpolicy.HTTPRouteMatchWithWeightedClusters)
+			for _, route := range gatewayAPIRoutes {
+				if isGatewayAPICatchAllRouteMatch(route.HTTPRouteMatch) {
+					for cluster := range route.WeightedClusters.Iter() {
+						routeMatch.UpstreamClusters = append(routeMatch.UpstreamClusters, cluster.(service.WeightedCluster))
+					}
+					break
+				}
+			}
+			if routeMatch.UpstreamClusters == nil {
+				// The requests not matching any route are forwarded to the service itself
+				hasTrafficSplitWildCard = true
+				routeMatch.UpstreamClusters = mc.mergeUpstreamClusters(meshSvc, routeMatch.UpstreamClusters)
+			}
+			routeMatches = append(routeMatches, routeMatch)
+		} else if len(trafficSplits) > 0 {
 			// Program routes to the backends specified in the traffic split
 			for _, split := range trafficSplits {
 				routeMatch := new(trafficpolicy.HTTPRouteMatchWithWeightedClusters)
@@ -133,17 +182,35 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 		}
 
 		// Build the HTTP route configs for this service and port combination.
-		// If the port's protocol corresponds to TCP, we can skip this step
-		if meshSvc.Protocol == constants.ProtocolTCP || meshSvc.Protocol == constants.ProtocolTCPServerFirst {
+		// If the port's protocol corresponds to TCP or UDP, we can skip this step
+		if meshSvc.Protocol == constants.ProtocolTCP || meshSvc.Protocol == constants.ProtocolTCPServerFirst || meshSvc.Protocol == constants.ProtocolUDP {
 			continue
 		}
 
 		// Create a route to access the upstream service via it's hostnames and upstream weighted clusters
-		httpHostNamesForServicePort := k8s.GetHostnamesForService(meshSvc, downstreamSvcAccount.Namespace == meshSvc.Namespace)
+		var httpHostNamesForServicePort []string
+		if externalService != nil {
+			httpHostNamesForServicePort = getHostnamesForExternalService(externalService, meshSvc.Port)
+		} else {
+			httpHostNamesForServicePort = k8s.GetHostnamesForService(meshSvc, downstreamSvcAccount.Namespace == meshSvc.Namespace)
+		}
 		outboundTrafficPolicy := trafficpolicy.NewOutboundTrafficPolicy(meshSvc.FQDN(), httpHostNamesForServicePort)
 		retryPolicy := mc.GetRetryPolicy(downstreamIdentity, meshSvc)
+		faultInjections := mc.GetFaultInjectionPolicies(downstreamIdentity, meshSvc)
+		mirrorClusters := mc.getMirrorClusters(downstreamIdentity, meshSvc)
 
 		hasWildCardRoute := false
+		for _, route := range gatewayAPIRoutes {
+			if isGatewayAPICatchAllRouteMatch(route.HTTPRouteMatch) {
+				hasWildCardRoute = true
+			}
+			route.RetryPolicy = retryPolicy
+			if err := outboundTrafficPolicy.AddRouteWeightedClusters(route); err != nil {
+				log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrAddingRouteToOutboundTrafficPolicy)).
+					Msgf("Error adding Gateway API route to outbound mesh HTTP traffic policy for destination %s", meshSvc)
+				continue
+			}
+		}
 		for _, routeMatch := range routeMatches {
 			for _, route := range routeMatch.RouteMatches {
 				if route.Path == constants.RegexMatchAll {
@@ -164,8 +231,21 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 				continue
 			}
 		}
+		for _, route := range outboundTrafficPolicy.Routes {
+			route.FaultInjections = faultInjections
+			if len(route.MirrorClusters) == 0 {
+				route.MirrorClusters = mirrorClusters
+			} else {
+				// Keep the mirror clusters of the Gateway API route, without sharing them with other routes
+				route.MirrorClusters = append(append([]*trafficpolicy.MirrorCluster{}, route.MirrorClusters...), mirrorClusters...)
+			}
+			if upstreamTrafficSetting := clusterConfigForServicePort.UpstreamTrafficSetting; upstreamTrafficSetting != nil {
+				route.LoadBalancer = upstreamTrafficSetting.Spec.LoadBalancer
+			}
+		}
 		routeConfigPerPort[int(meshSvc.Port)] = append(routeConfigPerPort[int(meshSvc.Port)], outboundTrafficPolicy)
 	}
+	pruneMirrorClusters(routeConfigPerPort, clusterConfigs)
 
 	return &trafficpolicy.OutboundMeshTrafficPolicy{
 		TrafficMatches:          trafficMatches,
@@ -175,6 +255,55 @@ func (mc *MeshCatalog) GetOutboundMeshTrafficPolicy(downstreamIdentity identity.
 	}
 }
 
+// getUpstreamTrafficSettingForService returns the UpstreamTrafficSetting for the given upstream service.
+// The UpstreamTrafficSetting of an external service may also match one of the hosts of its ExternalService.
+func (mc *MeshCatalog) getUpstreamTrafficSettingForService(meshSvc service.MeshService, externalService *policyv1alpha1.ExternalService) *policyv1alpha1.UpstreamTrafficSetting {
+	if externalService != nil {
+		for _, host := range externalService.Spec.Hosts {
+			if upstreamTrafficSetting := mc.policyController.GetUpstreamTrafficSetting(
+				policy.UpstreamTrafficSettingGetOpt{MeshService: &meshSvc, Host: host}); upstreamTrafficSetting != nil {
+				return upstreamTrafficSetting
+			}
+		}
+	}
+
+	return mc.policyController.GetUpstreamTrafficSetting(policy.UpstreamTrafficSettingGetOpt{MeshService: &meshSvc})
+}
+
+// addExternalServiceResolvableHosts adds the hosts of the given ExternalService with Static resolution to the
+// resolvable set, so that the local DNS proxy of the sidecars resolves them to the addresses of its endpoints
+func addExternalServiceResolvableHosts(servicesResolvableSet map[string][]interface{}, externalService *policyv1alpha1.ExternalService) {
+	if externalService.Spec.Resolution != policyv1alpha1.ExternalServiceResolutionStatic {
+		return
+	}
+
+	resolvableIPSet := mapset.NewSet()
+	for _, endpointSpec := range externalService.Spec.Endpoints {
+		if ip := net.ParseIP(endpointSpec.Address); ip != nil {
+			resolvableIPSet.Add(ip.String())
+		}
+	}
+	if resolvableIPSet.Cardinality() == 0 {
+		return
+	}
+
+	for _, host := range externalService.Spec.Hosts {
+		if net.ParseIP(host) != nil {
+			continue
+		}
+		servicesResolvableSet[host] = resolvableIPSet.ToSlice()
+	}
+}
+
+// getHostnamesForExternalService returns the hostnames over which the external service is accessible on the given port
+func getHostnamesForExternalService(externalService *policyv1alpha1.ExternalService, port uint16) []string {
+	var hostnames []string
+	for _, host := range externalService.Spec.Hosts {
+		hostnames = append(hostnames, host, fmt.Sprintf("%s:%d", host, port))
+	}
+	return hostnames
+}
+
 func (mc *MeshCatalog) getWildCardRouteUpstreamClusters(hasTrafficSplitWildCard bool, routeMatches []*trafficpolicy.HTTPRouteMatchWithWeightedClusters) []service.WeightedCluster {
 	var upstreamClusters []service.WeightedCluster
 	upstreamClusterMap := make(map[service.ClusterName]bool)
@@ -410,5 +539,15 @@ func (mc *MeshCatalog) ListOutboundServicesForIdentity(serviceIdentity identity.
 		}
 	}
 
+	// Services external to the mesh do not have a service identity that SMI TrafficTarget
+	// policies can refer to, they are accessible to every downstream in the mesh.
+	for _, externalService := range mc.policyController.ListExternalServices() {
+		for _, destService := range external.ExternalServiceToMeshServices(externalService) {
+			if added := serviceSet.Add(destService); added {
+				allowedServices = append(allowedServices, destService)
+			}
+		}
+	}
+
 	return allowedServices
 }
@@ -641,6 +641,9 @@ func TestGetOutboundMeshTrafficPolicy(t *testing.T) {
 					return svcToEndpointsMap[svc.String()], nil
 				}).AnyTimes()
 
+			mockPolicyController.EXPECT().ListExternalServices().Return(nil).AnyTimes()
+			mockPolicyController.EXPECT().GetExternalService(gomock.Any()).Return(nil).AnyTimes()
+
 			// Mock calls to UpstreamTrafficSetting lookups
 			mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).DoAndReturn(
 				func(opt policy.UpstreamTrafficSettingGetOpt) *policyv1alpha1.UpstreamTrafficSetting {
@@ -719,3 +722,66 @@ func TestListOutboundServicesForIdentity(t *testing.T) {
 		})
 	}
 }
+
+func TestExternalServiceOutboundPolicies(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+
+	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+	mockMeshSpec := smi.NewMockMeshSpec(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+
+	mc := MeshCatalog{
+		configurator:     mockConfigurator,
+		meshSpec:         mockMeshSpec,
+		policyController: mockPolicyController,
+	}
+
+	externalService := &policyv1alpha1.ExternalService{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpbin", Namespace: "test"},
+		Spec: policyv1alpha1.ExternalServiceSpec{
+			Hosts: []string{"httpbin.org", "www.httpbin.org"},
+			Ports: []policyv1alpha1.ExternalServicePortSpec{{Number: 80, Protocol: "http"}},
+		},
+	}
+	meshSvc := service.MeshService{Name: "httpbin", Namespace: "test", Port: 80, TargetPort: 80, Protocol: "http"}
+	upstreamTrafficSetting := &policyv1alpha1.UpstreamTrafficSetting{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpbin", Namespace: "test"},
+		Spec:       policyv1alpha1.UpstreamTrafficSettingSpec{Host: "www.httpbin.org"},
+	}
+
+	// External services are accessible to every downstream in SMI mode
+	mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false)
+	mockMeshSpec.EXPECT().ListTrafficTargets().Return(nil)
+	mockPolicyController.EXPECT().ListExternalServices().Return([]*policyv1alpha1.ExternalService{externalService})
+	assert.Equal([]service.MeshService{meshSvc}, mc.ListOutboundServicesForIdentity(tests.BookbuyerServiceIdentity))
+
+	// The UpstreamTrafficSetting of an external service can match one of its hosts
+	mockPolicyController.EXPECT().GetUpstreamTrafficSetting(gomock.Any()).DoAndReturn(
+		func(opt policy.UpstreamTrafficSettingGetOpt) *policyv1alpha1.UpstreamTrafficSetting {
+			if opt.Host == upstreamTrafficSetting.Spec.Host {
+				return upstreamTrafficSetting
+			}
+			return nil
+		}).Times(2)
+	assert.Equal(upstreamTrafficSetting, mc.getUpstreamTrafficSettingForService(meshSvc, externalService))
+
+	assert.Equal([]string{"httpbin.org", "httpbin.org:80", "www.httpbin.org", "www.httpbin.org:80"},
+		getHostnamesForExternalService(externalService, meshSvc.Port))
+
+	// Only the hosts of external services with Static resolution are resolved by the sidecars
+	resolvableSet := make(map[string][]interface{})
+	addExternalServiceResolvableHosts(resolvableSet, externalService)
+	assert.Empty(resolvableSet)
+
+	staticExternalService := externalService.DeepCopy()
+	staticExternalService.Spec.Hosts = append(staticExternalService.Spec.Hosts, "10.0.0.10")
+	staticExternalService.Spec.Resolution = policyv1alpha1.ExternalServiceResolutionStatic
+	staticExternalService.Spec.Endpoints = []policyv1alpha1.ExternalServiceEndpointSpec{
+		{Address: "10.0.0.1"}, {Address: "10.0.0.2"},
+	}
+	addExternalServiceResolvableHosts(resolvableSet, staticExternalService)
+	assert.Len(resolvableSet, 2)
+	assert.ElementsMatch([]interface{}{"10.0.0.1", "10.0.0.2"}, resolvableSet["httpbin.org"])
+	assert.ElementsMatch([]interface{}{"10.0.0.1", "10.0.0.2"}, resolvableSet["www.httpbin.org"])
+}
@@ -0,0 +1,77 @@
+package catalog
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// defaultJWKSSecretKey is the key a JSON Web Key Set is stored under in a secret if unspecified
+	defaultJWKSSecretKey = "jwks"
+)
+
+// getJWTAuthentication returns the JWT authentication applied to the inbound requests of the given upstream service
+func (mc *MeshCatalog) getJWTAuthentication(upstreamSvc service.MeshService) *trafficpolicy.JWTAuthentication {
+	if !mc.configurator.GetFeatureFlags().EnableRequestAuthenticationPolicy {
+		log.Trace().Msgf("RequestAuthentication policy flag not enabled")
+		return nil
+	}
+
+	requestAuthentication := mc.policyController.GetRequestAuthenticationPolicy(upstreamSvc)
+	if requestAuthentication == nil {
+		return nil
+	}
+
+	jwtAuthentication := &trafficpolicy.JWTAuthentication{
+		Rules: requestAuthentication.Spec.Rules,
+	}
+	for idx, rule := range requestAuthentication.Spec.JWTRules {
+		provider := &trafficpolicy.JWTProvider{
+			Name:                 fmt.Sprintf("%s/%s/%d", requestAuthentication.Namespace, requestAuthentication.Name, idx),
+			Issuer:               rule.Issuer,
+			Audiences:            rule.Audiences,
+			RemoteJWKSURI:        rule.JWKS.URI,
+			ForwardOriginalToken: rule.ForwardOriginalToken,
+			OutputClaimToHeaders: rule.OutputClaimToHeaders,
+		}
+
+		if secretRef := rule.JWKS.SecretRef; secretRef != nil {
+			secretReference := corev1.SecretReference{Name: secretRef.Name, Namespace: secretRef.Namespace}
+			if secretReference.Namespace == "" {
+				secretReference.Namespace = requestAuthentication.Namespace
+			}
+			secretKey := rule.JWKS.SecretKey
+			if secretKey == "" {
+				secretKey = defaultJWKSSecretKey
+			}
+
+			secret, err := mc.policyController.GetRequestAuthenticationJWKSSecret(secretReference)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error fetching JWKS secret %s/%s for RequestAuthentication policy %s/%s, skipping issuer %s",
+					secretReference.Namespace, secretReference.Name, requestAuthentication.Namespace, requestAuthentication.Name, rule.Issuer)
+				continue
+			}
+			jwks, ok := secret.Data[secretKey]
+			if !ok || len(jwks) == 0 {
+				log.Error().Msgf("JWKS secret %s/%s for RequestAuthentication policy %s/%s has no key %s, skipping issuer %s",
+					secretReference.Namespace, secretReference.Name, requestAuthentication.Namespace, requestAuthentication.Name, secretKey, rule.Issuer)
+				continue
+			}
+			provider.LocalJWKS = string(jwks)
+			provider.RemoteJWKSURI = ""
+		}
+
+		if provider.LocalJWKS == "" && provider.RemoteJWKSURI == "" {
+			log.Error().Msgf("RequestAuthentication policy %s/%s specifies no JWKS for issuer %s, skipping it",
+				requestAuthentication.Namespace, requestAuthentication.Name, rule.Issuer)
+			continue
+		}
+		jwtAuthentication.Providers = append(jwtAuthentication.Providers, provider)
+	}
+
+	return jwtAuthentication
+}
@@ -0,0 +1,165 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// testJWKS is a local stand-in for the JSON Web Key Set of an issuer
+const testJWKS = `{"keys":[{"kty":"oct","kid":"test","alg":"HS256","k":"c2VjcmV0"}]}`
+
+func TestGetJWTAuthentication(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:     mockCfg,
+		policyController: mockPolicyController,
+	}
+	svc := service.MeshService{Name: "s1", Namespace: "ns1", Port: 80, TargetPort: 8080}
+	rules := []policyV1alpha1.JWTAuthorizationRuleSpec{
+		{Claims: []policyV1alpha1.JWTClaimMatchSpec{{Name: "groups", Values: []string{"admin"}}}},
+	}
+	secrets := map[string]*corev1.Secret{
+		"ns1/jwks": {Data: map[string][]byte{"jwks": []byte(testJWKS)}},
+		"ns2/keys": {Data: map[string][]byte{"issuer-keys": []byte(testJWKS)}},
+	}
+
+	testcases := []struct {
+		name                      string
+		requestAuthnFlag          bool
+		requestAuthentication     *policyV1alpha1.RequestAuthentication
+		expectedJWTAuthentication *trafficpolicy.JWTAuthentication
+	}{
+		{
+			name:                      "feature flag disabled",
+			requestAuthnFlag:          false,
+			expectedJWTAuthentication: nil,
+		},
+		{
+			name:                      "no request authentication policy",
+			requestAuthnFlag:          true,
+			expectedJWTAuthentication: nil,
+		},
+		{
+			name:             "issuers with JWKS from secrets and a URI",
+			requestAuthnFlag: true,
+			requestAuthentication: &policyV1alpha1.RequestAuthentication{
+				ObjectMeta: metav1.ObjectMeta{Name: "authn", Namespace: "ns1"},
+				Spec: policyV1alpha1.RequestAuthenticationSpec{
+					Destination: policyV1alpha1.RequestAuthenticationDestinationSpec{Kind: "Service", Name: "s1"},
+					JWTRules: []policyV1alpha1.JWTRuleSpec{
+						{
+							Issuer:    "https://local.example.com",
+							Audiences: []string{"s1"},
+							JWKS:      policyV1alpha1.JWKSSpec{SecretRef: &corev1.SecretReference{Name: "jwks"}},
+							OutputClaimToHeaders: []policyV1alpha1.ClaimToHeaderSpec{
+								{Claim: "sub", Header: "x-jwt-sub"},
+							},
+						},
+						{
+							Issuer: "https://other.example.com",
+							JWKS: policyV1alpha1.JWKSSpec{
+								SecretRef: &corev1.SecretReference{Name: "keys", Namespace: "ns2"},
+								SecretKey: "issuer-keys",
+							},
+						},
+						{
+							Issuer:               "https://remote.example.com",
+							JWKS:                 policyV1alpha1.JWKSSpec{URI: "https://remote.example.com/.well-known/jwks.json"},
+							ForwardOriginalToken: true,
+						},
+					},
+					Rules: rules,
+				},
+			},
+			expectedJWTAuthentication: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{
+					{
+						Name:      "ns1/authn/0",
+						Issuer:    "https://local.example.com",
+						Audiences: []string{"s1"},
+						LocalJWKS: testJWKS,
+						OutputClaimToHeaders: []policyV1alpha1.ClaimToHeaderSpec{
+							{Claim: "sub", Header: "x-jwt-sub"},
+						},
+					},
+					{
+						Name:      "ns1/authn/1",
+						Issuer:    "https://other.example.com",
+						LocalJWKS: testJWKS,
+					},
+					{
+						Name:                 "ns1/authn/2",
+						Issuer:               "https://remote.example.com",
+						RemoteJWKSURI:        "https://remote.example.com/.well-known/jwks.json",
+						ForwardOriginalToken: true,
+					},
+				},
+				Rules: rules,
+			},
+		},
+		{
+			name:             "issuers whose JWKS cannot be resolved are skipped",
+			requestAuthnFlag: true,
+			requestAuthentication: &policyV1alpha1.RequestAuthentication{
+				ObjectMeta: metav1.ObjectMeta{Name: "authn", Namespace: "ns1"},
+				Spec: policyV1alpha1.RequestAuthenticationSpec{
+					Destination: policyV1alpha1.RequestAuthenticationDestinationSpec{Kind: "Service", Name: "s1"},
+					JWTRules: []policyV1alpha1.JWTRuleSpec{
+						{
+							Issuer: "https://missing-secret.example.com",
+							JWKS:   policyV1alpha1.JWKSSpec{SecretRef: &corev1.SecretReference{Name: "missing"}},
+						},
+						{
+							Issuer: "https://missing-key.example.com",
+							JWKS: policyV1alpha1.JWKSSpec{
+								SecretRef: &corev1.SecretReference{Name: "jwks"},
+								SecretKey: "missing",
+							},
+						},
+						{
+							Issuer: "https://no-jwks.example.com",
+						},
+					},
+				},
+			},
+			expectedJWTAuthentication: &trafficpolicy.JWTAuthentication{},
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableRequestAuthenticationPolicy: tc.requestAuthnFlag}).Times(1)
+			if tc.requestAuthnFlag {
+				mockPolicyController.EXPECT().GetRequestAuthenticationPolicy(svc).Return(tc.requestAuthentication).Times(1)
+			}
+			mockPolicyController.EXPECT().GetRequestAuthenticationJWKSSecret(gomock.Any()).DoAndReturn(
+				func(secretReference corev1.SecretReference) (*corev1.Secret, error) {
+					if secret, ok := secrets[secretReference.Namespace+"/"+secretReference.Name]; ok {
+						return secret, nil
+					}
+					return nil, errors.New("not found")
+				}).AnyTimes()
+
+			res := mc.getJWTAuthentication(svc)
+			assert.Equal(tc.expectedJWTAuthentication, res)
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// getMirrorClusters returns the clusters the requests from the given downstream identity to the given upstream service are mirrored to
+func (mc *MeshCatalog) getMirrorClusters(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) []*trafficpolicy.MirrorCluster {
+	if !mc.configurator.GetFeatureFlags().EnableTrafficMirrorPolicy {
+		log.Trace().Msgf("TrafficMirror policy flag not enabled")
+		return nil
+	}
+	src := downstreamIdentity.ToK8sServiceAccount()
+
+	var mirrorClusters []*trafficpolicy.MirrorCluster
+	mirrorClusterSet := make(map[service.ClusterName]bool)
+	for _, trafficMirrorCRD := range mc.policyController.ListTrafficMirrorPolicies(src) {
+		dest := trafficMirrorCRD.Spec.Destination
+		if dest.Kind != "Service" {
+			log.Error().Msgf("TrafficMirror policy destination must be a service: %s is a %s", dest.Name, dest.Kind)
+			continue
+		}
+		namespace := dest.Namespace
+		if namespace == "" {
+			namespace = trafficMirrorCRD.Namespace
+		}
+		destMeshSvc := service.MeshService{Name: dest.Name, Namespace: namespace}
+		if !upstreamSvc.SiblingTo(destMeshSvc) {
+			continue
+		}
+
+		for _, backend := range trafficMirrorCRD.Spec.Backends {
+			if backend.Percentage == 0 {
+				continue
+			}
+			backendMeshSvc := service.MeshService{
+				Namespace: upstreamSvc.Namespace, // Backends belong to the same namespace as the destination service
+				Name:      backend.Service,
+			}
+			targetPort, err := mc.kubeController.GetTargetPortForServicePort(
+				types.NamespacedName{Namespace: backendMeshSvc.Namespace, Name: backendMeshSvc.Name}, upstreamSvc.Port)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error fetching target port for TrafficMirror backend %s/%s", backendMeshSvc.Namespace, backendMeshSvc.Name)
+				continue
+			}
+			backendMeshSvc.TargetPort = targetPort
+			clusterName := service.ClusterName(backendMeshSvc.SidecarClusterName())
+			if mirrorClusterSet[clusterName] {
+				continue
+			}
+			mirrorClusterSet[clusterName] = true
+			mirrorClusters = append(mirrorClusters, &trafficpolicy.MirrorCluster{
+				ClusterName: clusterName,
+				Percentage:  backend.Percentage,
+			})
+		}
+	}
+
+	return mirrorClusters
+}
+
+// pruneMirrorClusters removes the mirror clusters the downstream has no cluster config for,
+// which is the case when the downstream is not allowed to access the shadow backend
+func pruneMirrorClusters(routeConfigPerPort map[int][]*trafficpolicy.OutboundTrafficPolicy, clusterConfigs []*trafficpolicy.MeshClusterConfig) {
+	clusterSet := make(map[service.ClusterName]bool)
+	for _, clusterConfig := range clusterConfigs {
+		clusterSet[service.ClusterName(clusterConfig.Name)] = true
+	}
+
+	for _, outboundTrafficPolicies := range routeConfigPerPort {
+		for _, outboundTrafficPolicy := range outboundTrafficPolicies {
+			for _, route := range outboundTrafficPolicy.Routes {
+				var mirrorClusters []*trafficpolicy.MirrorCluster
+				for _, mirrorCluster := range route.MirrorClusters {
+					if !clusterSet[mirrorCluster.ClusterName] {
+						log.Warn().Msgf("Skipping mirror cluster %s, no cluster config exists for it", mirrorCluster.ClusterName)
+						continue
+					}
+					mirrorClusters = append(mirrorClusters, mirrorCluster)
+				}
+				route.MirrorClusters = mirrorClusters
+			}
+		}
+	}
+}
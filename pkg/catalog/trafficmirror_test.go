@@ -0,0 +1,156 @@
+package catalog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetMirrorClusters(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockPolicyController := policy.NewMockController(mockCtrl)
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mc := &MeshCatalog{
+		configurator:     mockCfg,
+		policyController: mockPolicyController,
+		kubeController:   mockKubeController,
+	}
+	src := identity.ServiceIdentity("sa1.ns")
+
+	testcases := []struct {
+		name                   string
+		trafficMirrorFlag      bool
+		trafficMirrorCRDs      []*policyV1alpha1.TrafficMirror
+		destSvc                service.MeshService
+		targetPorts            map[string]uint16
+		expectedMirrorClusters []*trafficpolicy.MirrorCluster
+	}{
+		{
+			name:                   "feature flag disabled",
+			trafficMirrorFlag:      false,
+			destSvc:                service.MeshService{Name: "s1", Namespace: "b", Port: 80},
+			expectedMirrorClusters: nil,
+		},
+		{
+			name:                   "no traffic mirror policies",
+			trafficMirrorFlag:      true,
+			destSvc:                service.MeshService{Name: "s1", Namespace: "b", Port: 80},
+			expectedMirrorClusters: nil,
+		},
+		{
+			name:              "policy matching the destination service",
+			trafficMirrorFlag: true,
+			trafficMirrorCRDs: []*policyV1alpha1.TrafficMirror{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "tm1", Namespace: "b"},
+					Spec: policyV1alpha1.TrafficMirrorSpec{
+						Destination: policyV1alpha1.TrafficMirrorSrcDstSpec{
+							Kind: "Service",
+							Name: "s1",
+						},
+						Backends: []policyV1alpha1.TrafficMirrorBackendSpec{
+							{Service: "s1-shadow", Percentage: 20},
+							{Service: "s1-disabled", Percentage: 0},
+							{Service: "s1-unknown", Percentage: 100},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "tm2", Namespace: "b"},
+					Spec: policyV1alpha1.TrafficMirrorSpec{
+						Destination: policyV1alpha1.TrafficMirrorSrcDstSpec{
+							Kind: "Service",
+							Name: "s2",
+						},
+						Backends: []policyV1alpha1.TrafficMirrorBackendSpec{
+							{Service: "s2-shadow", Percentage: 100},
+						},
+					},
+				},
+			},
+			destSvc:     service.MeshService{Name: "s1", Namespace: "b", Port: 80},
+			targetPorts: map[string]uint16{"s1-shadow": 8080},
+			expectedMirrorClusters: []*trafficpolicy.MirrorCluster{
+				{ClusterName: "b/s1-shadow|8080", Percentage: 20},
+			},
+		},
+		{
+			name:              "policy with a destination that is not a service",
+			trafficMirrorFlag: true,
+			trafficMirrorCRDs: []*policyV1alpha1.TrafficMirror{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "tm1", Namespace: "b"},
+					Spec: policyV1alpha1.TrafficMirrorSpec{
+						Destination: policyV1alpha1.TrafficMirrorSrcDstSpec{
+							Kind: "ServiceAccount",
+							Name: "s1",
+						},
+						Backends: []policyV1alpha1.TrafficMirrorBackendSpec{
+							{Service: "s1-shadow", Percentage: 100},
+						},
+					},
+				},
+			},
+			destSvc:                service.MeshService{Name: "s1", Namespace: "b", Port: 80},
+			expectedMirrorClusters: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			mockCfg.EXPECT().GetFeatureFlags().Return(v1alpha2.FeatureFlags{EnableTrafficMirrorPolicy: tc.trafficMirrorFlag}).Times(1)
+			if tc.trafficMirrorFlag {
+				mockPolicyController.EXPECT().ListTrafficMirrorPolicies(gomock.Any()).Return(tc.trafficMirrorCRDs).Times(1)
+			}
+			mockKubeController.EXPECT().GetTargetPortForServicePort(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(svc types.NamespacedName, _ uint16) (uint16, error) {
+					if port, ok := tc.targetPorts[svc.Name]; ok {
+						return port, nil
+					}
+					return 0, errors.New("not found")
+				}).AnyTimes()
+
+			res := mc.getMirrorClusters(src, tc.destSvc)
+			assert.Equal(tc.expectedMirrorClusters, res)
+		})
+	}
+}
+
+func TestPruneMirrorClusters(t *testing.T) {
+	assert := tassert.New(t)
+
+	route := &trafficpolicy.RouteWeightedClusters{
+		MirrorClusters: []*trafficpolicy.MirrorCluster{
+			{ClusterName: "b/s1-shadow|8080", Percentage: 20},
+			{ClusterName: "b/s1-denied|8080", Percentage: 100},
+		},
+	}
+	routeConfigPerPort := map[int][]*trafficpolicy.OutboundTrafficPolicy{
+		80: {{Name: "s1.b.svc.cluster.local", Routes: []*trafficpolicy.RouteWeightedClusters{route}}},
+	}
+	clusterConfigs := []*trafficpolicy.MeshClusterConfig{
+		{Name: "b/s1|8080"},
+		{Name: "b/s1-shadow|8080"},
+	}
+
+	pruneMirrorClusters(routeConfigPerPort, clusterConfigs)
+	assert.Equal([]*trafficpolicy.MirrorCluster{{ClusterName: "b/s1-shadow|8080", Percentage: 20}}, route.MirrorClusters)
+}
@@ -25,12 +25,13 @@ func (mc *MeshCatalog) ListOutboundServiceIdentities(downstream identity.Service
 }
 
 // ListInboundTrafficTargetsWithRoutes returns a list traffic target objects composed of its routes for the given destination service account
+// The traffic targets derived from SMI TrafficTarget policies are followed by the ones derived from AuthorizationPolicy policies.
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func (mc *MeshCatalog) ListInboundTrafficTargetsWithRoutes(upstream identity.ServiceIdentity) ([]trafficpolicy.TrafficTargetWithRoutes, error) {
 	var trafficTargets []trafficpolicy.TrafficTargetWithRoutes
 
 	if mc.configurator.IsPermissiveTrafficPolicyMode() {
-		return nil, nil
+		return mc.getAuthorizationTrafficTargets(upstream), nil
 	}
 
 	for _, t := range mc.meshSpec.ListTrafficTargets() { // loop through all traffic targets
@@ -65,6 +66,8 @@ func (mc *MeshCatalog) ListInboundTrafficTargetsWithRoutes(upstream identity.Ser
 		}
 	}
 
+	trafficTargets = append(trafficTargets, mc.getAuthorizationTrafficTargets(upstream)...)
+
 	return trafficTargets, nil
 }
 
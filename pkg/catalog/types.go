@@ -11,9 +11,11 @@ import (
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/logger"
+	"github.com/openservicemesh/osm/pkg/messaging"
 	"github.com/openservicemesh/osm/pkg/multicluster"
 	"github.com/openservicemesh/osm/pkg/plugin"
 	"github.com/openservicemesh/osm/pkg/policy"
@@ -50,6 +52,10 @@ type MeshCatalog struct {
 	// multiclusterController implements the functionality related to the resources part of the flomesh.io
 	// API group, such a serviceimport.
 	multiclusterController multicluster.Controller
+
+	// gatewayAPIController implements the functionality related to the route resources part of the
+	// gateway.networking.k8s.io API group, such as HTTPRoute.
+	gatewayAPIController gatewayapi.Controller
 }
 
 // MeshCataloger is the mechanism by which the Service Mesh controller discovers all sidecar proxies connected to the catalog.
@@ -100,6 +106,9 @@ type MeshCataloger interface {
 	// GetRetryPolicy returns the RetryPolicySpec for the given downstream identity and upstream service
 	GetRetryPolicy(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) *v1alpha1.RetryPolicySpec
 
+	// GetFaultInjectionPolicies returns the FaultInjectionSpecs for the given downstream identity and upstream service
+	GetFaultInjectionPolicies(downstreamIdentity identity.ServiceIdentity, upstreamSvc service.MeshService) []*v1alpha1.FaultInjectionSpec
+
 	// GetExportTrafficPolicy returns the export policy for the given mesh service
 	GetExportTrafficPolicy(svc service.MeshService) (*trafficpolicy.ServiceExportTrafficPolicy, error)
 
@@ -111,6 +120,16 @@ type MeshCataloger interface {
 
 	// GetPluginChains lists plugin chains
 	GetPluginChains() []*trafficpolicy.PluginChain
+
+	// GetConfigurator returns the configurator of the mesh
+	GetConfigurator() *configurator.Configurator
+
+	// ListEndpointsForServiceIdentity returns the endpoints of the proxies with the given service identity
+	ListEndpointsForServiceIdentity(identity.ServiceIdentity) []endpoint.Endpoint
+
+	// ListProxyDependencies returns the services and policies the configuration of a proxy with the given
+	// identity, services and outbound mesh traffic policy depends on
+	ListProxyDependencies(identity.ServiceIdentity, []service.MeshService, *trafficpolicy.OutboundMeshTrafficPolicy) []messaging.ProxyDependency
 }
 
 type trafficDirection string
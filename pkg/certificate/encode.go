@@ -64,6 +64,32 @@ func DecodePEMCertificate(certPEM []byte) (*x509.Certificate, error) {
 	return nil, ErrNoCertificateInPEM
 }
 
+// decodePEMCertificates converts all the certificates of a PEM bundle to x509 encoding
+func decodePEMCertificates(certPEM []byte) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for len(certPEM) > 0 {
+		var block *pemEnc.Block
+		block, certPEM = pemEnc.Decode(certPEM)
+		if block == nil {
+			break
+		}
+		if block.Type != TypeCertificate || len(block.Headers) != 0 {
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	if len(certs) == 0 {
+		return nil, ErrNoCertificateInPEM
+	}
+	return certs, nil
+}
+
 // DecodePEMPrivateKey converts a certificate from PEM to x509 encoding
 func DecodePEMPrivateKey(keyPEM []byte) (*rsa.PrivateKey, error) {
 	for len(keyPEM) > 0 {
@@ -8,6 +8,7 @@ var errEncodeKey = errors.New("encode key")
 var errEncodeCert = errors.New("encode cert")
 var errMarshalPrivateKey = errors.New("marshal private key")
 var errNoPrivateKeyInPEM = errors.New("no private Key in PEM")
+var errMRCRoleSwapPending = errors.New("waiting for the intents of both the active and passive MeshRootCertificates to be updated")
 
 // ErrNoCertificateInPEM is the errror for no certificate in PEM
 var ErrNoCertificateInPEM = errors.New("no certificate in PEM")
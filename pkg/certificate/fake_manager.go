@@ -24,6 +24,11 @@ func (c *fakeMRCClient) GetCertIssuerForMRC(mrc *v1alpha2.MeshRootCertificate) (
 	return &fakeIssuer{}, pem.RootCertificate("rootCA"), nil
 }
 
+// UpdateMRCStatus returns the provided MRC as is, since the fake client does not persist MRCs.
+func (c *fakeMRCClient) UpdateMRCStatus(mrc *v1alpha2.MeshRootCertificate) (*v1alpha2.MeshRootCertificate, error) {
+	return mrc, nil
+}
+
 // List returns the single, pre-generated MRC. It is intended to implement the certificate.MRCClient interface.
 func (c *fakeMRCClient) List() ([]*v1alpha2.MeshRootCertificate, error) {
 	// return single empty object in the list.
@@ -3,13 +3,23 @@ package certificate
 import (
 	"context"
 	"errors"
+	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
 	"github.com/openservicemesh/osm/pkg/announcements"
+	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s/events"
 	"github.com/openservicemesh/osm/pkg/logger"
 	"github.com/openservicemesh/osm/pkg/messaging"
@@ -19,6 +29,15 @@ var (
 	log = logger.New("certificate")
 )
 
+const (
+	// Reasons of the MeshRootCertificate status conditions
+	mrcReasonRoleApplied    = "RoleApplied"
+	mrcReasonIntentApplied  = "IntentApplied"
+	mrcReasonInvalidIntents = "InvalidIntents"
+	mrcReasonPendingIntents = "PendingIntents"
+	mrcReasonErrorState     = "ErrorState"
+)
+
 // NewManager creates a new CertificateManager with the passed MRCClient and options
 func NewManager(ctx context.Context, mrcClient MRCClient, getServiceCertValidityPeriod func() time.Duration, getIngressCertValidityDuration func() time.Duration, msgBroker *messaging.Broker, checkInterval time.Duration) (*Manager, error) {
 	m := &Manager{
@@ -117,44 +136,225 @@ func (m *Manager) start(ctx context.Context, mrcClient MRCClient) error {
 
 func (m *Manager) handleMRCEvent(mrcClient MRCClient, event MRCEvent) error {
 	switch event.Type {
-	case MRCEventAdded:
-		mrc := event.MRC
+	case MRCEventAdded, MRCEventUpdated:
+		if m.mrcs == nil {
+			m.mrcs = make(map[string]*v1alpha2.MeshRootCertificate)
+		}
+		m.mrcs[event.MRC.Name] = event.MRC
+	default:
+		return nil
+	}
+
+	err := m.updateIssuers(mrcClient)
+
+	// Don't report the status of the MRCs until the manager is initialized, as the
+	// MRCs are observed one at a time and the intents may not be complete yet.
+	m.mu.Lock()
+	initialized := m.signingIssuer != nil
+	m.mu.Unlock()
+	if initialized {
+		m.updateMRCStatuses(mrcClient, err)
+	}
+
+	if errors.Is(err, errMRCRoleSwapPending) {
+		log.Info().Msgf("Keeping the current issuers: %s", err)
+		return nil
+	}
+	return err
+}
+
+// GetMRCIntent returns the intent of the MRC. MRCs without an intent fall back to the intent
+// corresponding to their state.
+func GetMRCIntent(mrc *v1alpha2.MeshRootCertificate) v1alpha2.MeshRootCertificateIntent {
+	if mrc.Spec.Intent != "" {
+		return mrc.Spec.Intent
+	}
+
+	switch mrc.Status.State {
+	case constants.MRCStateValidatingRollout, constants.MRCStateValidatingRollback:
+		return v1alpha2.MeshRootCertificateIntentPassive
+	case constants.MRCStateInactive:
+		return v1alpha2.MeshRootCertificateIntentInactive
+	default:
+		return v1alpha2.MeshRootCertificateIntentActive
+	}
+}
+
+// updateIssuers sets the signing issuer from the active MRC and the validating issuer from the
+// passive MRC, if any. Exactly one MRC must be active and at most one MRC can be passive.
+func (m *Manager) updateIssuers(mrcClient MRCClient) error {
+	var active, passive []*v1alpha2.MeshRootCertificate
+	for _, name := range m.sortedMRCNames() {
+		mrc := m.mrcs[name]
 		if mrc.Status.State == constants.MRCStateError {
 			log.Debug().Msgf("skipping MRC with error state %s", mrc.GetName())
-			return nil
+			continue
 		}
 
-		client, ca, err := mrcClient.GetCertIssuerForMRC(mrc)
-		if err != nil {
+		switch GetMRCIntent(mrc) {
+		case v1alpha2.MeshRootCertificateIntentActive:
+			active = append(active, mrc)
+		case v1alpha2.MeshRootCertificateIntentPassive:
+			passive = append(passive, mrc)
+		}
+	}
+
+	if m.isRoleSwapPending(active, passive) {
+		return errMRCRoleSwapPending
+	}
+	if len(active) != 1 {
+		return fmt.Errorf("expected exactly 1 active MeshRootCertificate, found %d", len(active))
+	}
+	if len(passive) > 1 {
+		return fmt.Errorf("expected at most 1 passive MeshRootCertificate, found %d", len(passive))
+	}
+
+	signingIssuer, err := m.getIssuer(mrcClient, active[0])
+	if err != nil {
+		return err
+	}
+	validatingIssuer := signingIssuer
+	if len(passive) == 1 {
+		if validatingIssuer, err = m.getIssuer(mrcClient, passive[0]); err != nil {
 			return err
 		}
+	}
+
+	// Release the issuers of the MRCs that are no longer active or passive
+	for name := range m.issuers {
+		if name != signingIssuer.ID && name != validatingIssuer.ID {
+			delete(m.issuers, name)
+		}
+	}
+
+	m.mu.Lock()
+	changed := m.signingIssuer != signingIssuer || m.validatingIssuer != validatingIssuer
+	m.signingIssuer = signingIssuer
+	m.validatingIssuer = validatingIssuer
+	m.mu.Unlock()
+
+	if changed {
+		// Certificates issued by the previous issuers are reissued by the rotation ticker, see ShouldRotate
+		log.Info().Msgf("Using MRC %s to issue certificates and MRC %s to validate certificates", signingIssuer.ID, validatingIssuer.ID)
+	}
+
+	return nil
+}
+
+// isRoleSwapPending returns true if the MRCs used to issue and validate certificates are both active or both
+// passive. The active and passive MRCs swap roles by updating the intent of one MRC at a time, so the intents
+// of only one of them may be updated yet. The current issuers are kept until the intents of both are updated.
+func (m *Manager) isRoleSwapPending(active, passive []*v1alpha2.MeshRootCertificate) bool {
+	m.mu.Lock()
+	signingIssuer := m.signingIssuer
+	validatingIssuer := m.validatingIssuer
+	m.mu.Unlock()
+	if signingIssuer == nil || validatingIssuer == nil || signingIssuer == validatingIssuer {
+		return false
+	}
+
+	var swapping []*v1alpha2.MeshRootCertificate
+	switch {
+	case len(active) == 2 && len(passive) == 0:
+		swapping = active
+	case len(active) == 0 && len(passive) == 2:
+		swapping = passive
+	default:
+		return false
+	}
+	for _, mrc := range swapping {
+		if mrc.Name != signingIssuer.ID && mrc.Name != validatingIssuer.ID {
+			return false
+		}
+	}
+	return true
+}
+
+// getIssuer returns the issuer for the given MRC, generating it if the MRC is new or its spec changed.
+func (m *Manager) getIssuer(mrcClient MRCClient, mrc *v1alpha2.MeshRootCertificate) (*issuer, error) {
+	if c, ok := m.issuers[mrc.Name]; ok && c.generation == mrc.Generation {
+		return c, nil
+	}
+
+	client, ca, err := mrcClient.GetCertIssuerForMRC(mrc)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &issuer{Issuer: client, ID: mrc.Name, CertificateAuthority: ca, TrustDomain: mrc.Spec.TrustDomain, SpiffeEnabled: mrc.Spec.SpiffeEnabled, generation: mrc.Generation}
+	if m.issuers == nil {
+		m.issuers = make(map[string]*issuer)
+	}
+	m.issuers[mrc.Name] = c
+	return c, nil
+}
+
+// updateMRCStatuses reports the role of each MRC in its status conditions, along with whether
+// the intents of the MRCs could be applied.
+func (m *Manager) updateMRCStatuses(mrcClient MRCClient, issuersErr error) {
+	m.mu.Lock()
+	signingIssuer := m.signingIssuer
+	validatingIssuer := m.validatingIssuer
+	m.mu.Unlock()
+
+	for _, name := range m.sortedMRCNames() {
+		mrc := m.mrcs[name]
+		updated := mrc.DeepCopy()
+
+		issuing := signingIssuer.ID == name
+		validating := issuing || validatingIssuer.ID == name
+		setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionIssuing, issuing, mrcReasonRoleApplied,
+			fmt.Sprintf("MeshRootCertificate is used to issue certificates: %t", issuing))
+		setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionValidating, validating, mrcReasonRoleApplied,
+			fmt.Sprintf("MeshRootCertificate is used to validate certificates: %t", validating))
 
-		c := &issuer{Issuer: client, ID: mrc.Name, CertificateAuthority: ca, TrustDomain: mrc.Spec.TrustDomain}
 		switch {
-		case mrc.Status.State == constants.MRCStateActive:
-			m.mu.Lock()
-			m.signingIssuer = c
-			m.validatingIssuer = c
-			m.mu.Unlock()
-		case mrc.Status.State == constants.MRCStateIssuingRollback || mrc.Status.State == constants.MRCStateIssuingRollout:
-			m.mu.Lock()
-			m.signingIssuer = c
-			m.mu.Unlock()
-		case mrc.Status.State == constants.MRCStateValidatingRollback || mrc.Status.State == constants.MRCStateValidatingRollout:
-			m.mu.Lock()
-			m.validatingIssuer = c
-			m.mu.Unlock()
+		case mrc.Status.State == constants.MRCStateError:
+			setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionReady, false, mrcReasonErrorState,
+				"MeshRootCertificate is in the error state")
+		case errors.Is(issuersErr, errMRCRoleSwapPending):
+			setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionReady, false, mrcReasonPendingIntents, issuersErr.Error())
+		case issuersErr != nil:
+			setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionReady, false, mrcReasonInvalidIntents, issuersErr.Error())
 		default:
-			m.mu.Lock()
-			m.signingIssuer = c
-			m.validatingIssuer = c
-			m.mu.Unlock()
+			setMRCCondition(updated, v1alpha2.MeshRootCertificateConditionReady, true, mrcReasonIntentApplied,
+				fmt.Sprintf("MeshRootCertificate intent %s is applied", GetMRCIntent(mrc)))
+		}
+
+		if equality.Semantic.DeepEqual(mrc.Status, updated.Status) {
+			continue
+		}
+
+		updated, err := mrcClient.UpdateMRCStatus(updated)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error updating the status of MRC %s", name)
+			continue
 		}
-	case MRCEventUpdated:
-		// TODO
+		m.mrcs[name] = updated
 	}
+}
 
-	return nil
+func setMRCCondition(mrc *v1alpha2.MeshRootCertificate, conditionType string, status bool, reason, message string) {
+	conditionStatus := metav1.ConditionFalse
+	if status {
+		conditionStatus = metav1.ConditionTrue
+	}
+	meta.SetStatusCondition(&mrc.Status.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             conditionStatus,
+		ObservedGeneration: mrc.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+func (m *Manager) sortedMRCNames() []string {
+	names := make([]string, 0, len(m.mrcs))
+	for name := range m.mrcs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 // GetTrustDomain returns the trust domain from the configured signingkey issuer.
@@ -167,6 +367,11 @@ func (m *Manager) GetTrustDomain() string {
 
 // ShouldRotate determines whether a certificate should be rotated.
 func (m *Manager) ShouldRotate(c *Certificate) bool {
+	if m.isRevoked(c.GetSerialNumber()) {
+		log.Info().Msgf("Cert %s should be rotated; SerialNumber=%s is revoked", c.GetCommonName(), c.GetSerialNumber())
+		return true
+	}
+
 	// The certificate is going to expire at a timestamp T
 	// We want to renew earlier. How much earlier is defined in renewBeforeCertExpires.
 	// We add a few seconds noise to the early renew period so that certificates that may have been
@@ -201,6 +406,15 @@ func (m *Manager) ShouldRotate(c *Certificate) bool {
 			c.GetCommonName())
 		return true
 	}
+
+	// Toggling SPIFFE on an MRC updates its issuer without changing its ID. The certificate must be
+	// reissued to add or remove the SPIFFE ID the proxies now expect, or authorization would fail
+	// until the certificate expires.
+	if c.spiffeEnabled != signingIssuer.SpiffeEnabled {
+		log.Info().Msgf("Cert %s should be rotated; SPIFFE enabled changed to %t",
+			c.GetCommonName(), signingIssuer.SpiffeEnabled)
+		return true
+	}
 	return false
 }
 
@@ -216,18 +430,136 @@ func (m *Manager) checkAndRotate() {
 	})
 
 	for key, cert := range certs {
-		opts := []IssueOption{}
-		if key == cert.CommonName.String() {
-			opts = append(opts, FullCNProvided())
-			opts = append(opts, SubjectAlternativeNames(uniqueSubjectAlternativeNames(cert.SANames, key)...))
-		}
-
-		_, err := m.IssueCertificate(key, cert.certType, opts...)
+		_, err := m.reissueCertificate(key, cert)
 		if err != nil {
 			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrRotatingCert)).
 				Msgf("Error rotating cert SerialNumber=%s", cert.GetSerialNumber())
 		}
 	}
+
+	// Revoked certificates are no longer presented once they expire
+	m.revoked.Range(func(snIface interface{}, expIface interface{}) bool {
+		if time.Now().After(expIface.(time.Time)) {
+			m.revoked.Delete(snIface)
+		}
+		return true // continue the iteration
+	})
+}
+
+// reissueCertificate issues the certificate cached with the given key with the same options it was issued with,
+// if it should be rotated.
+func (m *Manager) reissueCertificate(key string, cert *Certificate) (*Certificate, error) {
+	opts := []IssueOption{}
+	if key == cert.CommonName.String() {
+		opts = append(opts, FullCNProvided())
+		opts = append(opts, SubjectAlternativeNames(uniqueSubjectAlternativeNames(cert.SANames, key)...))
+	}
+	return m.IssueCertificate(key, cert.certType, opts...)
+}
+
+// RevokeCertificates revokes the issued certificates with the given serial numbers, and those issued for the given
+// service identities, and immediately reissues them. The reissued certificates are announced as rotated on the
+// cert pubsub. The revoked certificates are returned, including those that failed to be reissued, which are
+// reissued on their next use. The serial numbers of the certificates that are not cached, such as those issued
+// before the control plane restarted, are recorded as revoked until the longest certificate validity period elapses.
+func (m *Manager) RevokeCertificates(serialNumbers []SerialNumber, serviceIdentities []identity.ServiceIdentity) ([]*Certificate, error) {
+	serials := map[SerialNumber]bool{}
+	for _, sn := range serialNumbers {
+		serials[sn] = true
+	}
+
+	revoked := map[string]*Certificate{}
+	m.cache.Range(func(keyIface interface{}, certInterface interface{}) bool {
+		key := keyIface.(string)
+		cert := certInterface.(*Certificate)
+		if serials[cert.GetSerialNumber()] || isIssuedForServiceIdentity(key, cert, serviceIdentities) {
+			revoked[key] = cert
+		}
+		return true // continue the iteration
+	})
+
+	for _, cert := range revoked {
+		delete(serials, cert.GetSerialNumber())
+	}
+	if len(serials) > 0 {
+		expiration := time.Now().Add(m.getMaxValidityDuration())
+		for sn := range serials {
+			if sn == "" {
+				continue
+			}
+			m.revoked.Store(sn, expiration)
+			log.Warn().Msgf("Revoked uncached certificate SerialNumber=%s until %s", sn, expiration)
+		}
+	}
+
+	var certs []*Certificate
+	var errs []error
+	for key, cert := range revoked {
+		if cert.GetSerialNumber() != "" {
+			m.revoked.Store(cert.GetSerialNumber(), cert.GetExpiration())
+		}
+		certs = append(certs, cert)
+		log.Info().Msgf("Revoked certificate %s with SerialNumber=%s", cert.GetCommonName(), cert.GetSerialNumber())
+
+		if _, err := m.reissueCertificate(key, cert); err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrRotatingCert)).
+				Msgf("Error reissuing revoked cert SerialNumber=%s", cert.GetSerialNumber())
+			errs = append(errs, err)
+		}
+	}
+
+	if len(errs) > 0 {
+		return certs, fmt.Errorf("error reissuing %d revoked certificates: %w", len(errs), errs[0])
+	}
+	return certs, nil
+}
+
+// RestoreRevokedCertificates records the certificates with the given serial numbers as revoked until their given
+// expiration, so that the certificates revoked before the control plane restarted remain revoked.
+func (m *Manager) RestoreRevokedCertificates(revoked map[SerialNumber]time.Time) {
+	now := time.Now()
+	for sn, expiration := range revoked {
+		if sn == "" || now.After(expiration) {
+			continue
+		}
+		m.revoked.Store(sn, expiration)
+	}
+}
+
+// isIssuedForServiceIdentity returns whether the certificate cached with the given key is issued for one of
+// the given service identities.
+func isIssuedForServiceIdentity(key string, cert *Certificate, serviceIdentities []identity.ServiceIdentity) bool {
+	certIdentity, ok := getServiceIdentityFromCN(cert.GetCommonName())
+	for _, si := range serviceIdentities {
+		if key == si.String() || (ok && certIdentity == si) {
+			return true
+		}
+	}
+	return false
+}
+
+// getServiceIdentityFromCN returns the service identity of the given certificate Common Name, which is either of
+// the form <name>.<namespace>.<trust-domain> or, for bootstrap certificates, of the form
+// <proxy-UUID>.<kind>.<name>.<namespace>.<trust-domain>.
+func getServiceIdentityFromCN(cn CommonName) (identity.ServiceIdentity, bool) {
+	chunks := strings.Split(cn.String(), constants.DomainDelimiter)
+	if len(chunks) >= 4 {
+		if _, err := uuid.Parse(chunks[0]); err == nil {
+			chunks = chunks[2:]
+		}
+	}
+	if len(chunks) < 2 || chunks[0] == "" || chunks[1] == "" {
+		return "", false
+	}
+	return identity.New(chunks[0], chunks[1]), true
+}
+
+func (m *Manager) isRevoked(sn SerialNumber) bool {
+	if sn == "" {
+		return false
+	}
+	_, revoked := m.revoked.Load(sn)
+	return revoked
 }
 
 func (m *Manager) getValidityDurationForCertType(ct CertType) time.Duration {
@@ -244,6 +576,17 @@ func (m *Manager) getValidityDurationForCertType(ct CertType) time.Duration {
 	}
 }
 
+// getMaxValidityDuration returns the longest validity duration of the certificates issued by the manager
+func (m *Manager) getMaxValidityDuration() time.Duration {
+	maxValidityDuration := constants.OSMCertificateValidityPeriod
+	for _, ct := range []CertType{Service, IngressGateway} {
+		if validityDuration := m.getValidityDurationForCertType(ct); validityDuration > maxValidityDuration {
+			maxValidityDuration = validityDuration
+		}
+	}
+	return maxValidityDuration
+}
+
 // getFromCache returns the certificate with the specified cn from cache if it exists.
 // Note: getFromCache might return an expired or invalid certificate.
 func (m *Manager) getFromCache(key string) *Certificate {
@@ -308,7 +651,12 @@ func (m *Manager) issueCertificate(prefix string, ct CertType, opts ...IssueOpti
 
 	start := time.Now()
 	validityDuration := m.getValidityDurationForCertType(ct)
-	newCert, err := signingIssuer.IssueCertificate(options.formatCN(prefix, signingIssuer.TrustDomain), options.subjectAlternativeNames(), options.validityPeriod(validityDuration))
+	saNames := options.subjectAlternativeNames()
+	// Service certificates are issued for a service identity, whose SPIFFE ID is added as a URI SAN
+	if ct == Service && !options.fullCNProvided && signingIssuer.SpiffeEnabled && strings.Contains(prefix, ".") {
+		saNames = append(append([]string{}, saNames...), identity.ServiceIdentity(prefix).AsSpiffeID(signingIssuer.TrustDomain))
+	}
+	newCert, err := signingIssuer.IssueCertificate(options.formatCN(prefix, signingIssuer.TrustDomain), saNames, options.validityPeriod(validityDuration))
 	if err != nil {
 		return nil, err
 	}
@@ -321,6 +669,7 @@ func (m *Manager) issueCertificate(prefix string, ct CertType, opts ...IssueOpti
 
 	newCert.signingIssuerID = signingIssuer.ID
 	newCert.validatingIssuerID = validatingIssuer.ID
+	newCert.spiffeEnabled = signingIssuer.SpiffeEnabled
 	newCert.certType = ct
 	m.cache.Store(prefix, newCert)
 
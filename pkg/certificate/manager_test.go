@@ -2,22 +2,28 @@ package certificate
 
 import (
 	"context"
+	"fmt"
 	"testing"
 	time "time"
 
+	"github.com/google/uuid"
 	tassert "github.com/stretchr/testify/assert"
 	trequire "github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/api/meta"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/openservicemesh/osm/pkg/announcements"
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/certificate/pem"
 	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s/events"
 	"github.com/openservicemesh/osm/pkg/messaging"
 )
 
 func TestShouldRotate(t *testing.T) {
 	manager := &Manager{}
+	manager.revoked.Store(SerialNumber("1234"), time.Now().Add(1*time.Hour))
 
 	testCases := []struct {
 		name             string
@@ -59,6 +65,41 @@ func TestShouldRotate(t *testing.T) {
 			managerPubIssuer: &issuer{ID: "1"},
 			expectedRotation: false,
 		},
+		{
+			name: "Certificate issued before SPIFFE was enabled",
+			cert: &Certificate{
+				Expiration:         time.Now().Add(1 * time.Hour),
+				signingIssuerID:    "1",
+				validatingIssuerID: "1",
+			},
+			managerKeyIssuer: &issuer{ID: "1", SpiffeEnabled: true},
+			managerPubIssuer: &issuer{ID: "1", SpiffeEnabled: true},
+			expectedRotation: true,
+		},
+		{
+			name: "Certificate issued with SPIFFE enabled",
+			cert: &Certificate{
+				Expiration:         time.Now().Add(1 * time.Hour),
+				signingIssuerID:    "1",
+				validatingIssuerID: "1",
+				spiffeEnabled:      true,
+			},
+			managerKeyIssuer: &issuer{ID: "1", SpiffeEnabled: true},
+			managerPubIssuer: &issuer{ID: "1", SpiffeEnabled: true},
+			expectedRotation: false,
+		},
+		{
+			name: "Revoked certificate",
+			cert: &Certificate{
+				SerialNumber:       "1234",
+				Expiration:         time.Now().Add(1 * time.Hour),
+				signingIssuerID:    "1",
+				validatingIssuerID: "1",
+			},
+			managerKeyIssuer: &issuer{ID: "1"},
+			managerPubIssuer: &issuer{ID: "1"},
+			expectedRotation: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -101,6 +142,116 @@ func TestRotor(t *testing.T) {
 	assert.NotEqual(certA, newCert)
 }
 
+func TestRevokeCertificates(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	getCertValidityPeriod := func() time.Duration { return 1 * time.Hour }
+
+	stop := make(chan struct{})
+	defer close(stop)
+	msgBroker := messaging.NewBroker(stop)
+	certManager, err := NewManager(context.Background(), &fakeMRCClient{}, getCertValidityPeriod, getCertValidityPeriod, msgBroker, 1*time.Hour)
+	require.NoError(err)
+
+	// The fake issuer does not set serial numbers
+	certA, err := certManager.IssueCertificate("sa-a.ns", Service)
+	require.NoError(err)
+	certA.SerialNumber = "1"
+	certB, err := certManager.IssueCertificate("sa-b.ns", Service)
+	require.NoError(err)
+	certB.SerialNumber = "2"
+	certC, err := certManager.IssueCertificate("sa-c.ns", Service)
+	require.NoError(err)
+	certC.SerialNumber = "3"
+	bootstrapCertB, err := certManager.IssueCertificate(bootstrapCNPrefix("sa-b", "ns"), Internal)
+	require.NoError(err)
+	bootstrapCertB.SerialNumber = "4"
+	bootstrapCertC, err := certManager.IssueCertificate(bootstrapCNPrefix("sa-c", "ns"), Internal)
+	require.NoError(err)
+	bootstrapCertC.SerialNumber = "5"
+
+	certRotateChan := msgBroker.GetCertPubSub().Sub(announcements.CertificateRotated.String())
+	defer msgBroker.Unsub(msgBroker.GetCertPubSub(), certRotateChan)
+
+	revoked, err := certManager.RevokeCertificates([]SerialNumber{"1", "unknown"}, []identity.ServiceIdentity{"sa-b.ns"})
+	require.NoError(err)
+	assert.ElementsMatch([]*Certificate{certA, certB, bootstrapCertB}, revoked)
+
+	// The uncached certificates are revoked as well
+	assert.True(certManager.isRevoked("unknown"))
+
+	// All the revoked certificates are announced as rotated
+	for i := 0; i < 3; i++ {
+		select {
+		case msg := <-certRotateChan:
+			old := msg.(events.PubSubMessage).OldObj.(*Certificate)
+			assert.Contains([]*Certificate{certA, certB, bootstrapCertB}, old)
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for the revoked certificates to be rotated")
+		}
+	}
+
+	assert.NotSame(certA, certManager.GetCertificate("sa-a.ns"))
+	assert.NotSame(certB, certManager.GetCertificate("sa-b.ns"))
+	assert.Same(certC, certManager.GetCertificate("sa-c.ns"))
+	assert.True(certManager.ShouldRotate(certA))
+	assert.False(certManager.ShouldRotate(certC))
+	assert.True(certManager.ShouldRotate(bootstrapCertB))
+	assert.False(certManager.ShouldRotate(bootstrapCertC))
+}
+
+func TestRestoreRevokedCertificates(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	getCertValidityPeriod := func() time.Duration { return 1 * time.Hour }
+
+	stop := make(chan struct{})
+	defer close(stop)
+	certManager, err := NewManager(context.Background(), &fakeMRCClient{}, getCertValidityPeriod, getCertValidityPeriod, messaging.NewBroker(stop), 1*time.Hour)
+	require.NoError(err)
+
+	certManager.RestoreRevokedCertificates(map[SerialNumber]time.Time{
+		"1": time.Now().Add(time.Hour),
+		"2": time.Now().Add(-time.Hour),
+	})
+
+	// The restored certificates are rotated on their next use, unless they expired
+	assert.True(certManager.isRevoked("1"))
+	assert.False(certManager.isRevoked("2"))
+	assert.False(certManager.isRevoked("3"))
+}
+
+// bootstrapCNPrefix returns the Common Name prefix of a bootstrap certificate issued for the given service account
+func bootstrapCNPrefix(name, namespace string) string {
+	return fmt.Sprintf("%s.sidecar.%s.%s", uuid.New(), name, namespace)
+}
+
+func TestGetServiceIdentityFromCN(t *testing.T) {
+	testCases := []struct {
+		cn     CommonName
+		wantSI identity.ServiceIdentity
+		wantOK bool
+	}{
+		{cn: "sa.ns.cluster.local", wantSI: "sa.ns", wantOK: true},
+		{cn: "sa.ns", wantSI: "sa.ns", wantOK: true},
+		{cn: "8a3a8b8e-6a4c-4b0f-9a52-6c1d2f1f2b8e.sidecar.sa.ns.cluster.local", wantSI: "sa.ns", wantOK: true},
+		{cn: "sa"},
+		{cn: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cn.String(), func(t *testing.T) {
+			assert := tassert.New(t)
+
+			si, ok := getServiceIdentityFromCN(tc.cn)
+			assert.Equal(tc.wantSI, si)
+			assert.Equal(tc.wantOK, ok)
+		})
+	}
+}
+
 func TestReleaseCertificate(t *testing.T) {
 	cn := "Test CN"
 	cert := &Certificate{
@@ -365,3 +516,204 @@ func TestHandleMRCEvent(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleMRCEventRotation(t *testing.T) {
+	newMRC := func(name string, intent v1alpha2.MeshRootCertificateIntent) *v1alpha2.MeshRootCertificate {
+		return &v1alpha2.MeshRootCertificate{
+			ObjectMeta: v1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+			},
+			Spec: v1alpha2.MeshRootCertificateSpec{
+				TrustDomain: "cluster.local",
+				Intent:      intent,
+			},
+		}
+	}
+
+	type step struct {
+		name               string
+		mrcEvent           MRCEvent
+		wantSigningID      string
+		wantValidatingID   string
+		wantReady          map[string]bool
+		wantIssuingMRCs    []string
+		wantValidatingMRCs []string
+	}
+
+	startSteps := []step{
+		{
+			name:               "old MRC is active",
+			mrcEvent:           MRCEvent{Type: MRCEventAdded, MRC: newMRC("old", v1alpha2.MeshRootCertificateIntentActive)},
+			wantSigningID:      "old",
+			wantValidatingID:   "old",
+			wantReady:          map[string]bool{"old": true},
+			wantIssuingMRCs:    []string{"old"},
+			wantValidatingMRCs: []string{"old"},
+		},
+		{
+			name:               "new MRC is added as passive",
+			mrcEvent:           MRCEvent{Type: MRCEventAdded, MRC: newMRC("new", v1alpha2.MeshRootCertificateIntentPassive)},
+			wantSigningID:      "old",
+			wantValidatingID:   "new",
+			wantReady:          map[string]bool{"old": true, "new": true},
+			wantIssuingMRCs:    []string{"old"},
+			wantValidatingMRCs: []string{"old", "new"},
+		},
+	}
+	endSteps := []step{
+		{
+			name:               "old MRC is made inactive",
+			mrcEvent:           MRCEvent{Type: MRCEventUpdated, MRC: newMRC("old", v1alpha2.MeshRootCertificateIntentInactive)},
+			wantSigningID:      "new",
+			wantValidatingID:   "new",
+			wantReady:          map[string]bool{"old": true, "new": true},
+			wantIssuingMRCs:    []string{"new"},
+			wantValidatingMRCs: []string{"new"},
+		},
+	}
+
+	// The steps of each rotation are applied in order, each one corresponding to a change of intent.
+	// While the roles of the active and passive MRCs are swapped, the current issuers are kept.
+	testCases := []struct {
+		name  string
+		steps []step
+	}{
+		{
+			name: "old MRC is demoted first",
+			steps: []step{
+				{
+					name:               "old MRC is made passive while new MRC is still passive",
+					mrcEvent:           MRCEvent{Type: MRCEventUpdated, MRC: newMRC("old", v1alpha2.MeshRootCertificateIntentPassive)},
+					wantSigningID:      "old",
+					wantValidatingID:   "new",
+					wantReady:          map[string]bool{"old": false, "new": false},
+					wantIssuingMRCs:    []string{"old"},
+					wantValidatingMRCs: []string{"old", "new"},
+				},
+				{
+					name:               "new MRC is made active",
+					mrcEvent:           MRCEvent{Type: MRCEventUpdated, MRC: newMRC("new", v1alpha2.MeshRootCertificateIntentActive)},
+					wantSigningID:      "new",
+					wantValidatingID:   "old",
+					wantReady:          map[string]bool{"old": true, "new": true},
+					wantIssuingMRCs:    []string{"new"},
+					wantValidatingMRCs: []string{"old", "new"},
+				},
+			},
+		},
+		{
+			name: "new MRC is promoted first",
+			steps: []step{
+				{
+					name:               "new MRC is made active while old MRC is still active",
+					mrcEvent:           MRCEvent{Type: MRCEventUpdated, MRC: newMRC("new", v1alpha2.MeshRootCertificateIntentActive)},
+					wantSigningID:      "old",
+					wantValidatingID:   "new",
+					wantReady:          map[string]bool{"old": false, "new": false},
+					wantIssuingMRCs:    []string{"old"},
+					wantValidatingMRCs: []string{"old", "new"},
+				},
+				{
+					name:               "old MRC is made passive",
+					mrcEvent:           MRCEvent{Type: MRCEventUpdated, MRC: newMRC("old", v1alpha2.MeshRootCertificateIntentPassive)},
+					wantSigningID:      "new",
+					wantValidatingID:   "old",
+					wantReady:          map[string]bool{"old": true, "new": true},
+					wantIssuingMRCs:    []string{"new"},
+					wantValidatingMRCs: []string{"old", "new"},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Manager{}
+			steps := append(append(append([]step{}, startSteps...), tc.steps...), endSteps...)
+			for _, step := range steps {
+				t.Run(step.name, func(t *testing.T) {
+					assert := tassert.New(t)
+
+					err := m.handleMRCEvent(&fakeMRCClient{}, step.mrcEvent)
+					assert.NoError(err)
+
+					assert.Equal(step.wantSigningID, m.signingIssuer.ID)
+					assert.Equal(step.wantValidatingID, m.validatingIssuer.ID)
+
+					for name, mrc := range m.mrcs {
+						ready := meta.FindStatusCondition(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionReady)
+						assert.Equal(step.wantReady[name], ready.Status == v1.ConditionTrue, name)
+						if ready.Status != v1.ConditionTrue {
+							assert.Equal(mrcReasonPendingIntents, ready.Reason, name)
+						}
+						assert.Equal(int64(1), ready.ObservedGeneration)
+						assert.Equal(contains(step.wantIssuingMRCs, name), meta.IsStatusConditionTrue(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionIssuing), name)
+						assert.Equal(contains(step.wantValidatingMRCs, name), meta.IsStatusConditionTrue(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionValidating), name)
+					}
+				})
+			}
+		})
+	}
+}
+
+func TestHandleMRCEventInvalidIntents(t *testing.T) {
+	assert := tassert.New(t)
+
+	newMRC := func(name string) *v1alpha2.MeshRootCertificate {
+		return &v1alpha2.MeshRootCertificate{
+			ObjectMeta: v1.ObjectMeta{Name: name},
+			Spec: v1alpha2.MeshRootCertificateSpec{
+				TrustDomain: "cluster.local",
+				Intent:      v1alpha2.MeshRootCertificateIntentActive,
+			},
+		}
+	}
+
+	m := &Manager{}
+	assert.NoError(m.handleMRCEvent(&fakeMRCClient{}, MRCEvent{Type: MRCEventAdded, MRC: newMRC("a")}))
+
+	// A second active MRC is not a role swap, since no passive MRC was used to validate certificates
+	assert.Error(m.handleMRCEvent(&fakeMRCClient{}, MRCEvent{Type: MRCEventAdded, MRC: newMRC("b")}))
+	assert.Equal("a", m.signingIssuer.ID)
+	assert.Equal("a", m.validatingIssuer.ID)
+	for name, mrc := range m.mrcs {
+		ready := meta.FindStatusCondition(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionReady)
+		assert.Equal(v1.ConditionFalse, ready.Status, name)
+		assert.Equal(mrcReasonInvalidIntents, ready.Reason, name)
+	}
+}
+
+func TestGetMRCIntent(t *testing.T) {
+	testCases := []struct {
+		intent     v1alpha2.MeshRootCertificateIntent
+		state      string
+		wantIntent v1alpha2.MeshRootCertificateIntent
+	}{
+		{intent: v1alpha2.MeshRootCertificateIntentPassive, state: constants.MRCStateActive, wantIntent: v1alpha2.MeshRootCertificateIntentPassive},
+		{state: constants.MRCStateActive, wantIntent: v1alpha2.MeshRootCertificateIntentActive},
+		{state: constants.MRCStateIssuingRollout, wantIntent: v1alpha2.MeshRootCertificateIntentActive},
+		{state: constants.MRCStateValidatingRollback, wantIntent: v1alpha2.MeshRootCertificateIntentPassive},
+		{state: constants.MRCStateInactive, wantIntent: v1alpha2.MeshRootCertificateIntentInactive},
+		{wantIntent: v1alpha2.MeshRootCertificateIntentActive},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.intent)+"/"+tc.state, func(t *testing.T) {
+			mrc := &v1alpha2.MeshRootCertificate{
+				Spec:   v1alpha2.MeshRootCertificateSpec{Intent: tc.intent},
+				Status: v1alpha2.MeshRootCertificateStatus{State: tc.state},
+			}
+			tassert.Equal(t, tc.wantIntent, GetMRCIntent(mrc))
+		})
+	}
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
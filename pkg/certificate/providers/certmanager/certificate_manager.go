@@ -118,6 +118,7 @@ func (cm *CertManager) IssueCertificate(cn certificate.CommonName, saNames []str
 	if len(csr.DNSNames) > 0 {
 		csr.DNSNames = uniqueSubjectAlternativeNames(csr.DNSNames)
 	}
+	csr.URIs = certificate.GetSpiffeIDs(saNames)
 
 	csrDER, err := x509.CreateCertificateRequest(rand.Reader, csr, certPrivKey)
 	if err != nil {
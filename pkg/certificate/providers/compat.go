@@ -27,3 +27,8 @@ func (c *MRCCompatClient) Watch(ctx context.Context) (<-chan certificate.MRCEven
 
 	return ch, nil
 }
+
+// UpdateMRCStatus returns the provided MRC as is, since the pre-generated MRC is not stored in the cluster.
+func (c *MRCCompatClient) UpdateMRCStatus(mrc *v1alpha2.MeshRootCertificate) (*v1alpha2.MeshRootCertificate, error) {
+	return mrc, nil
+}
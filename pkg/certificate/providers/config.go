@@ -18,10 +18,13 @@ import (
 	"github.com/openservicemesh/osm/pkg/certificate/castorage/k8s"
 	"github.com/openservicemesh/osm/pkg/certificate/pem"
 	"github.com/openservicemesh/osm/pkg/certificate/providers/certmanager"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/external"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/spire"
 	"github.com/openservicemesh/osm/pkg/certificate/providers/tresor"
 	"github.com/openservicemesh/osm/pkg/certificate/providers/vault"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
+	configClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
 	"github.com/openservicemesh/osm/pkg/k8s/informers"
 	"github.com/openservicemesh/osm/pkg/messaging"
 )
@@ -45,13 +48,14 @@ var getCA = func(i certificate.Issuer) (pem.RootCertificate, error) {
 // NewCertificateManager returns a new certificate manager with a MRC compat client.
 // TODO(4713): Remove and use NewCertificateManagerFromMRC
 func NewCertificateManager(ctx context.Context, kubeClient kubernetes.Interface, kubeConfig *rest.Config, cfg configurator.Configurator,
-	providerNamespace string, option Options, msgBroker *messaging.Broker, checkInterval time.Duration, trustDomain string) (*certificate.Manager, error) {
+	providerNamespace string, option Options, msgBroker *messaging.Broker, checkInterval time.Duration, trustDomain string, spiffeEnabled bool) (*certificate.Manager, error) {
 	if err := option.Validate(); err != nil {
 		return nil, err
 	}
 
 	mrcClient := &MRCCompatClient{
 		MRCProviderGenerator: MRCProviderGenerator{
+			ctx:             ctx,
 			kubeClient:      kubeClient,
 			kubeConfig:      kubeConfig,
 			KeyBitSize:      cfg.GetCertKeyBitSize(),
@@ -63,8 +67,9 @@ func NewCertificateManager(ctx context.Context, kubeClient kubernetes.Interface,
 				Namespace: providerNamespace,
 			},
 			Spec: v1alpha2.MeshRootCertificateSpec{
-				Provider:    option.AsProviderSpec(),
-				TrustDomain: trustDomain,
+				Provider:      option.AsProviderSpec(),
+				TrustDomain:   trustDomain,
+				SpiffeEnabled: spiffeEnabled,
 			},
 			Status: v1alpha2.MeshRootCertificateStatus{
 				State: constants.MRCStateActive,
@@ -79,21 +84,24 @@ func NewCertificateManager(ctx context.Context, kubeClient kubernetes.Interface,
 	return certificate.NewManager(ctx, mrcClient, cfg.GetServiceCertValidityPeriod, cfg.GetIngressGatewayCertValidityPeriod, msgBroker, checkInterval)
 }
 
-// NewCertificateManagerFromMRC returns a new certificate manager.
+// NewCertificateManagerFromMRC returns a new certificate manager. The status of the MRCs is updated
+// with the given configClient, unless it is nil.
 func NewCertificateManagerFromMRC(ctx context.Context, kubeClient kubernetes.Interface, kubeConfig *rest.Config, cfg configurator.Configurator,
-	providerNamespace string, option Options, msgBroker *messaging.Broker, ic *informers.InformerCollection, checkInterval time.Duration) (*certificate.Manager, error) {
+	providerNamespace string, option Options, msgBroker *messaging.Broker, ic *informers.InformerCollection, configClient configClientset.Interface, checkInterval time.Duration) (*certificate.Manager, error) {
 	if err := option.Validate(); err != nil {
 		return nil, err
 	}
 
 	mrcClient := &MRCComposer{
 		MRCProviderGenerator: MRCProviderGenerator{
+			ctx:             ctx,
 			kubeClient:      kubeClient,
 			kubeConfig:      kubeConfig,
 			KeyBitSize:      cfg.GetCertKeyBitSize(),
 			caExtractorFunc: getCA,
 		},
 		informerCollection: ic,
+		configClient:       configClient,
 	}
 	// TODO(#4745): Remove after deprecating the osm.vault.token option.
 	if vaultOption, ok := option.(VaultOptions); ok {
@@ -115,6 +123,10 @@ func (c *MRCProviderGenerator) GetCertIssuerForMRC(mrc *v1alpha2.MeshRootCertifi
 		issuer, err = c.getHashiVaultOSMCertificateManager(mrc)
 	case p.CertManager != nil:
 		issuer, err = c.getCertManagerOSMCertificateManager(mrc)
+	case p.Spire != nil:
+		issuer, err = c.getSpireOSMCertificateManager(mrc)
+	case p.External != nil:
+		issuer, err = c.getExternalOSMCertificateManager(mrc)
 	default:
 		return nil, nil, fmt.Errorf("Unknown certificate provider: %+v", p)
 	}
@@ -239,3 +251,40 @@ func (c *MRCProviderGenerator) getCertManagerOSMCertificateManager(mrc *v1alpha2
 
 	return cmClient, nil
 }
+
+// getSpireOSMCertificateManager returns a certificate manager instance with SPIRE as the certificate provider
+func (c *MRCProviderGenerator) getSpireOSMCertificateManager(mrc *v1alpha2.MeshRootCertificate) (certificate.Issuer, error) {
+	provider := mrc.Spec.Provider.Spire
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	spireClient, err := spire.New(
+		ctx,
+		provider.ServerAddress,
+		provider.AgentSocketPath,
+		provider.ServerSpiffeID,
+		mrc.Spec.TrustDomain,
+		c.KeyBitSize,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error instantiating SPIRE as a Certificate Manager: %w", err)
+	}
+
+	return spireClient, nil
+}
+
+// getExternalOSMCertificateManager returns a certificate manager instance with an external issuer as the certificate provider
+func (c *MRCProviderGenerator) getExternalOSMCertificateManager(mrc *v1alpha2.MeshRootCertificate) (certificate.Issuer, error) {
+	provider := mrc.Spec.Provider.External
+	ctx := c.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	externalClient, err := external.New(ctx, provider.Address, provider.Parameters, c.KeyBitSize)
+	if err != nil {
+		return nil, fmt.Errorf("error instantiating the external issuer as a Certificate Manager: %w", err)
+	}
+
+	return externalClient, nil
+}
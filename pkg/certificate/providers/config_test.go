@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	tassert "github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes"
@@ -143,7 +144,7 @@ func TestGetCertificateManager(t *testing.T) {
 				getCA = oldCA
 			}()
 
-			manager, err := NewCertificateManager(context.Background(), tc.kubeClient, tc.restConfig, tc.cfg, tc.providerNamespace, tc.options, tc.msgBroker, 1*time.Hour, "cluster.local")
+			manager, err := NewCertificateManager(context.Background(), tc.kubeClient, tc.restConfig, tc.cfg, tc.providerNamespace, tc.options, tc.msgBroker, 1*time.Hour, "cluster.local", false)
 			if tc.expectError {
 				assert.Empty(manager)
 				assert.Error(err)
@@ -429,6 +430,52 @@ func TestGetCertificateManagerFromMRC(t *testing.T) {
 			}),
 			expectError: true,
 		},
+		{
+			name: "Invalid SPIRE options",
+			options: SpireOptions{
+				AgentSocketPath: "/run/spire/sockets/agent.sock",
+			},
+			cfg:        mockConfigurator,
+			kubeClient: fake.NewSimpleClientset(),
+			configClient: fakeConfigClientset.NewSimpleClientset(&v1alpha2.MeshRootCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "osm-mesh-root-certificate",
+					Namespace: "osm-system",
+				},
+				Spec: v1alpha2.MeshRootCertificateSpec{
+					Provider: v1alpha2.ProviderSpec{
+						Spire: &v1alpha2.SpireProviderSpec{
+							AgentSocketPath: "/run/spire/sockets/agent.sock",
+						},
+					},
+				},
+				Status: v1alpha2.MeshRootCertificateStatus{
+					State: constants.MRCStateActive,
+				},
+			}),
+			expectError: true,
+		},
+		{
+			name:       "Invalid external issuer options",
+			options:    ExternalOptions{},
+			cfg:        mockConfigurator,
+			kubeClient: fake.NewSimpleClientset(),
+			configClient: fakeConfigClientset.NewSimpleClientset(&v1alpha2.MeshRootCertificate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "osm-mesh-root-certificate",
+					Namespace: "osm-system",
+				},
+				Spec: v1alpha2.MeshRootCertificateSpec{
+					Provider: v1alpha2.ProviderSpec{
+						External: &v1alpha2.ExternalProviderSpec{},
+					},
+				},
+				Status: v1alpha2.MeshRootCertificateStatus{
+					State: constants.MRCStateActive,
+				},
+			}),
+			expectError: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -448,7 +495,7 @@ func TestGetCertificateManagerFromMRC(t *testing.T) {
 			assert.NoError(err)
 			assert.NotNil(ic)
 
-			manager, err := NewCertificateManagerFromMRC(context.Background(), tc.kubeClient, tc.restConfig, tc.cfg, tc.providerNamespace, tc.options, tc.msgBroker, ic, 1*time.Hour)
+			manager, err := NewCertificateManagerFromMRC(context.Background(), tc.kubeClient, tc.restConfig, tc.cfg, tc.providerNamespace, tc.options, tc.msgBroker, ic, tc.configClient, 1*time.Hour)
 			if tc.expectError {
 				assert.Empty(manager)
 				assert.Error(err)
@@ -460,6 +507,11 @@ func TestGetCertificateManagerFromMRC(t *testing.T) {
 			if opt, ok := tc.options.(TresorOptions); ok && !tc.expectError {
 				_, err := tc.kubeClient.CoreV1().Secrets(tc.providerNamespace).Get(context.TODO(), opt.SecretName, metav1.GetOptions{})
 				assert.NoError(err)
+
+				mrc, err := tc.configClient.ConfigV1alpha2().MeshRootCertificates("osm-system").Get(context.TODO(), "osm-mesh-root-certificate", metav1.GetOptions{})
+				assert.NoError(err)
+				assert.True(meta.IsStatusConditionTrue(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionReady))
+				assert.True(meta.IsStatusConditionTrue(mrc.Status.Conditions, v1alpha2.MeshRootCertificateConditionIssuing))
 			}
 		})
 	}
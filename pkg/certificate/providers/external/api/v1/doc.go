@@ -0,0 +1,4 @@
+// Package v1 contains the gRPC protocol of the external certificate issuers.
+package v1
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative issuer.proto
@@ -0,0 +1,168 @@
+package external
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+	externalv1 "github.com/openservicemesh/osm/pkg/certificate/providers/external/api/v1"
+	"github.com/openservicemesh/osm/pkg/errcode"
+)
+
+const (
+	// requestTimeout is the time to wait for the external issuer to issue a certificate
+	requestTimeout = 10 * time.Second
+)
+
+// New constructs a new certificate client delegating the signing of the certificates to the external issuer
+// served at the given address. The address is either a Unix domain socket, e.g. unix:///var/run/osm/issuer.sock,
+// or a host:port address. The connection is not authenticated, so the external issuer is expected to run
+// alongside the control plane, as a sidecar container for instance.
+//
+// The connection to the external issuer is closed once the given context is done.
+func New(ctx context.Context, address string, parameters map[string]string, keySize int) (*CertManager, error) {
+	if address == "" {
+		return nil, errors.New("external issuer address must not be empty")
+	}
+
+	conn, err := grpc.Dial(address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to the external issuer at %s: %w", address, err)
+	}
+
+	cm, err := newCertManager(conn, parameters, keySize)
+	if err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Error().Err(err).Msgf("Error closing the connection to the external issuer at %s", address)
+		}
+	}()
+	log.Info().Msgf("Created external issuer CertManager at %s", address)
+
+	return cm, nil
+}
+
+// newCertManager constructs a new certificate client using the external issuer served on the given connection
+func newCertManager(conn grpc.ClientConnInterface, parameters map[string]string, keySize int) (*CertManager, error) {
+	if keySize == 0 {
+		return nil, errors.New("key bit size cannot be zero")
+	}
+
+	return &CertManager{
+		client:     externalv1.NewIssuerClient(conn),
+		parameters: parameters,
+		keySize:    keySize,
+	}, nil
+}
+
+// IssueCertificate requests a new signed certificate from the external issuer.
+func (cm *CertManager) IssueCertificate(cn certificate.CommonName, saNames []string, validityPeriod time.Duration) (*certificate.Certificate, error) {
+	certPrivKey, err := rsa.GenerateKey(rand.Reader, cm.keySize)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrGeneratingPrivateKey)).
+			Msgf("Error generating private key for certificate with CN=%s", cn)
+		return nil, fmt.Errorf("failed to generate private key for certificate with CN=%s: %w", cn, err)
+	}
+
+	privKeyPEM, err := certificate.EncodeKeyDERtoPEM(certPrivKey)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrEncodingKeyDERtoPEM)).
+			Msgf("Error encoding private key for certificate with CN=%s", cn)
+		return nil, err
+	}
+
+	dnsNames := getDNSNames(cn, saNames)
+	uris := certificate.GetSpiffeIDs(saNames)
+	csr := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: cn.String(),
+		},
+		DNSNames: dnsNames,
+		URIs:     uris,
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, csr, certPrivKey)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrCreatingCertReq)).
+			Msg("error creating certificate request")
+		return nil, fmt.Errorf("error creating x509 certificate request: %w", err)
+	}
+
+	var uriSANs []string
+	for _, uri := range uris {
+		uriSANs = append(uriSANs, uri.String())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	resp, err := cm.client.IssueCertificate(ctx, &externalv1.IssueCertificateRequest{
+		Csr:             csrDER,
+		CommonName:      cn.String(),
+		DnsNames:        dnsNames,
+		UriSans:         uriSANs,
+		ValiditySeconds: int64(validityPeriod / time.Second),
+		Parameters:      cm.parameters,
+	})
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrIssuingCert)).
+			Msgf("Error issuing new certificate for CN=%s", cn)
+		return nil, err
+	}
+	if len(resp.GetCertificateChain()) == 0 {
+		return nil, errNoCertificate
+	}
+	if len(resp.GetIssuingCa()) == 0 {
+		return nil, errNoIssuingCA
+	}
+
+	cert, err := certificate.DecodePEMCertificate(resp.CertificateChain)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding the certificate issued by the external issuer for CN=%s: %w", cn, err)
+	}
+
+	return &certificate.Certificate{
+		CommonName:   certificate.CommonName(cert.Subject.CommonName),
+		SANames:      cert.DNSNames,
+		SerialNumber: certificate.SerialNumber(cert.SerialNumber.String()),
+		Expiration:   cert.NotAfter,
+		CertChain:    pem.Certificate(resp.CertificateChain),
+		PrivateKey:   privKeyPEM,
+		IssuingCA:    pem.RootCertificate(resp.IssuingCa),
+		TrustedCAs:   pem.RootCertificate(resp.IssuingCa),
+	}, nil
+}
+
+// getDNSNames returns the DNS SANs of the certificate, starting with its common name
+func getDNSNames(cn certificate.CommonName, saNames []string) []string {
+	dnsNames := []string{cn.String()}
+	seen := map[string]bool{cn.String(): true}
+	for _, san := range saNames {
+		// URIs and host:port SANs are not DNS names
+		if strings.Contains(san, ":") || seen[san] {
+			continue
+		}
+		seen[san] = true
+		dnsNames = append(dnsNames, san)
+	}
+	return dnsNames
+}
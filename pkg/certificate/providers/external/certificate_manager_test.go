@@ -0,0 +1,112 @@
+package external
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	externalv1 "github.com/openservicemesh/osm/pkg/certificate/providers/external/api/v1"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/external/signer"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/tresor"
+)
+
+const (
+	validity = 1 * time.Hour
+	keySize  = 2048
+)
+
+// startSigner serves the reference signer on a Unix domain socket and returns its address and root certificate
+func startSigner(t *testing.T) (string, *certificate.Certificate) {
+	require := trequire.New(t)
+
+	ca, err := tresor.NewCA("Fake External Issuer CN", validity, "US", "CA", "Open Service Mesh")
+	require.NoError(err)
+	s, err := signer.New(ca)
+	require.NoError(err)
+
+	socketPath := filepath.Join(t.TempDir(), "issuer.sock")
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(err)
+
+	grpcServer := grpc.NewServer()
+	externalv1.RegisterIssuerServer(grpcServer, s)
+	go func() {
+		_ = grpcServer.Serve(listener)
+	}()
+	t.Cleanup(grpcServer.Stop)
+
+	return "unix://" + socketPath, ca
+}
+
+func TestIssueCertificate(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	address, ca := startSigner(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cm, err := New(ctx, address, map[string]string{"keyID": "test"}, keySize)
+	require.NoError(err)
+
+	cn := certificate.CommonName("sa.ns.cluster.local")
+	saNames := []string{"sa.ns.svc", "sa.ns.svc:8080", "spiffe://cluster.local/ns/ns/sa/sa"}
+	cert, err := cm.IssueCertificate(cn, saNames, validity)
+	require.NoError(err)
+
+	assert.Equal(cn, cert.GetCommonName())
+	assert.Equal([]string{"sa.ns.cluster.local", "sa.ns.svc"}, cert.SANames)
+	assert.WithinDuration(time.Now().Add(validity), cert.GetExpiration(), 5*time.Second)
+	assert.NotEmpty(cert.GetPrivateKey())
+	assert.Equal([]byte(ca.GetCertificateChain()), []byte(cert.GetIssuingCA()))
+	assert.Equal(cert.GetIssuingCA(), cert.GetTrustedCAs())
+
+	x509Cert, err := certificate.DecodePEMCertificate(cert.GetCertificateChain())
+	require.NoError(err)
+	require.Len(x509Cert.URIs, 1)
+	assert.Equal("spiffe://cluster.local/ns/ns/sa/sa", x509Cert.URIs[0].String())
+	assert.Equal(cert.GetSerialNumber().String(), x509Cert.SerialNumber.String())
+
+	// The certificate is signed for the private key generated by the control plane
+	key, err := certificate.DecodePEMPrivateKey(cert.GetPrivateKey())
+	require.NoError(err)
+	assert.True(key.PublicKey.Equal(x509Cert.PublicKey))
+
+	x509CA, err := certificate.DecodePEMCertificate(cert.GetIssuingCA())
+	require.NoError(err)
+	assert.NoError(x509Cert.CheckSignatureFrom(x509CA))
+}
+
+func TestIssueCertificateError(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	address, _ := startSigner(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	cm, err := New(ctx, address, nil, keySize)
+	require.NoError(err)
+
+	// The reference signer rejects certificates without a validity period
+	cert, err := cm.IssueCertificate("sa.ns.cluster.local", nil, 0)
+	assert.Nil(cert)
+	assert.Error(err)
+}
+
+func TestNew(t *testing.T) {
+	assert := tassert.New(t)
+
+	cm, err := New(context.Background(), "", nil, keySize)
+	assert.Nil(cm)
+	assert.Error(err)
+
+	cm, err = New(context.Background(), "unix:///var/run/osm/issuer.sock", nil, 0)
+	assert.Nil(cm)
+	assert.Error(err)
+}
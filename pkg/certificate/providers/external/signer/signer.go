@@ -0,0 +1,122 @@
+// Package signer implements a reference external issuer, serving the gRPC protocol of the external certificate
+// provider and signing the certificates with a local root certificate.
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/url"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	externalv1 "github.com/openservicemesh/osm/pkg/certificate/providers/external/api/v1"
+)
+
+const (
+	// How many bits in the certificate serial number
+	certSerialNumberBits = 128
+)
+
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), certSerialNumberBits)
+
+// Signer is an external issuer signing the certificates with a root certificate
+type Signer struct {
+	externalv1.UnimplementedIssuerServer
+
+	ca    *x509.Certificate
+	caKey *rsa.PrivateKey
+	caPEM []byte
+}
+
+// New returns a new Signer signing the certificates with the given root certificate, which must carry its private key
+func New(ca *certificate.Certificate) (*Signer, error) {
+	if ca == nil || len(ca.GetPrivateKey()) == 0 {
+		return nil, errors.New("the root certificate and its private key must be provided")
+	}
+
+	x509CA, err := certificate.DecodePEMCertificate(ca.GetCertificateChain())
+	if err != nil {
+		return nil, err
+	}
+
+	caKey, err := certificate.DecodePEMPrivateKey(ca.GetPrivateKey())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Signer{
+		ca:    x509CA,
+		caKey: caKey,
+		caPEM: ca.GetCertificateChain(),
+	}, nil
+}
+
+// IssueCertificate signs a certificate for the public key of the CSR, with the common name and subject alternative
+// names of the request
+func (s *Signer) IssueCertificate(_ context.Context, req *externalv1.IssueCertificateRequest) (*externalv1.IssueCertificateResponse, error) {
+	csr, err := x509.ParseCertificateRequest(req.GetCsr())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed CSR: %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid CSR signature: %v", err)
+	}
+	if req.GetCommonName() == "" || csr.Subject.CommonName != req.GetCommonName() {
+		return nil, status.Errorf(codes.InvalidArgument, "the common name of the CSR %q does not match the requested common name %q", csr.Subject.CommonName, req.GetCommonName())
+	}
+	if req.GetValiditySeconds() <= 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid validity period of %d seconds", req.GetValiditySeconds())
+	}
+
+	var uris []*url.URL
+	for _, uriSAN := range req.GetUriSans() {
+		uri, err := url.Parse(uriSAN)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid URI SAN %s: %v", uriSAN, err)
+		}
+		uris = append(uris, uri)
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serialNumber,
+		Subject: pkix.Name{
+			CommonName: req.GetCommonName(),
+		},
+		DNSNames:              req.GetDnsNames(),
+		URIs:                  uris,
+		NotBefore:             now,
+		NotAfter:              now.Add(time.Duration(req.GetValiditySeconds()) * time.Second),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, s.ca, csr.PublicKey, s.caKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	certPEM, err := certificate.EncodeCertDERtoPEM(certDER)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &externalv1.IssueCertificateResponse{
+		CertificateChain: certPEM,
+		IssuingCa:        s.caPEM,
+	}, nil
+}
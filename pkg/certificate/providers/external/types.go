@@ -0,0 +1,29 @@
+// Package external implements the certificate.Manager interface for external certificate issuers, to which
+// the signing of the certificates is delegated over the gRPC protocol defined in the api/v1 package.
+package external
+
+import (
+	"errors"
+
+	externalv1 "github.com/openservicemesh/osm/pkg/certificate/providers/external/api/v1"
+	"github.com/openservicemesh/osm/pkg/logger"
+)
+
+var (
+	log = logger.New("external-issuer")
+
+	errNoCertificate = errors.New("no certificate returned by the external issuer")
+	errNoIssuingCA   = errors.New("no issuing CA returned by the external issuer")
+)
+
+// CertManager implements certificate.Manager
+type CertManager struct {
+	// client of the external issuer gRPC server.
+	client externalv1.IssuerClient
+
+	// parameters are passed as is to the external issuer with every request.
+	parameters map[string]string
+
+	// Issuing certificate properties.
+	keySize int
+}
@@ -3,10 +3,12 @@ package providers
 import (
 	"context"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/cache"
 
 	"github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/certificate"
+	configClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
 	"github.com/openservicemesh/osm/pkg/k8s/informers"
 )
 
@@ -15,6 +17,8 @@ import (
 // `certificate.Provider`s from those MRCs
 type MRCComposer struct {
 	informerCollection *informers.InformerCollection
+	// configClient is used to update the status of the MRCs, which is skipped when nil
+	configClient configClientset.Interface
 	MRCProviderGenerator
 }
 
@@ -37,6 +41,14 @@ func (m *MRCComposer) List() ([]*v1alpha2.MeshRootCertificate, error) {
 	return mrcs, nil
 }
 
+// UpdateMRCStatus updates the status of the provided MRC in the cluster
+func (m *MRCComposer) UpdateMRCStatus(mrc *v1alpha2.MeshRootCertificate) (*v1alpha2.MeshRootCertificate, error) {
+	if m.configClient == nil {
+		return mrc, nil
+	}
+	return m.configClient.ConfigV1alpha2().MeshRootCertificates(mrc.Namespace).UpdateStatus(context.Background(), mrc, metav1.UpdateOptions{})
+}
+
 // Watch returns a channel that receives events whenever MRCs are added, updated, and deleted
 // from the informerCollection's MRC store. Channels returned from multiple invocations of
 // Watch() are unique and have no coordination with each other. Events are guaranteed
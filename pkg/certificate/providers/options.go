@@ -97,3 +97,46 @@ func (options CertManagerOptions) AsProviderSpec() v1alpha2.ProviderSpec {
 		},
 	}
 }
+
+// Validate validates the options for SPIRE certificate provider
+func (options SpireOptions) Validate() error {
+	if options.ServerAddress == "" {
+		return errors.New("ServerAddress not specified in SPIRE options")
+	}
+
+	if options.AgentSocketPath == "" {
+		return errors.New("AgentSocketPath not specified in SPIRE options")
+	}
+
+	return nil
+}
+
+// AsProviderSpec returns the provider spec generated from the SPIRE options
+func (options SpireOptions) AsProviderSpec() v1alpha2.ProviderSpec {
+	return v1alpha2.ProviderSpec{
+		Spire: &v1alpha2.SpireProviderSpec{
+			ServerAddress:   options.ServerAddress,
+			AgentSocketPath: options.AgentSocketPath,
+			ServerSpiffeID:  options.ServerSpiffeID,
+		},
+	}
+}
+
+// Validate validates the options for the external issuer certificate provider
+func (options ExternalOptions) Validate() error {
+	if options.Address == "" {
+		return errors.New("Address not specified in external issuer options")
+	}
+
+	return nil
+}
+
+// AsProviderSpec returns the provider spec generated from the external issuer options
+func (options ExternalOptions) AsProviderSpec() v1alpha2.ProviderSpec {
+	return v1alpha2.ProviderSpec{
+		External: &v1alpha2.ExternalProviderSpec{
+			Address:    options.Address,
+			Parameters: options.Parameters,
+		},
+	}
+}
@@ -155,3 +155,82 @@ func TestValidateVaultOptions(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateSpireOptions(t *testing.T) {
+	assert := tassert.New(t)
+
+	testCases := []struct {
+		testName  string
+		options   SpireOptions
+		expectErr bool
+	}{
+		{
+			testName: "Empty server address",
+			options: SpireOptions{
+				ServerAddress:   "",
+				AgentSocketPath: "/run/spire/sockets/agent.sock",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "Empty agent socket path",
+			options: SpireOptions{
+				ServerAddress:   "spire-server.spire:8081",
+				AgentSocketPath: "",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "Valid SPIRE opts",
+			options: SpireOptions{
+				ServerAddress:   "spire-server.spire:8081",
+				AgentSocketPath: "/run/spire/sockets/agent.sock",
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, t := range testCases {
+		err := t.options.Validate()
+		if t.expectErr {
+			assert.Error(err, "test '%s' didn't error as expected", t.testName)
+		} else {
+			assert.NoError(err, "test '%s' didn't succeed as expected", t.testName)
+		}
+	}
+}
+
+func TestValidateExternalOptions(t *testing.T) {
+	assert := tassert.New(t)
+
+	testCases := []struct {
+		testName  string
+		options   ExternalOptions
+		expectErr bool
+	}{
+		{
+			testName: "Empty address",
+			options: ExternalOptions{
+				Address: "",
+			},
+			expectErr: true,
+		},
+		{
+			testName: "Valid external issuer opts",
+			options: ExternalOptions{
+				Address:    "unix:///var/run/osm/issuer.sock",
+				Parameters: map[string]string{"keyID": "test"},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, t := range testCases {
+		err := t.options.Validate()
+		if t.expectErr {
+			assert.Error(err, "test '%s' didn't error as expected", t.testName)
+		} else {
+			assert.NoError(err, "test '%s' didn't succeed as expected", t.testName)
+		}
+	}
+}
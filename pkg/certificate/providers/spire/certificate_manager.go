@@ -0,0 +1,275 @@
+package spire
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/spiffegrpc/grpccredentials"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
+	"github.com/spiffe/go-spiffe/v2/workloadapi"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	svidv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/svid/v1"
+	"google.golang.org/grpc"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+	"github.com/openservicemesh/osm/pkg/errcode"
+)
+
+const (
+	// workloadAPITimeout is the time to wait for the SPIRE agent to return the X.509-SVID of the mesh control plane
+	workloadAPITimeout = 30 * time.Second
+
+	// serverAPITimeout is the time to wait for the SPIRE server to respond to a request
+	serverAPITimeout = 10 * time.Second
+)
+
+// New constructs a new certificate client using the SPIRE server API. The mesh control plane authenticates
+// to the SPIRE server with its own X.509-SVID, obtained from the Workload API of the SPIRE agent, and must be
+// registered as an admin workload on the SPIRE server to be allowed to mint X.509-SVIDs.
+//
+// The server SVID API is used rather than the Delegated Identity API of the SPIRE agent because the control
+// plane issues certificates for the identities of workloads running on any node, while the Delegated Identity
+// API only serves the identities attested by the agent on the node of the caller. The private keys of the
+// certificates are generated by the control plane and are RSA keys of the configured key bit size.
+//
+// The X.509 source and the connection to the SPIRE server are closed once the given context is done.
+func New(
+	ctx context.Context,
+	serverAddress string,
+	agentSocketPath string,
+	serverSpiffeID string,
+	trustDomain string,
+	keySize int) (*CertManager, error) {
+	if serverAddress == "" {
+		return nil, errors.New("SPIRE server address must not be empty")
+	}
+	if agentSocketPath == "" {
+		return nil, errors.New("SPIRE agent socket path must not be empty")
+	}
+
+	td, err := spiffeid.TrustDomainFromString(trustDomain)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SPIFFE trust domain %s: %w", trustDomain, err)
+	}
+
+	authorizer := tlsconfig.AuthorizeMemberOf(td)
+	if serverSpiffeID != "" {
+		serverID, err := spiffeid.FromString(serverSpiffeID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SPIRE server SPIFFE ID %s: %w", serverSpiffeID, err)
+		}
+		authorizer = tlsconfig.AuthorizeID(serverID)
+	}
+
+	sourceCtx, cancel := context.WithTimeout(ctx, workloadAPITimeout)
+	defer cancel()
+	source, err := workloadapi.NewX509Source(sourceCtx, workloadapi.WithClientOptions(workloadapi.WithAddr("unix://"+agentSocketPath)))
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the X.509-SVID from the SPIRE agent at %s: %w", agentSocketPath, err)
+	}
+
+	conn, err := grpc.DialContext(ctx, serverAddress, grpc.WithTransportCredentials(grpccredentials.MTLSClientCredentials(source, source, authorizer)))
+	if err != nil {
+		_ = source.Close()
+		return nil, fmt.Errorf("error connecting to the SPIRE server at %s: %w", serverAddress, err)
+	}
+	go func() {
+		<-ctx.Done()
+		if err := conn.Close(); err != nil {
+			log.Error().Err(err).Msgf("Error closing the connection to the SPIRE server at %s", serverAddress)
+		}
+		if err := source.Close(); err != nil {
+			log.Error().Err(err).Msg("Error closing the X.509 source of the SPIRE agent")
+		}
+	}()
+	log.Info().Msgf("Created SPIRE CertManager for trust domain %s at %s", td, serverAddress)
+
+	return newCertManager(conn, td, keySize)
+}
+
+// newCertManager constructs a new certificate client using the SPIRE server API served on the given connection
+func newCertManager(conn grpc.ClientConnInterface, trustDomain spiffeid.TrustDomain, keySize int) (*CertManager, error) {
+	if keySize == 0 {
+		return nil, errors.New("key bit size cannot be zero")
+	}
+
+	return &CertManager{
+		svidClient:   svidv1.NewSVIDClient(conn),
+		bundleClient: bundlev1.NewBundleClient(conn),
+		trustDomain:  trustDomain,
+		keySize:      keySize,
+	}, nil
+}
+
+// IssueCertificate mints a new X.509-SVID on the SPIRE server. The SPIFFE ID of the X.509-SVID is the one
+// provided in the subject alternative names for service certificates, and is derived from the common name
+// of the certificate otherwise.
+func (cm *CertManager) IssueCertificate(cn certificate.CommonName, saNames []string, validityPeriod time.Duration) (*certificate.Certificate, error) {
+	id, err := cm.getSpiffeID(cn, saNames)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the SPIFFE ID of the certificate with CN=%s: %w", cn, err)
+	}
+
+	certPrivKey, err := rsa.GenerateKey(rand.Reader, cm.keySize)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrGeneratingPrivateKey)).
+			Msgf("Error generating private key for certificate with CN=%s", cn)
+		return nil, fmt.Errorf("failed to generate private key for certificate with CN=%s: %w", cn, err)
+	}
+
+	privKeyPEM, err := certificate.EncodeKeyDERtoPEM(certPrivKey)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrEncodingKeyDERtoPEM)).
+			Msgf("Error encoding private key for certificate with CN=%s", cn)
+		return nil, err
+	}
+
+	csr := &x509.CertificateRequest{
+		Subject: pkix.Name{
+			CommonName: cn.String(),
+		},
+		DNSNames: getDNSNames(cn, saNames),
+		URIs:     []*url.URL{id.URL()},
+	}
+
+	csrDER, err := x509.CreateCertificateRequest(rand.Reader, csr, certPrivKey)
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrCreatingCertReq)).
+			Msg("error creating certificate request")
+		return nil, fmt.Errorf("error creating x509 certificate request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), serverAPITimeout)
+	defer cancel()
+
+	resp, err := cm.svidClient.MintX509SVID(ctx, &svidv1.MintX509SVIDRequest{
+		Csr: csrDER,
+		Ttl: getTTL(validityPeriod),
+	})
+	if err != nil {
+		// TODO(#3962): metric might not be scraped before process restart resulting from this error
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrIssuingCert)).
+			Msgf("Error minting X.509-SVID %s for CN=%s", id, cn)
+		return nil, err
+	}
+	if len(resp.GetSvid().GetCertChain()) == 0 {
+		return nil, errNoSVID
+	}
+
+	leaf, err := x509.ParseCertificate(resp.Svid.CertChain[0])
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the X.509-SVID %s: %w", id, err)
+	}
+
+	certChain, err := encodeCertificates(resp.Svid.CertChain)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := cm.getCA(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &certificate.Certificate{
+		CommonName:   certificate.CommonName(leaf.Subject.CommonName),
+		SANames:      leaf.DNSNames,
+		SerialNumber: certificate.SerialNumber(leaf.SerialNumber.String()),
+		Expiration:   leaf.NotAfter,
+		CertChain:    pem.Certificate(certChain),
+		PrivateKey:   privKeyPEM,
+		IssuingCA:    ca,
+		TrustedCAs:   ca,
+	}, nil
+}
+
+// getSpiffeID returns the SPIFFE ID of the X.509-SVID to mint for the given certificate
+func (cm *CertManager) getSpiffeID(cn certificate.CommonName, saNames []string) (spiffeid.ID, error) {
+	uris := certificate.GetSpiffeIDs(saNames)
+	switch len(uris) {
+	case 0:
+		return spiffeid.FromSegments(cm.trustDomain, osmPathSegment, cn.String())
+	case 1:
+		id, err := spiffeid.FromURI(uris[0])
+		if err != nil {
+			return spiffeid.ID{}, err
+		}
+		if !id.MemberOf(cm.trustDomain) {
+			return spiffeid.ID{}, errForeignTrustDomain
+		}
+		return id, nil
+	default:
+		return spiffeid.ID{}, errMultipleSpiffeIDs
+	}
+}
+
+// getCA returns the X.509 authorities of the trust bundle of the SPIRE server
+func (cm *CertManager) getCA(ctx context.Context) (pem.RootCertificate, error) {
+	bundle, err := cm.bundleClient.GetBundle(ctx, &bundlev1.GetBundleRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("error fetching the SPIRE trust bundle: %w", err)
+	}
+
+	var authorities [][]byte
+	for _, authority := range bundle.GetX509Authorities() {
+		authorities = append(authorities, authority.GetAsn1())
+	}
+	if len(authorities) == 0 {
+		return nil, errNoX509Authorities
+	}
+
+	ca, err := encodeCertificates(authorities)
+	if err != nil {
+		return nil, err
+	}
+	return pem.RootCertificate(ca), nil
+}
+
+// encodeCertificates encodes the given DER certificates to a PEM bundle
+func encodeCertificates(certsDER [][]byte) ([]byte, error) {
+	var certsPEM []byte
+	for _, certDER := range certsDER {
+		certPEM, err := certificate.EncodeCertDERtoPEM(certDER)
+		if err != nil {
+			return nil, err
+		}
+		certsPEM = append(certsPEM, certPEM...)
+	}
+	return certsPEM, nil
+}
+
+// getDNSNames returns the DNS SANs of the certificate, starting with its common name
+func getDNSNames(cn certificate.CommonName, saNames []string) []string {
+	dnsNames := []string{cn.String()}
+	seen := map[string]bool{cn.String(): true}
+	for _, san := range saNames {
+		// URIs and host:port SANs are not DNS names
+		if strings.Contains(san, ":") || seen[san] {
+			continue
+		}
+		seen[san] = true
+		dnsNames = append(dnsNames, san)
+	}
+	return dnsNames
+}
+
+// getTTL returns the TTL of the X.509-SVID, in seconds, for the given validity period
+func getTTL(validityPeriod time.Duration) int32 {
+	if validityPeriod < time.Second {
+		return 1
+	}
+	return int32(validityPeriod / time.Second)
+}
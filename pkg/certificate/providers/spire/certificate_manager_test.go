@@ -0,0 +1,165 @@
+package spire
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/spire/fake"
+)
+
+const (
+	validity = 1 * time.Hour
+	keySize  = 2048
+)
+
+func newTestCertManager(t *testing.T) (*CertManager, *fake.Server) {
+	require := trequire.New(t)
+
+	server, err := fake.NewServer("cluster.local")
+	require.NoError(err)
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.DialContext(context.Background(), server.Addr(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	cm, err := newCertManager(conn, spiffeid.RequireTrustDomainFromString("cluster.local"), keySize)
+	require.NoError(err)
+	return cm, server
+}
+
+func TestIssueCertificate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		cn               certificate.CommonName
+		saNames          []string
+		expectedSpiffeID string
+		expectedDNSNames []string
+		expectError      bool
+	}{
+		{
+			name:             "service certificate",
+			cn:               "sa.ns.cluster.local",
+			saNames:          []string{"spiffe://cluster.local/ns/ns/sa/sa"},
+			expectedSpiffeID: "spiffe://cluster.local/ns/ns/sa/sa",
+			expectedDNSNames: []string{"sa.ns.cluster.local"},
+		},
+		{
+			name:             "internal certificate",
+			cn:               "osm-injector.osm-system.svc",
+			saNames:          []string{"osm-injector.osm-system.svc", "osm-injector.osm-system.svc.cluster.local", "osm-injector:9090"},
+			expectedSpiffeID: "spiffe://cluster.local/osm/osm-injector.osm-system.svc",
+			expectedDNSNames: []string{"osm-injector.osm-system.svc", "osm-injector.osm-system.svc.cluster.local"},
+		},
+		{
+			name:        "SPIFFE ID in another trust domain",
+			cn:          "sa.ns.cluster.local",
+			saNames:     []string{"spiffe://example.org/ns/ns/sa/sa"},
+			expectError: true,
+		},
+		{
+			name:        "multiple SPIFFE IDs",
+			cn:          "sa.ns.cluster.local",
+			saNames:     []string{"spiffe://cluster.local/ns/ns/sa/sa", "spiffe://cluster.local/ns/ns/sa/other"},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			require := trequire.New(t)
+
+			cm, server := newTestCertManager(t)
+
+			cert, err := cm.IssueCertificate(tc.cn, tc.saNames, validity)
+			if tc.expectError {
+				assert.Error(err)
+				return
+			}
+			require.NoError(err)
+
+			assert.Equal(tc.cn, cert.GetCommonName())
+			assert.Equal(tc.expectedDNSNames, cert.SANames)
+			assert.WithinDuration(time.Now().Add(validity), cert.GetExpiration(), 5*time.Second)
+			assert.NotEmpty(cert.GetPrivateKey())
+
+			x509Cert, err := certificate.DecodePEMCertificate(cert.GetCertificateChain())
+			require.NoError(err)
+			require.Len(x509Cert.URIs, 1)
+			assert.Equal(tc.expectedSpiffeID, x509Cert.URIs[0].String())
+			assert.Equal(cert.GetSerialNumber().String(), x509Cert.SerialNumber.String())
+
+			// The certificate is issued by the root certificate of the SPIRE trust bundle
+			x509CA, err := certificate.DecodePEMCertificate(cert.GetIssuingCA())
+			require.NoError(err)
+			assert.True(x509CA.Equal(server.CA()))
+			assert.NoError(x509Cert.CheckSignatureFrom(x509CA))
+			assert.Equal(cert.GetIssuingCA(), cert.GetTrustedCAs())
+		})
+	}
+}
+
+func TestIssueCertificateWithShortValidity(t *testing.T) {
+	assert := tassert.New(t)
+
+	cm, _ := newTestCertManager(t)
+
+	// The CA is extracted from a certificate issued with a validity period of a second
+	cert, err := cm.IssueCertificate("init-cert", nil, 1*time.Second)
+	assert.NoError(err)
+	assert.NotEmpty(cert.GetIssuingCA())
+	assert.WithinDuration(time.Now().Add(time.Second), cert.GetExpiration(), 5*time.Second)
+}
+
+func TestNew(t *testing.T) {
+	testCases := []struct {
+		name            string
+		serverAddress   string
+		agentSocketPath string
+		serverSpiffeID  string
+		trustDomain     string
+	}{
+		{
+			name:            "no server address",
+			agentSocketPath: "/run/spire/sockets/agent.sock",
+			trustDomain:     "cluster.local",
+		},
+		{
+			name:          "no agent socket path",
+			serverAddress: "spire-server.spire:8081",
+			trustDomain:   "cluster.local",
+		},
+		{
+			name:            "invalid trust domain",
+			serverAddress:   "spire-server.spire:8081",
+			agentSocketPath: "/run/spire/sockets/agent.sock",
+			trustDomain:     "Cluster Local",
+		},
+		{
+			name:            "invalid server SPIFFE ID",
+			serverAddress:   "spire-server.spire:8081",
+			agentSocketPath: "/run/spire/sockets/agent.sock",
+			serverSpiffeID:  "spire-server",
+			trustDomain:     "cluster.local",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			cm, err := New(context.Background(), tc.serverAddress, tc.agentSocketPath, tc.serverSpiffeID, tc.trustDomain, keySize)
+			assert.Nil(cm)
+			assert.Error(err)
+		})
+	}
+}
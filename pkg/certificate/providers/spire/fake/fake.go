@@ -0,0 +1,180 @@
+// Package fake implements a fake SPIRE server API backed by an in-memory CA, for testing
+package fake
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/url"
+	"time"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	svidv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/svid/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// defaultTTL is the TTL of the X.509-SVIDs minted without a TTL
+	defaultTTL = time.Hour
+
+	// serialNumberBits is the number of bits in the serial number of the certificates
+	serialNumberBits = 128
+)
+
+// Server is a fake SPIRE server serving the SVID and Bundle APIs over an insecure connection
+type Server struct {
+	svidv1.UnimplementedSVIDServer
+	bundlev1.UnimplementedBundleServer
+
+	trustDomain spiffeid.TrustDomain
+	ca          *x509.Certificate
+	caKey       *rsa.PrivateKey
+
+	grpcServer *grpc.Server
+	listener   net.Listener
+}
+
+// NewServer starts a fake SPIRE server for the given trust domain on a local port
+func NewServer(trustDomain string) (*Server, error) {
+	td, err := spiffeid.TrustDomainFromString(trustDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Fake SPIRE CA"},
+		URIs:                  []*url.URL{td.ID().URL()},
+		NotBefore:             now,
+		NotAfter:              now.Add(24 * time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, err
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		return nil, err
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		trustDomain: td,
+		ca:          ca,
+		caKey:       caKey,
+		grpcServer:  grpc.NewServer(),
+		listener:    listener,
+	}
+	svidv1.RegisterSVIDServer(s.grpcServer, s)
+	bundlev1.RegisterBundleServer(s.grpcServer, s)
+	go func() {
+		_ = s.grpcServer.Serve(listener)
+	}()
+
+	return s, nil
+}
+
+// Addr returns the address the fake SPIRE server listens on
+func (s *Server) Addr() string {
+	return s.listener.Addr().String()
+}
+
+// Stop stops the fake SPIRE server
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// CA returns the root certificate of the fake SPIRE server
+func (s *Server) CA() *x509.Certificate {
+	return s.ca
+}
+
+// MintX509SVID mints an X.509-SVID for the SPIFFE ID and the public key of the CSR, as the SPIRE server does
+func (s *Server) MintX509SVID(_ context.Context, req *svidv1.MintX509SVIDRequest) (*svidv1.MintX509SVIDResponse, error) {
+	csr, err := x509.ParseCertificateRequest(req.GetCsr())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "malformed CSR: %v", err)
+	}
+	if err := csr.CheckSignature(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid CSR signature: %v", err)
+	}
+	if len(csr.URIs) != 1 {
+		return nil, status.Error(codes.InvalidArgument, "CSR must have exactly one URI SAN")
+	}
+	id, err := spiffeid.FromURI(csr.URIs[0])
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "CSR URI SAN is invalid: %v", err)
+	}
+	if !id.MemberOf(s.trustDomain) {
+		return nil, status.Errorf(codes.InvalidArgument, "CSR URI SAN %s is not a member of the trust domain %s", id, s.trustDomain)
+	}
+
+	ttl := defaultTTL
+	if req.GetTtl() > 0 {
+		ttl = time.Duration(req.GetTtl()) * time.Second
+	}
+
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialNumberBits))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serialNumber,
+		Subject:               csr.Subject,
+		DNSNames:              csr.DNSNames,
+		URIs:                  csr.URIs,
+		NotBefore:             now,
+		NotAfter:              now.Add(ttl),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, s.ca, csr.PublicKey, s.caKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &svidv1.MintX509SVIDResponse{
+		Svid: &types.X509SVID{
+			CertChain: [][]byte{certDER},
+			Id: &types.SPIFFEID{
+				TrustDomain: id.TrustDomain().String(),
+				Path:        id.Path(),
+			},
+			ExpiresAt: template.NotAfter.Unix(),
+		},
+	}, nil
+}
+
+// GetBundle returns the trust bundle of the fake SPIRE server, made of its root certificate
+func (s *Server) GetBundle(_ context.Context, _ *bundlev1.GetBundleRequest) (*types.Bundle, error) {
+	return &types.Bundle{
+		TrustDomain: s.trustDomain.String(),
+		X509Authorities: []*types.X509Certificate{
+			{Asn1: s.ca.Raw},
+		},
+	}, nil
+}
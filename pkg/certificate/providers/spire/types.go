@@ -0,0 +1,42 @@
+// Package spire implements the certificate.Manager interface for SPIRE as the certificate provider.
+package spire
+
+import (
+	"errors"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	svidv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/svid/v1"
+
+	"github.com/openservicemesh/osm/pkg/logger"
+)
+
+const (
+	// osmPathSegment is the first path segment of the SPIFFE IDs minted for certificates that are not
+	// issued for a service identity, followed by the common name of the certificate.
+	osmPathSegment = "osm"
+)
+
+var (
+	log = logger.New("spire")
+
+	errNoSVID             = errors.New("no X.509-SVID returned by the SPIRE server")
+	errNoX509Authorities  = errors.New("no X.509 authorities in the SPIRE trust bundle")
+	errMultipleSpiffeIDs  = errors.New("a certificate can only carry one SPIFFE ID")
+	errForeignTrustDomain = errors.New("the SPIFFE ID is not a member of the trust domain of the SPIRE server")
+)
+
+// CertManager implements certificate.Manager
+type CertManager struct {
+	// svidClient mints the X.509-SVIDs on the SPIRE server.
+	svidClient svidv1.SVIDClient
+
+	// bundleClient fetches the trust bundle of the SPIRE server.
+	bundleClient bundlev1.BundleClient
+
+	// trustDomain is the trust domain of the SPIRE server, which the minted SPIFFE IDs are members of.
+	trustDomain spiffeid.TrustDomain
+
+	// Issuing certificate properties.
+	keySize int
+}
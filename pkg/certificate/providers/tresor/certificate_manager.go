@@ -83,6 +83,7 @@ func (cm *CertManager) IssueCertificate(cn certificate.CommonName, saNames []str
 	if len(template.DNSNames) > 1 {
 		template.DNSNames = uniqueSubjectAlternativeNames(template.DNSNames)
 	}
+	template.URIs = certificate.GetSpiffeIDs(saNames)
 
 	x509Root, err := certificate.DecodePEMCertificate(cm.ca.GetCertificateChain())
 	if err != nil {
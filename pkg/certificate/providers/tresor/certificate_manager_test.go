@@ -58,6 +58,18 @@ var _ = Describe("Test Certificate Manager", func() {
 			Expect(err).ToNot(HaveOccurred(), string(pemRootCert))
 			Expect(xRootCert.Subject.CommonName).To(Equal(cn.String()))
 		})
+
+		It("should issue a certificate with a SPIFFE ID URI SAN", func() {
+			spiffeID := "spiffe://cluster.local/ns/c/sa/b"
+			cert, issueCertificateError := m.IssueCertificate(serviceFQDN, []string{spiffeID}, validity)
+			Expect(issueCertificateError).ToNot(HaveOccurred())
+
+			xCert, err := certificate.DecodePEMCertificate(cert.GetCertificateChain())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(xCert.DNSNames).To(Equal([]string{serviceFQDN}))
+			Expect(xCert.URIs).To(HaveLen(1))
+			Expect(xCert.URIs[0].String()).To(Equal(spiffeID))
+		})
 	})
 
 	Context("Test nil certificate issue", func() {
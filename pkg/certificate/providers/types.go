@@ -2,6 +2,8 @@
 package providers
 
 import (
+	"context"
+
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 
@@ -30,11 +32,17 @@ const (
 
 	// CertManagerKind represents cert-manager.io; certificates are requested using cert-manager
 	CertManagerKind Kind = "cert-manager"
+
+	// SpireKind represents SPIRE; certificates are minted as X.509-SVIDs by an external SPIRE server
+	SpireKind Kind = "spire"
+
+	// ExternalKind represents an external issuer; signing of certs happens in a plugin process over gRPC
+	ExternalKind Kind = "external"
 )
 
 var (
 	// ValidCertificateProviders is the list of supported certificate providers
-	ValidCertificateProviders = []Kind{TresorKind, VaultKind, CertManagerKind}
+	ValidCertificateProviders = []Kind{TresorKind, VaultKind, CertManagerKind, SpireKind, ExternalKind}
 )
 
 // Options is an interface that contains required fields to convert the old style options to the new style MRC for
@@ -71,6 +79,19 @@ type CertManagerOptions struct {
 	IssuerGroup string
 }
 
+// SpireOptions is a type that specifies 'SPIRE' certificate provider options
+type SpireOptions struct {
+	ServerAddress   string
+	AgentSocketPath string
+	ServerSpiffeID  string
+}
+
+// ExternalOptions is a type that specifies 'external' issuer certificate provider options
+type ExternalOptions struct {
+	Address    string
+	Parameters map[string]string
+}
+
 // MRCCompatClient is a backwards compatible client to convert old certificate options into an MRC.
 // It's intent is to match the custom interface that will wrap the MRC k8s informer.
 // TODO(#4502): Remove this entirely once we are fully onboarded to MRC informers.
@@ -81,6 +102,8 @@ type MRCCompatClient struct {
 
 // MRCProviderGenerator knows how to convert a given MRC to its appropriate provider.
 type MRCProviderGenerator struct {
+	// ctx is the context of the certificate providers, which release their resources once it is done
+	ctx        context.Context
 	kubeClient kubernetes.Interface
 	kubeConfig *rest.Config // used to generate a CertificateManager client.
 
@@ -24,6 +24,7 @@ const (
 	issuingCAField    = "issuing_ca"
 	commonNameField   = "common_name"
 	ttlField          = "ttl"
+	uriSANsField      = "uri_sans"
 )
 
 // New constructs a new certificate client using Vault's cert-manager
@@ -56,7 +57,7 @@ func New(vaultAddr, token, role string) (*CertManager, error) {
 
 // IssueCertificate requests a new signed certificate from the configured Vault issuer.
 func (cm *CertManager) IssueCertificate(cn certificate.CommonName, saNames []string, validityPeriod time.Duration) (*certificate.Certificate, error) {
-	secret, err := cm.client.Logical().Write(getIssueURL(cm.role), getIssuanceData(cn, validityPeriod))
+	secret, err := cm.client.Logical().Write(getIssueURL(cm.role), getIssuanceData(cn, saNames, validityPeriod))
 	if err != nil {
 		// TODO(#3962): metric might not be scraped before process restart resulting from this error
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrIssuingCert)).
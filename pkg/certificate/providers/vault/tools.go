@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/openservicemesh/osm/pkg/certificate"
@@ -15,9 +16,19 @@ func getIssueURL(role string) string {
 	return fmt.Sprintf("pki/issue/%+v", role)
 }
 
-func getIssuanceData(cn certificate.CommonName, validityPeriod time.Duration) map[string]interface{} {
-	return map[string]interface{}{
+func getIssuanceData(cn certificate.CommonName, saNames []string, validityPeriod time.Duration) map[string]interface{} {
+	data := map[string]interface{}{
 		commonNameField: cn.String(),
 		ttlField:        getDurationInMinutes(validityPeriod),
 	}
+
+	// The Vault role must allow the SPIFFE IDs with its allowed_uri_sans parameter
+	var uriSANs []string
+	for _, uri := range certificate.GetSpiffeIDs(saNames) {
+		uriSANs = append(uriSANs, uri.String())
+	}
+	if len(uriSANs) > 0 {
+		data[uriSANsField] = strings.Join(uriSANs, ",")
+	}
+	return data
 }
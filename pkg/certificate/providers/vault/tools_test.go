@@ -34,12 +34,23 @@ var _ = Describe("Test tools", func() {
 	Context("Test cert issuance data for request", func() {
 		It("creates a map w/ correct fields", func() {
 			cn := certificate.CommonName("blah.foo.com")
-			actual := getIssuanceData(cn, 8123*time.Minute)
+			actual := getIssuanceData(cn, nil, 8123*time.Minute)
 			expected := map[string]interface{}{
 				"common_name": "blah.foo.com",
 				"ttl":         "135h",
 			}
 			Expect(actual).To(Equal(expected))
 		})
+
+		It("adds the SPIFFE IDs as URI SANs", func() {
+			cn := certificate.CommonName("sa.ns.cluster.local")
+			actual := getIssuanceData(cn, []string{"sa.ns.svc", "spiffe://cluster.local/ns/ns/sa/sa"}, 8123*time.Minute)
+			expected := map[string]interface{}{
+				"common_name": "sa.ns.cluster.local",
+				"ttl":         "135h",
+				"uri_sans":    "spiffe://cluster.local/ns/ns/sa/sa",
+			}
+			Expect(actual).To(Equal(expected))
+		})
 	})
 })
@@ -0,0 +1,171 @@
+// Package revocation implements the controller revoking the certificates listed by the CertificateRevocation
+// resources, and recording the revoked certificates denied by the sidecars in their status.
+package revocation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/openservicemesh/osm/pkg/announcements"
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	configClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s/events"
+	"github.com/openservicemesh/osm/pkg/logger"
+	"github.com/openservicemesh/osm/pkg/messaging"
+)
+
+var log = logger.New("certificate-revocation")
+
+// Revoker revokes and reissues the certificates issued by the control plane
+type Revoker interface {
+	// RevokeCertificates revokes the certificates with the given serial numbers, and those issued for the
+	// given service identities, and reissues them.
+	RevokeCertificates([]certificate.SerialNumber, []identity.ServiceIdentity) ([]*certificate.Certificate, error)
+
+	// RestoreRevokedCertificates records the certificates with the given serial numbers as revoked until
+	// their given expiration.
+	RestoreRevokedCertificates(map[certificate.SerialNumber]time.Time)
+}
+
+// WatchAndRevokeCertificates revokes the certificates listed by the CertificateRevocation resources when they are
+// created and each time their spec changes, and records the revoked certificates in their status.
+// The certificates recorded in their status when the watch starts are restored as revoked.
+func WatchAndRevokeCertificates(revoker Revoker, configClient configClientset.Interface, msgBroker *messaging.Broker, stop <-chan struct{}) {
+	kubePubSub := msgBroker.GetKubeEventPubSub()
+	revocationChan := kubePubSub.Sub(announcements.CertificateRevocationAdded.String(), announcements.CertificateRevocationUpdated.String())
+	defer msgBroker.Unsub(kubePubSub, revocationChan)
+
+	if err := restoreRevokedCertificates(revoker, configClient); err != nil {
+		log.Error().Err(err).Msg("Error restoring the revoked certificates of the CertificateRevocation resources")
+	}
+
+	for {
+		select {
+		case <-stop:
+			log.Info().Msg("Received stop signal, exiting certificate revocation routine")
+			return
+
+		case event := <-revocationChan:
+			msg, ok := event.(events.PubSubMessage)
+			if !ok {
+				log.Error().Msgf("Error casting to PubSubMessage, got type %T", event)
+				continue
+			}
+
+			cr, ok := msg.NewObj.(*configv1alpha2.CertificateRevocation)
+			if !ok {
+				log.Error().Msgf("Error casting to *CertificateRevocation, got type %T", msg.NewObj)
+				continue
+			}
+
+			// The certificates are revoked once per generation of the spec, status updates are ignored
+			if cr.Status.ObservedGeneration == cr.Generation {
+				continue
+			}
+
+			if err := revokeCertificates(revoker, configClient, cr); err != nil {
+				log.Error().Err(err).Msgf("Error revoking the certificates of CertificateRevocation %s/%s", cr.Namespace, cr.Name)
+			}
+		}
+	}
+}
+
+// restoreRevokedCertificates restores the certificates recorded as revoked in the status of the CertificateRevocation
+// resources, which the revoker does not remember across restarts of the control plane.
+func restoreRevokedCertificates(revoker Revoker, configClient configClientset.Interface) error {
+	crs, err := configClient.ConfigV1alpha2().CertificateRevocations(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		return err
+	}
+
+	revoked := map[certificate.SerialNumber]time.Time{}
+	for _, cr := range crs.Items {
+		for _, rc := range cr.Status.RevokedCertificates {
+			revoked[certificate.SerialNumber(rc.SerialNumber)] = rc.Expiration.Time
+		}
+	}
+	revoker.RestoreRevokedCertificates(revoked)
+	log.Info().Msgf("Restored %d revoked certificates from %d CertificateRevocation resources", len(revoked), len(crs.Items))
+	return nil
+}
+
+// revokeCertificates revokes the certificates listed by the given CertificateRevocation and records them
+// in its status, along with the previously revoked certificates that have not expired yet.
+func revokeCertificates(revoker Revoker, configClient configClientset.Interface, cr *configv1alpha2.CertificateRevocation) error {
+	var serialNumbers []certificate.SerialNumber
+	for _, sn := range cr.Spec.SerialNumbers {
+		serialNumbers = append(serialNumbers, certificate.SerialNumber(sn))
+	}
+	var serviceIdentities []identity.ServiceIdentity
+	for _, si := range cr.Spec.ServiceIdentities {
+		serviceIdentities = append(serviceIdentities, identity.ServiceIdentity(si))
+	}
+
+	// The certificates that failed to be reissued are still revoked, and recorded in the status
+	certs, revokeErr := revoker.RevokeCertificates(serialNumbers, serviceIdentities)
+	log.Info().Msgf("Revoked %d certificates for CertificateRevocation %s/%s, reason: %q", len(certs), cr.Namespace, cr.Name, cr.Spec.Reason)
+
+	var revoked []configv1alpha2.RevokedCertificate
+	for _, cert := range certs {
+		rc, err := newRevokedCertificate(cert)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error decoding revoked certificate SerialNumber=%s", cert.GetSerialNumber())
+			continue
+		}
+		revoked = append(revoked, rc)
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := configClient.ConfigV1alpha2().CertificateRevocations(cr.Namespace).Get(context.Background(), cr.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		latest.Status.RevokedCertificates = mergeRevokedCertificates(latest.Status.RevokedCertificates, revoked)
+		latest.Status.ObservedGeneration = cr.Generation
+		_, err = configClient.ConfigV1alpha2().CertificateRevocations(cr.Namespace).UpdateStatus(context.Background(), latest, metav1.UpdateOptions{})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return revokeErr
+}
+
+// newRevokedCertificate returns the status entry of the given revoked certificate
+func newRevokedCertificate(cert *certificate.Certificate) (configv1alpha2.RevokedCertificate, error) {
+	x509Cert, err := certificate.DecodePEMCertificate(cert.GetCertificateChain())
+	if err != nil {
+		return configv1alpha2.RevokedCertificate{}, err
+	}
+	fingerprint := sha256.Sum256(x509Cert.Raw)
+	return configv1alpha2.RevokedCertificate{
+		SerialNumber: cert.GetSerialNumber().String(),
+		CommonName:   cert.GetCommonName().String(),
+		Fingerprint:  hex.EncodeToString(fingerprint[:]),
+		NotBefore:    metav1.NewTime(x509Cert.NotBefore),
+		Expiration:   metav1.NewTime(x509Cert.NotAfter),
+	}, nil
+}
+
+// mergeRevokedCertificates returns the given existing and newly revoked certificates, without duplicates
+// and without the expired ones, which are no longer presented by the sidecars.
+func mergeRevokedCertificates(existing, revoked []configv1alpha2.RevokedCertificate) []configv1alpha2.RevokedCertificate {
+	now := time.Now()
+	seen := map[string]bool{}
+	var merged []configv1alpha2.RevokedCertificate
+	for _, rc := range append(append([]configv1alpha2.RevokedCertificate{}, existing...), revoked...) {
+		if seen[rc.Fingerprint] || now.After(rc.Expiration.Time) {
+			continue
+		}
+		seen[rc.Fingerprint] = true
+		merged = append(merged, rc)
+	}
+	return merged
+}
@@ -0,0 +1,151 @@
+package revocation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/certificate/providers/tresor"
+	configFake "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+	"github.com/openservicemesh/osm/pkg/identity"
+)
+
+type fakeRevoker struct {
+	certs         []*certificate.Certificate
+	err           error
+	serialNumbers []certificate.SerialNumber
+	identities    []identity.ServiceIdentity
+	restored      map[certificate.SerialNumber]time.Time
+}
+
+func (r *fakeRevoker) RevokeCertificates(serialNumbers []certificate.SerialNumber, identities []identity.ServiceIdentity) ([]*certificate.Certificate, error) {
+	r.serialNumbers = serialNumbers
+	r.identities = identities
+	return r.certs, r.err
+}
+
+func (r *fakeRevoker) RestoreRevokedCertificates(revoked map[certificate.SerialNumber]time.Time) {
+	r.restored = revoked
+}
+
+func TestRevokeCertificates(t *testing.T) {
+	cert, err := tresor.NewCA("sa.ns.cluster.local", time.Hour, "US", "Seattle", "Open Service Mesh")
+	trequire.NoError(t, err)
+	expired := configv1alpha2.RevokedCertificate{
+		SerialNumber: "1",
+		Fingerprint:  "expired",
+		Expiration:   metav1.NewTime(time.Now().Add(-time.Hour)),
+	}
+	valid := configv1alpha2.RevokedCertificate{
+		SerialNumber: "2",
+		Fingerprint:  "valid",
+		Expiration:   metav1.NewTime(time.Now().Add(time.Hour)),
+	}
+
+	testCases := []struct {
+		name            string
+		revoker         *fakeRevoker
+		existing        []configv1alpha2.RevokedCertificate
+		expectedSerials []string
+		expectErr       bool
+	}{
+		{
+			name:            "revoked certificate is recorded",
+			revoker:         &fakeRevoker{certs: []*certificate.Certificate{cert}},
+			expectedSerials: []string{cert.GetSerialNumber().String()},
+		},
+		{
+			name:            "expired certificates are pruned",
+			revoker:         &fakeRevoker{certs: []*certificate.Certificate{cert}},
+			existing:        []configv1alpha2.RevokedCertificate{expired, valid},
+			expectedSerials: []string{"2", cert.GetSerialNumber().String()},
+		},
+		{
+			name:            "certificate failing to be reissued is recorded",
+			revoker:         &fakeRevoker{certs: []*certificate.Certificate{cert}, err: errors.New("reissue failed")},
+			expectedSerials: []string{cert.GetSerialNumber().String()},
+			expectErr:       true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			require := trequire.New(t)
+
+			cr := &configv1alpha2.CertificateRevocation{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "compromised",
+					Namespace:  "osm-system",
+					Generation: 2,
+				},
+				Spec: configv1alpha2.CertificateRevocationSpec{
+					SerialNumbers:     []string{"1234"},
+					ServiceIdentities: []string{"sa.ns"},
+				},
+				Status: configv1alpha2.CertificateRevocationStatus{
+					ObservedGeneration:  1,
+					RevokedCertificates: tc.existing,
+				},
+			}
+			configClient := configFake.NewSimpleClientset(cr)
+
+			err := revokeCertificates(tc.revoker, configClient, cr)
+			assert.Equal(tc.expectErr, err != nil)
+			assert.Equal([]certificate.SerialNumber{"1234"}, tc.revoker.serialNumbers)
+			assert.Equal([]identity.ServiceIdentity{"sa.ns"}, tc.revoker.identities)
+
+			updated, err := configClient.ConfigV1alpha2().CertificateRevocations(cr.Namespace).Get(context.Background(), cr.Name, metav1.GetOptions{})
+			require.NoError(err)
+			assert.Equal(int64(2), updated.Status.ObservedGeneration)
+
+			var serials []string
+			for _, rc := range updated.Status.RevokedCertificates {
+				serials = append(serials, rc.SerialNumber)
+			}
+			assert.Equal(tc.expectedSerials, serials)
+
+			rc := updated.Status.RevokedCertificates[len(updated.Status.RevokedCertificates)-1]
+			assert.Equal("sa.ns.cluster.local", rc.CommonName)
+			assert.Len(rc.Fingerprint, 64)
+			assert.True(rc.Expiration.After(time.Now()))
+		})
+	}
+}
+
+func TestRestoreRevokedCertificates(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	expiration := time.Now().Add(time.Hour).Truncate(time.Second)
+	newCR := func(name string, serialNumbers ...string) *configv1alpha2.CertificateRevocation {
+		cr := &configv1alpha2.CertificateRevocation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "osm-system",
+			},
+		}
+		for _, sn := range serialNumbers {
+			cr.Status.RevokedCertificates = append(cr.Status.RevokedCertificates, configv1alpha2.RevokedCertificate{
+				SerialNumber: sn,
+				Expiration:   metav1.NewTime(expiration),
+			})
+		}
+		return cr
+	}
+	configClient := configFake.NewSimpleClientset(newCR("a", "1", "2"), newCR("b", "3"), newCR("c"))
+
+	revoker := &fakeRevoker{}
+	require.NoError(restoreRevokedCertificates(revoker, configClient))
+	assert.Len(revoker.restored, 3)
+	for _, sn := range []certificate.SerialNumber{"1", "2", "3"} {
+		assert.True(expiration.Equal(revoker.restored[sn]), sn)
+	}
+}
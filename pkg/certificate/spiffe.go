@@ -0,0 +1,109 @@
+package certificate
+
+import (
+	"crypto/x509"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"gopkg.in/square/go-jose.v2"
+
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+	"github.com/openservicemesh/osm/pkg/identity"
+)
+
+const (
+	// spiffeX509SVIDUse is the 'use' parameter of the JWKs of X.509 SVID authorities in a SPIFFE bundle
+	spiffeX509SVIDUse = "x509-svid"
+
+	// spiffeBundleRefreshHintSeconds is the interval at which consumers of the SPIFFE bundle should refresh it,
+	// short enough for root certificate rotations to be picked up before they complete.
+	spiffeBundleRefreshHintSeconds = 60
+)
+
+// SpiffeBundle is a SPIFFE trust bundle, as returned by the SPIFFE Workload API and the SPIFFE bundle endpoints.
+// See https://github.com/spiffe/spiffe/blob/main/standards/SPIFFE_Trust_Domain_and_Bundle.md
+type SpiffeBundle struct {
+	Keys        []jose.JSONWebKey `json:"keys"`
+	RefreshHint int               `json:"spiffe_refresh_hint,omitempty"`
+}
+
+// GetSpiffeIDs returns the SPIFFE IDs among the given subject alternative names, which providers
+// issue as URI SANs rather than DNS SANs.
+func GetSpiffeIDs(saNames []string) []*url.URL {
+	var uris []*url.URL
+	for _, san := range saNames {
+		if !strings.HasPrefix(san, identity.SpiffeScheme+"://") {
+			continue
+		}
+		uri, err := url.Parse(san)
+		if err != nil {
+			log.Error().Err(err).Msgf("Invalid SPIFFE ID %s", san)
+			continue
+		}
+		uris = append(uris, uri)
+	}
+	return uris
+}
+
+// GetSpiffeEnabled returns whether the service certificates carry the SPIFFE ID of their service identity,
+// as configured on the signing issuer.
+func (m *Manager) GetSpiffeEnabled() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.signingIssuer.SpiffeEnabled
+}
+
+// GetSpiffeBundle returns the SPIFFE trust bundle of the mesh, made of the root certificates of the
+// signing and validating issuers.
+func (m *Manager) GetSpiffeBundle() (*SpiffeBundle, error) {
+	m.mu.Lock()
+	roots := []pem.RootCertificate{m.signingIssuer.CertificateAuthority}
+	if m.validatingIssuer.ID != m.signingIssuer.ID {
+		roots = append(roots, m.validatingIssuer.CertificateAuthority)
+	}
+	m.mu.Unlock()
+
+	bundle := &SpiffeBundle{
+		Keys:        []jose.JSONWebKey{},
+		RefreshHint: spiffeBundleRefreshHintSeconds,
+	}
+	for _, root := range roots {
+		certs, err := decodePEMCertificates(root)
+		if err != nil {
+			return nil, err
+		}
+		for _, cert := range certs {
+			bundle.Keys = append(bundle.Keys, jose.JSONWebKey{
+				Key:          cert.PublicKey,
+				Certificates: []*x509.Certificate{cert},
+				Use:          spiffeX509SVIDUse,
+			})
+		}
+	}
+	return bundle, nil
+}
+
+// SpiffeBundleHandler returns an HTTP handler serving the SPIFFE trust bundle of the mesh
+// when SPIFFE is enabled.
+func (m *Manager) SpiffeBundleHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if !m.GetSpiffeEnabled() {
+			http.Error(w, "SPIFFE is not enabled", http.StatusNotFound)
+			return
+		}
+
+		bundle, err := m.GetSpiffeBundle()
+		if err != nil {
+			log.Error().Err(err).Msg("Error building the SPIFFE bundle")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(bundle); err != nil {
+			log.Error().Err(err).Msg("Error writing the SPIFFE bundle")
+		}
+	})
+}
@@ -0,0 +1,128 @@
+package certificate
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+
+	"github.com/openservicemesh/osm/pkg/certificate/pem"
+)
+
+func TestGetSpiffeIDs(t *testing.T) {
+	assert := tassert.New(t)
+
+	uris := GetSpiffeIDs([]string{"sa.ns.cluster.local", "spiffe://cluster.local/ns/ns/sa/sa", "svc.ns.svc:8080"})
+	assert.Len(uris, 1)
+	assert.Equal("spiffe://cluster.local/ns/ns/sa/sa", uris[0].String())
+
+	assert.Empty(GetSpiffeIDs([]string{"sa.ns.cluster.local"}))
+}
+
+func TestIssueCertificateWithSpiffeID(t *testing.T) {
+	testCases := []struct {
+		name          string
+		spiffeEnabled bool
+		certType      CertType
+		opts          []IssueOption
+		expectedSANs  []string
+	}{
+		{
+			name:          "service certificate with SPIFFE enabled",
+			spiffeEnabled: true,
+			certType:      Service,
+			expectedSANs:  []string{"spiffe://cluster.local/ns/ns/sa/sa"},
+		},
+		{
+			name:     "service certificate with SPIFFE disabled",
+			certType: Service,
+		},
+		{
+			name:          "internal certificate with SPIFFE enabled",
+			spiffeEnabled: true,
+			certType:      Internal,
+		},
+		{
+			name:          "service certificate with full CN provided",
+			spiffeEnabled: true,
+			certType:      Service,
+			opts:          []IssueOption{FullCNProvided()},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			signingIssuer := &issuer{Issuer: &fakeIssuer{id: "id"}, ID: "id", TrustDomain: "cluster.local", SpiffeEnabled: tc.spiffeEnabled}
+			m := &Manager{
+				signingIssuer:               signingIssuer,
+				validatingIssuer:            signingIssuer,
+				serviceCertValidityDuration: func() time.Duration { return time.Hour },
+			}
+
+			cert, err := m.IssueCertificate("sa.ns", tc.certType, tc.opts...)
+			assert.NoError(err)
+			assert.Equal(tc.expectedSANs, cert.SANames)
+		})
+	}
+}
+
+func TestSpiffeBundleHandler(t *testing.T) {
+	require := trequire.New(t)
+	assert := tassert.New(t)
+
+	newRoot := func(cn string) pem.RootCertificate {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(err)
+		template := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: cn},
+			NotBefore:             time.Now(),
+			NotAfter:              time.Now().Add(time.Hour),
+			IsCA:                  true,
+			BasicConstraintsValid: true,
+		}
+		der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+		require.NoError(err)
+		root, err := EncodeCertDERtoPEM(der)
+		require.NoError(err)
+		return pem.RootCertificate(root)
+	}
+
+	signingIssuer := &issuer{ID: "new", CertificateAuthority: newRoot("new"), SpiffeEnabled: true}
+	validatingIssuer := &issuer{ID: "old", CertificateAuthority: newRoot("old")}
+	m := &Manager{signingIssuer: signingIssuer, validatingIssuer: validatingIssuer}
+
+	w := httptest.NewRecorder()
+	m.SpiffeBundleHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/spiffe/bundle", nil))
+	assert.Equal(http.StatusOK, w.Code)
+
+	// The bundle contains the roots of both issuers during a root certificate rotation
+	var bundle map[string]interface{}
+	require.NoError(json.Unmarshal(w.Body.Bytes(), &bundle))
+	keys := bundle["keys"].([]interface{})
+	assert.Len(keys, 2)
+	for _, key := range keys {
+		jwk := key.(map[string]interface{})
+		assert.Equal("x509-svid", jwk["use"])
+		assert.Equal("RSA", jwk["kty"])
+		assert.Len(jwk["x5c"], 1)
+	}
+	assert.Equal(float64(spiffeBundleRefreshHintSeconds), bundle["spiffe_refresh_hint"])
+
+	// The bundle is not served when SPIFFE is disabled
+	signingIssuer.SpiffeEnabled = false
+	w = httptest.NewRecorder()
+	m.SpiffeBundleHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/spiffe/bundle", nil))
+	assert.Equal(http.StatusNotFound, w.Code)
+}
@@ -83,6 +83,8 @@ type Certificate struct {
 
 	signingIssuerID    string
 	validatingIssuerID string
+	// whether SPIFFE was enabled on the signing issuer when the certificate was issued
+	spiffeEnabled bool
 
 	certType CertType
 }
@@ -99,6 +101,10 @@ type issuer struct {
 	TrustDomain string
 	// memoized once the first certificate is issued
 	CertificateAuthority pem.RootCertificate
+	// SpiffeEnabled specifies whether the service certificates carry the SPIFFE ID of their service identity
+	SpiffeEnabled bool
+	// generation of the MRC the issuer was generated from
+	generation int64
 }
 
 // Manager represents all necessary information for the certificate managers.
@@ -107,6 +113,10 @@ type Manager struct {
 	// Types: map[certificate.CommonName]*certificate.Certificate
 	cache sync.Map
 
+	// Serial numbers of the revoked certificates, which are rotated on their next use
+	// Types: map[certificate.SerialNumber]time.Time, the value being the expiration of the certificate
+	revoked sync.Map
+
 	ingressCertValidityDuration func() time.Duration
 	// TODO(#4711): define serviceCertValidityDuration in the MRC
 	serviceCertValidityDuration func() time.Duration
@@ -117,6 +127,11 @@ type Manager struct {
 	// equal to signingIssuer if there is no additional public cert issuer.
 	validatingIssuer *issuer
 
+	// mrcs and issuers are only accessed from the MRC watch goroutine, and hold the observed MRCs
+	// and the issuers generated from them, keyed by MRC name.
+	mrcs    map[string]*v1alpha2.MeshRootCertificate
+	issuers map[string]*issuer
+
 	group singleflight.Group
 }
 
@@ -127,6 +142,9 @@ type MRCClient interface {
 
 	// GetCertIssuerForMRC returns an Issuer based on the provided MRC.
 	GetCertIssuerForMRC(mrc *v1alpha2.MeshRootCertificate) (Issuer, pem.RootCertificate, error)
+
+	// UpdateMRCStatus updates the status of the provided MRC and returns the updated MRC.
+	UpdateMRCStatus(mrc *v1alpha2.MeshRootCertificate) (*v1alpha2.MeshRootCertificate, error)
 }
 
 // MRCEventType is a type alias for a string describing the type of MRC event
@@ -75,6 +75,12 @@ type cidr struct {
 	_    [3]uint8 // pad
 }
 
+type cidr6 struct {
+	net  [4]uint32 // network order
+	mask uint8
+	_    [3]uint8 // pad
+}
+
 type podConfig struct {
 	statusPort       uint16
 	_                uint16 // pad
@@ -84,6 +90,12 @@ type podConfig struct {
 	includeOutPorts  [maxItemLen]uint16
 	excludeInPorts   [maxItemLen]uint16
 	excludeOutPorts  [maxItemLen]uint16
+	// ipv6 ranges, a mask of 0 terminates the list
+	excludeOutRanges6 [maxItemLen]cidr6
+	includeOutRanges6 [maxItemLen]cidr6
+	denyOutUDPPorts   [maxItemLen]uint16
+	captureDNS        uint8
+	_                 [3]uint8 // pad
 }
 
 func isInjectedSidecar(pod *v1.Pod) bool {
@@ -93,6 +105,20 @@ func isInjectedSidecar(pod *v1.Pod) bool {
 	return false
 }
 
+// getPodIPs returns all the IPs of the pod, one per IP family on dual-stack clusters
+func getPodIPs(pod *v1.Pod) []string {
+	var ips []string
+	for _, podIP := range pod.Status.PodIPs {
+		if len(podIP.IP) > 0 {
+			ips = append(ips, podIP.IP)
+		}
+	}
+	if len(ips) == 0 && len(pod.Status.PodIP) > 0 {
+		ips = append(ips, pod.Status.PodIP)
+	}
+	return ips
+}
+
 func addFunc(obj interface{}) {
 	pod, ok := obj.(*v1.Pod)
 	if !ok || len(pod.Status.PodIP) == 0 {
@@ -103,13 +129,19 @@ func addFunc(obj interface{}) {
 	}
 	log.Debug().Msgf("got pod updated %s/%s", pod.Namespace, pod.Name)
 
-	_ip, _ := util.IP2Pointer(pod.Status.PodIP)
-	log.Info().Msgf("update osm_pod_fib with ip: %s", pod.Status.PodIP)
 	p := podConfig{}
 	parsePodConfigFromAnnotations(pod.Annotations, &p)
-	err := helpers.GetPodFibMap().Update(_ip, &p, ebpf.UpdateAny)
-	if err != nil {
-		log.Error().Msgf("update osm_pod_fib %s error: %v", pod.Status.PodIP, err)
+	for _, podIP := range getPodIPs(pod) {
+		_ip, err := util.IP2Pointer(podIP)
+		if err != nil {
+			log.Error().Msgf("parse pod ip %s error: %v", podIP, err)
+			continue
+		}
+		log.Info().Msgf("update osm_pod_fib with ip: %s", podIP)
+		err = helpers.GetPodFibMap().Update(_ip, &p, ebpf.UpdateAny)
+		if err != nil {
+			log.Error().Msgf("update osm_pod_fib %s error: %v", podIP, err)
+		}
 	}
 }
 
@@ -126,8 +158,9 @@ func getPortsFromString(v string) []uint16 {
 	return ports
 }
 
-func getIPRangesFromString(v string) []cidr {
+func getIPRangesFromString(v string) ([]cidr, []cidr6) {
 	var ranges []cidr
+	var ranges6 []cidr6
 	for _, vv := range strings.Split(v, ",") {
 		if vv == "*" {
 			ranges = append(ranges, cidr{
@@ -142,20 +175,36 @@ func getIPRangesFromString(v string) []cidr {
 				log.Error().Msgf("parse cidr from %s error: %v", vv, err)
 				continue
 			}
-			c := cidr{}
 			ones, _ := n.Mask.Size()
-			c.mask = uint8(ones)
-			if len(n.IP) == 16 {
-				//#nosec G103
-				c.net = *(*uint32)(unsafe.Pointer(&n.IP[12]))
-			} else {
-				//#nosec G103
-				c.net = *(*uint32)(unsafe.Pointer(&n.IP[0]))
+			if n.IP.To4() == nil {
+				if ones == 0 {
+					// a mask of 0 terminates the list in the ebpf progs,
+					// so ::/0 is split into its two halves.
+					ranges6 = append(ranges6, getCIDR6(net.IPv6zero, 1))
+					ranges6 = append(ranges6, getCIDR6(net.ParseIP("8000::"), 1))
+					continue
+				}
+				ranges6 = append(ranges6, getCIDR6(n.IP, uint8(ones)))
+				continue
 			}
+			c := cidr{}
+			c.mask = uint8(ones)
+			//#nosec G103
+			c.net = *(*uint32)(unsafe.Pointer(&n.IP.To4()[0]))
 			ranges = append(ranges, c)
 		}
 	}
-	return ranges
+	return ranges, ranges6
+}
+
+func getCIDR6(ip net.IP, mask uint8) cidr6 {
+	c := cidr6{mask: mask}
+	ip = ip.To16()
+	for i := range c.net {
+		//#nosec G103
+		c.net[i] = *(*uint32)(unsafe.Pointer(&ip[i*4]))
+	}
+	return c
 }
 
 func parsePodConfigFromAnnotations(annotations map[string]string, pod *podConfig) {
@@ -215,7 +264,7 @@ func parsePodConfigFromAnnotations(annotations map[string]string, pod *podConfig
 	}
 
 	if v, ok := annotations["openservicemesh.io/outbound-ip-range-exclusion-list"]; ok {
-		excludeOutboundIPRanges := getIPRangesFromString(v)
+		excludeOutboundIPRanges, excludeOutboundIPRanges6 := getIPRangesFromString(v)
 		if len(excludeOutboundIPRanges) > 0 {
 			for i, p := range excludeOutboundIPRanges {
 				if i >= maxItemLen {
@@ -224,9 +273,34 @@ func parsePodConfigFromAnnotations(annotations map[string]string, pod *podConfig
 				pod.excludeOutRanges[i] = p
 			}
 		}
+		if len(excludeOutboundIPRanges6) > 0 {
+			for i, p := range excludeOutboundIPRanges6 {
+				if i >= maxItemLen {
+					break
+				}
+				pod.excludeOutRanges6[i] = p
+			}
+		}
+	}
+	if v, ok := annotations[constants.OutboundUDPPortDenyListAnnotation]; ok {
+		denyOutboundUDPPorts := getPortsFromString(v)
+		if len(denyOutboundUDPPorts) > 0 {
+			for i, p := range denyOutboundUDPPorts {
+				if i >= maxItemLen {
+					break
+				}
+				pod.denyOutUDPPorts[i] = p
+			}
+		}
 	}
+	if v, ok := annotations[constants.CaptureDNSAnnotation]; ok {
+		if captureDNS, err := strconv.ParseBool(v); err == nil && captureDNS {
+			pod.captureDNS = 1
+		}
+	}
+
 	if v, ok := annotations["openservicemesh.io/outbound-ip-range-inclusion-list"]; ok {
-		includeOutboundIPRanges := getIPRangesFromString(v)
+		includeOutboundIPRanges, includeOutboundIPRanges6 := getIPRangesFromString(v)
 		if len(includeOutboundIPRanges) > 0 {
 			for i, p := range includeOutboundIPRanges {
 				if i >= maxItemLen {
@@ -235,6 +309,14 @@ func parsePodConfigFromAnnotations(annotations map[string]string, pod *podConfig
 				pod.includeOutRanges[i] = p
 			}
 		}
+		if len(includeOutboundIPRanges6) > 0 {
+			for i, p := range includeOutboundIPRanges6 {
+				if i >= maxItemLen {
+					break
+				}
+				pod.includeOutRanges6[i] = p
+			}
+		}
 	}
 }
 
@@ -247,7 +329,7 @@ func updateFunc(old, cur interface{}) {
 	if !ok {
 		return
 	}
-	if oldPod.Status.PodIP != curPod.Status.PodIP {
+	if strings.Join(getPodIPs(oldPod), ",") != strings.Join(getPodIPs(curPod), ",") {
 		// only care about ip changes
 		addFunc(cur)
 	}
@@ -256,7 +338,10 @@ func updateFunc(old, cur interface{}) {
 func deleteFunc(obj interface{}) {
 	if pod, ok := obj.(*v1.Pod); ok {
 		log.Debug().Msgf("got pod delete %s/%s", pod.Namespace, pod.Name)
-		_ip, _ := util.IP2Pointer(pod.Status.PodIP)
-		_ = helpers.GetPodFibMap().Delete(_ip)
+		for _, podIP := range getPodIPs(pod) {
+			if _ip, err := util.IP2Pointer(podIP); err == nil {
+				_ = helpers.GetPodFibMap().Delete(_ip)
+			}
+		}
 	}
 }
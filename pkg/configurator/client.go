@@ -41,6 +41,20 @@ func NewConfigurator(informerCollection *informers.InformerCollection, osmNamesp
 	}
 	informerCollection.AddEventHandler(informers.InformerKeyMeshRootCertificate, k8s.GetEventHandlerFuncs(nil, meshRootCertificateEventTypes, msgBroker))
 
+	meshConfigOverrideEventTypes := k8s.EventTypes{
+		Add:    announcements.MeshConfigOverrideAdded,
+		Update: announcements.MeshConfigOverrideUpdated,
+		Delete: announcements.MeshConfigOverrideDeleted,
+	}
+	informerCollection.AddEventHandler(informers.InformerKeyMeshConfigOverride, k8s.GetEventHandlerFuncs(nil, meshConfigOverrideEventTypes, msgBroker))
+
+	certificateRevocationEventTypes := k8s.EventTypes{
+		Add:    announcements.CertificateRevocationAdded,
+		Update: announcements.CertificateRevocationUpdated,
+		Delete: announcements.CertificateRevocationDeleted,
+	}
+	informerCollection.AddEventHandler(informers.InformerKeyCertificateRevocation, k8s.GetEventHandlerFuncs(nil, certificateRevocationEventTypes, msgBroker))
+
 	return c
 }
 
@@ -61,10 +75,13 @@ func (c *Client) getMeshConfig() configv1alpha2.MeshConfig {
 
 	if !exists {
 		log.Warn().Msgf("MeshConfig %s does not exist. Default config values will be used.", meshConfigCacheKey)
-		return meshConfig
+	} else {
+		meshConfig = *item.(*configv1alpha2.MeshConfig)
 	}
 
-	meshConfig = *item.(*configv1alpha2.MeshConfig)
+	if c.pod != nil {
+		applyMeshConfigOverrides(&meshConfig, c.ListMeshConfigOverrides(c.pod.namespace, c.pod.labels))
+	}
 	return meshConfig
 }
 
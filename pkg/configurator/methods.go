@@ -132,6 +132,9 @@ func (c *Client) GetTracingEndpoint() string {
 	if tracingEndpoint != "" {
 		return tracingEndpoint
 	}
+	if c.GetTracingProtocol() == configv1alpha2.TelemetryProtocolOTLPHTTP {
+		return constants.DefaultOTLPTracingEndpoint
+	}
 	return constants.DefaultTracingEndpoint
 }
 
@@ -146,6 +149,15 @@ func (c *Client) GetTracingSampledFraction() float32 {
 	return 1
 }
 
+// GetTracingProtocol returns the protocol used to export the spans to the collector
+func (c *Client) GetTracingProtocol() configv1alpha2.TelemetryProtocol {
+	tracingProtocol := c.getMeshConfig().Spec.Observability.Tracing.Protocol
+	if tracingProtocol != "" {
+		return tracingProtocol
+	}
+	return configv1alpha2.TelemetryProtocolZipkin
+}
+
 // IsRemoteLoggingEnabled returns whether remote logging is enabled
 func (c *Client) IsRemoteLoggingEnabled() bool {
 	return c.getMeshConfig().Spec.Observability.RemoteLogging.Enable
@@ -203,6 +215,15 @@ func (c *Client) GetRemoteLoggingSampledFraction() float32 {
 	return 1
 }
 
+// GetRemoteLoggingProtocol returns the protocol used to export the access logs to the collector
+func (c *Client) GetRemoteLoggingProtocol() configv1alpha2.TelemetryProtocol {
+	remoteLoggingProtocol := c.getMeshConfig().Spec.Observability.RemoteLogging.Protocol
+	if remoteLoggingProtocol != "" {
+		return remoteLoggingProtocol
+	}
+	return configv1alpha2.TelemetryProtocolHTTP
+}
+
 // GetMaxDataPlaneConnections returns the max data plane connections allowed, 0 if disabled
 func (c *Client) GetMaxDataPlaneConnections() int {
 	return c.getMeshConfig().Spec.Sidecar.MaxDataPlaneConnections
@@ -228,7 +249,14 @@ func (c *Client) GetSidecarLogLevel() string {
 
 // GetSidecarClass returns the sidecar class
 func (c *Client) GetSidecarClass() string {
-	class := c.getMeshConfig().Spec.Sidecar.SidecarClass
+	meshConfig := c.getMeshConfig()
+	return GetSidecarClassForMeshConfig(&meshConfig)
+}
+
+// GetSidecarClassForMeshConfig returns the sidecar class of the given MeshConfig,
+// defaulting to the class of the OSM_DEFAULT_SIDECAR_CLASS environment variable and then to Pipy
+func GetSidecarClassForMeshConfig(meshConfig *configv1alpha2.MeshConfig) string {
+	class := meshConfig.Spec.Sidecar.SidecarClass
 	if class == "" {
 		class = os.Getenv("OSM_DEFAULT_SIDECAR_CLASS")
 	}
@@ -206,6 +206,42 @@ func TestCreateUpdateConfig(t *testing.T) {
 				assert.False(cfg.IsTracingEnabled())
 			},
 		},
+		{
+			name:                  "GetTelemetryProtocols",
+			initialMeshConfigData: &configv1alpha2.MeshConfigSpec{},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(configv1alpha2.TelemetryProtocolZipkin, cfg.GetTracingProtocol())
+				assert.Equal(configv1alpha2.TelemetryProtocolHTTP, cfg.GetRemoteLoggingProtocol())
+				assert.Equal(constants.DefaultTracingEndpoint, cfg.GetTracingEndpoint())
+			},
+			updatedMeshConfigData: &configv1alpha2.MeshConfigSpec{
+				Observability: configv1alpha2.ObservabilitySpec{
+					Tracing: configv1alpha2.TracingSpec{
+						Protocol: configv1alpha2.TelemetryProtocolOTLPGRPC,
+					},
+					RemoteLogging: configv1alpha2.RemoteLoggingSpec{
+						Protocol: configv1alpha2.TelemetryProtocolOTLPHTTP,
+					},
+				},
+			},
+			checkUpdate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(configv1alpha2.TelemetryProtocolOTLPGRPC, cfg.GetTracingProtocol())
+				assert.Equal(configv1alpha2.TelemetryProtocolOTLPHTTP, cfg.GetRemoteLoggingProtocol())
+			},
+		},
+		{
+			name: "GetTracingEndpointOTLPHTTP",
+			initialMeshConfigData: &configv1alpha2.MeshConfigSpec{
+				Observability: configv1alpha2.ObservabilitySpec{
+					Tracing: configv1alpha2.TracingSpec{
+						Protocol: configv1alpha2.TelemetryProtocolOTLPHTTP,
+					},
+				},
+			},
+			checkCreate: func(assert *tassert.Assertions, cfg Configurator) {
+				assert.Equal(constants.DefaultOTLPTracingEndpoint, cfg.GetTracingEndpoint())
+			},
+		},
 		{
 			name:                  "GetSidecarLogLevel",
 			initialMeshConfigData: &configv1alpha2.MeshConfigSpec{},
@@ -526,3 +562,16 @@ func TestCreateUpdateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestGetSidecarClassForMeshConfig(t *testing.T) {
+	assert := tassert.New(t)
+
+	meshConfig := &configv1alpha2.MeshConfig{}
+	assert.Equal(constants.SidecarClassPipy, GetSidecarClassForMeshConfig(meshConfig))
+
+	t.Setenv("OSM_DEFAULT_SIDECAR_CLASS", constants.SidecarClassEnvoy)
+	assert.Equal(constants.SidecarClassEnvoy, GetSidecarClassForMeshConfig(meshConfig))
+
+	meshConfig.Spec.Sidecar.SidecarClass = constants.SidecarClassPipy
+	assert.Equal(constants.SidecarClassPipy, GetSidecarClassForMeshConfig(meshConfig))
+}
@@ -38,6 +38,20 @@ func (m *MockConfigurator) EXPECT() *MockConfiguratorMockRecorder {
 	return m.recorder
 }
 
+// ForPod mocks base method.
+func (m *MockConfigurator) ForPod(arg0 string, arg1 map[string]string) Configurator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForPod", arg0, arg1)
+	ret0, _ := ret[0].(Configurator)
+	return ret0
+}
+
+// ForPod indicates an expected call of ForPod.
+func (mr *MockConfiguratorMockRecorder) ForPod(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForPod", reflect.TypeOf((*MockConfigurator)(nil).ForPod), arg0, arg1)
+}
+
 // GetCertKeyBitSize mocks base method.
 func (m *MockConfigurator) GetCertKeyBitSize() int {
 	m.ctrl.T.Helper()
@@ -333,6 +347,20 @@ func (mr *MockConfiguratorMockRecorder) GetRemoteLoggingPort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteLoggingPort", reflect.TypeOf((*MockConfigurator)(nil).GetRemoteLoggingPort))
 }
 
+// GetRemoteLoggingProtocol mocks base method.
+func (m *MockConfigurator) GetRemoteLoggingProtocol() v1alpha2.TelemetryProtocol {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteLoggingProtocol")
+	ret0, _ := ret[0].(v1alpha2.TelemetryProtocol)
+	return ret0
+}
+
+// GetRemoteLoggingProtocol indicates an expected call of GetRemoteLoggingProtocol.
+func (mr *MockConfiguratorMockRecorder) GetRemoteLoggingProtocol() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteLoggingProtocol", reflect.TypeOf((*MockConfigurator)(nil).GetRemoteLoggingProtocol))
+}
+
 // GetRemoteLoggingSampledFraction mocks base method.
 func (m *MockConfigurator) GetRemoteLoggingSampledFraction() float32 {
 	m.ctrl.T.Helper()
@@ -375,6 +403,20 @@ func (mr *MockConfiguratorMockRecorder) GetRepoServerIPAddr() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRepoServerIPAddr", reflect.TypeOf((*MockConfigurator)(nil).GetRepoServerIPAddr))
 }
 
+// GetRevokedCertificates mocks base method.
+func (m *MockConfigurator) GetRevokedCertificates() []v1alpha2.RevokedCertificate {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRevokedCertificates")
+	ret0, _ := ret[0].([]v1alpha2.RevokedCertificate)
+	return ret0
+}
+
+// GetRevokedCertificates indicates an expected call of GetRevokedCertificates.
+func (mr *MockConfiguratorMockRecorder) GetRevokedCertificates() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRevokedCertificates", reflect.TypeOf((*MockConfigurator)(nil).GetRevokedCertificates))
+}
+
 // GetServiceCertValidityPeriod mocks base method.
 func (m *MockConfigurator) GetServiceCertValidityPeriod() time.Duration {
 	m.ctrl.T.Helper()
@@ -515,6 +557,20 @@ func (mr *MockConfiguratorMockRecorder) GetTracingPort() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingPort", reflect.TypeOf((*MockConfigurator)(nil).GetTracingPort))
 }
 
+// GetTracingProtocol mocks base method.
+func (m *MockConfigurator) GetTracingProtocol() v1alpha2.TelemetryProtocol {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTracingProtocol")
+	ret0, _ := ret[0].(v1alpha2.TelemetryProtocol)
+	return ret0
+}
+
+// GetTracingProtocol indicates an expected call of GetTracingProtocol.
+func (mr *MockConfiguratorMockRecorder) GetTracingProtocol() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTracingProtocol", reflect.TypeOf((*MockConfigurator)(nil).GetTracingProtocol))
+}
+
 // GetTracingSampledFraction mocks base method.
 func (m *MockConfigurator) GetTracingSampledFraction() float32 {
 	m.ctrl.T.Helper()
@@ -640,3 +696,17 @@ func (mr *MockConfiguratorMockRecorder) IsTracingEnabled() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsTracingEnabled", reflect.TypeOf((*MockConfigurator)(nil).IsTracingEnabled))
 }
+
+// ListMeshConfigOverrides mocks base method.
+func (m *MockConfigurator) ListMeshConfigOverrides(arg0 string, arg1 map[string]string) []*v1alpha2.MeshConfigOverride {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListMeshConfigOverrides", arg0, arg1)
+	ret0, _ := ret[0].([]*v1alpha2.MeshConfigOverride)
+	return ret0
+}
+
+// ListMeshConfigOverrides indicates an expected call of ListMeshConfigOverrides.
+func (mr *MockConfiguratorMockRecorder) ListMeshConfigOverrides(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListMeshConfigOverrides", reflect.TypeOf((*MockConfigurator)(nil).ListMeshConfigOverrides), arg0, arg1)
+}
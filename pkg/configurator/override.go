@@ -0,0 +1,99 @@
+package configurator
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+)
+
+// podScope identifies the pod a Client resolves the MeshConfig for
+type podScope struct {
+	namespace string
+	labels    map[string]string
+}
+
+// ForPod returns a Configurator whose settings are resolved for the pod with the given namespace and labels,
+// by applying the MeshConfigOverride resources selecting the pod on top of the MeshConfig.
+func (c *Client) ForPod(namespace string, podLabels map[string]string) Configurator {
+	return &Client{
+		osmNamespace:   c.osmNamespace,
+		informers:      c.informers,
+		meshConfigName: c.meshConfigName,
+		pod: &podScope{
+			namespace: namespace,
+			labels:    podLabels,
+		},
+	}
+}
+
+// ListMeshConfigOverrides returns the MeshConfigOverride resources selecting the pod with the given namespace and labels,
+// in increasing order of precedence.
+func (c *Client) ListMeshConfigOverrides(namespace string, podLabels map[string]string) []*configv1alpha2.MeshConfigOverride {
+	var namespaceOverrides, podOverrides []*configv1alpha2.MeshConfigOverride
+
+	for _, obj := range c.informers.List(informers.InformerKeyMeshConfigOverride) {
+		override := obj.(*configv1alpha2.MeshConfigOverride)
+		if override.Namespace != namespace {
+			continue
+		}
+
+		if override.Spec.Selector == nil {
+			namespaceOverrides = append(namespaceOverrides, override)
+			continue
+		}
+
+		selector, err := metav1.LabelSelectorAsSelector(override.Spec.Selector)
+		if err != nil {
+			log.Error().Err(err).Msgf("Invalid selector for MeshConfigOverride %s/%s, ignoring it", override.Namespace, override.Name)
+			continue
+		}
+		if selector.Matches(labels.Set(podLabels)) {
+			podOverrides = append(podOverrides, override)
+		}
+	}
+
+	byName := func(overrides []*configv1alpha2.MeshConfigOverride) func(i, j int) bool {
+		return func(i, j int) bool {
+			return overrides[i].Name < overrides[j].Name
+		}
+	}
+	sort.Slice(namespaceOverrides, byName(namespaceOverrides))
+	sort.Slice(podOverrides, byName(podOverrides))
+
+	return append(namespaceOverrides, podOverrides...)
+}
+
+// applyMeshConfigOverrides applies the overrides to the given MeshConfig in order, a later override taking precedence
+func applyMeshConfigOverrides(meshConfig *configv1alpha2.MeshConfig, overrides []*configv1alpha2.MeshConfigOverride) {
+	for _, override := range overrides {
+		sidecar := override.Spec.Sidecar
+		if sidecar.LogLevel != "" {
+			meshConfig.Spec.Sidecar.LogLevel = sidecar.LogLevel
+		}
+		if sidecar.SidecarTimeout != nil {
+			meshConfig.Spec.Sidecar.SidecarTimeout = *sidecar.SidecarTimeout
+		}
+		if sidecar.LocalProxyMode != "" {
+			meshConfig.Spec.Sidecar.LocalProxyMode = sidecar.LocalProxyMode
+		}
+
+		traffic := override.Spec.Traffic
+		if traffic.HTTP1PerRequestLoadBalancing != nil {
+			meshConfig.Spec.Traffic.HTTP1PerRequestLoadBalancing = *traffic.HTTP1PerRequestLoadBalancing
+		}
+		if traffic.HTTP2PerRequestLoadBalancing != nil {
+			meshConfig.Spec.Traffic.HTTP2PerRequestLoadBalancing = *traffic.HTTP2PerRequestLoadBalancing
+		}
+
+		if sampledFraction := override.Spec.Observability.Tracing.SampledFraction; sampledFraction != nil {
+			meshConfig.Spec.Observability.Tracing.SampledFraction = sampledFraction
+		}
+		if accessLogging := override.Spec.Observability.AccessLogging; accessLogging != nil {
+			meshConfig.Spec.Observability.AccessLogging = *accessLogging
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package configurator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	fakeConfig "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+)
+
+func TestForPod(t *testing.T) {
+	a := assert.New(t)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	ic, err := informers.NewInformerCollection("osm", stop, informers.WithConfigClient(fakeConfig.NewSimpleClientset(), osmMeshConfigName, osmNamespace))
+	a.Nil(err)
+
+	c := NewConfigurator(ic, osmNamespace, osmMeshConfigName, nil)
+
+	sampledFraction := "0.5"
+	err = c.informers.Add(informers.InformerKeyMeshConfig, &configv1alpha2.MeshConfig{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: osmNamespace,
+			Name:      osmMeshConfigName,
+		},
+		Spec: configv1alpha2.MeshConfigSpec{
+			Sidecar: configv1alpha2.SidecarSpec{
+				LogLevel:       "error",
+				SidecarTimeout: 30,
+			},
+			Observability: configv1alpha2.ObservabilitySpec{
+				Tracing: configv1alpha2.TracingSpec{
+					SampledFraction: &sampledFraction,
+				},
+			},
+		},
+	}, t)
+	a.Nil(err)
+
+	timeout := 90
+	enabled := true
+	overrides := []*configv1alpha2.MeshConfigOverride{
+		{
+			// Applies to all the pods in namespace ns
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "b-namespace"},
+			Spec: configv1alpha2.MeshConfigOverrideSpec{
+				Sidecar: configv1alpha2.SidecarOverrideSpec{LogLevel: "debug", SidecarTimeout: &timeout},
+			},
+		},
+		{
+			// Applies to all the pods in namespace ns, takes precedence over b-namespace
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "c-namespace"},
+			Spec: configv1alpha2.MeshConfigOverrideSpec{
+				Sidecar: configv1alpha2.SidecarOverrideSpec{LogLevel: "info"},
+				Observability: configv1alpha2.ObservabilityOverrideSpec{
+					AccessLogging: &configv1alpha2.AccessLoggingSpec{Format: configv1alpha2.AccessLogFormatText},
+				},
+			},
+		},
+		{
+			// Applies to the pods labeled app=foo in namespace ns, takes precedence over the namespace wide overrides
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "a-foo"},
+			Spec: configv1alpha2.MeshConfigOverrideSpec{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+				Sidecar:  configv1alpha2.SidecarOverrideSpec{LogLevel: "trace"},
+				Traffic:  configv1alpha2.TrafficOverrideSpec{HTTP1PerRequestLoadBalancing: &enabled},
+			},
+		},
+		{
+			// Applies to another namespace
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other"},
+			Spec: configv1alpha2.MeshConfigOverrideSpec{
+				Sidecar: configv1alpha2.SidecarOverrideSpec{LogLevel: "critical"},
+			},
+		},
+	}
+	for _, override := range overrides {
+		a.Nil(c.informers.Add(informers.InformerKeyMeshConfigOverride, override, t))
+	}
+
+	testCases := []struct {
+		name                       string
+		namespace                  string
+		labels                     map[string]string
+		expectedOverrides          []string
+		expectedLogLevel           string
+		expectedTimeout            int
+		expectedHTTP1PerRequestLB  bool
+		expectedTracingSampledFrac float32
+		expectedAccessLogFormat    configv1alpha2.AccessLogFormat
+	}{
+		{
+			name:                       "pod without overrides",
+			namespace:                  "default",
+			expectedLogLevel:           "error",
+			expectedTimeout:            30,
+			expectedTracingSampledFrac: 0.5,
+		},
+		{
+			name:                       "pod with namespace wide overrides",
+			namespace:                  "ns",
+			labels:                     map[string]string{"app": "bar"},
+			expectedOverrides:          []string{"b-namespace", "c-namespace"},
+			expectedLogLevel:           "info",
+			expectedTimeout:            90,
+			expectedTracingSampledFrac: 0.5,
+			expectedAccessLogFormat:    configv1alpha2.AccessLogFormatText,
+		},
+		{
+			name:                       "pod with namespace wide and selector overrides",
+			namespace:                  "ns",
+			labels:                     map[string]string{"app": "foo"},
+			expectedOverrides:          []string{"b-namespace", "c-namespace", "a-foo"},
+			expectedLogLevel:           "trace",
+			expectedTimeout:            90,
+			expectedHTTP1PerRequestLB:  true,
+			expectedTracingSampledFrac: 0.5,
+			expectedAccessLogFormat:    configv1alpha2.AccessLogFormatText,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			var overrideNames []string
+			for _, override := range c.ListMeshConfigOverrides(tc.namespace, tc.labels) {
+				overrideNames = append(overrideNames, override.Name)
+			}
+			a.Equal(tc.expectedOverrides, overrideNames)
+
+			podCfg := c.ForPod(tc.namespace, tc.labels)
+			a.Equal(tc.expectedLogLevel, podCfg.GetSidecarLogLevel())
+			a.Equal(tc.expectedTimeout, podCfg.GetSidecarTimeout())
+			a.Equal(tc.expectedHTTP1PerRequestLB, podCfg.GetMeshConfig().Spec.Traffic.HTTP1PerRequestLoadBalancing)
+			a.Equal(tc.expectedTracingSampledFrac, podCfg.GetTracingSampledFraction())
+			a.Equal(tc.expectedAccessLogFormat, podCfg.GetMeshConfig().Spec.Observability.AccessLogging.Format)
+		})
+	}
+
+	// The mesh wide config is not affected by the overrides
+	a.Equal("error", c.GetSidecarLogLevel())
+}
@@ -0,0 +1,33 @@
+package configurator
+
+import (
+	"sort"
+	"time"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+)
+
+// GetRevokedCertificates returns the unexpired certificates revoked by the CertificateRevocation resources,
+// sorted by fingerprint so the deny lists programmed on the sidecars are stable.
+func (c *Client) GetRevokedCertificates() []configv1alpha2.RevokedCertificate {
+	now := time.Now()
+	seen := map[string]bool{}
+	var revoked []configv1alpha2.RevokedCertificate
+
+	for _, obj := range c.informers.List(informers.InformerKeyCertificateRevocation) {
+		cr := obj.(*configv1alpha2.CertificateRevocation)
+		for _, rc := range cr.Status.RevokedCertificates {
+			if seen[rc.Fingerprint] || now.After(rc.Expiration.Time) {
+				continue
+			}
+			seen[rc.Fingerprint] = true
+			revoked = append(revoked, rc)
+		}
+	}
+
+	sort.Slice(revoked, func(i, j int) bool {
+		return revoked[i].Fingerprint < revoked[j].Fingerprint
+	})
+	return revoked
+}
@@ -0,0 +1,56 @@
+package configurator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	fakeConfig "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned/fake"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+)
+
+func TestGetRevokedCertificates(t *testing.T) {
+	a := assert.New(t)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	ic, err := informers.NewInformerCollection("osm", stop, informers.WithConfigClient(fakeConfig.NewSimpleClientset(), osmMeshConfigName, osmNamespace))
+	a.Nil(err)
+
+	c := NewConfigurator(ic, osmNamespace, osmMeshConfigName, nil)
+	a.Empty(c.GetRevokedCertificates())
+
+	valid := metav1.NewTime(time.Now().Add(time.Hour))
+	expired := metav1.NewTime(time.Now().Add(-time.Hour))
+	revocations := []*configv1alpha2.CertificateRevocation{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: osmNamespace, Name: "a"},
+			Status: configv1alpha2.CertificateRevocationStatus{
+				RevokedCertificates: []configv1alpha2.RevokedCertificate{
+					{SerialNumber: "2", Fingerprint: "bb", Expiration: valid},
+					{SerialNumber: "3", Fingerprint: "cc", Expiration: expired},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: osmNamespace, Name: "b"},
+			Status: configv1alpha2.CertificateRevocationStatus{
+				RevokedCertificates: []configv1alpha2.RevokedCertificate{
+					{SerialNumber: "1", Fingerprint: "aa", Expiration: valid},
+					{SerialNumber: "2", Fingerprint: "bb", Expiration: valid},
+				},
+			},
+		},
+	}
+	for _, cr := range revocations {
+		a.Nil(c.informers.Add(informers.InformerKeyCertificateRevocation, cr, t))
+	}
+
+	a.Equal([]configv1alpha2.RevokedCertificate{
+		{SerialNumber: "1", Fingerprint: "aa", Expiration: valid},
+		{SerialNumber: "2", Fingerprint: "bb", Expiration: valid},
+	}, c.GetRevokedCertificates())
+}
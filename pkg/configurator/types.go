@@ -23,6 +23,9 @@ type Client struct {
 	osmNamespace   string
 	informers      *informers.InformerCollection
 	meshConfigName string
+
+	// pod is the pod the MeshConfig is resolved for, nil for the mesh wide MeshConfig
+	pod *podScope
 }
 
 // Configurator is the controller interface for K8s namespaces
@@ -72,6 +75,9 @@ type Configurator interface {
 	// GetTracingSampledFraction returns the sampled fraction
 	GetTracingSampledFraction() float32
 
+	// GetTracingProtocol returns the protocol used to export the spans to the collector
+	GetTracingProtocol() configv1alpha2.TelemetryProtocol
+
 	// IsRemoteLoggingEnabled returns whether remote logging is enabled
 	IsRemoteLoggingEnabled() bool
 
@@ -93,6 +99,9 @@ type Configurator interface {
 	// GetRemoteLoggingSampledFraction returns the sampled fraction
 	GetRemoteLoggingSampledFraction() float32
 
+	// GetRemoteLoggingProtocol returns the protocol used to export the access logs to the collector
+	GetRemoteLoggingProtocol() configv1alpha2.TelemetryProtocol
+
 	// GetMaxDataPlaneConnections returns the max data plane connections allowed, 0 if disabled
 	GetMaxDataPlaneConnections() int
 
@@ -157,4 +166,16 @@ type Configurator interface {
 
 	// GetGlobalPluginChains returns plugin chains
 	GetGlobalPluginChains() map[string][]trafficpolicy.Plugin
+
+	// ForPod returns a Configurator whose settings are resolved for the pod with the given namespace and labels,
+	// by applying the MeshConfigOverride resources selecting the pod on top of the MeshConfig
+	ForPod(namespace string, podLabels map[string]string) Configurator
+
+	// ListMeshConfigOverrides returns the MeshConfigOverride resources selecting the pod with the given namespace and labels,
+	// in increasing order of precedence
+	ListMeshConfigOverrides(namespace string, podLabels map[string]string) []*configv1alpha2.MeshConfigOverride
+
+	// GetRevokedCertificates returns the unexpired certificates revoked by the CertificateRevocation resources,
+	// which are denied by the sidecars
+	GetRevokedCertificates() []configv1alpha2.RevokedCertificate
 }
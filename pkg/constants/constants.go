@@ -7,6 +7,9 @@ const (
 	// WildcardIPAddr is a string constant.
 	WildcardIPAddr = "0.0.0.0"
 
+	// WildcardIPv6Addr is the IPv6 wildcard address.
+	WildcardIPv6Addr = "::"
+
 	// SidecarAdminPort is Sidecar's admin port
 	SidecarAdminPort = 15000
 
@@ -28,6 +31,24 @@ const (
 	// SidecarOutboundListenerPortName is Sidecar's outbound listener port name.
 	SidecarOutboundListenerPortName = "proxy-outbound"
 
+	// SidecarUDPOutboundListenerPort is Sidecar's outbound listener port number for the intercepted UDP traffic.
+	SidecarUDPOutboundListenerPort = 15001
+
+	// SidecarUDPTProxyMark is the firewall mark of the outbound UDP traffic transparently proxied to the Sidecar.
+	SidecarUDPTProxyMark = 0x539
+
+	// SidecarUDPTProxyRouteTable is the routing table delivering the marked outbound UDP traffic to the Sidecar.
+	SidecarUDPTProxyRouteTable = 133
+
+	// LocalDNSProxyIPAddress is the address of the Sidecar's local DNS proxy.
+	LocalDNSProxyIPAddress = "127.0.0.153"
+
+	// LocalDNSProxyPort is the port of the Sidecar's local DNS proxy.
+	LocalDNSProxyPort = 5300
+
+	// DNSPort is the port DNS queries are sent to.
+	DNSPort = 53
+
 	// SidecarUID is the Sidecar's User ID
 	SidecarUID int64 = 1500
 
@@ -43,9 +64,15 @@ const (
 	// SidecarTracingCluster is the default name to refer to the tracing cluster.
 	SidecarTracingCluster = "sidecar-tracing-cluster"
 
+	// SidecarRemoteLoggingCluster is the default name to refer to the remote logging cluster.
+	SidecarRemoteLoggingCluster = "sidecar-remote-logging-cluster"
+
 	// DefaultTracingEndpoint is the default endpoint route.
 	DefaultTracingEndpoint = "/api/v2/spans"
 
+	// DefaultOTLPTracingEndpoint is the default endpoint route of the spans exported over OTLP/HTTP.
+	DefaultOTLPTracingEndpoint = "/v1/traces"
+
 	// DefaultTracingHost is the default tracing server name.
 	DefaultTracingHost = "jaeger"
 
@@ -100,6 +127,12 @@ const (
 	// DefaultCABundleSecretName is the default name of the secret for the OSM CA bundle
 	DefaultCABundleSecretName = "osm-ca-bundle" // #nosec G101: Potential hardcoded credentials
 
+	// SessionAffinityCookieName is the default name of the cookie generated by sidecars for session affinity
+	SessionAffinityCookieName = "osm-session-affinity"
+
+	// SessionAffinityCookieTTL is the default lifetime of the cookie generated by sidecars for session affinity
+	SessionAffinityCookieTTL = 1 * time.Hour
+
 	// RegexMatchAll is a regex pattern match for all
 	RegexMatchAll = ".*"
 
@@ -186,6 +219,12 @@ const (
 
 	// MetricsAnnotation is the annotation used for enabling/disabling metrics
 	MetricsAnnotation = "openservicemesh.io/metrics"
+
+	// CaptureDNSAnnotation is the annotation used by the CNI plugin to redirect all the DNS traffic of the pod to the sidecar
+	CaptureDNSAnnotation = "openservicemesh.io/capture-dns"
+
+	// OutboundUDPPortDenyListAnnotation is the annotation used by the CNI plugin to drop the outbound UDP traffic to the given ports
+	OutboundUDPPortDenyListAnnotation = "openservicemesh.io/outbound-udp-port-deny-list"
 )
 
 // Annotations and labels used by the MeshRootCertificate
@@ -286,6 +325,9 @@ const (
 	// Ex. MySQL, SMTP, PostgreSQL etc. where the server initiates the first
 	// byte in a TCP connection.
 	ProtocolTCPServerFirst = "tcp-server-first"
+
+	// UDP protocol
+	ProtocolUDP = "udp"
 )
 
 // Operating systems.
@@ -314,6 +356,9 @@ const (
 	// OSMControllerSMIVersionPath is the path at which OSM controller servers SMI version info
 	OSMControllerSMIVersionPath = "/smi/version"
 
+	// OSMControllerSpiffeBundlePath is the path at which OSM controller serves the SPIFFE trust bundle of the mesh
+	OSMControllerSpiffeBundlePath = "/spiffe/bundle"
+
 	// MetricsPath is the path at which OSM controller serves metrics
 	MetricsPath = "/metrics"
 
@@ -335,7 +380,7 @@ const (
 
 var (
 	// SupportedProtocolsInMesh is a list of the protocols OSM supports for in-mesh traffic
-	SupportedProtocolsInMesh = []string{ProtocolTCPServerFirst, ProtocolHTTP, ProtocolTCP, ProtocolGRPC}
+	SupportedProtocolsInMesh = []string{ProtocolTCPServerFirst, ProtocolHTTP, ProtocolTCP, ProtocolGRPC, ProtocolUDP}
 )
 
 const (
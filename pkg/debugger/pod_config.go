@@ -0,0 +1,53 @@
+package debugger
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+)
+
+type podConfig struct {
+	Pod        string                        `json:"pod"`
+	Overrides  []string                      `json:"overrides"`
+	MeshConfig configv1alpha2.MeshConfigSpec `json:"meshConfig"`
+}
+
+// getPodConfigHandler returns the effective MeshConfig of the pod given by the 'namespace' and 'name' query parameters,
+// resolved by applying the MeshConfigOverride resources selecting the pod on top of the MeshConfig
+func (ds DebugConfig) getPodConfigHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		namespace := r.URL.Query().Get("namespace")
+		name := r.URL.Query().Get("name")
+		if namespace == "" || name == "" {
+			http.Error(w, "'namespace' and 'name' query parameters must be specified", http.StatusBadRequest)
+			return
+		}
+
+		pod, err := ds.kubeClient.CoreV1().Pods(namespace).Get(r.Context(), name, metav1.GetOptions{})
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Error getting pod %s/%s: %s", namespace, name, err), http.StatusNotFound)
+			return
+		}
+
+		c := podConfig{
+			Pod:        fmt.Sprintf("%s/%s", namespace, name),
+			Overrides:  []string{},
+			MeshConfig: ds.configurator.ForPod(namespace, pod.Labels).GetMeshConfig().Spec,
+		}
+		for _, override := range ds.configurator.ListMeshConfigOverrides(namespace, pod.Labels) {
+			c.Overrides = append(c.Overrides, override.Name)
+		}
+
+		jsonConfig, err := json.MarshalIndent(c, "", "    ")
+		if err != nil {
+			log.Error().Err(err).Msgf("Error marshalling config of pod %s/%s", namespace, name)
+			return
+		}
+
+		_, _ = fmt.Fprint(w, string(jsonConfig))
+	})
+}
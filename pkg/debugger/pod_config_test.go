@@ -0,0 +1,93 @@
+package debugger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/configurator"
+)
+
+// Tests getPodConfigHandler through HTTP handler returns the effective MeshConfig of a pod
+func TestGetPodConfigHandler(t *testing.T) {
+	podLabels := map[string]string{"app": "bookstore"}
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "ns",
+			Name:      "pod",
+			Labels:    podLabels,
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		query                string
+		expectedStatusCode   int
+		expectedResponseBody []string
+	}{
+		{
+			name:               "missing query parameters",
+			query:              "?namespace=ns",
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:               "pod not found",
+			query:              "?namespace=ns&name=unknown",
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name:               "pod config",
+			query:              "?namespace=ns&name=pod",
+			expectedStatusCode: http.StatusOK,
+			expectedResponseBody: []string{
+				`"pod": "ns/pod"`,
+				`"overrides": [
+        "debug-logs"
+    ]`,
+				`"logLevel": "debug"`,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
+			mockPodConfigurator := configurator.NewMockConfigurator(mockCtrl)
+
+			ds := DebugConfig{
+				kubeClient:   fake.NewSimpleClientset(pod),
+				configurator: mockConfigurator,
+			}
+
+			if tc.expectedStatusCode == http.StatusOK {
+				mockConfigurator.EXPECT().ForPod("ns", podLabels).Return(mockPodConfigurator)
+				mockConfigurator.EXPECT().ListMeshConfigOverrides("ns", podLabels).Return([]*configv1alpha2.MeshConfigOverride{
+					{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "debug-logs"}},
+				})
+				mockPodConfigurator.EXPECT().GetMeshConfig().Return(configv1alpha2.MeshConfig{
+					Spec: configv1alpha2.MeshConfigSpec{
+						Sidecar: configv1alpha2.SidecarSpec{LogLevel: "debug"},
+					},
+				})
+			}
+
+			responseRecorder := httptest.NewRecorder()
+			podConfigHandler := ds.getPodConfigHandler()
+			podConfigHandler.ServeHTTP(responseRecorder, httptest.NewRequest(http.MethodGet, "/debug/pod-config"+tc.query, nil))
+
+			assert.Equal(tc.expectedStatusCode, responseRecorder.Code)
+			for _, expected := range tc.expectedResponseBody {
+				assert.Contains(responseRecorder.Body.String(), expected)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ func (ds DebugConfig) GetHandlers(handlers map[string]http.Handler) map[string]h
 		"/debug/certs":         ds.getCertHandler(),
 		"/debug/policies":      ds.getSMIPoliciesHandler(),
 		"/debug/config":        ds.getOSMConfigHandler(),
+		"/debug/pod-config":    ds.getPodConfigHandler(),
 		"/debug/namespaces":    ds.getMonitoredNamespacesHandler(),
 		"/debug/feature-flags": ds.getFeatureFlags(),
 
@@ -78,6 +78,9 @@ const (
 
 	// ErrInvalidSourceKind	indicated an applied SMI TrafficTarget policy has an invalid source kind
 	ErrInvalidSourceKind
+
+	// ErrFetchingEgressTLSSecret indicates a secret referenced by the TLS origination of an egress policy could not be fetched
+	ErrFetchingEgressTLSSecret
 )
 
 // Range 3000-3500 is reserved for errors related to k8s constructs (service accounts, namespaces, etc.)
@@ -461,6 +464,14 @@ An applied SMI TrafficTarget policy has an invalid destination kind.
 
 	ErrInvalidSourceKind: `
 An applied SMI TrafficTarget policy has an invalid source kind.
+`,
+
+	ErrFetchingEgressTLSSecret: `
+A secret referenced by the TLS origination of an egress policy could not be fetched,
+or is missing the expected keys. TLS is not originated to the affected external hosts,
+which are ignored by the system until the secret is fixed. Please verify that the secret
+exists and stores the 'ca.crt' key for a CA secret, or the 'tls.crt' and 'tls.key' keys
+for a client certificate secret.
 `,
 
 	ErrGettingInboundTrafficTargets: `
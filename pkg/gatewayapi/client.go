@@ -0,0 +1,233 @@
+package gatewayapi
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/openservicemesh/osm/pkg/announcements"
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+const (
+	// kindService is the Service kind
+	kindService = "Service"
+)
+
+// NewGatewayAPIController returns a gatewayapi.Controller interface related to functionality provided by the route resources
+// in the gateway.networking.k8s.io API group
+func NewGatewayAPIController(informerCollection *informers.InformerCollection, kubeController k8s.Controller, msgBroker *messaging.Broker) *Client {
+	client := &Client{
+		informers:      informerCollection,
+		kubeController: kubeController,
+	}
+
+	shouldObserve := func(obj interface{}) bool {
+		object, ok := obj.(metav1.Object)
+		if !ok {
+			return false
+		}
+		return kubeController.IsMonitoredNamespace(object.GetNamespace())
+	}
+
+	httpRouteEventTypes := k8s.EventTypes{
+		Add:    announcements.GatewayAPIHTTPRouteAdded,
+		Update: announcements.GatewayAPIHTTPRouteUpdated,
+		Delete: announcements.GatewayAPIHTTPRouteDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyGatewayAPIHTTPRoute, k8s.GetEventHandlerFuncs(shouldObserve, httpRouteEventTypes, msgBroker))
+
+	grpcRouteEventTypes := k8s.EventTypes{
+		Add:    announcements.GatewayAPIGRPCRouteAdded,
+		Update: announcements.GatewayAPIGRPCRouteUpdated,
+		Delete: announcements.GatewayAPIGRPCRouteDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyGatewayAPIGRPCRoute, k8s.GetEventHandlerFuncs(shouldObserve, grpcRouteEventTypes, msgBroker))
+
+	tcpRouteEventTypes := k8s.EventTypes{
+		Add:    announcements.GatewayAPITCPRouteAdded,
+		Update: announcements.GatewayAPITCPRouteUpdated,
+		Delete: announcements.GatewayAPITCPRouteDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyGatewayAPITCPRoute, k8s.GetEventHandlerFuncs(shouldObserve, tcpRouteEventTypes, msgBroker))
+
+	return client
+}
+
+// ListHTTPRoutes lists the HTTPRoute resources matching the given filters, sorted by namespace and name
+func (c *Client) ListHTTPRoutes(options ...RouteListOption) []*gwv1beta1.HTTPRoute {
+	o := newRouteListOpt(options...)
+	var routes []*gwv1beta1.HTTPRoute
+	for _, obj := range c.informers.List(informers.InformerKeyGatewayAPIHTTPRoute) {
+		route := obj.(*gwv1beta1.HTTPRoute)
+		if !c.kubeController.IsMonitoredNamespace(route.Namespace) {
+			continue
+		}
+
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+		}
+		if o.matches(route.Namespace, route.Spec.ParentRefs, backendRefs) {
+			routes = append(routes, route)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return lessObject(routes[i], routes[j])
+	})
+	return routes
+}
+
+// ListGRPCRoutes lists the GRPCRoute resources matching the given filters, sorted by namespace and name
+func (c *Client) ListGRPCRoutes(options ...RouteListOption) []*gwv1alpha2.GRPCRoute {
+	o := newRouteListOpt(options...)
+	var routes []*gwv1alpha2.GRPCRoute
+	for _, obj := range c.informers.List(informers.InformerKeyGatewayAPIGRPCRoute) {
+		route := obj.(*gwv1alpha2.GRPCRoute)
+		if !c.kubeController.IsMonitoredNamespace(route.Namespace) {
+			continue
+		}
+
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			for _, backendRef := range rule.BackendRefs {
+				backendRefs = append(backendRefs, backendRef.BackendRef)
+			}
+		}
+		if o.matches(route.Namespace, route.Spec.ParentRefs, backendRefs) {
+			routes = append(routes, route)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return lessObject(routes[i], routes[j])
+	})
+	return routes
+}
+
+// ListTCPRoutes lists the TCPRoute resources matching the given filters, sorted by namespace and name
+func (c *Client) ListTCPRoutes(options ...RouteListOption) []*gwv1alpha2.TCPRoute {
+	o := newRouteListOpt(options...)
+	var routes []*gwv1alpha2.TCPRoute
+	for _, obj := range c.informers.List(informers.InformerKeyGatewayAPITCPRoute) {
+		route := obj.(*gwv1alpha2.TCPRoute)
+		if !c.kubeController.IsMonitoredNamespace(route.Namespace) {
+			continue
+		}
+
+		var backendRefs []gwv1beta1.BackendRef
+		for _, rule := range route.Spec.Rules {
+			backendRefs = append(backendRefs, rule.BackendRefs...)
+		}
+		if o.matches(route.Namespace, route.Spec.ParentRefs, backendRefs) {
+			routes = append(routes, route)
+		}
+	}
+	sort.Slice(routes, func(i, j int) bool {
+		return lessObject(routes[i], routes[j])
+	})
+	return routes
+}
+
+func newRouteListOpt(options ...RouteListOption) *RouteListOpt {
+	o := &RouteListOpt{}
+	for _, opt := range options {
+		opt(o)
+	}
+	return o
+}
+
+// matches returns true if a route in the given namespace, with the given parent and backend references, matches the filters
+func (o *RouteListOpt) matches(routeNamespace string, parentRefs []gwv1beta1.ParentReference, backendRefs []gwv1beta1.BackendRef) bool {
+	if o.ParentService.Name != "" && !IsAttachedToService(routeNamespace, parentRefs, o.ParentService) {
+		return false
+	}
+	if o.BackendService.Name != "" {
+		found := false
+		for _, backendRef := range backendRefs {
+			if IsServiceBackendRef(routeNamespace, backendRef.BackendObjectReference, o.BackendService) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// IsAttachedToService returns true if one of the given parent references of a route in the given namespace
+// refers to the given MeshService, in which case the route configures the traffic directed to the service.
+// A parent reference without a port refers to all the ports of the service.
+func IsAttachedToService(routeNamespace string, parentRefs []gwv1beta1.ParentReference, meshSvc service.MeshService) bool {
+	for _, parentRef := range parentRefs {
+		svc, ok := GetParentService(routeNamespace, parentRef)
+		if !ok || svc.Namespace != meshSvc.Namespace || svc.Name != meshSvc.ProviderKey() {
+			continue
+		}
+		if parentRef.Port == nil || uint16(*parentRef.Port) == meshSvc.Port {
+			return true
+		}
+	}
+	return false
+}
+
+// IsServiceBackendRef returns true if the given backend reference of a route in the given namespace refers to the given MeshService.
+// A backend reference without a port refers to all the ports of the service.
+func IsServiceBackendRef(routeNamespace string, backendRef gwv1beta1.BackendObjectReference, meshSvc service.MeshService) bool {
+	svc, ok := GetBackendService(routeNamespace, backendRef)
+	if !ok || svc.Namespace != meshSvc.Namespace || svc.Name != meshSvc.ProviderKey() {
+		return false
+	}
+	return backendRef.Port == nil || uint16(*backendRef.Port) == meshSvc.Port
+}
+
+// GetParentService returns the service the given parent reference of a route in the given namespace refers to,
+// false if the parent reference does not refer to a service
+func GetParentService(routeNamespace string, parentRef gwv1beta1.ParentReference) (types.NamespacedName, bool) {
+	// The kind of a parent reference defaults to Gateway
+	if parentRef.Kind == nil || *parentRef.Kind != kindService || !isCoreGroup(parentRef.Group) {
+		return types.NamespacedName{}, false
+	}
+
+	svc := types.NamespacedName{Namespace: routeNamespace, Name: string(parentRef.Name)}
+	if parentRef.Namespace != nil {
+		svc.Namespace = string(*parentRef.Namespace)
+	}
+	return svc, true
+}
+
+// GetBackendService returns the service the given backend reference of a route in the given namespace refers to,
+// false if the backend reference does not refer to a service
+func GetBackendService(routeNamespace string, backendRef gwv1beta1.BackendObjectReference) (types.NamespacedName, bool) {
+	// The kind of a backend reference defaults to Service
+	if backendRef.Kind != nil && *backendRef.Kind != kindService || !isCoreGroup(backendRef.Group) {
+		return types.NamespacedName{}, false
+	}
+
+	svc := types.NamespacedName{Namespace: routeNamespace, Name: string(backendRef.Name)}
+	if backendRef.Namespace != nil {
+		svc.Namespace = string(*backendRef.Namespace)
+	}
+	return svc, true
+}
+
+// isCoreGroup returns true if the given group of an object reference is the core API group
+func isCoreGroup(group *gwv1beta1.Group) bool {
+	return group == nil || *group == "" || *group == "core"
+}
+
+func lessObject(a, b metav1.Object) bool {
+	if a.GetNamespace() != b.GetNamespace() {
+		return a.GetNamespace() < b.GetNamespace()
+	}
+	return a.GetName() < b.GetName()
+}
@@ -0,0 +1,248 @@
+package gatewayapi
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+	fakeGatewayAPIClient "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned/fake"
+
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+func serviceParentRef(namespace *string, name string, port *int32) gwv1beta1.ParentReference {
+	kind := gwv1beta1.Kind(kindService)
+	group := gwv1beta1.Group("core")
+	parentRef := gwv1beta1.ParentReference{
+		Group: &group,
+		Kind:  &kind,
+		Name:  gwv1beta1.ObjectName(name),
+	}
+	if namespace != nil {
+		ns := gwv1beta1.Namespace(*namespace)
+		parentRef.Namespace = &ns
+	}
+	if port != nil {
+		p := gwv1beta1.PortNumber(*port)
+		parentRef.Port = &p
+	}
+	return parentRef
+}
+
+func TestListRoutes(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("client").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("unmonitored").Return(false).AnyTimes()
+
+	testNs := "test"
+	port80 := int32(80)
+	port90 := int32(90)
+	gatewayKind := gwv1beta1.Kind("Gateway")
+
+	httpRoutes := []*gwv1beta1.HTTPRoute{
+		{
+			// Producer route attached to all the ports of the service
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "b-producer"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(nil, "foo", nil)},
+				},
+			},
+		},
+		{
+			// Producer route attached to port 80 of the service
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "a-producer-port"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(nil, "foo", &port80)},
+				},
+			},
+		},
+		{
+			// Consumer route attached to port 90 of the service
+			ObjectMeta: metav1.ObjectMeta{Namespace: "client", Name: "consumer-other-port"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(&testNs, "foo", &port90)},
+				},
+			},
+		},
+		{
+			// Consumer route attached to the service
+			ObjectMeta: metav1.ObjectMeta{Namespace: "client", Name: "consumer"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(&testNs, "foo", nil)},
+				},
+			},
+		},
+		{
+			// Route attached to a service with the same name in another namespace
+			ObjectMeta: metav1.ObjectMeta{Namespace: "client", Name: "consumer-local"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(nil, "foo", nil)},
+				},
+			},
+		},
+		{
+			// Route attached to a Gateway
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{{Kind: &gatewayKind, Name: "foo"}},
+				},
+			},
+		},
+		{
+			// Route in an unmonitored namespace
+			ObjectMeta: metav1.ObjectMeta{Namespace: "unmonitored", Name: "unmonitored"},
+			Spec: gwv1beta1.HTTPRouteSpec{
+				CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+					ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(&testNs, "foo", nil)},
+				},
+			},
+		},
+	}
+	grpcRoute := &gwv1alpha2.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "grpc"},
+		Spec: gwv1alpha2.GRPCRouteSpec{
+			CommonRouteSpec: gwv1alpha2.CommonRouteSpec{
+				ParentRefs: []gwv1alpha2.ParentReference{serviceParentRef(nil, "foo", &port80)},
+			},
+		},
+	}
+	tcpRoute := &gwv1alpha2.TCPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tcp"},
+		Spec: gwv1alpha2.TCPRouteSpec{
+			CommonRouteSpec: gwv1alpha2.CommonRouteSpec{
+				ParentRefs: []gwv1alpha2.ParentReference{serviceParentRef(nil, "foo", &port90)},
+			},
+		},
+	}
+
+	a := tassert.New(t)
+	informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithGatewayAPIClient(fakeGatewayAPIClient.NewSimpleClientset(),
+		informers.InformerKeyGatewayAPIHTTPRoute, informers.InformerKeyGatewayAPIGRPCRoute, informers.InformerKeyGatewayAPITCPRoute))
+	a.Nil(err)
+	c := NewGatewayAPIController(informerCollection, mockKubeController, nil)
+	a.NotNil(c)
+
+	for _, route := range httpRoutes {
+		a.Nil(c.informers.Add(informers.InformerKeyGatewayAPIHTTPRoute, route, t))
+	}
+	a.Nil(c.informers.Add(informers.InformerKeyGatewayAPIGRPCRoute, grpcRoute, t))
+	a.Nil(c.informers.Add(informers.InformerKeyGatewayAPITCPRoute, tcpRoute, t))
+
+	testCases := []struct {
+		name               string
+		meshSvc            service.MeshService
+		expectedHTTPRoutes []string
+		expectedGRPCRoutes []string
+		expectedTCPRoutes  []string
+	}{
+		{
+			name:               "routes attached to port 80",
+			meshSvc:            service.MeshService{Namespace: "test", Name: "foo", Port: 80},
+			expectedHTTPRoutes: []string{"client/consumer", "test/a-producer-port", "test/b-producer"},
+			expectedGRPCRoutes: []string{"test/grpc"},
+		},
+		{
+			name:               "routes attached to port 90",
+			meshSvc:            service.MeshService{Namespace: "test", Name: "foo", Port: 90},
+			expectedHTTPRoutes: []string{"client/consumer", "client/consumer-other-port", "test/b-producer"},
+			expectedTCPRoutes:  []string{"test/tcp"},
+		},
+		{
+			name:               "routes attached to a service in another namespace",
+			meshSvc:            service.MeshService{Namespace: "client", Name: "foo", Port: 80},
+			expectedHTTPRoutes: []string{"client/consumer-local"},
+		},
+		{
+			name:    "no routes attached to the service",
+			meshSvc: service.MeshService{Namespace: "test", Name: "bar", Port: 80},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			var names []string
+			for _, route := range c.ListHTTPRoutes(WithParentService(tc.meshSvc)) {
+				names = append(names, route.Namespace+"/"+route.Name)
+			}
+			a.Equal(tc.expectedHTTPRoutes, names)
+
+			names = nil
+			for _, route := range c.ListGRPCRoutes(WithParentService(tc.meshSvc)) {
+				names = append(names, route.Namespace+"/"+route.Name)
+			}
+			a.Equal(tc.expectedGRPCRoutes, names)
+
+			names = nil
+			for _, route := range c.ListTCPRoutes(WithParentService(tc.meshSvc)) {
+				names = append(names, route.Namespace+"/"+route.Name)
+			}
+			a.Equal(tc.expectedTCPRoutes, names)
+		})
+	}
+}
+
+func TestListRoutesWithBackendService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	port80 := int32(80)
+	backendPort := gwv1beta1.PortNumber(8080)
+	otherNs := gwv1beta1.Namespace("other")
+
+	route := &gwv1beta1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "split"},
+		Spec: gwv1beta1.HTTPRouteSpec{
+			CommonRouteSpec: gwv1beta1.CommonRouteSpec{
+				ParentRefs: []gwv1beta1.ParentReference{serviceParentRef(nil, "foo", &port80)},
+			},
+			Rules: []gwv1beta1.HTTPRouteRule{
+				{
+					BackendRefs: []gwv1beta1.HTTPBackendRef{
+						{BackendRef: gwv1beta1.BackendRef{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "foo-v1", Port: &backendPort}}},
+						{BackendRef: gwv1beta1.BackendRef{BackendObjectReference: gwv1beta1.BackendObjectReference{Name: "foo-v2", Namespace: &otherNs}}},
+					},
+				},
+			},
+		},
+	}
+
+	a := tassert.New(t)
+	informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithGatewayAPIClient(fakeGatewayAPIClient.NewSimpleClientset(),
+		informers.InformerKeyGatewayAPIHTTPRoute))
+	a.Nil(err)
+	c := NewGatewayAPIController(informerCollection, mockKubeController, nil)
+	a.Nil(c.informers.Add(informers.InformerKeyGatewayAPIHTTPRoute, route, t))
+
+	a.Len(c.ListHTTPRoutes(WithBackendService(service.MeshService{Namespace: "test", Name: "foo-v1", Port: 8080})), 1)
+	a.Len(c.ListHTTPRoutes(WithBackendService(service.MeshService{Namespace: "test", Name: "foo-v1", Port: 9090})), 0)
+	a.Len(c.ListHTTPRoutes(WithBackendService(service.MeshService{Namespace: "other", Name: "foo-v2", Port: 9090})), 1)
+	a.Len(c.ListHTTPRoutes(WithBackendService(service.MeshService{Namespace: "test", Name: "foo-v2", Port: 9090})), 0)
+	a.Len(c.ListHTTPRoutes(WithParentService(service.MeshService{Namespace: "test", Name: "foo", Port: 80}),
+		WithBackendService(service.MeshService{Namespace: "test", Name: "foo-v1", Port: 8080})), 1)
+	a.Len(c.ListHTTPRoutes(WithParentService(service.MeshService{Namespace: "test", Name: "foo", Port: 90}),
+		WithBackendService(service.MeshService{Namespace: "test", Name: "foo-v1", Port: 8080})), 0)
+
+	// The GRPCRoute and TCPRoute informers are not registered
+	a.Empty(c.ListGRPCRoutes())
+	a.Empty(c.ListTCPRoutes())
+}
@@ -0,0 +1,53 @@
+// Package gatewayapi implements the Kubernetes client for the route resources in the gateway.networking.k8s.io API group
+// that are used to configure routing within the mesh, by attaching them to Kubernetes services.
+package gatewayapi
+
+import (
+	gwv1alpha2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	gwv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+
+	"github.com/openservicemesh/osm/pkg/k8s"
+	"github.com/openservicemesh/osm/pkg/k8s/informers"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// Client is the type used to represent the Kubernetes Client for the route resources in the gateway.networking.k8s.io API group
+type Client struct {
+	informers      *informers.InformerCollection
+	kubeController k8s.Controller
+}
+
+// Controller is the interface for the functionality provided by the route resources in the gateway.networking.k8s.io API group
+type Controller interface {
+	// ListHTTPRoutes lists the HTTPRoute resources. An optional filter can be applied to filter the returned list
+	ListHTTPRoutes(...RouteListOption) []*gwv1beta1.HTTPRoute
+
+	// ListGRPCRoutes lists the GRPCRoute resources. An optional filter can be applied to filter the returned list
+	ListGRPCRoutes(...RouteListOption) []*gwv1alpha2.GRPCRoute
+
+	// ListTCPRoutes lists the TCPRoute resources. An optional filter can be applied to filter the returned list
+	ListTCPRoutes(...RouteListOption) []*gwv1alpha2.TCPRoute
+}
+
+// RouteListOpt specifies the options used to filter route objects as a part of their listers
+type RouteListOpt struct {
+	ParentService  service.MeshService
+	BackendService service.MeshService
+}
+
+// RouteListOption is a function type that implements filters on the route listers
+type RouteListOption func(o *RouteListOpt)
+
+// WithParentService applies a filter based on the service the routes are attached to through their parent references
+func WithParentService(s service.MeshService) RouteListOption {
+	return func(o *RouteListOpt) {
+		o.ParentService = s
+	}
+}
+
+// WithBackendService applies a filter based on the service referenced by the backend references of the routes
+func WithBackendService(s service.MeshService) RouteListOption {
+	return func(o *RouteListOpt) {
+		o.BackendService = s
+	}
+}
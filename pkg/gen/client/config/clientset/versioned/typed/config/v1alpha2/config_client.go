@@ -25,7 +25,9 @@ import (
 
 type ConfigV1alpha2Interface interface {
 	RESTClient() rest.Interface
+	CertificateRevocationsGetter
 	MeshConfigsGetter
+	MeshConfigOverridesGetter
 	MeshRootCertificatesGetter
 }
 
@@ -34,10 +36,18 @@ type ConfigV1alpha2Client struct {
 	restClient rest.Interface
 }
 
+func (c *ConfigV1alpha2Client) CertificateRevocations(namespace string) CertificateRevocationInterface {
+	return newCertificateRevocations(c, namespace)
+}
+
 func (c *ConfigV1alpha2Client) MeshConfigs(namespace string) MeshConfigInterface {
 	return newMeshConfigs(c, namespace)
 }
 
+func (c *ConfigV1alpha2Client) MeshConfigOverrides(namespace string) MeshConfigOverrideInterface {
+	return newMeshConfigOverrides(c, namespace)
+}
+
 func (c *ConfigV1alpha2Client) MeshRootCertificates(namespace string) MeshRootCertificateInterface {
 	return newMeshRootCertificates(c, namespace)
 }
@@ -25,10 +25,18 @@ type FakeConfigV1alpha2 struct {
 	*testing.Fake
 }
 
+func (c *FakeConfigV1alpha2) CertificateRevocations(namespace string) v1alpha2.CertificateRevocationInterface {
+	return &FakeCertificateRevocations{c, namespace}
+}
+
 func (c *FakeConfigV1alpha2) MeshConfigs(namespace string) v1alpha2.MeshConfigInterface {
 	return &FakeMeshConfigs{c, namespace}
 }
 
+func (c *FakeConfigV1alpha2) MeshConfigOverrides(namespace string) v1alpha2.MeshConfigOverrideInterface {
+	return &FakeMeshConfigOverrides{c, namespace}
+}
+
 func (c *FakeConfigV1alpha2) MeshRootCertificates(namespace string) v1alpha2.MeshRootCertificateInterface {
 	return &FakeMeshRootCertificates{c, namespace}
 }
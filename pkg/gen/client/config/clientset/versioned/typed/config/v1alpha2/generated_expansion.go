@@ -15,6 +15,10 @@ limitations under the License.
 
 package v1alpha2
 
+type CertificateRevocationExpansion interface{}
+
 type MeshConfigExpansion interface{}
 
+type MeshConfigOverrideExpansion interface{}
+
 type MeshRootCertificateExpansion interface{}
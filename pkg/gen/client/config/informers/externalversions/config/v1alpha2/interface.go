@@ -21,8 +21,12 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// CertificateRevocations returns a CertificateRevocationInformer.
+	CertificateRevocations() CertificateRevocationInformer
 	// MeshConfigs returns a MeshConfigInformer.
 	MeshConfigs() MeshConfigInformer
+	// MeshConfigOverrides returns a MeshConfigOverrideInformer.
+	MeshConfigOverrides() MeshConfigOverrideInformer
 	// MeshRootCertificates returns a MeshRootCertificateInformer.
 	MeshRootCertificates() MeshRootCertificateInformer
 }
@@ -38,11 +42,21 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// CertificateRevocations returns a CertificateRevocationInformer.
+func (v *version) CertificateRevocations() CertificateRevocationInformer {
+	return &certificateRevocationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MeshConfigs returns a MeshConfigInformer.
 func (v *version) MeshConfigs() MeshConfigInformer {
 	return &meshConfigInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// MeshConfigOverrides returns a MeshConfigOverrideInformer.
+func (v *version) MeshConfigOverrides() MeshConfigOverrideInformer {
+	return &meshConfigOverrideInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // MeshRootCertificates returns a MeshRootCertificateInformer.
 func (v *version) MeshRootCertificates() MeshRootCertificateInformer {
 	return &meshRootCertificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
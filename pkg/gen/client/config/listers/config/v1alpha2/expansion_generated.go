@@ -15,6 +15,14 @@ limitations under the License.
 
 package v1alpha2
 
+// CertificateRevocationListerExpansion allows custom methods to be added to
+// CertificateRevocationLister.
+type CertificateRevocationListerExpansion interface{}
+
+// CertificateRevocationNamespaceListerExpansion allows custom methods to be added to
+// CertificateRevocationNamespaceLister.
+type CertificateRevocationNamespaceListerExpansion interface{}
+
 // MeshConfigListerExpansion allows custom methods to be added to
 // MeshConfigLister.
 type MeshConfigListerExpansion interface{}
@@ -23,6 +31,14 @@ type MeshConfigListerExpansion interface{}
 // MeshConfigNamespaceLister.
 type MeshConfigNamespaceListerExpansion interface{}
 
+// MeshConfigOverrideListerExpansion allows custom methods to be added to
+// MeshConfigOverrideLister.
+type MeshConfigOverrideListerExpansion interface{}
+
+// MeshConfigOverrideNamespaceListerExpansion allows custom methods to be added to
+// MeshConfigOverrideNamespaceLister.
+type MeshConfigOverrideNamespaceListerExpansion interface{}
+
 // MeshRootCertificateListerExpansion allows custom methods to be added to
 // MeshRootCertificateLister.
 type MeshRootCertificateListerExpansion interface{}
@@ -33,6 +33,10 @@ func (c *FakePolicyV1alpha1) AccessControls(namespace string) v1alpha1.AccessCon
 	return &FakeAccessControls{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) AuthorizationPolicies(namespace string) v1alpha1.AuthorizationPolicyInterface {
+	return &FakeAuthorizationPolicies{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) Egresses(namespace string) v1alpha1.EgressInterface {
 	return &FakeEgresses{c, namespace}
 }
@@ -41,14 +45,30 @@ func (c *FakePolicyV1alpha1) EgressGateways(namespace string) v1alpha1.EgressGat
 	return &FakeEgressGateways{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) ExternalServices(namespace string) v1alpha1.ExternalServiceInterface {
+	return &FakeExternalServices{c, namespace}
+}
+
+func (c *FakePolicyV1alpha1) FaultInjections(namespace string) v1alpha1.FaultInjectionInterface {
+	return &FakeFaultInjections{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) IngressBackends(namespace string) v1alpha1.IngressBackendInterface {
 	return &FakeIngressBackends{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) RequestAuthentications(namespace string) v1alpha1.RequestAuthenticationInterface {
+	return &FakeRequestAuthentications{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) Retries(namespace string) v1alpha1.RetryInterface {
 	return &FakeRetries{c, namespace}
 }
 
+func (c *FakePolicyV1alpha1) TrafficMirrors(namespace string) v1alpha1.TrafficMirrorInterface {
+	return &FakeTrafficMirrors{c, namespace}
+}
+
 func (c *FakePolicyV1alpha1) UpstreamTrafficSettings(namespace string) v1alpha1.UpstreamTrafficSettingInterface {
 	return &FakeUpstreamTrafficSettings{c, namespace}
 }
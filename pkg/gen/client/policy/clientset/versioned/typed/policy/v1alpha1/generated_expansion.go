@@ -19,12 +19,22 @@ type AccessCertExpansion interface{}
 
 type AccessControlExpansion interface{}
 
+type AuthorizationPolicyExpansion interface{}
+
 type EgressExpansion interface{}
 
 type EgressGatewayExpansion interface{}
 
+type ExternalServiceExpansion interface{}
+
+type FaultInjectionExpansion interface{}
+
 type IngressBackendExpansion interface{}
 
+type RequestAuthenticationExpansion interface{}
+
 type RetryExpansion interface{}
 
+type TrafficMirrorExpansion interface{}
+
 type UpstreamTrafficSettingExpansion interface{}
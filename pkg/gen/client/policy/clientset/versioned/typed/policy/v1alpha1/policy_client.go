@@ -27,10 +27,15 @@ type PolicyV1alpha1Interface interface {
 	RESTClient() rest.Interface
 	AccessCertsGetter
 	AccessControlsGetter
+	AuthorizationPoliciesGetter
 	EgressesGetter
 	EgressGatewaysGetter
+	ExternalServicesGetter
+	FaultInjectionsGetter
 	IngressBackendsGetter
+	RequestAuthenticationsGetter
 	RetriesGetter
+	TrafficMirrorsGetter
 	UpstreamTrafficSettingsGetter
 }
 
@@ -47,6 +52,10 @@ func (c *PolicyV1alpha1Client) AccessControls(namespace string) AccessControlInt
 	return newAccessControls(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) AuthorizationPolicies(namespace string) AuthorizationPolicyInterface {
+	return newAuthorizationPolicies(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) Egresses(namespace string) EgressInterface {
 	return newEgresses(c, namespace)
 }
@@ -55,14 +64,30 @@ func (c *PolicyV1alpha1Client) EgressGateways(namespace string) EgressGatewayInt
 	return newEgressGateways(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) ExternalServices(namespace string) ExternalServiceInterface {
+	return newExternalServices(c, namespace)
+}
+
+func (c *PolicyV1alpha1Client) FaultInjections(namespace string) FaultInjectionInterface {
+	return newFaultInjections(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) IngressBackends(namespace string) IngressBackendInterface {
 	return newIngressBackends(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) RequestAuthentications(namespace string) RequestAuthenticationInterface {
+	return newRequestAuthentications(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) Retries(namespace string) RetryInterface {
 	return newRetries(c, namespace)
 }
 
+func (c *PolicyV1alpha1Client) TrafficMirrors(namespace string) TrafficMirrorInterface {
+	return newTrafficMirrors(c, namespace)
+}
+
 func (c *PolicyV1alpha1Client) UpstreamTrafficSettings(namespace string) UpstreamTrafficSettingInterface {
 	return newUpstreamTrafficSettings(c, namespace)
 }
@@ -54,14 +54,24 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AccessCerts().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("accesscontrols"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AccessControls().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("authorizationpolicies"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().AuthorizationPolicies().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("egresses"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Egresses().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("egressgateways"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().EgressGateways().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("externalservices"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().ExternalServices().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("faultinjections"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().FaultInjections().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("ingressbackends"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().IngressBackends().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("requestauthentications"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().RequestAuthentications().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("retries"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().Retries().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("trafficmirrors"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().TrafficMirrors().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("upstreamtrafficsettings"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Policy().V1alpha1().UpstreamTrafficSettings().Informer()}, nil
 
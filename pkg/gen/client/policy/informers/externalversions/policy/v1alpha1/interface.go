@@ -25,14 +25,24 @@ type Interface interface {
 	AccessCerts() AccessCertInformer
 	// AccessControls returns a AccessControlInformer.
 	AccessControls() AccessControlInformer
+	// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+	AuthorizationPolicies() AuthorizationPolicyInformer
 	// Egresses returns a EgressInformer.
 	Egresses() EgressInformer
 	// EgressGateways returns a EgressGatewayInformer.
 	EgressGateways() EgressGatewayInformer
+	// ExternalServices returns a ExternalServiceInformer.
+	ExternalServices() ExternalServiceInformer
+	// FaultInjections returns a FaultInjectionInformer.
+	FaultInjections() FaultInjectionInformer
 	// IngressBackends returns a IngressBackendInformer.
 	IngressBackends() IngressBackendInformer
+	// RequestAuthentications returns a RequestAuthenticationInformer.
+	RequestAuthentications() RequestAuthenticationInformer
 	// Retries returns a RetryInformer.
 	Retries() RetryInformer
+	// TrafficMirrors returns a TrafficMirrorInformer.
+	TrafficMirrors() TrafficMirrorInformer
 	// UpstreamTrafficSettings returns a UpstreamTrafficSettingInformer.
 	UpstreamTrafficSettings() UpstreamTrafficSettingInformer
 }
@@ -58,6 +68,11 @@ func (v *version) AccessControls() AccessControlInformer {
 	return &accessControlInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// AuthorizationPolicies returns a AuthorizationPolicyInformer.
+func (v *version) AuthorizationPolicies() AuthorizationPolicyInformer {
+	return &authorizationPolicyInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Egresses returns a EgressInformer.
 func (v *version) Egresses() EgressInformer {
 	return &egressInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -68,16 +83,36 @@ func (v *version) EgressGateways() EgressGatewayInformer {
 	return &egressGatewayInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// ExternalServices returns a ExternalServiceInformer.
+func (v *version) ExternalServices() ExternalServiceInformer {
+	return &externalServiceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// FaultInjections returns a FaultInjectionInformer.
+func (v *version) FaultInjections() FaultInjectionInformer {
+	return &faultInjectionInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // IngressBackends returns a IngressBackendInformer.
 func (v *version) IngressBackends() IngressBackendInformer {
 	return &ingressBackendInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// RequestAuthentications returns a RequestAuthenticationInformer.
+func (v *version) RequestAuthentications() RequestAuthenticationInformer {
+	return &requestAuthenticationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Retries returns a RetryInformer.
 func (v *version) Retries() RetryInformer {
 	return &retryInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// TrafficMirrors returns a TrafficMirrorInformer.
+func (v *version) TrafficMirrors() TrafficMirrorInformer {
+	return &trafficMirrorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // UpstreamTrafficSettings returns a UpstreamTrafficSettingInformer.
 func (v *version) UpstreamTrafficSettings() UpstreamTrafficSettingInformer {
 	return &upstreamTrafficSettingInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
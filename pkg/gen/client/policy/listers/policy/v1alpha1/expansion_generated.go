@@ -31,6 +31,14 @@ type AccessControlListerExpansion interface{}
 // AccessControlNamespaceLister.
 type AccessControlNamespaceListerExpansion interface{}
 
+// AuthorizationPolicyListerExpansion allows custom methods to be added to
+// AuthorizationPolicyLister.
+type AuthorizationPolicyListerExpansion interface{}
+
+// AuthorizationPolicyNamespaceListerExpansion allows custom methods to be added to
+// AuthorizationPolicyNamespaceLister.
+type AuthorizationPolicyNamespaceListerExpansion interface{}
+
 // EgressListerExpansion allows custom methods to be added to
 // EgressLister.
 type EgressListerExpansion interface{}
@@ -47,6 +55,22 @@ type EgressGatewayListerExpansion interface{}
 // EgressGatewayNamespaceLister.
 type EgressGatewayNamespaceListerExpansion interface{}
 
+// ExternalServiceListerExpansion allows custom methods to be added to
+// ExternalServiceLister.
+type ExternalServiceListerExpansion interface{}
+
+// ExternalServiceNamespaceListerExpansion allows custom methods to be added to
+// ExternalServiceNamespaceLister.
+type ExternalServiceNamespaceListerExpansion interface{}
+
+// FaultInjectionListerExpansion allows custom methods to be added to
+// FaultInjectionLister.
+type FaultInjectionListerExpansion interface{}
+
+// FaultInjectionNamespaceListerExpansion allows custom methods to be added to
+// FaultInjectionNamespaceLister.
+type FaultInjectionNamespaceListerExpansion interface{}
+
 // IngressBackendListerExpansion allows custom methods to be added to
 // IngressBackendLister.
 type IngressBackendListerExpansion interface{}
@@ -55,6 +79,14 @@ type IngressBackendListerExpansion interface{}
 // IngressBackendNamespaceLister.
 type IngressBackendNamespaceListerExpansion interface{}
 
+// RequestAuthenticationListerExpansion allows custom methods to be added to
+// RequestAuthenticationLister.
+type RequestAuthenticationListerExpansion interface{}
+
+// RequestAuthenticationNamespaceListerExpansion allows custom methods to be added to
+// RequestAuthenticationNamespaceLister.
+type RequestAuthenticationNamespaceListerExpansion interface{}
+
 // RetryListerExpansion allows custom methods to be added to
 // RetryLister.
 type RetryListerExpansion interface{}
@@ -63,6 +95,14 @@ type RetryListerExpansion interface{}
 // RetryNamespaceLister.
 type RetryNamespaceListerExpansion interface{}
 
+// TrafficMirrorListerExpansion allows custom methods to be added to
+// TrafficMirrorLister.
+type TrafficMirrorListerExpansion interface{}
+
+// TrafficMirrorNamespaceListerExpansion allows custom methods to be added to
+// TrafficMirrorNamespaceLister.
+type TrafficMirrorNamespaceListerExpansion interface{}
+
 // UpstreamTrafficSettingListerExpansion allows custom methods to be added to
 // UpstreamTrafficSettingLister.
 type UpstreamTrafficSettingListerExpansion interface{}
@@ -9,6 +9,12 @@ import (
 const (
 	// namespaceNameSeparator used for marshalling/unmarshalling MeshService to a string or vice versa
 	namespaceNameSeparator = "/"
+
+	// SpiffeScheme is the URI scheme of SPIFFE IDs
+	SpiffeScheme = "spiffe"
+
+	// spiffeIDPrefix is the prefix of SPIFFE IDs
+	spiffeIDPrefix = SpiffeScheme + "://"
 )
 
 // ServiceIdentity is the type used to represent the identity for a service
@@ -20,11 +26,25 @@ func New(name, namespace string) ServiceIdentity {
 	return ServiceIdentity(fmt.Sprintf("%s.%s", name, namespace))
 }
 
-// FromPrincipal returns a new ServiceIdentity for the given servicePrincipal.
+// FromPrincipal returns a new ServiceIdentity for the given servicePrincipal, which is either in the
+// <ServiceAccount>.<Namespace>.<TrustDomain> format or a SPIFFE ID.
 func FromPrincipal(servicePrincipal, trustDomain string) ServiceIdentity {
+	if strings.HasPrefix(servicePrincipal, spiffeIDPrefix) {
+		return fromSpiffeID(servicePrincipal, trustDomain)
+	}
 	return ServiceIdentity(strings.TrimSuffix(servicePrincipal, fmt.Sprintf(`.%s`, trustDomain)))
 }
 
+// fromSpiffeID returns the ServiceIdentity for a SPIFFE ID in the spiffe://<TrustDomain>/ns/<Namespace>/sa/<ServiceAccount> format.
+// SPIFFE IDs in another format are returned as is.
+func fromSpiffeID(spiffeID, trustDomain string) ServiceIdentity {
+	chunks := strings.Split(strings.TrimPrefix(spiffeID, fmt.Sprintf("%s%s/", spiffeIDPrefix, trustDomain)), "/")
+	if len(chunks) != 4 || chunks[0] != "ns" || chunks[2] != "sa" {
+		return ServiceIdentity(spiffeID)
+	}
+	return New(chunks[3], chunks[1])
+}
+
 // WildcardServiceIdentity is a wildcard to match all service identities
 const WildcardServiceIdentity ServiceIdentity = "*"
 
@@ -42,13 +62,24 @@ func (si ServiceIdentity) IsWildcard() bool {
 }
 
 // AsPrincipal converts the ServiceIdentity to a Principal with the given trust domain.
-func (si ServiceIdentity) AsPrincipal(trustDomain string) string {
+// The Principal is the SPIFFE ID of the ServiceIdentity when SPIFFE is enabled.
+func (si ServiceIdentity) AsPrincipal(trustDomain string, spiffeEnabled bool) string {
 	if si.IsWildcard() {
 		return si.String()
 	}
+	if spiffeEnabled {
+		return si.AsSpiffeID(trustDomain)
+	}
 	return fmt.Sprintf("%s.%s", si.String(), trustDomain)
 }
 
+// AsSpiffeID converts the ServiceIdentity to a SPIFFE ID with the given trust domain, in the
+// spiffe://<TrustDomain>/ns/<Namespace>/sa/<ServiceAccount> format.
+func (si ServiceIdentity) AsSpiffeID(trustDomain string) string {
+	sa := si.ToK8sServiceAccount()
+	return fmt.Sprintf("%s%s/ns/%s/sa/%s", spiffeIDPrefix, trustDomain, sa.Namespace, sa.Name)
+}
+
 // ToK8sServiceAccount converts a ServiceIdentity to a K8sServiceAccount to help with transition from K8sServiceAccount to ServiceIdentity
 func (si ServiceIdentity) ToK8sServiceAccount() K8sServiceAccount {
 	// By convention as of release-v0.8 ServiceIdentity is in the format: <ServiceAccount>.<Namespace>.cluster.local
@@ -79,6 +110,6 @@ func (sa K8sServiceAccount) ToServiceIdentity() ServiceIdentity {
 }
 
 // AsPrincipal converts the K8sServiceAccount to a Principal with the given trust domain.
-func (sa K8sServiceAccount) AsPrincipal(trustDomain string) string {
-	return sa.ToServiceIdentity().AsPrincipal(trustDomain)
+func (sa K8sServiceAccount) AsPrincipal(trustDomain string, spiffeEnabled bool) string {
+	return sa.ToServiceIdentity().AsPrincipal(trustDomain, spiffeEnabled)
 }
@@ -56,3 +56,44 @@ func TestToServiceIdentity(t *testing.T) {
 		assert.Equal(si, tc.expectedServiceIdentity)
 	}
 }
+
+func TestPrincipals(t *testing.T) {
+	testCases := []struct {
+		name              string
+		si                ServiceIdentity
+		spiffeEnabled     bool
+		expectedPrincipal string
+	}{
+		{
+			name:              "principal",
+			si:                ServiceIdentity("foo.bar"),
+			expectedPrincipal: "foo.bar.cluster.local",
+		},
+		{
+			name:              "SPIFFE ID principal",
+			si:                ServiceIdentity("foo.bar"),
+			spiffeEnabled:     true,
+			expectedPrincipal: "spiffe://cluster.local/ns/bar/sa/foo",
+		},
+		{
+			name:              "wildcard principal",
+			si:                WildcardServiceIdentity,
+			spiffeEnabled:     true,
+			expectedPrincipal: WildcardPrincipal,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			principal := tc.si.AsPrincipal("cluster.local", tc.spiffeEnabled)
+			assert.Equal(tc.expectedPrincipal, principal)
+			assert.Equal(tc.si, FromPrincipal(principal, "cluster.local"))
+		})
+	}
+
+	// SPIFFE IDs that don't identify a Kubernetes service account are kept as is
+	assert := tassert.New(t)
+	assert.Equal(ServiceIdentity("spiffe://example.org/vm/foo"), FromPrincipal("spiffe://example.org/vm/foo", "cluster.local"))
+}
@@ -1,19 +1,28 @@
 package injector
 
 import (
+	"strconv"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/pointer"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
 )
 
 // GetInitContainerSpec returns the spec of init container.
 func GetInitContainerSpec(containerName string, cfg configurator.Configurator, outboundIPRangeExclusionList []string,
 	outboundIPRangeInclusionList []string, outboundPortExclusionList []int,
 	inboundPortExclusionList []int, enablePrivilegedInitContainer bool, pullPolicy corev1.PullPolicy, networkInterfaceExclusionList []string) corev1.Container {
-	proxyMode := cfg.GetMeshConfig().Spec.Sidecar.LocalProxyMode
+	meshConfigSpec := cfg.GetMeshConfig().Spec
+	proxyMode := meshConfigSpec.Sidecar.LocalProxyMode
 	enabledDNSProxy := cfg.IsLocalDNSProxyEnabled()
-	iptablesInitCommand := GenerateIptablesCommands(proxyMode, enabledDNSProxy, outboundIPRangeExclusionList, outboundIPRangeInclusionList, outboundPortExclusionList, inboundPortExclusionList, networkInterfaceExclusionList)
+	captureAllDNS := meshConfigSpec.Sidecar.LocalDNSProxy.CaptureAllDNSTraffic
+	udpPortPolicies := getUDPPortPolicies(cfg, meshConfigSpec.Traffic.UDPInterception)
+	iptablesInitCommand := GenerateIptablesCommands(proxyMode, enabledDNSProxy, captureAllDNS, udpPortPolicies, outboundIPRangeExclusionList, outboundIPRangeInclusionList, outboundPortExclusionList, inboundPortExclusionList, networkInterfaceExclusionList)
 
 	return corev1.Container{
 		Name:            containerName,
@@ -45,6 +54,50 @@ func GetInitContainerSpec(containerName string, cfg configurator.Configurator, o
 					},
 				},
 			},
+			{
+				Name: "POD_IPS",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{
+						APIVersion: "v1",
+						FieldPath:  "status.podIPs",
+					},
+				},
+			},
 		},
 	}
 }
+
+// getUDPPortPolicies returns the policies of the outbound UDP ports intercepted for the sidecar.
+// The intercepted UDP traffic is only proxied by Pipy sidecars.
+func getUDPPortPolicies(cfg configurator.Configurator, udpInterception configv1alpha2.UDPInterceptionSpec) []configv1alpha2.UDPPortPolicySpec {
+	if !udpInterception.Enable || cfg.GetSidecarClass() != constants.SidecarClassPipy {
+		return nil
+	}
+	return udpInterception.PortPolicies
+}
+
+// setInterceptionAnnotations records the DNS capture and the denied UDP ports on the pod, so that
+// the CNI plugin intercepting the traffic of the pod in place of the init container applies them.
+func setInterceptionAnnotations(pod *corev1.Pod, cfg configurator.Configurator) {
+	meshConfigSpec := cfg.GetMeshConfig().Spec
+	var deniedPorts []string
+	for _, policy := range getUDPPortPolicies(cfg, meshConfigSpec.Traffic.UDPInterception) {
+		if policy.Action == configv1alpha2.UDPPortActionDeny {
+			deniedPorts = append(deniedPorts, strconv.Itoa(policy.Port))
+		}
+	}
+	captureDNS := meshConfigSpec.Sidecar.LocalDNSProxy.CaptureAllDNSTraffic && cfg.IsLocalDNSProxyEnabled()
+	if !captureDNS && len(deniedPorts) == 0 {
+		return
+	}
+
+	if pod.Annotations == nil {
+		pod.Annotations = make(map[string]string)
+	}
+	if captureDNS {
+		pod.Annotations[constants.CaptureDNSAnnotation] = strconv.FormatBool(true)
+	}
+	if len(deniedPorts) > 0 {
+		pod.Annotations[constants.OutboundUDPPortDenyListAnnotation] = strings.Join(deniedPorts, ",")
+	}
+}
@@ -10,6 +10,7 @@ import (
 	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
 )
 
 var _ = Describe("Test functions creating Sidecar bootstrap configuration", func() {
@@ -46,7 +47,10 @@ var _ = Describe("Test functions creating Sidecar bootstrap configuration", func
 				Command:         []string{"/bin/sh"},
 				Args: []string{
 					"-c",
-					`iptables-restore --noflush <<EOF
+					`POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
 # OSM sidecar interception rules
 *nat
 :OSM_PROXY_INBOUND - [0:0]
@@ -71,6 +75,34 @@ var _ = Describe("Test functions creating Sidecar bootstrap configuration", func
 -A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
 COMMIT
 EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+EOF
+fi
 `,
 				},
 				WorkingDir: "",
@@ -95,6 +127,15 @@ EOF
 							},
 						},
 					},
+					{
+						Name: "POD_IPS",
+						ValueFrom: &corev1.EnvVarSource{
+							FieldRef: &corev1.ObjectFieldSelector{
+								APIVersion: "v1",
+								FieldPath:  "status.podIPs",
+							},
+						},
+					},
 				},
 				Stdin:     false,
 				StdinOnce: false,
@@ -123,7 +164,10 @@ EOF
 				Command:         []string{"/bin/sh"},
 				Args: []string{
 					"-c",
-					`iptables-restore --noflush <<EOF
+					`POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
 # OSM sidecar interception rules
 *nat
 :OSM_PROXY_INBOUND - [0:0]
@@ -145,10 +189,39 @@ EOF
 -A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
 -A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
 -A OSM_PROXY_OUTBOUND -d 127.0.0.1/32 -j RETURN
--I OUTPUT -p tcp -o lo -d 127.0.0.1/32 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IP
+-I OUTPUT -p tcp -o lo -d 127.0.0.1/32 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IPV4
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-I OUTPUT -p tcp -o lo -d ::1/128 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IPV6
 -A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
 COMMIT
 EOF
+fi
 `,
 				},
 				WorkingDir: "",
@@ -173,6 +246,15 @@ EOF
 							},
 						},
 					},
+					{
+						Name: "POD_IPS",
+						ValueFrom: &corev1.EnvVarSource{
+							FieldRef: &corev1.ObjectFieldSelector{
+								APIVersion: "v1",
+								FieldPath:  "status.podIPs",
+							},
+						},
+					},
 				},
 				Stdin:     false,
 				StdinOnce: false,
@@ -182,4 +264,62 @@ EOF
 			Expect(actual).To(Equal(expected))
 		})
 	})
+
+	Context("test setInterceptionAnnotations()", func() {
+		It("Records the DNS capture and the denied UDP ports for pipy sidecars", func() {
+			mockConfigurator := configurator.NewMockConfigurator(gomock.NewController(GinkgoT()))
+			mockConfigurator.EXPECT().GetMeshConfig().Return(configv1alpha2.MeshConfig{
+				Spec: configv1alpha2.MeshConfigSpec{
+					Sidecar: configv1alpha2.SidecarSpec{
+						LocalDNSProxy: configv1alpha2.LocalDNSProxy{
+							Enable:               true,
+							CaptureAllDNSTraffic: true,
+						},
+					},
+					Traffic: configv1alpha2.TrafficSpec{
+						UDPInterception: configv1alpha2.UDPInterceptionSpec{
+							Enable: true,
+							PortPolicies: []configv1alpha2.UDPPortPolicySpec{
+								{Port: 5353, Action: configv1alpha2.UDPPortActionDeny},
+								{Port: 8125, Action: configv1alpha2.UDPPortActionAllow, EnableMetrics: true},
+								{Port: 9999, Action: configv1alpha2.UDPPortActionDeny},
+							},
+						},
+					},
+				},
+			}).Times(1)
+			mockConfigurator.EXPECT().GetSidecarClass().Return(constants.SidecarClassPipy).Times(1)
+			mockConfigurator.EXPECT().IsLocalDNSProxyEnabled().Return(true).Times(1)
+
+			pod := &corev1.Pod{}
+			setInterceptionAnnotations(pod, mockConfigurator)
+
+			Expect(pod.Annotations).To(Equal(map[string]string{
+				constants.CaptureDNSAnnotation:              "true",
+				constants.OutboundUDPPortDenyListAnnotation: "5353,9999",
+			}))
+		})
+
+		It("Does not annotate the pod when the UDP traffic is not intercepted", func() {
+			mockConfigurator := configurator.NewMockConfigurator(gomock.NewController(GinkgoT()))
+			mockConfigurator.EXPECT().GetMeshConfig().Return(configv1alpha2.MeshConfig{
+				Spec: configv1alpha2.MeshConfigSpec{
+					Traffic: configv1alpha2.TrafficSpec{
+						UDPInterception: configv1alpha2.UDPInterceptionSpec{
+							Enable: true,
+							PortPolicies: []configv1alpha2.UDPPortPolicySpec{
+								{Port: 5353, Action: configv1alpha2.UDPPortActionDeny},
+							},
+						},
+					},
+				},
+			}).Times(1)
+			mockConfigurator.EXPECT().GetSidecarClass().Return(constants.SidecarClassEnvoy).Times(1)
+
+			pod := &corev1.Pod{}
+			setInterceptionAnnotations(pod, mockConfigurator)
+
+			Expect(pod.Annotations).To(BeNil())
+		})
+	})
 })
@@ -8,39 +8,72 @@ import (
 	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 
 	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
-// iptablesOutboundStaticRules is the list of iptables rules related to outbound traffic interception and redirection
-var iptablesOutboundStaticRules = []string{
-	// Redirects outbound TCP traffic hitting OSM_PROXY_OUT_REDIRECT chain to Sidecar's outbound listener port
-	fmt.Sprintf("-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port %d", constants.SidecarOutboundListenerPort),
+// iptablesOutboundStaticRules returns the list of iptables rules related to outbound traffic interception and redirection
+// for the IP family of the given loopback CIDR
+func iptablesOutboundStaticRules(loopbackCIDR string) []string {
+	return []string{
+		// Redirects outbound TCP traffic hitting OSM_PROXY_OUT_REDIRECT chain to Sidecar's outbound listener port
+		fmt.Sprintf("-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port %d", constants.SidecarOutboundListenerPort),
 
-	// Traffic to the Proxy Admin port flows to the Proxy -- not redirected
-	fmt.Sprintf("-A OSM_PROXY_OUT_REDIRECT -p tcp --dport %d -j ACCEPT", constants.SidecarAdminPort),
+		// Traffic to the Proxy Admin port flows to the Proxy -- not redirected
+		fmt.Sprintf("-A OSM_PROXY_OUT_REDIRECT -p tcp --dport %d -j ACCEPT", constants.SidecarAdminPort),
 
-	// For outbound TCP traffic jump from OUTPUT chain to OSM_PROXY_OUTBOUND chain
-	"-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND",
+		// For outbound TCP traffic jump from OUTPUT chain to OSM_PROXY_OUTBOUND chain
+		"-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND",
 
-	// Outbound traffic from Sidecar to the local app over the loopback interface should jump to the inbound proxy redirect chain.
-	// So when an app directs traffic to itself via the k8s service, traffic flows as follows:
-	// app -> local sidecar's outbound listener -> iptables -> local sidecar's inbound listener -> app
-	fmt.Sprintf("-A OSM_PROXY_OUTBOUND -o lo ! -d 127.0.0.1/32 -m owner --uid-owner %d -j OSM_PROXY_IN_REDIRECT", constants.SidecarUID),
+		// Outbound traffic from Sidecar to the local app over the loopback interface should jump to the inbound proxy redirect chain.
+		// So when an app directs traffic to itself via the k8s service, traffic flows as follows:
+		// app -> local sidecar's outbound listener -> iptables -> local sidecar's inbound listener -> app
+		fmt.Sprintf("-A OSM_PROXY_OUTBOUND -o lo ! -d %s -m owner --uid-owner %d -j OSM_PROXY_IN_REDIRECT", loopbackCIDR, constants.SidecarUID),
 
-	// Outbound traffic from the app to itself over the loopback interface is not be redirected via the proxy.
-	// E.g. when app sends traffic to itself via the pod IP.
-	fmt.Sprintf("-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner %d -j RETURN", constants.SidecarUID),
+		// Outbound traffic from the app to itself over the loopback interface is not be redirected via the proxy.
+		// E.g. when app sends traffic to itself via the pod IP.
+		fmt.Sprintf("-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner %d -j RETURN", constants.SidecarUID),
 
-	// Don't redirect Sidecar traffic back to itself, return it to the next chain for processing
-	fmt.Sprintf("-A OSM_PROXY_OUTBOUND -m owner --uid-owner %d -j RETURN", constants.SidecarUID),
+		// Don't redirect Sidecar traffic back to itself, return it to the next chain for processing
+		fmt.Sprintf("-A OSM_PROXY_OUTBOUND -m owner --uid-owner %d -j RETURN", constants.SidecarUID),
 
-	// Skip localhost traffic, doesn't need to be routed via the proxy
-	"-A OSM_PROXY_OUTBOUND -d 127.0.0.1/32 -j RETURN",
+		// Skip localhost traffic, doesn't need to be routed via the proxy
+		fmt.Sprintf("-A OSM_PROXY_OUTBOUND -d %s -j RETURN", loopbackCIDR),
+	}
 }
 
 // iptablesDNSOutboundStaticRules is the list of iptables rules related to DNS outbound traffic interception and redirection
 var iptablesDNSOutboundStaticRules = []string{
 	// Redirects outbound UDP traffic to Sidecar's local DNS proxy listener port
-	"-A OUTPUT -p udp -d 127.0.0.153 --dport 53 -j DNAT --to-destination 127.0.0.153:5300",
+	fmt.Sprintf("-A OUTPUT -p udp -d %s --dport %d -j DNAT --to-destination %s:%d",
+		constants.LocalDNSProxyIPAddress, constants.DNSPort, constants.LocalDNSProxyIPAddress, constants.LocalDNSProxyPort),
+}
+
+// iptablesDNSCaptureStaticRules is the list of iptables rules capturing the DNS traffic sent to any name server
+var iptablesDNSCaptureStaticRules = []string{
+	// Redirects the DNS queries of the app to Sidecar's local DNS proxy listener port, the queries of the Sidecar
+	// itself are sent to the upstream name servers
+	fmt.Sprintf("-A OUTPUT -p udp --dport %d -m owner ! --uid-owner %d -j DNAT --to-destination %s:%d",
+		constants.DNSPort, constants.SidecarUID, constants.LocalDNSProxyIPAddress, constants.LocalDNSProxyPort),
+}
+
+// iptablesUDPOutboundStaticRules returns the list of iptables rules related to outbound UDP traffic interception
+// for the IP family of the given loopback CIDR. The intercepted datagrams are marked in the mangle table, routed
+// back through the loopback interface and transparently proxied to Sidecar's UDP outbound listener port.
+func iptablesUDPOutboundStaticRules(loopbackCIDR string, loopbackIP string) []string {
+	return []string{
+		// Transparently proxies the marked UDP traffic to Sidecar's UDP outbound listener port
+		fmt.Sprintf("-A PREROUTING -p udp -i lo -m mark --mark %#x -j TPROXY --on-ip %s --on-port %d --tproxy-mark %#x",
+			constants.SidecarUDPTProxyMark, loopbackIP, constants.SidecarUDPOutboundListenerPort, constants.SidecarUDPTProxyMark),
+
+		// For outbound UDP traffic jump from OUTPUT chain to OSM_PROXY_UDP_OUTBOUND chain
+		"-A OUTPUT -p udp -j OSM_PROXY_UDP_OUTBOUND",
+
+		// Don't intercept Sidecar traffic
+		fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -m owner --uid-owner %d -j RETURN", constants.SidecarUID),
+
+		// Skip localhost traffic
+		fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -d %s -j RETURN", loopbackCIDR),
+	}
 }
 
 // iptablesInboundStaticRules is the list of iptables rules related to inbound traffic interception and redirection
@@ -67,8 +100,93 @@ var iptablesInboundStaticRules = []string{
 	"-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT",
 }
 
-// GenerateIptablesCommands generates a list of iptables commands to set up sidecar interception and redirection
-func GenerateIptablesCommands(proxyMode configv1alpha2.LocalProxyMode, enabledDNSProxy bool, outboundIPRangeExclusionList []string, outboundIPRangeInclusionList []string, outboundPortExclusionList []int, inboundPortExclusionList []int, networkInterfaceExclusionList []string) string {
+// ipFamily describes the parts of the interception rules specific to an IP family
+type ipFamily struct {
+	// restoreCmd is the command restoring the rules of the IP family
+	restoreCmd string
+
+	// loopbackCIDR is the CIDR of the loopback address of the IP family
+	loopbackCIDR string
+
+	// podIPVar is the shell variable holding the pod IP of the IP family
+	podIPVar string
+
+	// loopbackIP is the loopback address of the IP family
+	loopbackIP string
+
+	// ipCmd is the ip command managing the routing of the IP family
+	ipCmd string
+
+	// defaultRoute is the destination of the default route of the IP family
+	defaultRoute string
+
+	// dnsProxy specifies whether DNS traffic of the IP family can be redirected to the local DNS proxy,
+	// which only listens on an IPv4 address
+	dnsProxy bool
+}
+
+var (
+	ipv4Family = ipFamily{
+		restoreCmd:   "iptables-restore",
+		loopbackCIDR: "127.0.0.1/32",
+		podIPVar:     "$POD_IPV4",
+		loopbackIP:   "127.0.0.1",
+		ipCmd:        "ip",
+		defaultRoute: "0.0.0.0/0",
+		dnsProxy:     true,
+	}
+
+	ipv6Family = ipFamily{
+		restoreCmd:   "ip6tables-restore",
+		loopbackCIDR: "::1/128",
+		podIPVar:     "$POD_IPV6",
+		loopbackIP:   "::1",
+		ipCmd:        "ip -6",
+		defaultRoute: "::/0",
+	}
+)
+
+// podIPv4Cmd sets POD_IPV4 to the first IPv4 address in the comma separated list of pod IPs in POD_IPS,
+// since POD_IP holds the IPv6 address of the pods in IPv6-only and IPv6-primary dual-stack clusters
+const podIPv4Cmd = `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)`
+
+// podIPv6Cmd sets POD_IPV6 to the first IPv6 address in the comma separated list of pod IPs in POD_IPS
+const podIPv6Cmd = `POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)`
+
+// GenerateIptablesCommands generates a list of iptables commands to set up sidecar interception and redirection.
+// The rules of each IP family are only restored when the pod is assigned an address of the IP family.
+// The outbound UDP traffic is only intercepted on the ports of the given UDP port policies.
+func GenerateIptablesCommands(proxyMode configv1alpha2.LocalProxyMode, enabledDNSProxy bool, captureAllDNS bool, udpPortPolicies []configv1alpha2.UDPPortPolicySpec, outboundIPRangeExclusionList []string, outboundIPRangeInclusionList []string, outboundPortExclusionList []int, inboundPortExclusionList []int, networkInterfaceExclusionList []string) string {
+	ipv4Exclusions, ipv6Exclusions := cidr.SplitByFamily(outboundIPRangeExclusionList)
+	ipv4Inclusions, ipv6Inclusions := cidr.SplitByFamily(outboundIPRangeInclusionList)
+
+	// When inclusions are specified, the outbound traffic of an IP family without inclusions is not redirected
+	outboundInclusionOnly := len(outboundIPRangeInclusionList) > 0
+
+	captureAllDNS = enabledDNSProxy && captureAllDNS
+	var udpPorts []int
+	for _, policy := range udpPortPolicies {
+		if captureAllDNS && policy.Port == constants.DNSPort {
+			// The DNS traffic is captured by the local DNS proxy
+			continue
+		}
+		udpPorts = append(udpPorts, policy.Port)
+	}
+
+	ipv4Cmd := generateRestoreCommand(ipv4Family, proxyMode, enabledDNSProxy, captureAllDNS, udpPorts, ipv4Exclusions, ipv4Inclusions, outboundInclusionOnly, outboundPortExclusionList, inboundPortExclusionList, networkInterfaceExclusionList)
+	ipv6Cmd := generateRestoreCommand(ipv6Family, proxyMode, enabledDNSProxy, captureAllDNS, udpPorts, ipv6Exclusions, ipv6Inclusions, outboundInclusionOnly, outboundPortExclusionList, inboundPortExclusionList, networkInterfaceExclusionList)
+
+	return fmt.Sprintf(`%s
+%s
+if [ -n "$POD_IPV4" ]; then
+%sfi
+if [ -n "$POD_IPV6" ]; then
+%sfi
+`, podIPv4Cmd, podIPv6Cmd, ipv4Cmd, ipv6Cmd)
+}
+
+// generateRestoreCommand generates the command restoring the interception and redirection rules of the given IP family
+func generateRestoreCommand(family ipFamily, proxyMode configv1alpha2.LocalProxyMode, enabledDNSProxy bool, captureAllDNS bool, udpPorts []int, outboundIPRangeExclusionList []string, outboundIPRangeInclusionList []string, outboundInclusionOnly bool, outboundPortExclusionList []int, inboundPortExclusionList []int, networkInterfaceExclusionList []string) string {
 	var rules strings.Builder
 
 	fmt.Fprintln(&rules, `# OSM sidecar interception rules
@@ -101,16 +219,19 @@ func GenerateIptablesCommands(proxyMode configv1alpha2.LocalProxyMode, enabledDN
 	}
 
 	// 3. Create outbound rules
-	cmds = append(cmds, iptablesOutboundStaticRules...)
-	if enabledDNSProxy {
+	cmds = append(cmds, iptablesOutboundStaticRules(family.loopbackCIDR)...)
+	if enabledDNSProxy && family.dnsProxy {
 		cmds = append(cmds, iptablesDNSOutboundStaticRules...)
+		if captureAllDNS {
+			cmds = append(cmds, iptablesDNSCaptureStaticRules...)
+		}
 	}
 
 	if proxyMode == configv1alpha2.LocalProxyModePodIP {
 		// For sidecar -> local service container proxying, send traffic to pod IP instead of localhost
 		// *Note: it is important to use the insert option '-I' instead of the append option '-A' to ensure the
 		// DNAT to the pod ip for sidecar -> localhost traffic happens before the rule that redirects traffic to the proxy
-		cmds = append(cmds, fmt.Sprintf("-I OUTPUT -p tcp -o lo -d 127.0.0.1/32 -m owner --uid-owner %d -j DNAT --to-destination $POD_IP", constants.SidecarUID))
+		cmds = append(cmds, fmt.Sprintf("-I OUTPUT -p tcp -o lo -d %s -m owner --uid-owner %d -j DNAT --to-destination %s", family.loopbackCIDR, constants.SidecarUID, family.podIPVar))
 	}
 
 	// Ignore outbound traffic in specified interfaces
@@ -143,7 +264,7 @@ func GenerateIptablesCommands(proxyMode configv1alpha2.LocalProxyMode, enabledDN
 	}
 
 	// 6. Create dynamic outbound IP range inclusion rules
-	if len(outboundIPRangeInclusionList) > 0 {
+	if outboundInclusionOnly {
 		// Redirect specified IP ranges to the proxy
 		for _, cidr := range outboundIPRangeInclusionList {
 			rule := fmt.Sprintf("-A OSM_PROXY_OUTBOUND -d %s -j OSM_PROXY_OUT_REDIRECT", cidr)
@@ -162,10 +283,89 @@ func GenerateIptablesCommands(proxyMode configv1alpha2.LocalProxyMode, enabledDN
 
 	fmt.Fprint(&rules, "COMMIT")
 
-	cmd := fmt.Sprintf(`iptables-restore --noflush <<EOF
+	var routes string
+	if len(udpPorts) > 0 {
+		fmt.Fprintln(&rules)
+		writeUDPInterceptionRules(&rules, family, udpPorts, outboundIPRangeExclusionList, outboundIPRangeInclusionList, outboundInclusionOnly, outboundPortExclusionList, networkInterfaceExclusionList)
+
+		// Route the marked UDP traffic back through the loopback interface to be transparently proxied
+		routes = fmt.Sprintf(`%s rule add fwmark %#x lookup %d
+%s route add local %s dev lo table %d
+`, family.ipCmd, constants.SidecarUDPTProxyMark, constants.SidecarUDPTProxyRouteTable,
+			family.ipCmd, family.defaultRoute, constants.SidecarUDPTProxyRouteTable)
+	}
+
+	cmd := fmt.Sprintf(`%s%s --noflush <<EOF
 %s
 EOF
-`, rules.String())
+`, routes, family.restoreCmd, rules.String())
 
 	return cmd
 }
+
+// writeUDPInterceptionRules writes the mangle table rules intercepting the outbound UDP traffic to the given ports
+func writeUDPInterceptionRules(rules *strings.Builder, family ipFamily, udpPorts []int, outboundIPRangeExclusionList []string, outboundIPRangeInclusionList []string, outboundInclusionOnly bool, outboundPortExclusionList []int, networkInterfaceExclusionList []string) {
+	fmt.Fprintln(rules, `*mangle
+:OSM_PROXY_UDP_OUTBOUND - [0:0]
+:OSM_PROXY_UDP_REDIRECT - [0:0]`)
+	cmds := iptablesUDPOutboundStaticRules(family.loopbackCIDR, family.loopbackIP)
+
+	// Ignore outbound traffic in specified interfaces
+	for _, iface := range networkInterfaceExclusionList {
+		cmds = append(cmds, fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -o %s -j RETURN", iface))
+	}
+
+	for _, cidr := range outboundIPRangeExclusionList {
+		cmds = append(cmds, fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -d %s -j RETURN", cidr))
+	}
+
+	for _, ports := range chunkPorts(outboundPortExclusionList) {
+		cmds = append(cmds, fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -p udp --match multiport --dports %s -j RETURN", joinPorts(ports)))
+	}
+
+	if outboundInclusionOnly {
+		for _, cidr := range outboundIPRangeInclusionList {
+			cmds = append(cmds, fmt.Sprintf("-A OSM_PROXY_UDP_OUTBOUND -d %s -j OSM_PROXY_UDP_REDIRECT", cidr))
+		}
+		cmds = append(cmds, "-A OSM_PROXY_UDP_OUTBOUND -j RETURN")
+	} else {
+		cmds = append(cmds, "-A OSM_PROXY_UDP_OUTBOUND -j OSM_PROXY_UDP_REDIRECT")
+	}
+
+	// Mark the UDP traffic to the ports with a policy, the remaining UDP traffic is not intercepted
+	for _, ports := range chunkPorts(udpPorts) {
+		cmds = append(cmds, fmt.Sprintf("-A OSM_PROXY_UDP_REDIRECT -p udp --match multiport --dports %s -j MARK --set-mark %#x",
+			joinPorts(ports), constants.SidecarUDPTProxyMark))
+	}
+
+	for _, rule := range cmds {
+		fmt.Fprintln(rules, rule)
+	}
+
+	fmt.Fprint(rules, "COMMIT")
+}
+
+// multiportMaxPorts is the maximum number of ports matched by a single iptables multiport match
+const multiportMaxPorts = 15
+
+// chunkPorts splits the given ports into chunks small enough to be matched by a multiport match
+func chunkPorts(ports []int) [][]int {
+	var chunks [][]int
+	for len(ports) > multiportMaxPorts {
+		chunks = append(chunks, ports[:multiportMaxPorts])
+		ports = ports[multiportMaxPorts:]
+	}
+	if len(ports) > 0 {
+		chunks = append(chunks, ports)
+	}
+	return chunks
+}
+
+// joinPorts returns the comma separated list of the given ports
+func joinPorts(ports []int) string {
+	var portsStr []string
+	for _, port := range ports {
+		portsStr = append(portsStr, strconv.Itoa(port))
+	}
+	return strings.Join(portsStr, ",")
+}
@@ -1,6 +1,8 @@
 package injector
 
 import (
+	"os"
+	"os/exec"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -12,6 +14,9 @@ func TestGenerateIptablesCommands(t *testing.T) {
 	testCases := []struct {
 		name                       string
 		proxyMode                  configv1alpha2.LocalProxyMode
+		enabledDNSProxy            bool
+		captureAllDNS              bool
+		udpPortPolicies            []configv1alpha2.UDPPortPolicySpec
 		outboundIPRangeExclusions  []string
 		outboundIPRangeInclusions  []string
 		outboundPortExclusions     []int
@@ -21,7 +26,10 @@ func TestGenerateIptablesCommands(t *testing.T) {
 	}{
 		{
 			name: "no exclusions or inclusions",
-			expected: `iptables-restore --noflush <<EOF
+			expected: `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
 # OSM sidecar interception rules
 *nat
 :OSM_PROXY_INBOUND - [0:0]
@@ -46,6 +54,34 @@ func TestGenerateIptablesCommands(t *testing.T) {
 -A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
 COMMIT
 EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+EOF
+fi
 `,
 		},
 		{
@@ -55,7 +91,10 @@ EOF
 			outboundPortExclusions:     []int{10, 20},
 			inboundPortExclusions:      []int{30, 40},
 			networkInterfaceExclusions: []string{"eth0", "eth1"},
-			expected: `iptables-restore --noflush <<EOF
+			expected: `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
 # OSM sidecar interception rules
 *nat
 :OSM_PROXY_INBOUND - [0:0]
@@ -90,12 +129,49 @@ EOF
 -A OSM_PROXY_OUTBOUND -j RETURN
 COMMIT
 EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-I OSM_PROXY_INBOUND -i eth0 -j RETURN
+-I OSM_PROXY_INBOUND -i eth1 -j RETURN
+-I OSM_PROXY_INBOUND -p tcp --match multiport --dports 30,40 -j RETURN
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -o eth0 -j RETURN
+-A OSM_PROXY_OUTBOUND -o eth1 -j RETURN
+-A OSM_PROXY_OUTBOUND -p tcp --match multiport --dports 10,20 -j RETURN
+-A OSM_PROXY_OUTBOUND -j RETURN
+COMMIT
+EOF
+fi
 `,
 		},
 		{
 			name:      "proxy mode pod ip",
 			proxyMode: configv1alpha2.LocalProxyModePodIP,
-			expected: `iptables-restore --noflush <<EOF
+			expected: `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
 # OSM sidecar interception rules
 *nat
 :OSM_PROXY_INBOUND - [0:0]
@@ -117,10 +193,207 @@ EOF
 -A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
 -A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
 -A OSM_PROXY_OUTBOUND -d 127.0.0.1/32 -j RETURN
--I OUTPUT -p tcp -o lo -d 127.0.0.1/32 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IP
+-I OUTPUT -p tcp -o lo -d 127.0.0.1/32 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IPV4
 -A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
 COMMIT
 EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-I OUTPUT -p tcp -o lo -d ::1/128 -m owner --uid-owner 1500 -j DNAT --to-destination $POD_IPV6
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+EOF
+fi
+`,
+		},
+		{
+			name:                      "dual-stack exclusions and inclusions",
+			outboundIPRangeExclusions: []string{"1.1.1.1/32", "fd00::1/128"},
+			outboundIPRangeInclusions: []string{"fd00:10::/64"},
+			expected: `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+iptables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d 127.0.0.1/32 -j RETURN
+-A OSM_PROXY_OUTBOUND -d 1.1.1.1/32 -j RETURN
+-A OSM_PROXY_OUTBOUND -j RETURN
+COMMIT
+EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -d fd00::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -d fd00:10::/64 -j OSM_PROXY_OUT_REDIRECT
+-A OSM_PROXY_OUTBOUND -j RETURN
+COMMIT
+EOF
+fi
+`,
+		},
+		{
+			name:            "dns capture and udp interception",
+			enabledDNSProxy: true,
+			captureAllDNS:   true,
+			udpPortPolicies: []configv1alpha2.UDPPortPolicySpec{
+				{Port: 53, Action: configv1alpha2.UDPPortActionDeny},
+				{Port: 514, Action: configv1alpha2.UDPPortActionAllow, EnableMetrics: true},
+				{Port: 8125, Action: configv1alpha2.UDPPortActionDeny},
+			},
+			outboundIPRangeExclusions: []string{"1.1.1.1/32", "fd00::1/128"},
+			outboundPortExclusions:    []int{6060},
+			expected: `POD_IPV4=$(echo "$POD_IPS" | tr ',' '\n' | grep '\.' | head -n 1)
+POD_IPV6=$(echo "$POD_IPS" | tr ',' '\n' | grep ':' | head -n 1)
+if [ -n "$POD_IPV4" ]; then
+ip rule add fwmark 0x539 lookup 133
+ip route add local 0.0.0.0/0 dev lo table 133
+iptables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d 127.0.0.1/32 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d 127.0.0.1/32 -j RETURN
+-A OUTPUT -p udp -d 127.0.0.153 --dport 53 -j DNAT --to-destination 127.0.0.153:5300
+-A OUTPUT -p udp --dport 53 -m owner ! --uid-owner 1500 -j DNAT --to-destination 127.0.0.153:5300
+-A OSM_PROXY_OUTBOUND -d 1.1.1.1/32 -j RETURN
+-A OSM_PROXY_OUTBOUND -p tcp --match multiport --dports 6060 -j RETURN
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+*mangle
+:OSM_PROXY_UDP_OUTBOUND - [0:0]
+:OSM_PROXY_UDP_REDIRECT - [0:0]
+-A PREROUTING -p udp -i lo -m mark --mark 0x539 -j TPROXY --on-ip 127.0.0.1 --on-port 15001 --tproxy-mark 0x539
+-A OUTPUT -p udp -j OSM_PROXY_UDP_OUTBOUND
+-A OSM_PROXY_UDP_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -d 127.0.0.1/32 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -d 1.1.1.1/32 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -p udp --match multiport --dports 6060 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -j OSM_PROXY_UDP_REDIRECT
+-A OSM_PROXY_UDP_REDIRECT -p udp --match multiport --dports 514,8125 -j MARK --set-mark 0x539
+COMMIT
+EOF
+fi
+if [ -n "$POD_IPV6" ]; then
+ip -6 rule add fwmark 0x539 lookup 133
+ip -6 route add local ::/0 dev lo table 133
+ip6tables-restore --noflush <<EOF
+# OSM sidecar interception rules
+*nat
+:OSM_PROXY_INBOUND - [0:0]
+:OSM_PROXY_IN_REDIRECT - [0:0]
+:OSM_PROXY_OUTBOUND - [0:0]
+:OSM_PROXY_OUT_REDIRECT - [0:0]
+-A OSM_PROXY_IN_REDIRECT -p tcp -j REDIRECT --to-port 15003
+-A PREROUTING -p tcp -j OSM_PROXY_INBOUND
+-A OSM_PROXY_INBOUND -p tcp --dport 15010 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15901 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15902 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15903 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp --dport 15904 -j RETURN
+-A OSM_PROXY_INBOUND -p tcp -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUT_REDIRECT -p tcp -j REDIRECT --to-port 15001
+-A OSM_PROXY_OUT_REDIRECT -p tcp --dport 15000 -j ACCEPT
+-A OUTPUT -p tcp -j OSM_PROXY_OUTBOUND
+-A OSM_PROXY_OUTBOUND -o lo ! -d ::1/128 -m owner --uid-owner 1500 -j OSM_PROXY_IN_REDIRECT
+-A OSM_PROXY_OUTBOUND -o lo -m owner ! --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -d fd00::1/128 -j RETURN
+-A OSM_PROXY_OUTBOUND -p tcp --match multiport --dports 6060 -j RETURN
+-A OSM_PROXY_OUTBOUND -j OSM_PROXY_OUT_REDIRECT
+COMMIT
+*mangle
+:OSM_PROXY_UDP_OUTBOUND - [0:0]
+:OSM_PROXY_UDP_REDIRECT - [0:0]
+-A PREROUTING -p udp -i lo -m mark --mark 0x539 -j TPROXY --on-ip ::1 --on-port 15001 --tproxy-mark 0x539
+-A OUTPUT -p udp -j OSM_PROXY_UDP_OUTBOUND
+-A OSM_PROXY_UDP_OUTBOUND -m owner --uid-owner 1500 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -d ::1/128 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -d fd00::1/128 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -p udp --match multiport --dports 6060 -j RETURN
+-A OSM_PROXY_UDP_OUTBOUND -j OSM_PROXY_UDP_REDIRECT
+-A OSM_PROXY_UDP_REDIRECT -p udp --match multiport --dports 514,8125 -j MARK --set-mark 0x539
+COMMIT
+EOF
+fi
 `,
 		},
 	}
@@ -129,8 +402,66 @@ EOF
 		t.Run(tc.name, func(t *testing.T) {
 			a := assert.New(t)
 
-			actual := GenerateIptablesCommands(tc.proxyMode, false, tc.outboundIPRangeExclusions, tc.outboundIPRangeInclusions, tc.outboundPortExclusions, tc.inboundPortExclusions, tc.networkInterfaceExclusions)
+			actual := GenerateIptablesCommands(tc.proxyMode, tc.enabledDNSProxy, tc.captureAllDNS, tc.udpPortPolicies, tc.outboundIPRangeExclusions, tc.outboundIPRangeInclusions, tc.outboundPortExclusions, tc.inboundPortExclusions, tc.networkInterfaceExclusions)
 			a.Equal(tc.expected, actual)
 		})
 	}
 }
+
+func TestPodIPCmds(t *testing.T) {
+	testCases := []struct {
+		name         string
+		podIPs       string
+		expectedIPv4 string
+		expectedIPv6 string
+	}{
+		{
+			name:         "IPv4 only",
+			podIPs:       "10.0.0.1",
+			expectedIPv4: "10.0.0.1",
+		},
+		{
+			name:         "IPv6 only",
+			podIPs:       "fd00::1",
+			expectedIPv6: "fd00::1",
+		},
+		{
+			name:         "IPv4 first dual-stack",
+			podIPs:       "10.0.0.1,fd00::1",
+			expectedIPv4: "10.0.0.1",
+			expectedIPv6: "fd00::1",
+		},
+		{
+			name:         "IPv6 first dual-stack",
+			podIPs:       "fd00::1,10.0.0.1",
+			expectedIPv4: "10.0.0.1",
+			expectedIPv6: "fd00::1",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			cmd := exec.Command("/bin/sh", "-c", podIPv4Cmd+"\n"+podIPv6Cmd+"\n"+`echo "$POD_IPV4,$POD_IPV6"`)
+			cmd.Env = append(os.Environ(), "POD_IPS="+tc.podIPs)
+			out, err := cmd.Output()
+			a.Nil(err)
+			a.Equal(tc.expectedIPv4+","+tc.expectedIPv6+"\n", string(out))
+		})
+	}
+}
+
+func TestChunkPorts(t *testing.T) {
+	a := assert.New(t)
+
+	var ports []int
+	for port := 1; port <= 2*multiportMaxPorts+1; port++ {
+		ports = append(ports, port)
+	}
+
+	a.Nil(chunkPorts(nil))
+	a.Equal([][]int{{1, 2}}, chunkPorts([]int{1, 2}))
+	a.Equal([][]int{ports[:multiportMaxPorts]}, chunkPorts(ports[:multiportMaxPorts]))
+	a.Equal([][]int{ports[:multiportMaxPorts], ports[multiportMaxPorts : 2*multiportMaxPorts], {2*multiportMaxPorts + 1}}, chunkPorts(ports))
+}
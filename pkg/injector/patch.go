@@ -78,7 +78,7 @@ func (wh *mutatingWebhook) createPatch(pod *corev1.Pod, req *admissionv1.Admissi
 		MeshName:                     wh.meshName,
 		OsmNamespace:                 wh.osmNamespace,
 		OsmContainerPullPolicy:       wh.osmContainerPullPolicy,
-		Configurator:                 wh.configurator,
+		Configurator:                 wh.configurator.ForPod(namespace, pod.Labels),
 		CertManager:                  wh.certManager,
 		Pod:                          pod,
 		PodOS:                        podOS,
@@ -163,6 +163,7 @@ func ConfigurePodInit(cfg configurator.Configurator, podOS string, pod *corev1.P
 	// Add the init container to the pod spec
 	initContainer := GetInitContainerSpec(constants.InitContainerName, cfg, outboundIPRangeExclusionList, outboundIPRangeInclusionList, outboundPortExclusionList, inboundPortExclusionList, cfg.IsPrivilegedInitContainer(), osmContainerPullPolicy, networkInterfaceExclusionList)
 	pod.Spec.InitContainers = append(pod.Spec.InitContainers, initContainer)
+	setInterceptionAnnotations(pod, cfg)
 
 	return nil
 }
@@ -3,6 +3,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"net"
 	"strconv"
 	"strings"
 
@@ -266,6 +267,19 @@ func IsMetricsEnabled(pod *corev1.Pod) bool {
 	return isScrapingEnabled
 }
 
+// HasIPv6PodIP returns true if the pod is assigned an IPv6 address, as in a dual-stack or IPv6 cluster
+func HasIPv6PodIP(pod *corev1.Pod) bool {
+	for _, podIP := range pod.Status.PodIPs {
+		if ip := net.ParseIP(podIP.IP); ip != nil && ip.To4() == nil {
+			return true
+		}
+	}
+	if ip := net.ParseIP(pod.Status.PodIP); ip != nil && ip.To4() == nil {
+		return true
+	}
+	return false
+}
+
 // UpdateStatus updates the status subresource for the given resource and GroupVersionKind
 // The resource within the 'interface{}' must be a pointer to the underlying resource
 func (c client) UpdateStatus(resource interface{}) (metav1.Object, error) {
@@ -315,8 +329,11 @@ func ServiceToMeshServices(c Controller, svc corev1.Service) []service.MeshServi
 		// Order of Preference is:
 		// 1. port.appProtocol field
 		// 2. protocol prefixed to port name (e.g. tcp-my-port)
-		// 3. default to http
+		// 3. default to udp for UDP ports, http otherwise
 		protocol := constants.ProtocolHTTP
+		if portSpec.Protocol == corev1.ProtocolUDP {
+			protocol = constants.ProtocolUDP
+		}
 		for _, p := range constants.SupportedProtocolsInMesh {
 			if strings.HasPrefix(portSpec.Name, p+"-") {
 				protocol = p
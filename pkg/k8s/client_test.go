@@ -612,6 +612,49 @@ func TestIsMetricsEnabled(t *testing.T) {
 	}
 }
 
+func TestHasIPv6PodIP(t *testing.T) {
+	testCases := []struct {
+		name     string
+		status   corev1.PodStatus
+		expected bool
+	}{
+		{
+			name:     "pod without IP",
+			expected: false,
+		},
+		{
+			name: "IPv4 pod",
+			status: corev1.PodStatus{
+				PodIP:  "10.0.0.1",
+				PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}},
+			},
+			expected: false,
+		},
+		{
+			name: "dual-stack pod",
+			status: corev1.PodStatus{
+				PodIP:  "10.0.0.1",
+				PodIPs: []corev1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+			},
+			expected: true,
+		},
+		{
+			name: "IPv6 pod without pod IPs",
+			status: corev1.PodStatus{
+				PodIP: "fd00::1",
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			pod := &corev1.Pod{Status: tc.status}
+			tassert.Equal(t, tc.expected, HasIPv6PodIP(pod))
+		})
+	}
+}
+
 func TestUpdateStatus(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -20,6 +20,8 @@ import (
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/cache"
+	gatewayAPIClientset "sigs.k8s.io/gateway-api/pkg/client/clientset/versioned"
+	gatewayAPIInformers "sigs.k8s.io/gateway-api/pkg/client/informers/externalversions"
 
 	"github.com/openservicemesh/osm/pkg/constants"
 	configClientset "github.com/openservicemesh/osm/pkg/gen/client/config/clientset/versioned"
@@ -78,6 +80,8 @@ func WithKubeClient(kubeClient kubernetes.Interface) InformerCollectionOption {
 		ic.informers[InformerKeyServiceAccount] = v1api.ServiceAccounts().Informer()
 		ic.informers[InformerKeyPod] = v1api.Pods().Informer()
 		ic.informers[InformerKeyEndpoints] = v1api.Endpoints().Informer()
+		// Only the secrets labeled as monitored by the mesh are cached, instead of all the secrets in the cluster
+		ic.informers[InformerKeySecret] = nsInformerFactory.Core().V1().Secrets().Informer()
 	}
 }
 
@@ -95,6 +99,26 @@ func WithSMIClients(smiTrafficSplitClient smiTrafficSplitClient.Interface, smiTr
 	}
 }
 
+// WithGatewayAPIClient sets the Gateway API client for the InformerCollection.
+// Informers are only created for the given route kinds, since the Gateway API
+// CRDs are not installed by OSM and may be partially installed in the cluster.
+func WithGatewayAPIClient(gatewayAPIClient gatewayAPIClientset.Interface, routeKeys ...InformerKey) InformerCollectionOption {
+	return func(ic *InformerCollection) {
+		informerFactory := gatewayAPIInformers.NewSharedInformerFactory(gatewayAPIClient, DefaultKubeEventResyncInterval)
+
+		for _, key := range routeKeys {
+			switch key {
+			case InformerKeyGatewayAPIHTTPRoute:
+				ic.informers[key] = informerFactory.Gateway().V1beta1().HTTPRoutes().Informer()
+			case InformerKeyGatewayAPIGRPCRoute:
+				ic.informers[key] = informerFactory.Gateway().V1alpha2().GRPCRoutes().Informer()
+			case InformerKeyGatewayAPITCPRoute:
+				ic.informers[key] = informerFactory.Gateway().V1alpha2().TCPRoutes().Informer()
+			}
+		}
+	}
+}
+
 // WithConfigClient sets the config client for the InformerCollection
 func WithConfigClient(configClient configClientset.Interface, meshConfigName, osmNamespace string) InformerCollectionOption {
 	return func(ic *InformerCollection) {
@@ -103,9 +127,12 @@ func WithConfigClient(configClient configClientset.Interface, meshConfigName, os
 		})
 		meshConfiginformerFactory := configInformers.NewSharedInformerFactoryWithOptions(configClient, DefaultKubeEventResyncInterval, configInformers.WithNamespace(osmNamespace), listOption)
 		mrcInformerFactory := configInformers.NewSharedInformerFactoryWithOptions(configClient, DefaultKubeEventResyncInterval, configInformers.WithNamespace(osmNamespace))
+		overrideInformerFactory := configInformers.NewSharedInformerFactory(configClient, DefaultKubeEventResyncInterval)
 
 		ic.informers[InformerKeyMeshConfig] = meshConfiginformerFactory.Config().V1alpha2().MeshConfigs().Informer()
 		ic.informers[InformerKeyMeshRootCertificate] = mrcInformerFactory.Config().V1alpha2().MeshRootCertificates().Informer()
+		ic.informers[InformerKeyMeshConfigOverride] = overrideInformerFactory.Config().V1alpha2().MeshConfigOverrides().Informer()
+		ic.informers[InformerKeyCertificateRevocation] = mrcInformerFactory.Config().V1alpha2().CertificateRevocations().Informer()
 	}
 }
 
@@ -116,9 +143,14 @@ func WithPolicyClient(policyClient policyClientset.Interface) InformerCollection
 
 		ic.informers[InformerKeyEgress] = informerFactory.Policy().V1alpha1().Egresses().Informer()
 		ic.informers[InformerKeyEgressGateway] = informerFactory.Policy().V1alpha1().EgressGateways().Informer()
+		ic.informers[InformerKeyExternalService] = informerFactory.Policy().V1alpha1().ExternalServices().Informer()
 		ic.informers[InformerKeyIngressBackend] = informerFactory.Policy().V1alpha1().IngressBackends().Informer()
 		ic.informers[InformerKeyUpstreamTrafficSetting] = informerFactory.Policy().V1alpha1().UpstreamTrafficSettings().Informer()
 		ic.informers[InformerKeyRetry] = informerFactory.Policy().V1alpha1().Retries().Informer()
+		ic.informers[InformerKeyFaultInjection] = informerFactory.Policy().V1alpha1().FaultInjections().Informer()
+		ic.informers[InformerKeyTrafficMirror] = informerFactory.Policy().V1alpha1().TrafficMirrors().Informer()
+		ic.informers[InformerKeyRequestAuthentication] = informerFactory.Policy().V1alpha1().RequestAuthentications().Informer()
+		ic.informers[InformerKeyAuthorizationPolicy] = informerFactory.Policy().V1alpha1().AuthorizationPolicies().Informer()
 		ic.informers[InformerKeyAccessControl] = informerFactory.Policy().V1alpha1().AccessControls().Informer()
 		ic.informers[InformerKeyAccessCert] = informerFactory.Policy().V1alpha1().AccessCerts().Informer()
 	}
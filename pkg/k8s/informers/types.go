@@ -21,6 +21,8 @@ const (
 	InformerKeyEndpoints InformerKey = "Endpoints"
 	// InformerKeyServiceAccount is the InformerKey for a ServiceAccount informer
 	InformerKeyServiceAccount InformerKey = "ServiceAccount"
+	// InformerKeySecret is the InformerKey for a Secret informer
+	InformerKeySecret InformerKey = "Secret"
 
 	// InformerKeyTrafficSplit is the InformerKey for a TrafficSplit informer
 	InformerKeyTrafficSplit InformerKey = "TrafficSplit"
@@ -31,21 +33,42 @@ const (
 	// InformerKeyTCPRoute is the InformerKey for a TCPRoute informer
 	InformerKeyTCPRoute InformerKey = "TCPRoute"
 
+	// InformerKeyGatewayAPIHTTPRoute is the InformerKey for a Gateway API HTTPRoute informer
+	InformerKeyGatewayAPIHTTPRoute InformerKey = "GatewayAPIHTTPRoute"
+	// InformerKeyGatewayAPIGRPCRoute is the InformerKey for a Gateway API GRPCRoute informer
+	InformerKeyGatewayAPIGRPCRoute InformerKey = "GatewayAPIGRPCRoute"
+	// InformerKeyGatewayAPITCPRoute is the InformerKey for a Gateway API TCPRoute informer
+	InformerKeyGatewayAPITCPRoute InformerKey = "GatewayAPITCPRoute"
+
 	// InformerKeyMeshConfig is the InformerKey for a MeshConfig informer
 	InformerKeyMeshConfig InformerKey = "MeshConfig"
 	// InformerKeyMeshRootCertificate is the InformerKey for a MeshRootCertificate informer
 	InformerKeyMeshRootCertificate InformerKey = "MeshRootCertificate"
+	// InformerKeyMeshConfigOverride is the InformerKey for a MeshConfigOverride informer
+	InformerKeyMeshConfigOverride InformerKey = "MeshConfigOverride"
+	// InformerKeyCertificateRevocation is the InformerKey for a CertificateRevocation informer
+	InformerKeyCertificateRevocation InformerKey = "CertificateRevocation"
 
 	// InformerKeyEgress is the InformerKey for an Egress informer
 	InformerKeyEgress InformerKey = "Egress"
 	// InformerKeyEgressGateway is the InformerKey for an EgressGateway informer
 	InformerKeyEgressGateway InformerKey = "EgressGateway"
+	// InformerKeyExternalService is the InformerKey for an ExternalService informer
+	InformerKeyExternalService InformerKey = "ExternalService"
 	// InformerKeyIngressBackend is the InformerKey for a IngressBackend informer
 	InformerKeyIngressBackend InformerKey = "IngressBackend"
 	// InformerKeyUpstreamTrafficSetting is the InformerKey for a UpstreamTrafficSetting informer
 	InformerKeyUpstreamTrafficSetting InformerKey = "UpstreamTrafficSetting"
 	// InformerKeyRetry is the InformerKey for a Retry informer
 	InformerKeyRetry InformerKey = "Retry"
+	// InformerKeyFaultInjection is the InformerKey for a FaultInjection informer
+	InformerKeyFaultInjection InformerKey = "FaultInjection"
+	// InformerKeyTrafficMirror is the InformerKey for a TrafficMirror informer
+	InformerKeyTrafficMirror InformerKey = "TrafficMirror"
+	// InformerKeyRequestAuthentication is the InformerKey for a RequestAuthentication informer
+	InformerKeyRequestAuthentication InformerKey = "RequestAuthentication"
+	// InformerKeyAuthorizationPolicy is the InformerKey for an AuthorizationPolicy informer
+	InformerKeyAuthorizationPolicy InformerKey = "AuthorizationPolicy"
 	// InformerKeyAccessControl is the InformerKey for a AccessControl informer
 	InformerKeyAccessControl InformerKey = "AccessControl"
 	// InformerKeyAccessCert is the InformerKey for a AccessCert informer
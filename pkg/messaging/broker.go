@@ -7,11 +7,14 @@ import (
 	"time"
 
 	"github.com/cskr/pubsub"
+	mapset "github.com/deckarep/golang-set"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/workqueue"
 
 	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 
 	"github.com/openservicemesh/osm/pkg/announcements"
 	"github.com/openservicemesh/osm/pkg/constants"
@@ -37,6 +40,7 @@ func NewBroker(stopCh <-chan struct{}) *Broker {
 		proxyUpdateCh:     make(chan proxyUpdateEvent),
 		kubeEventPubSub:   pubsub.New(10240),
 		certPubSub:        pubsub.New(10240),
+		dependencies:      newDependencyIndex(),
 	}
 
 	go b.runWorkqueueProcessor(stopCh)
@@ -136,6 +140,12 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 	dispatchPending := false
 	batchCount := 0 // number of proxy update events batched per dispatch
 
+	// broadcastPending indicates whether one of the pending events must update
+	// all the proxies. When it is not set, the pending events only update the
+	// proxies depending on the resources in 'pendingDependencies'.
+	broadcastPending := false
+	pendingDependencies := mapset.NewSet()
+
 	var event proxyUpdateEvent
 	for {
 		select {
@@ -145,6 +155,12 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				return
 			}
 			event = e
+			if len(e.dependencies) == 0 {
+				broadcastPending = true
+			}
+			for _, dependency := range e.dependencies {
+				pendingDependencies.Add(dependency)
+			}
 
 			if !dispatchPending {
 				// No proxy update events are pending send on the pub-sub.
@@ -179,12 +195,16 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				<-maxTimer.C
 			}
 			maxTimer.Reset(noTimeout)
-			b.proxyUpdatePubSub.Pub(event.msg, event.topic)
-			atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
-			metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount.Inc()
+			if broadcastPending {
+				b.dispatchProxyUpdate(event)
+			} else {
+				b.dispatchTargetedProxyUpdate(pendingDependencies)
+			}
 			log.Trace().Msgf("Sliding window expired, msg kind %s, batch size %d", event.msg.Kind, batchCount)
 			dispatchPending = false
 			batchCount = 0
+			broadcastPending = false
+			pendingDependencies = mapset.NewSet()
 
 		case <-maxTimer.C:
 			maxTimer.Reset(noTimeout) // 'maxTimer' drained in this case statement
@@ -194,12 +214,16 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 				<-slidingTimer.C
 			}
 			slidingTimer.Reset(noTimeout)
-			b.proxyUpdatePubSub.Pub(event.msg, event.topic)
-			atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
-			metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount.Inc()
+			if broadcastPending {
+				b.dispatchProxyUpdate(event)
+			} else {
+				b.dispatchTargetedProxyUpdate(pendingDependencies)
+			}
 			log.Trace().Msgf("Max window expired, msg kind %s, batch size %d", event.msg.Kind, batchCount)
 			dispatchPending = false
 			batchCount = 0
+			broadcastPending = false
+			pendingDependencies = mapset.NewSet()
 
 		case <-stopCh:
 			log.Info().Msg("Proxy update dispatcher received stop signal, exiting")
@@ -208,6 +232,54 @@ func (b *Broker) runProxyUpdateDispatcher(stopCh <-chan struct{}) {
 	}
 }
 
+// dispatchProxyUpdate publishes the given proxy update event to all the proxies subscribed to its topic
+func (b *Broker) dispatchProxyUpdate(event proxyUpdateEvent) {
+	b.proxyUpdatePubSub.Pub(event.msg, event.topic)
+	atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
+	metricsstore.DefaultMetricsStore.ProxyBroadcastEventCount.Inc()
+	metricsstore.DefaultMetricsStore.ProxyUpdateFanOutRatio.Observe(1)
+}
+
+// dispatchTargetedProxyUpdate publishes a proxy update event to the proxies whose configuration
+// depends on any of the given dependencies, on the topics specific to these proxies.
+// The event is also published on the ProxyTargetedUpdate topic with the UUIDs of these proxies,
+// for the subscribers updating proxies without subscribing to the topics specific to each proxy.
+func (b *Broker) dispatchTargetedProxyUpdate(dependencies mapset.Set) {
+	proxies := b.dependencies.listProxies(dependencies)
+	if indexedProxyCount := b.dependencies.proxyCount(); indexedProxyCount > 0 {
+		metricsstore.DefaultMetricsStore.ProxyUpdateFanOutRatio.Observe(float64(proxies.Cardinality()) / float64(indexedProxyCount))
+	}
+	if proxies.Cardinality() == 0 {
+		log.Trace().Msgf("No proxy depends on %v, skipping dispatch", dependencies)
+		return
+	}
+
+	msg := events.PubSubMessage{
+		Kind:   announcements.ProxyTargetedUpdate,
+		NewObj: proxies,
+	}
+	topics := []string{announcements.ProxyTargetedUpdate.String()}
+	for proxyUUID := range proxies.Iter() {
+		topics = append(topics, GetPubSubTopicForProxyUUID(proxyUUID.(string)))
+	}
+
+	b.proxyUpdatePubSub.Pub(msg, topics...)
+	atomic.AddUint64(&b.totalDispatchedProxyEventCount, 1)
+	metricsstore.DefaultMetricsStore.ProxyTargetedEventCount.Inc()
+	log.Trace().Msgf("Dispatched targeted update for %v to %d proxies", dependencies, proxies.Cardinality())
+}
+
+// GetProxyUpdateTargets returns the UUIDs of the proxies to update for the given message published on the
+// ProxyTargetedUpdate topic. Nil is returned if the message must update all the proxies.
+func GetProxyUpdateTargets(msg interface{}) mapset.Set {
+	pubSubMsg, ok := msg.(events.PubSubMessage)
+	if !ok || pubSubMsg.Kind != announcements.ProxyTargetedUpdate {
+		return nil
+	}
+	proxies, _ := pubSubMsg.NewObj.(mapset.Set)
+	return proxies
+}
+
 // processEvent processes an event dispatched from the workqueue.
 // It does the following:
 // 1. If the event must update a proxy, it publishes a proxy update message
@@ -231,6 +303,15 @@ func (b *Broker) processEvent(msg events.PubSubMessage) {
 		}
 	}
 
+	// The dependencies of deleted proxies are no longer needed to determine the proxies to update
+	if msg.Kind == announcements.PodDeleted {
+		if pod, ok := msg.OldObj.(*corev1.Pod); ok {
+			if proxyUUID := pod.Labels[constants.SidecarUniqueIDLabelName]; len(proxyUUID) > 0 {
+				b.dependencies.remove(proxyUUID)
+			}
+		}
+	}
+
 	// Publish event to other interested clients, e.g. log level changes, debug server on/off etc.
 	b.kubeEventPubSub.Pub(msg, msg.Kind.String())
 
@@ -269,7 +350,7 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		// K8s native resource events
 		//
 		// Endpoint event
-		announcements.EndpointAdded, announcements.EndpointDeleted, announcements.EndpointUpdated,
+		announcements.EndpointAdded,
 		// k8s Ingress event
 		announcements.IngressAdded, announcements.IngressDeleted, announcements.IngressUpdated,
 		// k8s IngressClass event
@@ -281,14 +362,28 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		announcements.EgressAdded, announcements.EgressDeleted, announcements.EgressUpdated,
 		// EgressGateway event
 		announcements.EgressGatewayAdded, announcements.EgressGatewayDeleted, announcements.EgressGatewayUpdated,
+		// ExternalService event
+		announcements.ExternalServiceAdded,
 		// IngressBackend event
 		announcements.IngressBackendAdded, announcements.IngressBackendDeleted, announcements.IngressBackendUpdated,
 		// AccessControl event
 		announcements.AccessControlAdded, announcements.AccessControlDeleted, announcements.AccessControlUpdated,
 		// Retry event
 		announcements.RetryPolicyAdded, announcements.RetryPolicyDeleted, announcements.RetryPolicyUpdated,
+		// FaultInjection event
+		announcements.FaultInjectionAdded, announcements.FaultInjectionDeleted, announcements.FaultInjectionUpdated,
+		// TrafficMirror event
+		announcements.TrafficMirrorAdded, announcements.TrafficMirrorDeleted, announcements.TrafficMirrorUpdated,
+		// RequestAuthentication event
+		announcements.RequestAuthenticationAdded, announcements.RequestAuthenticationDeleted, announcements.RequestAuthenticationUpdated,
+		// AuthorizationPolicy event
+		announcements.AuthorizationPolicyAdded, announcements.AuthorizationPolicyDeleted, announcements.AuthorizationPolicyUpdated,
 		// UpstreamTrafficSetting event
-		announcements.UpstreamTrafficSettingAdded, announcements.UpstreamTrafficSettingDeleted, announcements.UpstreamTrafficSettingUpdated,
+		announcements.UpstreamTrafficSettingAdded,
+		// MeshConfigOverride event
+		announcements.MeshConfigOverrideAdded, announcements.MeshConfigOverrideDeleted, announcements.MeshConfigOverrideUpdated,
+		// CertificateRevocation event
+		announcements.CertificateRevocationAdded, announcements.CertificateRevocationDeleted, announcements.CertificateRevocationUpdated,
 		//
 		// SMI resource events
 		//
@@ -301,6 +396,15 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 		// SMI TrafficTarget event
 		announcements.TrafficTargetAdded, announcements.TrafficTargetDeleted, announcements.TrafficTargetUpdated,
 		//
+		// Gateway API resource events
+		//
+		// Gateway API HTTPRoute event
+		announcements.GatewayAPIHTTPRouteAdded, announcements.GatewayAPIHTTPRouteDeleted, announcements.GatewayAPIHTTPRouteUpdated,
+		// Gateway API GRPCRoute event
+		announcements.GatewayAPIGRPCRouteAdded, announcements.GatewayAPIGRPCRouteDeleted, announcements.GatewayAPIGRPCRouteUpdated,
+		// Gateway API TCPRoute event
+		announcements.GatewayAPITCPRouteAdded, announcements.GatewayAPITCPRouteDeleted, announcements.GatewayAPITCPRouteUpdated,
+		//
 		// MultiCluster events
 		//
 		// ServiceImport event
@@ -327,6 +431,34 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 			topic: announcements.ProxyUpdate.String(),
 		}
 
+	case announcements.EndpointUpdated, announcements.EndpointDeleted:
+		// Changes to the endpoints of an existing service only affect the proxies depending on the service
+		return getTargetedProxyUpdateEvent(msg, ServiceDependency)
+
+	case announcements.ExternalServiceUpdated, announcements.ExternalServiceDeleted:
+		// Changes to the endpoints of an existing external service only affect the proxies depending on it
+		return getTargetedProxyUpdateEvent(msg, ServiceDependency)
+
+	case announcements.SecretAdded, announcements.SecretUpdated, announcements.SecretDeleted:
+		// Secrets are referenced by name, so the proxies depending on a secret are known even before it is added
+		return getTargetedProxyUpdateEvent(msg, SecretDependency)
+
+	case announcements.UpstreamTrafficSettingUpdated:
+		// The host of an UpstreamTrafficSetting determines the services it applies to, so a change
+		// to the host may apply it to services the proxies did not previously depend on it for
+		prevUpstreamTrafficSetting, okPrevCast := msg.OldObj.(*policyv1alpha1.UpstreamTrafficSetting)
+		newUpstreamTrafficSetting, okNewCast := msg.NewObj.(*policyv1alpha1.UpstreamTrafficSetting)
+		if okPrevCast && okNewCast && prevUpstreamTrafficSetting.Spec.Host == newUpstreamTrafficSetting.Spec.Host {
+			return getTargetedProxyUpdateEvent(msg, UpstreamTrafficSettingDependency)
+		}
+		return &proxyUpdateEvent{
+			msg:   msg,
+			topic: announcements.ProxyUpdate.String(),
+		}
+
+	case announcements.UpstreamTrafficSettingDeleted:
+		return getTargetedProxyUpdateEvent(msg, UpstreamTrafficSettingDependency)
+
 	case announcements.MeshConfigUpdated:
 		prevMeshConfig, okPrevCast := msg.OldObj.(*configv1alpha2.MeshConfig)
 		newMeshConfig, okNewCast := msg.NewObj.(*configv1alpha2.MeshConfig)
@@ -343,8 +475,10 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 			prevSpec.Traffic.EnablePermissiveTrafficPolicyMode != newSpec.Traffic.EnablePermissiveTrafficPolicyMode ||
 			prevSpec.Traffic.HTTP1PerRequestLoadBalancing != newSpec.Traffic.HTTP1PerRequestLoadBalancing ||
 			prevSpec.Traffic.HTTP2PerRequestLoadBalancing != newSpec.Traffic.HTTP2PerRequestLoadBalancing ||
+			!reflect.DeepEqual(prevSpec.Traffic.UDPInterception, newSpec.Traffic.UDPInterception) ||
 			prevSpec.Observability.Tracing != newSpec.Observability.Tracing ||
 			prevSpec.Observability.RemoteLogging != newSpec.Observability.RemoteLogging ||
+			!reflect.DeepEqual(prevSpec.Observability.AccessLogging, newSpec.Observability.AccessLogging) ||
 			prevSpec.Sidecar.LogLevel != newSpec.Sidecar.LogLevel ||
 			prevSpec.Sidecar.SidecarTimeout != newSpec.Sidecar.SidecarTimeout ||
 			prevSpec.Traffic.InboundExternalAuthorization.Enable != newSpec.Traffic.InboundExternalAuthorization.Enable ||
@@ -389,6 +523,25 @@ func getProxyUpdateEvent(msg events.PubSubMessage) *proxyUpdateEvent {
 	}
 }
 
+// getTargetedProxyUpdateEvent returns a proxyUpdateEvent that only updates the proxies depending on the
+// resource of the given kind in the given PubSubMessage. The event updates all the proxies if the
+// resource cannot be determined.
+func getTargetedProxyUpdateEvent(msg events.PubSubMessage, kind ProxyDependencyKind) *proxyUpdateEvent {
+	event := &proxyUpdateEvent{
+		msg:   msg,
+		topic: announcements.ProxyUpdate.String(),
+	}
+
+	obj := msg.NewObj
+	if obj == nil {
+		obj = msg.OldObj
+	}
+	if resource, ok := obj.(metav1.Object); ok {
+		event.dependencies = []ProxyDependency{NewProxyDependency(kind, resource.GetNamespace(), resource.GetName())}
+	}
+	return event
+}
+
 // GetPubSubTopicForProxyUUID returns the topic on which PubSubMessages specific to a proxy UUID are published
 func GetPubSubTopicForProxyUUID(uuid string) string {
 	return fmt.Sprintf("proxy:%s", uuid)
@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	mapset "github.com/deckarep/golang-set"
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/openservicemesh/osm/pkg/announcements"
 	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/k8s/events"
 	"github.com/openservicemesh/osm/pkg/metricsstore"
@@ -178,10 +180,11 @@ func TestAllEvents(t *testing.T) {
 
 func TestGetProxyUpdateEvent(t *testing.T) {
 	testCases := []struct {
-		name          string
-		msg           events.PubSubMessage
-		expectEvent   bool
-		expectedTopic string
+		name                 string
+		msg                  events.PubSubMessage
+		expectEvent          bool
+		expectedTopic        string
+		expectedDependencies []ProxyDependency
 	}{
 		{
 			name: "egress event",
@@ -272,6 +275,175 @@ func TestGetProxyUpdateEvent(t *testing.T) {
 			expectEvent:   true,
 			expectedTopic: announcements.ProxyUpdate.String(),
 		},
+		{
+			name: "MeshConfig update with access logging results in proxy update",
+			msg: events.PubSubMessage{
+				Kind: announcements.MeshConfigUpdated,
+				OldObj: &configv1alpha2.MeshConfig{
+					Spec: configv1alpha2.MeshConfigSpec{
+						Observability: configv1alpha2.ObservabilitySpec{
+							AccessLogging: configv1alpha2.AccessLoggingSpec{
+								Format: configv1alpha2.AccessLogFormatJSON,
+							},
+						},
+					},
+				},
+				NewObj: &configv1alpha2.MeshConfig{
+					Spec: configv1alpha2.MeshConfigSpec{
+						Observability: configv1alpha2.ObservabilitySpec{
+							AccessLogging: configv1alpha2.AccessLoggingSpec{
+								Format: configv1alpha2.AccessLogFormatText,
+							},
+						},
+					},
+				},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "MeshConfig update with UDP interception results in proxy update",
+			msg: events.PubSubMessage{
+				Kind: announcements.MeshConfigUpdated,
+				OldObj: &configv1alpha2.MeshConfig{
+					Spec: configv1alpha2.MeshConfigSpec{
+						Traffic: configv1alpha2.TrafficSpec{
+							UDPInterception: configv1alpha2.UDPInterceptionSpec{
+								Enable: true,
+							},
+						},
+					},
+				},
+				NewObj: &configv1alpha2.MeshConfig{
+					Spec: configv1alpha2.MeshConfigSpec{
+						Traffic: configv1alpha2.TrafficSpec{
+							UDPInterception: configv1alpha2.UDPInterceptionSpec{
+								Enable: true,
+								PortPolicies: []configv1alpha2.UDPPortPolicySpec{
+									{Port: 53, Action: configv1alpha2.UDPPortActionAllow},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "Endpoints update event only updates the proxies depending on the service or the mesh endpoints",
+			msg: events.PubSubMessage{
+				Kind:   announcements.EndpointUpdated,
+				OldObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+				NewObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")},
+		},
+		{
+			name: "Endpoints delete event only updates the proxies depending on the service or the mesh endpoints",
+			msg: events.PubSubMessage{
+				Kind:   announcements.EndpointDeleted,
+				OldObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")},
+		},
+		{
+			name: "Endpoints add event updates all the proxies",
+			msg: events.PubSubMessage{
+				Kind:   announcements.EndpointAdded,
+				NewObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "Endpoints update event with unexpected object type updates all the proxies",
+			msg: events.PubSubMessage{
+				Kind:   announcements.EndpointUpdated,
+				OldObj: "unexpected-type",
+				NewObj: "unexpected-type",
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "UpstreamTrafficSetting update event with the same host only updates the proxies depending on it",
+			msg: events.PubSubMessage{
+				Kind: announcements.UpstreamTrafficSettingUpdated,
+				OldObj: &policyv1alpha1.UpstreamTrafficSetting{
+					ObjectMeta: metav1.ObjectMeta{Name: "uts", Namespace: "ns"},
+					Spec:       policyv1alpha1.UpstreamTrafficSettingSpec{Host: "bookstore.ns.svc.cluster.local"},
+				},
+				NewObj: &policyv1alpha1.UpstreamTrafficSetting{
+					ObjectMeta: metav1.ObjectMeta{Name: "uts", Namespace: "ns"},
+					Spec:       policyv1alpha1.UpstreamTrafficSettingSpec{Host: "bookstore.ns.svc.cluster.local"},
+				},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(UpstreamTrafficSettingDependency, "ns", "uts")},
+		},
+		{
+			name: "UpstreamTrafficSetting update event with a different host updates all the proxies",
+			msg: events.PubSubMessage{
+				Kind: announcements.UpstreamTrafficSettingUpdated,
+				OldObj: &policyv1alpha1.UpstreamTrafficSetting{
+					ObjectMeta: metav1.ObjectMeta{Name: "uts", Namespace: "ns"},
+					Spec:       policyv1alpha1.UpstreamTrafficSettingSpec{Host: "bookstore.ns.svc.cluster.local"},
+				},
+				NewObj: &policyv1alpha1.UpstreamTrafficSetting{
+					ObjectMeta: metav1.ObjectMeta{Name: "uts", Namespace: "ns"},
+					Spec:       policyv1alpha1.UpstreamTrafficSettingSpec{Host: "bookbuyer.ns.svc.cluster.local"},
+				},
+			},
+			expectEvent:   true,
+			expectedTopic: announcements.ProxyUpdate.String(),
+		},
+		{
+			name: "ExternalService delete event only updates the proxies depending on the service",
+			msg: events.PubSubMessage{
+				Kind:   announcements.ExternalServiceDeleted,
+				OldObj: &policyv1alpha1.ExternalService{ObjectMeta: metav1.ObjectMeta{Name: "httpbin", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "httpbin")},
+		},
+		{
+			name: "Secret add event only updates the proxies depending on the secret",
+			msg: events.PubSubMessage{
+				Kind:   announcements.SecretAdded,
+				NewObj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(SecretDependency, "ns", "ca")},
+		},
+		{
+			name: "Secret update event only updates the proxies depending on the secret",
+			msg: events.PubSubMessage{
+				Kind:   announcements.SecretUpdated,
+				OldObj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"}},
+				NewObj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(SecretDependency, "ns", "ca")},
+		},
+		{
+			name: "Secret delete event only updates the proxies depending on the secret",
+			msg: events.PubSubMessage{
+				Kind:   announcements.SecretDeleted,
+				OldObj: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "ca", Namespace: "ns"}},
+			},
+			expectEvent:          true,
+			expectedTopic:        announcements.ProxyUpdate.String(),
+			expectedDependencies: []ProxyDependency{NewProxyDependency(SecretDependency, "ns", "ca")},
+		},
 		{
 			name: "Namespace event",
 			msg: events.PubSubMessage{
@@ -352,6 +524,7 @@ func TestGetProxyUpdateEvent(t *testing.T) {
 			a.Equal(tc.expectEvent, actual != nil)
 			if tc.expectEvent {
 				a.Equal(tc.expectedTopic, actual.topic)
+				a.Equal(tc.expectedDependencies, actual.dependencies)
 			}
 		})
 	}
@@ -404,6 +577,101 @@ func TestRunProxyUpdateDispatcher(t *testing.T) {
 	a.EqualValues(b.GetTotalDispatchedProxyEventCount(), 2) // 1 carried over from sliding window test
 }
 
+func TestRunProxyUpdateDispatcherTargetedUpdate(t *testing.T) {
+	a := assert.New(t)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	b := NewBroker(stopCh) // this starts runProxyUpdateDispatcher() in a goroutine
+	b.SetProxyDependencies("proxy-1", []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")})
+	b.SetProxyDependencies("proxy-2", []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookbuyer")})
+
+	broadcastChan := b.GetProxyUpdatePubSub().Sub(announcements.ProxyUpdate.String())
+	defer b.Unsub(b.proxyUpdatePubSub, broadcastChan)
+	proxy1Chan := b.GetProxyUpdatePubSub().Sub(GetPubSubTopicForProxyUUID("proxy-1"))
+	defer b.Unsub(b.proxyUpdatePubSub, proxy1Chan)
+	proxy2Chan := b.GetProxyUpdatePubSub().Sub(GetPubSubTopicForProxyUUID("proxy-2"))
+	defer b.Unsub(b.proxyUpdatePubSub, proxy2Chan)
+	targetedChan := b.GetProxyUpdatePubSub().Sub(announcements.ProxyTargetedUpdate.String())
+	defer b.Unsub(b.proxyUpdatePubSub, targetedChan)
+
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:          events.PubSubMessage{Kind: announcements.EndpointUpdated},
+		topic:        announcements.ProxyUpdate.String(),
+		dependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")},
+	}
+
+	// Only the proxy depending on the service is updated
+	<-proxy1Chan
+	targets := GetProxyUpdateTargets(<-targetedChan)
+	a.True(targets.Equal(mapset.NewSet("proxy-1")))
+	a.Len(proxy2Chan, 0)
+	a.Len(broadcastChan, 0)
+	a.EqualValues(1, b.GetTotalDispatchedProxyEventCount())
+
+	// Events without dependencies batched with targeted events update all the proxies
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:          events.PubSubMessage{Kind: announcements.EndpointUpdated},
+		topic:        announcements.ProxyUpdate.String(),
+		dependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")},
+	}
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:   events.PubSubMessage{Kind: announcements.EgressAdded},
+		topic: announcements.ProxyUpdate.String(),
+	}
+
+	msg := <-broadcastChan
+	a.Nil(GetProxyUpdateTargets(msg))
+	a.Len(proxy1Chan, 0)
+	a.Len(targetedChan, 0)
+	a.EqualValues(2, b.GetTotalDispatchedProxyEventCount())
+}
+
+func TestRunProxyUpdateDispatcherTargetedUpdateUnindexedProxy(t *testing.T) {
+	a := assert.New(t)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	b := NewBroker(stopCh) // this starts runProxyUpdateDispatcher() in a goroutine
+	b.SetProxyDependencies("proxy-1", []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookbuyer")})
+	b.AddProxy("proxy-1")
+	// proxy-2 is connected but its dependencies were never recorded
+	b.AddProxy("proxy-2")
+
+	proxy1Chan := b.GetProxyUpdatePubSub().Sub(GetPubSubTopicForProxyUUID("proxy-1"))
+	defer b.Unsub(b.proxyUpdatePubSub, proxy1Chan)
+	proxy2Chan := b.GetProxyUpdatePubSub().Sub(GetPubSubTopicForProxyUUID("proxy-2"))
+	defer b.Unsub(b.proxyUpdatePubSub, proxy2Chan)
+	targetedChan := b.GetProxyUpdatePubSub().Sub(announcements.ProxyTargetedUpdate.String())
+	defer b.Unsub(b.proxyUpdatePubSub, targetedChan)
+
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:          events.PubSubMessage{Kind: announcements.EndpointUpdated},
+		topic:        announcements.ProxyUpdate.String(),
+		dependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookstore")},
+	}
+
+	// The proxy never indexed is updated, the proxy not depending on the service is not
+	<-proxy2Chan
+	targets := GetProxyUpdateTargets(<-targetedChan)
+	a.True(targets.Equal(mapset.NewSet("proxy-2")))
+	a.Len(proxy1Chan, 0)
+	a.True(b.HasProxyDependencies("proxy-1"))
+	a.False(b.HasProxyDependencies("proxy-2"))
+
+	// Once disconnected, the proxy is no longer updated
+	b.RemoveProxy("proxy-2")
+	b.proxyUpdateCh <- proxyUpdateEvent{
+		msg:          events.PubSubMessage{Kind: announcements.EndpointUpdated},
+		topic:        announcements.ProxyUpdate.String(),
+		dependencies: []ProxyDependency{NewProxyDependency(ServiceDependency, "ns", "bookbuyer")},
+	}
+	<-proxy1Chan
+	targets = GetProxyUpdateTargets(<-targetedChan)
+	a.True(targets.Equal(mapset.NewSet("proxy-1")))
+	a.Len(proxy2Chan, 0)
+}
+
 func TestGetPubSubTopicForProxyUUID(t *testing.T) {
 	a := assert.New(t)
 
@@ -0,0 +1,165 @@
+package messaging
+
+import (
+	mapset "github.com/deckarep/golang-set"
+)
+
+// The kinds of dependencies are those of the resources whose changes can update only some of the proxies.
+const (
+	// ServiceDependency is the kind of dependency on a service, which includes the endpoints of the service
+	ServiceDependency ProxyDependencyKind = "Service"
+
+	// UpstreamTrafficSettingDependency is the kind of dependency on an UpstreamTrafficSetting policy
+	UpstreamTrafficSettingDependency ProxyDependencyKind = "UpstreamTrafficSetting"
+
+	// SecretDependency is the kind of dependency on a secret, such as the certificates referenced by an Egress policy
+	SecretDependency ProxyDependencyKind = "Secret"
+)
+
+// NewProxyDependency returns a dependency on the resource of the given kind, namespace and name
+func NewProxyDependency(kind ProxyDependencyKind, namespace, name string) ProxyDependency {
+	return ProxyDependency{
+		Kind:      kind,
+		Namespace: namespace,
+		Name:      name,
+	}
+}
+
+// String returns the string representation of the dependency
+func (d ProxyDependency) String() string {
+	return string(d.Kind) + ":" + d.Namespace + "/" + d.Name
+}
+
+// SetProxyDependencies records the resources the configuration of the proxy with the given UUID depends on,
+// replacing the previously recorded dependencies of the proxy. The recorded dependencies determine the proxies
+// that are updated when one of these resources changes.
+func (b *Broker) SetProxyDependencies(proxyUUID string, dependencies []ProxyDependency) {
+	b.dependencies.set(proxyUUID, dependencies)
+}
+
+// RemoveProxyDependencies removes the dependencies recorded for the proxy with the given UUID.
+// While connected, the proxy is then updated on every targeted update until its dependencies are recorded again.
+func (b *Broker) RemoveProxyDependencies(proxyUUID string) {
+	b.dependencies.remove(proxyUUID)
+}
+
+// HasProxyDependencies returns true if the dependencies of the proxy with the given UUID are recorded.
+// A proxy without recorded dependencies must be updated on every targeted update.
+func (b *Broker) HasProxyDependencies(proxyUUID string) bool {
+	return b.dependencies.has(proxyUUID)
+}
+
+// AddProxy records the proxy with the given UUID as connected. Until its dependencies are recorded,
+// the proxy is updated on every targeted update.
+func (b *Broker) AddProxy(proxyUUID string) {
+	b.dependencies.connect(proxyUUID)
+}
+
+// RemoveProxy removes the proxy with the given UUID and its recorded dependencies once it disconnected
+func (b *Broker) RemoveProxy(proxyUUID string) {
+	b.dependencies.disconnect(proxyUUID)
+}
+
+func newDependencyIndex() *dependencyIndex {
+	return &dependencyIndex{
+		proxiesByDependency: make(map[ProxyDependency]map[string]struct{}),
+		dependenciesByProxy: make(map[string][]ProxyDependency),
+		connectedProxies:    make(map[string]struct{}),
+	}
+}
+
+// connect records the given proxy as connected
+func (idx *dependencyIndex) connect(proxyUUID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.connectedProxies[proxyUUID] = struct{}{}
+}
+
+// disconnect removes the given proxy and its recorded dependencies
+func (idx *dependencyIndex) disconnect(proxyUUID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(proxyUUID)
+	delete(idx.connectedProxies, proxyUUID)
+}
+
+// has returns true if the dependencies of the given proxy are recorded
+func (idx *dependencyIndex) has(proxyUUID string) bool {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	_, ok := idx.dependenciesByProxy[proxyUUID]
+	return ok
+}
+
+// set records the dependencies of the given proxy, replacing its previously recorded dependencies
+func (idx *dependencyIndex) set(proxyUUID string, dependencies []ProxyDependency) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(proxyUUID)
+	for _, dependency := range dependencies {
+		proxies, ok := idx.proxiesByDependency[dependency]
+		if !ok {
+			proxies = make(map[string]struct{})
+			idx.proxiesByDependency[dependency] = proxies
+		}
+		proxies[proxyUUID] = struct{}{}
+	}
+	idx.dependenciesByProxy[proxyUUID] = dependencies
+}
+
+// remove removes the dependencies recorded for the given proxy
+func (idx *dependencyIndex) remove(proxyUUID string) {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+
+	idx.removeLocked(proxyUUID)
+}
+
+func (idx *dependencyIndex) removeLocked(proxyUUID string) {
+	for _, dependency := range idx.dependenciesByProxy[proxyUUID] {
+		proxies := idx.proxiesByDependency[dependency]
+		delete(proxies, proxyUUID)
+		if len(proxies) == 0 {
+			delete(idx.proxiesByDependency, dependency)
+		}
+	}
+	delete(idx.dependenciesByProxy, proxyUUID)
+}
+
+// listProxies returns the UUIDs of the proxies depending on any of the given dependencies,
+// and of the connected proxies whose dependencies are not recorded
+func (idx *dependencyIndex) listProxies(dependencies mapset.Set) mapset.Set {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	proxies := mapset.NewSet()
+	for dependency := range dependencies.Iter() {
+		for proxyUUID := range idx.proxiesByDependency[dependency.(ProxyDependency)] {
+			proxies.Add(proxyUUID)
+		}
+	}
+	for proxyUUID := range idx.connectedProxies {
+		if _, ok := idx.dependenciesByProxy[proxyUUID]; !ok {
+			proxies.Add(proxyUUID)
+		}
+	}
+	return proxies
+}
+
+// proxyCount returns the number of proxies either connected or whose dependencies are recorded
+func (idx *dependencyIndex) proxyCount() int {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	count := len(idx.dependenciesByProxy)
+	for proxyUUID := range idx.connectedProxies {
+		if _, ok := idx.dependenciesByProxy[proxyUUID]; !ok {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,74 @@
+package messaging
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDependencyIndex(t *testing.T) {
+	a := assert.New(t)
+
+	bookstore := NewProxyDependency(ServiceDependency, "ns", "bookstore")
+	bookbuyer := NewProxyDependency(ServiceDependency, "ns", "bookbuyer")
+	uts := NewProxyDependency(UpstreamTrafficSettingDependency, "ns", "bookstore")
+
+	idx := newDependencyIndex()
+	idx.set("proxy-1", []ProxyDependency{bookstore, uts})
+	idx.set("proxy-2", []ProxyDependency{bookstore, bookbuyer})
+	a.Equal(2, idx.proxyCount())
+
+	a.True(idx.listProxies(mapset.NewSet(bookstore)).Equal(mapset.NewSet("proxy-1", "proxy-2")))
+	a.True(idx.listProxies(mapset.NewSet(uts)).Equal(mapset.NewSet("proxy-1")))
+	a.True(idx.listProxies(mapset.NewSet(uts, bookbuyer)).Equal(mapset.NewSet("proxy-1", "proxy-2")))
+	a.Equal(0, idx.listProxies(mapset.NewSet(NewProxyDependency(ServiceDependency, "ns", "unknown"))).Cardinality())
+
+	// Setting the dependencies of a proxy replaces its previous dependencies
+	idx.set("proxy-1", []ProxyDependency{bookbuyer})
+	a.Equal(0, idx.listProxies(mapset.NewSet(uts)).Cardinality())
+	a.True(idx.listProxies(mapset.NewSet(bookbuyer)).Equal(mapset.NewSet("proxy-1", "proxy-2")))
+
+	idx.remove("proxy-2")
+	a.Equal(1, idx.proxyCount())
+	a.True(idx.listProxies(mapset.NewSet(bookstore, bookbuyer)).Equal(mapset.NewSet("proxy-1")))
+	a.NotContains(idx.proxiesByDependency, bookstore)
+}
+
+func TestDependencyIndexConnectedProxies(t *testing.T) {
+	a := assert.New(t)
+
+	bookstore := NewProxyDependency(ServiceDependency, "ns", "bookstore")
+	bookbuyer := NewProxyDependency(ServiceDependency, "ns", "bookbuyer")
+
+	idx := newDependencyIndex()
+	idx.set("proxy-1", []ProxyDependency{bookstore})
+	idx.connect("proxy-1")
+	idx.connect("proxy-2")
+	a.Equal(2, idx.proxyCount())
+	a.True(idx.has("proxy-1"))
+	a.False(idx.has("proxy-2"))
+
+	// A connected proxy whose dependencies are not recorded depends on any resource
+	a.True(idx.listProxies(mapset.NewSet(bookstore)).Equal(mapset.NewSet("proxy-1", "proxy-2")))
+	a.True(idx.listProxies(mapset.NewSet(bookbuyer)).Equal(mapset.NewSet("proxy-2")))
+
+	idx.set("proxy-2", []ProxyDependency{bookbuyer})
+	a.True(idx.listProxies(mapset.NewSet(bookstore)).Equal(mapset.NewSet("proxy-1")))
+
+	// Removing the dependencies of a connected proxy makes it depend on any resource again
+	idx.remove("proxy-1")
+	a.True(idx.listProxies(mapset.NewSet(bookbuyer)).Equal(mapset.NewSet("proxy-1", "proxy-2")))
+
+	// A disconnected proxy is no longer updated
+	idx.disconnect("proxy-1")
+	idx.disconnect("proxy-2")
+	a.Equal(0, idx.proxyCount())
+	a.Equal(0, idx.listProxies(mapset.NewSet(bookstore, bookbuyer)).Cardinality())
+}
+
+func TestProxyDependencyString(t *testing.T) {
+	a := assert.New(t)
+
+	a.Equal("Service:ns/bookstore", NewProxyDependency(ServiceDependency, "ns", "bookstore").String())
+}
@@ -3,6 +3,8 @@
 package messaging
 
 import (
+	"sync"
+
 	"github.com/cskr/pubsub"
 	"k8s.io/client-go/util/workqueue"
 
@@ -21,6 +23,7 @@ type Broker struct {
 	proxyUpdateCh                  chan proxyUpdateEvent
 	kubeEventPubSub                *pubsub.PubSub
 	certPubSub                     *pubsub.PubSub
+	dependencies                   *dependencyIndex
 	totalQEventCount               uint64
 	totalQProxyEventCount          uint64
 	totalDispatchedProxyEventCount uint64
@@ -31,4 +34,29 @@ type Broker struct {
 type proxyUpdateEvent struct {
 	msg   events.PubSubMessage
 	topic string
+
+	// dependencies are the resources changed by the event. An event with dependencies
+	// only updates the proxies whose configuration depends on these resources, while
+	// an event without dependencies updates all the proxies subscribed to the topic.
+	dependencies []ProxyDependency
+}
+
+// ProxyDependencyKind is the kind of a resource the configuration of a proxy depends on
+type ProxyDependencyKind string
+
+// ProxyDependency is the type used to represent a resource the configuration of a proxy depends on
+type ProxyDependency struct {
+	Kind      ProxyDependencyKind
+	Namespace string
+	Name      string
+}
+
+// dependencyIndex indexes the proxies by the resources their configuration depends on.
+// The connected proxies whose dependencies are not recorded, because their configuration was not
+// generated yet or failed to be, are updated on every targeted update.
+type dependencyIndex struct {
+	mu                  sync.RWMutex
+	proxiesByDependency map[ProxyDependency]map[string]struct{}
+	dependenciesByProxy map[string][]ProxyDependency
+	connectedProxies    map[string]struct{}
 }
@@ -45,6 +45,13 @@ type MetricsStore struct {
 	// ProxyBroadcastEventCounter is the metric for the total number of ProxyBroadcast events published
 	ProxyBroadcastEventCount prometheus.Counter
 
+	// ProxyTargetedEventCount is the metric for the total number of proxy update events dispatched only to the
+	// proxies whose configuration depends on the changed resources
+	ProxyTargetedEventCount prometheus.Counter
+
+	// ProxyUpdateFanOutRatio is the histogram to track the ratio of proxies updated per proxy update event
+	ProxyUpdateFanOutRatio prometheus.Histogram
+
 	// ProxyResponseSendSuccessCount is the metric for the total number of successful responses sent to the proxies
 	ProxyResponseSendSuccessCount *prometheus.CounterVec
 
@@ -185,6 +192,21 @@ func init() {
 		Help:      "Represents the number of ProxyBroadcast events published by the OSM controller",
 	})
 
+	defaultMetricsStore.ProxyTargetedEventCount = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "proxy",
+		Name:      "targeted_event_count",
+		Help:      "Represents the number of proxy update events published by the OSM controller to the proxies depending on the changed resources",
+	})
+
+	defaultMetricsStore.ProxyUpdateFanOutRatio = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metricsRootNamespace,
+		Subsystem: "proxy",
+		Name:      "update_fan_out_ratio",
+		Buckets:   []float64{.01, .05, .1, .25, .5, .75, 1},
+		Help:      "Histogram to track the ratio of proxies updated by a proxy update event to the proxies known to the OSM controller",
+	})
+
 	defaultMetricsStore.ProxyXDSRequestCount = prometheus.NewCounterVec(prometheus.CounterOpts{
 		Namespace: metricsRootNamespace,
 		Subsystem: "proxy",
@@ -0,0 +1,253 @@
+package discovery
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+
+	multiclusterv1alpha1 "github.com/openservicemesh/osm/pkg/apis/multicluster/v1alpha1"
+	multiclusterClientset "github.com/openservicemesh/osm/pkg/gen/client/multicluster/clientset/versioned"
+	multiclusterInformers "github.com/openservicemesh/osm/pkg/gen/client/multicluster/informers/externalversions"
+	k8sInformers "github.com/openservicemesh/osm/pkg/k8s/informers"
+)
+
+var (
+	errMissingKubeconfig = errors.New("missing kubeconfig")
+)
+
+// NewController returns a multicluster discovery controller watching the cluster Secrets in the given namespace.
+// The clusterKey is the key of the local cluster, matched against the target clusters of the ServiceExport resources.
+// The clients of the peer clusters are created with NewRemoteClients if remoteClients is nil.
+func NewController(kubeClient kubernetes.Interface, multiclusterClient multiclusterClientset.Interface, osmNamespace, clusterKey string, remoteClients RemoteClientsFunc) *Controller {
+	if remoteClients == nil {
+		remoteClients = NewRemoteClients
+	}
+
+	c := &Controller{
+		kubeClient:         kubeClient,
+		multiclusterClient: multiclusterClient,
+		osmNamespace:       osmNamespace,
+		clusterKey:         clusterKey,
+		remoteClients:      remoteClients,
+		resyncInterval:     defaultResyncInterval,
+		queue:              make(chan struct{}, 1),
+		clusters:           make(map[string]*remoteCluster),
+	}
+
+	informerFactory := informers.NewSharedInformerFactoryWithOptions(kubeClient, k8sInformers.DefaultKubeEventResyncInterval,
+		informers.WithNamespace(osmNamespace),
+		informers.WithTweakListOptions(func(opt *metav1.ListOptions) {
+			opt.LabelSelector = ClusterSecretLabel
+		}))
+	c.secretInformer = informerFactory.Core().V1().Secrets().Informer()
+	_, _ = c.secretInformer.AddEventHandler(c.eventHandler())
+
+	// The ServiceImport resources managed by the controller are recreated or reverted if they are modified
+	multiclusterInformerFactory := multiclusterInformers.NewSharedInformerFactoryWithOptions(multiclusterClient, k8sInformers.DefaultKubeEventResyncInterval,
+		multiclusterInformers.WithTweakListOptions(func(opt *metav1.ListOptions) {
+			opt.LabelSelector = fmt.Sprintf("%s=%s", ManagedByLabel, ManagedByLabelValue)
+		}))
+	c.serviceImportInformer = multiclusterInformerFactory.Flomesh().V1alpha1().ServiceImports().Informer()
+	_, _ = c.serviceImportInformer.AddEventHandler(c.eventHandler())
+
+	return c
+}
+
+// NewRemoteClients returns the clients used to connect to a peer cluster given its kubeconfig
+func NewRemoteClients(kubeconfig []byte) (kubernetes.Interface, multiclusterClientset.Interface, error) {
+	config, err := clientcmd.RESTConfigFromKubeConfig(kubeconfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	kubeClient, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	multiclusterClient, err := multiclusterClientset.NewForConfig(config)
+	if err != nil {
+		return nil, nil, err
+	}
+	return kubeClient, multiclusterClient, nil
+}
+
+// Run runs the controller until the given channel is closed
+func (c *Controller) Run(stop <-chan struct{}) {
+	go c.secretInformer.Run(stop)
+	go c.serviceImportInformer.Run(stop)
+	if !cache.WaitForCacheSync(stop, c.secretInformer.HasSynced, c.serviceImportInformer.HasSynced) {
+		log.Error().Msg("Failed initial cache sync for the multicluster Secrets and ServiceImports informers")
+		return
+	}
+	log.Info().Msgf("Multicluster discovery controller started for cluster %s", c.clusterKey)
+
+	ticker := time.NewTicker(c.resyncInterval)
+	defer ticker.Stop()
+	defer c.stopClusters()
+
+	c.enqueue()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-c.queue:
+			c.sync()
+		case <-ticker.C:
+			c.sync()
+		}
+	}
+}
+
+// eventHandler returns the event handler queuing a synchronization on any change of the watched resources
+func (c *Controller) eventHandler() cache.ResourceEventHandlerFuncs {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(_ interface{}) { c.enqueue() },
+		UpdateFunc: func(_, _ interface{}) { c.enqueue() },
+		DeleteFunc: func(_ interface{}) { c.enqueue() },
+	}
+}
+
+// enqueue queues a synchronization, the synchronizations queued while another one is pending are coalesced
+func (c *Controller) enqueue() {
+	select {
+	case c.queue <- struct{}{}:
+	default:
+	}
+}
+
+// sync synchronizes the peer clusters with the cluster Secrets, then the ServiceImport resources
+// with the services exported by the peer clusters
+func (c *Controller) sync() {
+	c.syncClusters()
+	if err := c.syncServiceImports(); err != nil {
+		log.Error().Err(err).Msg("Error synchronizing the ServiceImport resources")
+	}
+}
+
+// syncClusters starts watching the peer clusters of the new cluster Secrets,
+// and stops watching the peer clusters of the updated or deleted ones
+func (c *Controller) syncClusters() {
+	secrets := make(map[string]*corev1.Secret)
+	for _, obj := range c.secretInformer.GetStore().List() {
+		secret := obj.(*corev1.Secret)
+		secrets[secret.Name] = secret
+	}
+
+	for name, cluster := range c.clusters {
+		if secret, ok := secrets[name]; ok &&
+			bytes.Equal(secret.Data[ClusterSecretKubeconfigKey], cluster.kubeconfig) && getClusterKey(secret) == cluster.key {
+			continue
+		}
+		log.Info().Msgf("Stopped watching peer cluster %s", cluster.key)
+		close(cluster.stop)
+		delete(c.clusters, name)
+	}
+
+	for name, secret := range secrets {
+		if _, ok := c.clusters[name]; ok {
+			continue
+		}
+		cluster, err := c.newRemoteCluster(secret)
+		if err != nil {
+			log.Error().Err(err).Msgf("Error watching the peer cluster configured by Secret %s/%s", secret.Namespace, secret.Name)
+			continue
+		}
+		log.Info().Msgf("Started watching peer cluster %s", cluster.key)
+		c.clusters[name] = cluster
+	}
+}
+
+// stopClusters stops watching all the peer clusters
+func (c *Controller) stopClusters() {
+	for name, cluster := range c.clusters {
+		close(cluster.stop)
+		delete(c.clusters, name)
+	}
+}
+
+// newRemoteCluster starts watching the resources of the peer cluster configured by the given Secret
+func (c *Controller) newRemoteCluster(secret *corev1.Secret) (*remoteCluster, error) {
+	kubeconfig := secret.Data[ClusterSecretKubeconfigKey]
+	if len(kubeconfig) == 0 {
+		return nil, fmt.Errorf("%w: key %s not found", errMissingKubeconfig, ClusterSecretKubeconfigKey)
+	}
+
+	kubeClient, multiclusterClient, err := c.remoteClients(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := &remoteCluster{
+		key:        getClusterKey(secret),
+		kubeconfig: kubeconfig,
+		stop:       make(chan struct{}),
+	}
+
+	kubeInformerFactory := informers.NewSharedInformerFactory(kubeClient, k8sInformers.DefaultKubeEventResyncInterval)
+	multiclusterInformerFactory := multiclusterInformers.NewSharedInformerFactory(multiclusterClient, k8sInformers.DefaultKubeEventResyncInterval)
+
+	cluster.serviceExportInformer = multiclusterInformerFactory.Flomesh().V1alpha1().ServiceExports().Informer()
+	cluster.serviceInformer = kubeInformerFactory.Core().V1().Services().Informer()
+	cluster.endpointsInformer = kubeInformerFactory.Core().V1().Endpoints().Informer()
+
+	// Only the changes to the services exported to the local cluster are synchronized
+	_, _ = cluster.serviceExportInformer.AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			svcExport, ok := obj.(*multiclusterv1alpha1.ServiceExport)
+			return !ok || c.isExportedToLocalCluster(svcExport)
+		},
+		Handler: c.eventHandler(),
+	})
+	for _, informer := range []cache.SharedIndexInformer{cluster.serviceInformer, cluster.endpointsInformer} {
+		_, _ = informer.AddEventHandler(cache.FilteringResourceEventHandler{
+			FilterFunc: func(obj interface{}) bool { return c.isExportedByCluster(cluster, obj) },
+			Handler:    c.eventHandler(),
+		})
+	}
+
+	kubeInformerFactory.Start(cluster.stop)
+	multiclusterInformerFactory.Start(cluster.stop)
+
+	// The services exported by the peer cluster are imported once its resources are listed
+	go func() {
+		if cache.WaitForCacheSync(cluster.stop, cluster.hasSynced) {
+			c.enqueue()
+		}
+	}()
+
+	return cluster, nil
+}
+
+// isExportedByCluster returns true if the given Service or Endpoints of the given peer cluster belong to a service
+// exported to the local cluster
+func (c *Controller) isExportedByCluster(cluster *remoteCluster, obj interface{}) bool {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return false
+	}
+	svcExportObj, exists, err := cluster.serviceExportInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return false
+	}
+	return c.isExportedToLocalCluster(svcExportObj.(*multiclusterv1alpha1.ServiceExport))
+}
+
+// hasSynced returns true if the initial listing of the resources of the peer cluster completed
+func (rc *remoteCluster) hasSynced() bool {
+	return rc.serviceExportInformer.HasSynced() && rc.serviceInformer.HasSynced() && rc.endpointsInformer.HasSynced()
+}
+
+// getClusterKey returns the cluster key of the peer cluster configured by the given Secret
+func getClusterKey(secret *corev1.Secret) string {
+	if clusterKey := string(secret.Data[ClusterSecretClusterKeyKey]); clusterKey != "" {
+		return clusterKey
+	}
+	return secret.Name
+}
@@ -0,0 +1,237 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	multiclusterv1alpha1 "github.com/openservicemesh/osm/pkg/apis/multicluster/v1alpha1"
+	multiclusterClientset "github.com/openservicemesh/osm/pkg/gen/client/multicluster/clientset/versioned"
+	multiclusterFake "github.com/openservicemesh/osm/pkg/gen/client/multicluster/clientset/versioned/fake"
+)
+
+const (
+	testOSMNamespace = "osm-system"
+	testNamespace    = "ns"
+)
+
+type testCluster struct {
+	kubeClient         *fake.Clientset
+	multiclusterClient *multiclusterFake.Clientset
+}
+
+func newTestCluster(ip string, exports ...*multiclusterv1alpha1.ServiceExport) testCluster {
+	var kubeObjects []runtime.Object
+	var multiclusterObjects []runtime.Object
+	for _, svcExport := range exports {
+		kubeObjects = append(kubeObjects,
+			&corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: svcExport.Namespace, Name: svcExport.Name},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 80}},
+				},
+			},
+			&corev1.Endpoints{
+				ObjectMeta: metav1.ObjectMeta{Namespace: svcExport.Namespace, Name: svcExport.Name},
+				Subsets: []corev1.EndpointSubset{
+					{
+						Addresses: []corev1.EndpointAddress{
+							{IP: ip, TargetRef: &corev1.ObjectReference{Kind: "Pod", Name: svcExport.Name + "-pod"}},
+						},
+						Ports: []corev1.EndpointPort{{Name: "http", Protocol: corev1.ProtocolTCP, Port: 8080}},
+					},
+				},
+			})
+		multiclusterObjects = append(multiclusterObjects, svcExport)
+	}
+	return testCluster{
+		kubeClient:         fake.NewSimpleClientset(kubeObjects...),
+		multiclusterClient: multiclusterFake.NewSimpleClientset(multiclusterObjects...),
+	}
+}
+
+func newTestServiceExport(name string, targetClusters ...string) *multiclusterv1alpha1.ServiceExport {
+	return &multiclusterv1alpha1.ServiceExport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: name},
+		Spec: multiclusterv1alpha1.ServiceExportSpec{
+			ServiceAccountName: name,
+			TargetClusters:     targetClusters,
+			Rules:              []multiclusterv1alpha1.ServiceExportRule{{PortNumber: 80, Path: "/" + name}},
+		},
+	}
+}
+
+func newTestClusterSecret(name, kubeconfig, clusterKey string) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testOSMNamespace,
+			Name:      name,
+			Labels:    map[string]string{ClusterSecretLabel: "true"},
+		},
+		Data: map[string][]byte{ClusterSecretKubeconfigKey: []byte(kubeconfig)},
+	}
+	if clusterKey != "" {
+		secret.Data[ClusterSecretClusterKeyKey] = []byte(clusterKey)
+	}
+	return secret
+}
+
+func newTestEndpoint(ip, host, path, clusterKey string) multiclusterv1alpha1.Endpoint {
+	return multiclusterv1alpha1.Endpoint{
+		Target:     multiclusterv1alpha1.Target{Host: host, IP: ip, Port: 8080, Path: path},
+		ClusterKey: clusterKey,
+	}
+}
+
+func TestController(t *testing.T) {
+	assert := tassert.New(t)
+
+	clusters := map[string]testCluster{
+		"kubeconfig-a": newTestCluster("10.1.0.1", newTestServiceExport("svc-1")),
+		"kubeconfig-b": newTestCluster("10.2.0.1", newTestServiceExport("svc-1"), newTestServiceExport("svc-2", "other")),
+	}
+	remoteClients := func(kubeconfig []byte) (kubernetes.Interface, multiclusterClientset.Interface, error) {
+		cluster, ok := clusters[string(kubeconfig)]
+		if !ok {
+			return nil, nil, fmt.Errorf("unknown kubeconfig %s", kubeconfig)
+		}
+		return cluster.kubeClient, cluster.multiclusterClient, nil
+	}
+
+	unmanagedImport := &multiclusterv1alpha1.ServiceImport{
+		ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "svc-3"},
+	}
+	kubeClient := fake.NewSimpleClientset(
+		newTestClusterSecret("cluster-a", "kubeconfig-a", ""),
+		newTestClusterSecret("cluster-b", "kubeconfig-b", "region/zone/cluster-b"),
+		newTestClusterSecret("cluster-c", "", ""),
+	)
+	multiclusterClient := multiclusterFake.NewSimpleClientset(unmanagedImport)
+
+	c := NewController(kubeClient, multiclusterClient, testOSMNamespace, "local", remoteClients)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	defer c.stopClusters()
+	go c.secretInformer.Run(stop)
+	go c.serviceImportInformer.Run(stop)
+	assert.True(cache.WaitForCacheSync(stop, c.secretInformer.HasSynced, c.serviceImportInformer.HasSynced))
+
+	// waitForServiceImports synchronizes the controller until the ServiceImport resources match the expected ones
+	waitForServiceImports := func(expected map[types.NamespacedName][]multiclusterv1alpha1.Endpoint) bool {
+		return assert.Eventually(func() bool {
+			c.sync()
+			svcImports, err := multiclusterClient.FlomeshV1alpha1().ServiceImports(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{})
+			if err != nil || len(svcImports.Items) != len(expected) {
+				return false
+			}
+			for _, svcImport := range svcImports.Items {
+				endpoints, ok := expected[types.NamespacedName{Namespace: svcImport.Namespace, Name: svcImport.Name}]
+				if !ok {
+					return false
+				}
+				if len(endpoints) == 0 {
+					if len(svcImport.Spec.Ports) != 0 {
+						return false
+					}
+					continue
+				}
+				if len(svcImport.Spec.Ports) != 1 || !tassert.ObjectsAreEqual(endpoints, svcImport.Spec.Ports[0].Endpoints) {
+					return false
+				}
+			}
+			return true
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+
+	unmanagedImportName := types.NamespacedName{Namespace: testNamespace, Name: "svc-3"}
+	svc1 := types.NamespacedName{Namespace: testNamespace, Name: "svc-1"}
+
+	// The services exported by both peer clusters are merged, the services exported to other clusters are ignored
+	assert.True(waitForServiceImports(map[types.NamespacedName][]multiclusterv1alpha1.Endpoint{
+		unmanagedImportName: nil,
+		svc1: {
+			newTestEndpoint("10.1.0.1", "svc-1-pod", "/svc-1", "cluster-a"),
+			newTestEndpoint("10.2.0.1", "svc-1-pod", "/svc-1", "region/zone/cluster-b"),
+		},
+	}))
+	assert.Len(c.clusters, 2)
+
+	svcImport, err := multiclusterClient.FlomeshV1alpha1().ServiceImports(testNamespace).Get(context.Background(), "svc-1", metav1.GetOptions{})
+	assert.Nil(err)
+	assert.Equal(ManagedByLabelValue, svcImport.Labels[ManagedByLabel])
+	assert.Equal("svc-1", svcImport.Spec.ServiceAccountName)
+	assert.Equal(multiclusterv1alpha1.ClusterSetIP, svcImport.Spec.Type)
+	assert.Equal("http", svcImport.Spec.Ports[0].Name)
+	assert.Equal(int32(80), svcImport.Spec.Ports[0].Port)
+
+	// The endpoints of a service no longer exported are removed
+	err = clusters["kubeconfig-a"].multiclusterClient.FlomeshV1alpha1().ServiceExports(testNamespace).Delete(context.Background(), "svc-1", metav1.DeleteOptions{})
+	assert.Nil(err)
+	assert.True(waitForServiceImports(map[types.NamespacedName][]multiclusterv1alpha1.Endpoint{
+		unmanagedImportName: nil,
+		svc1: {
+			newTestEndpoint("10.2.0.1", "svc-1-pod", "/svc-1", "region/zone/cluster-b"),
+		},
+	}))
+
+	// The ServiceImport resources of a peer cluster removed from the mesh are garbage collected
+	err = kubeClient.CoreV1().Secrets(testOSMNamespace).Delete(context.Background(), "cluster-b", metav1.DeleteOptions{})
+	assert.Nil(err)
+	assert.True(waitForServiceImports(map[types.NamespacedName][]multiclusterv1alpha1.Endpoint{
+		unmanagedImportName: nil,
+	}))
+	assert.Len(c.clusters, 1)
+}
+
+func TestAddImportedEndpoints(t *testing.T) {
+	assert := tassert.New(t)
+
+	svcImports := []*multiclusterv1alpha1.ServiceImport{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: testNamespace, Name: "svc-1"},
+			Spec: multiclusterv1alpha1.ServiceImportSpec{
+				ServiceAccountName: "svc-1",
+				Ports: []multiclusterv1alpha1.ServicePort{
+					{
+						Name: "http",
+						Port: 80,
+						Endpoints: []multiclusterv1alpha1.Endpoint{
+							newTestEndpoint("10.2.0.1", "pod-b", "", "cluster-b"),
+							newTestEndpoint("10.1.0.1", "pod-a", "", "cluster-a"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	desired := make(map[types.NamespacedName]*importedService)
+	addImportedEndpoints(desired, svcImports, "cluster-a")
+
+	imported := desired[types.NamespacedName{Namespace: testNamespace, Name: "svc-1"}]
+	assert.NotNil(imported)
+	assert.Equal(multiclusterv1alpha1.ServiceImportSpec{
+		Type:               multiclusterv1alpha1.ClusterSetIP,
+		ServiceAccountName: "svc-1",
+		Ports: []multiclusterv1alpha1.ServicePort{
+			{
+				Name: "http",
+				Port: 80,
+				Endpoints: []multiclusterv1alpha1.Endpoint{
+					newTestEndpoint("10.1.0.1", "pod-a", "", "cluster-a"),
+				},
+			},
+		},
+	}, imported.getSpec())
+}
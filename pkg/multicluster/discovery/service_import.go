@@ -0,0 +1,255 @@
+package discovery
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	multiclusterv1alpha1 "github.com/openservicemesh/osm/pkg/apis/multicluster/v1alpha1"
+)
+
+// importedService is the type used to represent the ports and endpoints of a service
+// exported by one or more peer clusters
+type importedService struct {
+	serviceAccountName string
+	ports              map[int32]*multiclusterv1alpha1.ServicePort
+}
+
+// syncServiceImports creates, updates and deletes the ServiceImport resources managed by the controller
+// according to the services exported by the peer clusters
+func (c *Controller) syncServiceImports() error {
+	var existing []*multiclusterv1alpha1.ServiceImport
+	for _, obj := range c.serviceImportInformer.GetStore().List() {
+		existing = append(existing, obj.(*multiclusterv1alpha1.ServiceImport))
+	}
+
+	desired := make(map[types.NamespacedName]*importedService)
+	for _, cluster := range c.clusters {
+		if cluster.hasSynced() {
+			c.addExportedServices(desired, cluster)
+		} else {
+			// The endpoints of a peer cluster not synced yet are kept until its resources are listed
+			addImportedEndpoints(desired, existing, cluster.key)
+		}
+	}
+
+	stale := make(map[types.NamespacedName]*multiclusterv1alpha1.ServiceImport)
+	for _, svcImport := range existing {
+		stale[types.NamespacedName{Namespace: svcImport.Namespace, Name: svcImport.Name}] = svcImport
+	}
+
+	for name, imported := range desired {
+		spec := imported.getSpec()
+		if len(spec.Ports) == 0 {
+			continue
+		}
+
+		if svcImport, ok := stale[name]; ok {
+			delete(stale, name)
+			if equality.Semantic.DeepEqual(svcImport.Spec, spec) {
+				continue
+			}
+			svcImport = svcImport.DeepCopy()
+			svcImport.Spec = spec
+			if _, err := c.multiclusterClient.FlomeshV1alpha1().ServiceImports(name.Namespace).Update(context.Background(), svcImport, metav1.UpdateOptions{}); err != nil {
+				log.Error().Err(err).Msgf("Error updating ServiceImport %s", name)
+				continue
+			}
+			log.Debug().Msgf("Updated ServiceImport %s", name)
+			continue
+		}
+
+		svcImport := &multiclusterv1alpha1.ServiceImport{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: name.Namespace,
+				Name:      name.Name,
+				Labels:    map[string]string{ManagedByLabel: ManagedByLabelValue},
+			},
+			Spec: spec,
+		}
+		if _, err := c.multiclusterClient.FlomeshV1alpha1().ServiceImports(name.Namespace).Create(context.Background(), svcImport, metav1.CreateOptions{}); err != nil {
+			log.Error().Err(err).Msgf("Error creating ServiceImport %s", name)
+			continue
+		}
+		log.Debug().Msgf("Created ServiceImport %s", name)
+	}
+
+	for name := range stale {
+		if err := c.multiclusterClient.FlomeshV1alpha1().ServiceImports(name.Namespace).Delete(context.Background(), name.Name, metav1.DeleteOptions{}); err != nil {
+			log.Error().Err(err).Msgf("Error deleting stale ServiceImport %s", name)
+			continue
+		}
+		log.Debug().Msgf("Deleted stale ServiceImport %s", name)
+	}
+
+	return nil
+}
+
+// addExportedServices adds the endpoints of the services exported to the local cluster by the given peer cluster
+func (c *Controller) addExportedServices(desired map[types.NamespacedName]*importedService, cluster *remoteCluster) {
+	for _, obj := range cluster.serviceExportInformer.GetStore().List() {
+		svcExport := obj.(*multiclusterv1alpha1.ServiceExport)
+		if !c.isExportedToLocalCluster(svcExport) {
+			continue
+		}
+
+		name := types.NamespacedName{Namespace: svcExport.Namespace, Name: svcExport.Name}
+		svcObj, exists, err := cluster.serviceInformer.GetStore().GetByKey(name.String())
+		if err != nil || !exists {
+			continue
+		}
+		endpointsObj, exists, err := cluster.endpointsInformer.GetStore().GetByKey(name.String())
+		if err != nil || !exists {
+			continue
+		}
+		svc := svcObj.(*corev1.Service)
+		endpoints := endpointsObj.(*corev1.Endpoints)
+
+		imported := getImportedService(desired, name, svcExport.Spec.ServiceAccountName)
+		for _, svcPort := range svc.Spec.Ports {
+			port := imported.getPort(multiclusterv1alpha1.ServicePort{
+				Name:        svcPort.Name,
+				Protocol:    svcPort.Protocol,
+				AppProtocol: svcPort.AppProtocol,
+				Port:        svcPort.Port,
+			})
+			path := getExportedPath(svcExport, svcPort.Port)
+			for _, subset := range endpoints.Subsets {
+				for _, endpointPort := range subset.Ports {
+					if endpointPort.Name != svcPort.Name {
+						continue
+					}
+					for _, address := range subset.Addresses {
+						port.Endpoints = append(port.Endpoints, multiclusterv1alpha1.Endpoint{
+							Target: multiclusterv1alpha1.Target{
+								Host: getEndpointHost(address),
+								IP:   address.IP,
+								Port: endpointPort.Port,
+								Path: path,
+							},
+							ClusterKey: cluster.key,
+						})
+					}
+				}
+			}
+		}
+	}
+}
+
+// isExportedToLocalCluster returns true if the given ServiceExport is valid and targets the local cluster
+func (c *Controller) isExportedToLocalCluster(svcExport *multiclusterv1alpha1.ServiceExport) bool {
+	for _, condition := range svcExport.Status.Conditions {
+		if condition.Type == string(multiclusterv1alpha1.ServiceExportValid) && condition.Status != metav1.ConditionTrue {
+			return false
+		}
+		if condition.Type == string(multiclusterv1alpha1.ServiceExportConflict) && condition.Status != metav1.ConditionFalse {
+			return false
+		}
+	}
+
+	if len(svcExport.Spec.TargetClusters) == 0 {
+		return true
+	}
+	for _, targetCluster := range svcExport.Spec.TargetClusters {
+		if targetCluster == c.clusterKey {
+			return true
+		}
+	}
+	return false
+}
+
+// addImportedEndpoints adds the endpoints of the given ServiceImport resources imported from the given peer cluster
+func addImportedEndpoints(desired map[types.NamespacedName]*importedService, svcImports []*multiclusterv1alpha1.ServiceImport, clusterKey string) {
+	for _, svcImport := range svcImports {
+		name := types.NamespacedName{Namespace: svcImport.Namespace, Name: svcImport.Name}
+		for _, svcPort := range svcImport.Spec.Ports {
+			for _, endpoint := range svcPort.Endpoints {
+				if endpoint.ClusterKey != clusterKey {
+					continue
+				}
+				port := getImportedService(desired, name, svcImport.Spec.ServiceAccountName).getPort(svcPort)
+				port.Endpoints = append(port.Endpoints, endpoint)
+			}
+		}
+	}
+}
+
+// getImportedService returns the imported service with the given name, adding it if not found
+func getImportedService(desired map[types.NamespacedName]*importedService, name types.NamespacedName, serviceAccountName string) *importedService {
+	imported, ok := desired[name]
+	if !ok {
+		imported = &importedService{
+			ports: make(map[int32]*multiclusterv1alpha1.ServicePort),
+		}
+		desired[name] = imported
+	}
+	if imported.serviceAccountName == "" {
+		imported.serviceAccountName = serviceAccountName
+	}
+	return imported
+}
+
+// getPort returns the port of the imported service with the number of the given port, adding it without its endpoints if not found
+func (s *importedService) getPort(svcPort multiclusterv1alpha1.ServicePort) *multiclusterv1alpha1.ServicePort {
+	port, ok := s.ports[svcPort.Port]
+	if !ok {
+		port = &multiclusterv1alpha1.ServicePort{
+			Name:        svcPort.Name,
+			Protocol:    svcPort.Protocol,
+			AppProtocol: svcPort.AppProtocol,
+			Port:        svcPort.Port,
+		}
+		s.ports[svcPort.Port] = port
+	}
+	return port
+}
+
+// getSpec returns the spec of the ServiceImport of the imported service, its ports without endpoints are omitted
+func (s *importedService) getSpec() multiclusterv1alpha1.ServiceImportSpec {
+	spec := multiclusterv1alpha1.ServiceImportSpec{
+		Type:               multiclusterv1alpha1.ClusterSetIP,
+		ServiceAccountName: s.serviceAccountName,
+	}
+	for _, port := range s.ports {
+		if len(port.Endpoints) == 0 {
+			continue
+		}
+		endpoints := port.Endpoints
+		sort.Slice(endpoints, func(i, j int) bool {
+			if endpoints[i].ClusterKey != endpoints[j].ClusterKey {
+				return endpoints[i].ClusterKey < endpoints[j].ClusterKey
+			}
+			if endpoints[i].Target.IP != endpoints[j].Target.IP {
+				return endpoints[i].Target.IP < endpoints[j].Target.IP
+			}
+			return endpoints[i].Target.Port < endpoints[j].Target.Port
+		})
+		spec.Ports = append(spec.Ports, *port)
+	}
+	sort.Slice(spec.Ports, func(i, j int) bool {
+		return spec.Ports[i].Port < spec.Ports[j].Port
+	})
+	return spec
+}
+
+// getExportedPath returns the path of the export rule of the given port, if any
+func getExportedPath(svcExport *multiclusterv1alpha1.ServiceExport, port int32) string {
+	for _, rule := range svcExport.Spec.Rules {
+		if rule.PortNumber == port {
+			return rule.Path
+		}
+	}
+	return ""
+}
+
+// getEndpointHost returns the name of the pod of the given endpoint address, or its hostname
+func getEndpointHost(address corev1.EndpointAddress) string {
+	if address.TargetRef != nil && address.TargetRef.Kind == "Pod" {
+		return address.TargetRef.Name
+	}
+	return address.Hostname
+}
@@ -0,0 +1,73 @@
+// Package discovery implements the multicluster discovery controller, which imports the services
+// exported by the peer clusters of the mesh as ServiceImport resources in the local cluster.
+package discovery
+
+import (
+	"time"
+
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	multiclusterClientset "github.com/openservicemesh/osm/pkg/gen/client/multicluster/clientset/versioned"
+	"github.com/openservicemesh/osm/pkg/logger"
+)
+
+var (
+	log = logger.New("multicluster-discovery")
+)
+
+const (
+	// ClusterSecretLabel is the label identifying the Secrets, in the OSM namespace, that configure the peer clusters
+	ClusterSecretLabel = "openservicemesh.io/multicluster-peer"
+
+	// ClusterSecretKubeconfigKey is the key of the kubeconfig used to connect to the peer cluster in the cluster Secret
+	ClusterSecretKubeconfigKey = "kubeconfig"
+
+	// ClusterSecretClusterKeyKey is the key of the cluster key of the peer cluster in the cluster Secret.
+	// The name of the Secret is used as the cluster key if unspecified.
+	ClusterSecretClusterKeyKey = "clusterKey"
+
+	// ManagedByLabel is the label identifying the ServiceImport resources managed by the discovery controller.
+	// ServiceImport resources without this label are never updated nor deleted by the controller.
+	ManagedByLabel = "openservicemesh.io/managed-by"
+
+	// ManagedByLabelValue is the value of ManagedByLabel
+	ManagedByLabelValue = "osm-multicluster-discovery"
+
+	// defaultResyncInterval is the interval at which the ServiceImport resources are periodically reconciled
+	defaultResyncInterval = 5 * time.Minute
+)
+
+// RemoteClientsFunc returns the clients used to connect to a peer cluster given its kubeconfig
+type RemoteClientsFunc func(kubeconfig []byte) (kubernetes.Interface, multiclusterClientset.Interface, error)
+
+// Controller synchronizes the ServiceImport resources of the local cluster with the ServiceExport
+// resources of the peer clusters and the Endpoints of the exported services.
+// The IP addresses of the endpoints of the peer clusters must be reachable from the local cluster.
+type Controller struct {
+	kubeClient         kubernetes.Interface
+	multiclusterClient multiclusterClientset.Interface
+	osmNamespace       string
+	clusterKey         string
+	remoteClients      RemoteClientsFunc
+	resyncInterval     time.Duration
+
+	secretInformer        cache.SharedIndexInformer
+	serviceImportInformer cache.SharedIndexInformer
+	queue                 chan struct{}
+
+	// clusters are the peer clusters, keyed by the name of their Secret.
+	// They are only accessed by the goroutine running the controller.
+	clusters map[string]*remoteCluster
+}
+
+// remoteCluster is the type used to represent a peer cluster and the informers watching its resources
+type remoteCluster struct {
+	key        string
+	kubeconfig []byte
+	stop       chan struct{}
+
+	serviceExportInformer cache.SharedIndexInformer
+	serviceInformer       cache.SharedIndexInformer
+	endpointsInformer     cache.SharedIndexInformer
+}
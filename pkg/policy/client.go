@@ -2,6 +2,7 @@ package policy
 
 import (
 	"context"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -52,6 +53,13 @@ func NewPolicyController(informerCollection *informers.InformerCollection, kubeC
 	}
 	client.informers.AddEventHandler(informers.InformerKeyEgressGateway, k8s.GetEventHandlerFuncs(shouldObserve, egressGatewayEventTypes, msgBroker))
 
+	externalServiceEventTypes := k8s.EventTypes{
+		Add:    announcements.ExternalServiceAdded,
+		Update: announcements.ExternalServiceUpdated,
+		Delete: announcements.ExternalServiceDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyExternalService, k8s.GetEventHandlerFuncs(shouldObserve, externalServiceEventTypes, msgBroker))
+
 	ingressBackendEventTypes := k8s.EventTypes{
 		Add:    announcements.IngressBackendAdded,
 		Update: announcements.IngressBackendUpdated,
@@ -80,6 +88,34 @@ func NewPolicyController(informerCollection *informers.InformerCollection, kubeC
 	}
 	client.informers.AddEventHandler(informers.InformerKeyRetry, k8s.GetEventHandlerFuncs(shouldObserve, retryEventTypes, msgBroker))
 
+	faultInjectionEventTypes := k8s.EventTypes{
+		Add:    announcements.FaultInjectionAdded,
+		Update: announcements.FaultInjectionUpdated,
+		Delete: announcements.FaultInjectionDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyFaultInjection, k8s.GetEventHandlerFuncs(shouldObserve, faultInjectionEventTypes, msgBroker))
+
+	trafficMirrorEventTypes := k8s.EventTypes{
+		Add:    announcements.TrafficMirrorAdded,
+		Update: announcements.TrafficMirrorUpdated,
+		Delete: announcements.TrafficMirrorDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyTrafficMirror, k8s.GetEventHandlerFuncs(shouldObserve, trafficMirrorEventTypes, msgBroker))
+
+	requestAuthenticationEventTypes := k8s.EventTypes{
+		Add:    announcements.RequestAuthenticationAdded,
+		Update: announcements.RequestAuthenticationUpdated,
+		Delete: announcements.RequestAuthenticationDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyRequestAuthentication, k8s.GetEventHandlerFuncs(shouldObserve, requestAuthenticationEventTypes, msgBroker))
+
+	authorizationPolicyEventTypes := k8s.EventTypes{
+		Add:    announcements.AuthorizationPolicyAdded,
+		Update: announcements.AuthorizationPolicyUpdated,
+		Delete: announcements.AuthorizationPolicyDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeyAuthorizationPolicy, k8s.GetEventHandlerFuncs(shouldObserve, authorizationPolicyEventTypes, msgBroker))
+
 	upstreamTrafficSettingEventTypes := k8s.EventTypes{
 		Add:    announcements.UpstreamTrafficSettingAdded,
 		Update: announcements.UpstreamTrafficSettingUpdated,
@@ -87,6 +123,22 @@ func NewPolicyController(informerCollection *informers.InformerCollection, kubeC
 	}
 	client.informers.AddEventHandler(informers.InformerKeyUpstreamTrafficSetting, k8s.GetEventHandlerFuncs(shouldObserve, upstreamTrafficSettingEventTypes, msgBroker))
 
+	// Only the secrets referenced by the Egress policies are observed, since the other secrets do not update the proxies.
+	// The secret informer only caches the secrets labeled as monitored by the mesh.
+	shouldObserveSecret := func(obj interface{}) bool {
+		object, ok := obj.(metav1.Object)
+		if !ok {
+			return false
+		}
+		return client.isEgressSecret(object.GetNamespace(), object.GetName())
+	}
+	secretEventTypes := k8s.EventTypes{
+		Add:    announcements.SecretAdded,
+		Update: announcements.SecretUpdated,
+		Delete: announcements.SecretDeleted,
+	}
+	client.informers.AddEventHandler(informers.InformerKeySecret, k8s.GetEventHandlerFuncs(shouldObserveSecret, secretEventTypes, msgBroker))
+
 	return client
 }
 
@@ -122,12 +174,98 @@ func (c *Client) ListEgressPoliciesForSourceIdentity(source identity.K8sServiceA
 	return policies
 }
 
-// GetEgressSourceSecret returns the secret resource that matches the given options
+// GetEgressSourceSecret returns the secret resource that matches the given options.
+// Only the secrets labeled as monitored by the mesh are cached, the other secrets are fetched from the API server.
 func (c *Client) GetEgressSourceSecret(secretReference corev1.SecretReference) (*corev1.Secret, error) {
+	secretIface, exists, err := c.informers.GetByKey(informers.InformerKeySecret, fmt.Sprintf("%s/%s", secretReference.Namespace, secretReference.Name))
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return secretIface.(*corev1.Secret), nil
+	}
 	return c.kubeClient.CoreV1().Secrets(secretReference.Namespace).
 		Get(context.Background(), secretReference.Name, metav1.GetOptions{})
 }
 
+// isEgressSecret returns true if the given secret is referenced by an Egress policy in the monitored namespaces
+func (c *Client) isEgressSecret(namespace, name string) bool {
+	for _, egressIface := range c.informers.List(informers.InformerKeyEgress) {
+		egressPolicy := egressIface.(*policyV1alpha1.Egress)
+
+		if !c.kubeController.IsMonitoredNamespace(egressPolicy.Namespace) {
+			continue
+		}
+
+		for _, secretReference := range GetEgressSecretReferences(egressPolicy) {
+			if secretReference.Namespace == namespace && secretReference.Name == name {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// GetEgressSecretReferences returns the references to the secrets the given Egress policy stores certificates in.
+// Secrets without a namespace in the TLS specification of a port are referenced in the namespace of the Egress policy.
+func GetEgressSecretReferences(egressPolicy *policyV1alpha1.Egress) []corev1.SecretReference {
+	var secretReferences []corev1.SecretReference
+
+	for _, sourceSpec := range egressPolicy.Spec.Sources {
+		if sourceSpec.MTLS != nil && sourceSpec.MTLS.Cert != nil {
+			secretReferences = append(secretReferences, sourceSpec.MTLS.Cert.Secret)
+		}
+	}
+
+	for _, portSpec := range egressPolicy.Spec.Ports {
+		if portSpec.TLS == nil {
+			continue
+		}
+		for _, secretReference := range []*corev1.SecretReference{portSpec.TLS.CASecret, portSpec.TLS.ClientCertSecret} {
+			if secretReference == nil {
+				continue
+			}
+			ref := *secretReference
+			if ref.Namespace == "" {
+				ref.Namespace = egressPolicy.Namespace
+			}
+			secretReferences = append(secretReferences, ref)
+		}
+	}
+
+	return secretReferences
+}
+
+// ListExternalServices lists the ExternalService resources in the monitored namespaces
+func (c *Client) ListExternalServices() []*policyV1alpha1.ExternalService {
+	var externalServices []*policyV1alpha1.ExternalService
+	for _, externalServiceIface := range c.informers.List(informers.InformerKeyExternalService) {
+		externalService := externalServiceIface.(*policyV1alpha1.ExternalService)
+
+		if !c.kubeController.IsMonitoredNamespace(externalService.Namespace) {
+			continue
+		}
+		externalServices = append(externalServices, externalService)
+	}
+
+	return externalServices
+}
+
+// GetExternalService returns the ExternalService resource backing the given MeshService
+func (c *Client) GetExternalService(svc service.MeshService) *policyV1alpha1.ExternalService {
+	if !c.kubeController.IsMonitoredNamespace(svc.Namespace) {
+		return nil
+	}
+
+	resource, exists, err := c.informers.GetByKey(informers.InformerKeyExternalService, svc.NamespacedKey())
+	if !exists || err != nil {
+		return nil
+	}
+
+	return resource.(*policyV1alpha1.ExternalService)
+}
+
 // GetIngressBackendPolicy returns the IngressBackend policy for the given backend MeshService
 func (c *Client) GetIngressBackendPolicy(svc service.MeshService) *policyV1alpha1.IngressBackend {
 	for _, ingressBackendIface := range c.informers.List(informers.InformerKeyIngressBackend) {
@@ -165,6 +303,106 @@ func (c *Client) ListRetryPolicies(source identity.K8sServiceAccount) []*policyV
 	return retries
 }
 
+// ListFaultInjectionPolicies returns the fault injection policies applicable to the given source identity.
+// Policies without sources apply to all source identities.
+func (c *Client) ListFaultInjectionPolicies(source identity.K8sServiceAccount) []*policyV1alpha1.FaultInjection {
+	var faultInjections []*policyV1alpha1.FaultInjection
+
+	for _, faultInjectionInterface := range c.informers.List(informers.InformerKeyFaultInjection) {
+		faultInjection := faultInjectionInterface.(*policyV1alpha1.FaultInjection)
+		if len(faultInjection.Spec.Sources) == 0 {
+			faultInjections = append(faultInjections, faultInjection)
+			continue
+		}
+		for _, src := range faultInjection.Spec.Sources {
+			namespace := src.Namespace
+			if namespace == "" {
+				namespace = faultInjection.Namespace
+			}
+			if src.Kind == kindSvcAccount && src.Name == source.Name && namespace == source.Namespace {
+				faultInjections = append(faultInjections, faultInjection)
+				break
+			}
+		}
+	}
+
+	return faultInjections
+}
+
+// ListTrafficMirrorPolicies returns the traffic mirror policies applicable to the given source identity.
+// Policies without sources apply to all source identities.
+func (c *Client) ListTrafficMirrorPolicies(source identity.K8sServiceAccount) []*policyV1alpha1.TrafficMirror {
+	var trafficMirrors []*policyV1alpha1.TrafficMirror
+
+	for _, trafficMirrorInterface := range c.informers.List(informers.InformerKeyTrafficMirror) {
+		trafficMirror := trafficMirrorInterface.(*policyV1alpha1.TrafficMirror)
+		if len(trafficMirror.Spec.Sources) == 0 {
+			trafficMirrors = append(trafficMirrors, trafficMirror)
+			continue
+		}
+		for _, src := range trafficMirror.Spec.Sources {
+			namespace := src.Namespace
+			if namespace == "" {
+				namespace = trafficMirror.Namespace
+			}
+			if src.Kind == kindSvcAccount && src.Name == source.Name && namespace == source.Namespace {
+				trafficMirrors = append(trafficMirrors, trafficMirror)
+				break
+			}
+		}
+	}
+
+	return trafficMirrors
+}
+
+// GetRequestAuthenticationPolicy returns the RequestAuthentication policy for the given destination MeshService
+func (c *Client) GetRequestAuthenticationPolicy(svc service.MeshService) *policyV1alpha1.RequestAuthentication {
+	for _, requestAuthenticationIface := range c.informers.List(informers.InformerKeyRequestAuthentication) {
+		requestAuthentication := requestAuthenticationIface.(*policyV1alpha1.RequestAuthentication)
+
+		if requestAuthentication.Namespace != svc.Namespace {
+			continue
+		}
+
+		// Return the first RequestAuthentication corresponding to the given MeshService.
+		// Multiple RequestAuthentication policies for the same destination will be prevented
+		// using a validating webhook.
+		dest := requestAuthentication.Spec.Destination
+		if dest.Kind == policyV1alpha1.KindService && dest.Name == svc.Name {
+			return requestAuthentication
+		}
+	}
+
+	return nil
+}
+
+// ListAuthorizationPolicies returns the AuthorizationPolicy policies for the given destination identity
+func (c *Client) ListAuthorizationPolicies(destination identity.K8sServiceAccount) []*policyV1alpha1.AuthorizationPolicy {
+	var authorizationPolicies []*policyV1alpha1.AuthorizationPolicy
+
+	for _, authorizationPolicyIface := range c.informers.List(informers.InformerKeyAuthorizationPolicy) {
+		authorizationPolicy := authorizationPolicyIface.(*policyV1alpha1.AuthorizationPolicy)
+
+		if authorizationPolicy.Namespace != destination.Namespace {
+			continue
+		}
+
+		// A policy without a destination applies to all the identities in its namespace
+		dest := authorizationPolicy.Spec.Destination
+		if dest == nil || (dest.Kind == kindSvcAccount && dest.Name == destination.Name) {
+			authorizationPolicies = append(authorizationPolicies, authorizationPolicy)
+		}
+	}
+
+	return authorizationPolicies
+}
+
+// GetRequestAuthenticationJWKSSecret returns the secret resource holding a JSON Web Key Set
+func (c *Client) GetRequestAuthenticationJWKSSecret(secretReference corev1.SecretReference) (*corev1.Secret, error) {
+	return c.kubeClient.CoreV1().Secrets(secretReference.Namespace).
+		Get(context.Background(), secretReference.Name, metav1.GetOptions{})
+}
+
 // GetAccessControlPolicy returns the AccessControl policy for the given backend MeshService
 func (c *Client) GetAccessControlPolicy(svc service.MeshService) *policyV1alpha1.AccessControl {
 	for _, aclIface := range c.informers.List(informers.InformerKeyAccessControl) {
@@ -7,10 +7,14 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	testclient "k8s.io/client-go/kubernetes/fake"
 
 	policyV1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
 	fakePolicyClient "github.com/openservicemesh/osm/pkg/gen/client/policy/clientset/versioned/fake"
 	"github.com/openservicemesh/osm/pkg/k8s/informers"
 
@@ -152,6 +156,108 @@ func TestListEgressPoliciesForSourceIdentity(t *testing.T) {
 	}
 }
 
+func TestGetEgressSourceSecret(t *testing.T) {
+	a := assert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	// The secret not labeled as monitored by the mesh is only known to the API server
+	unlabeledSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "client-cert",
+			Namespace: "test",
+		},
+		Data: map[string][]byte{"tls.crt": []byte("crt"), "tls.key": []byte("key")},
+	}
+	kubeClient := testclient.NewSimpleClientset(unlabeledSecret)
+
+	informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithKubeClient(kubeClient), informers.WithPolicyClient(fakePolicyClient.NewSimpleClientset()))
+	a.Nil(err)
+	c := NewPolicyController(informerCollection, kubeClient, mockKubeController, nil)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "ca",
+			Namespace: "test",
+			Labels:    map[string]string{constants.OSMKubeResourceMonitorAnnotation: "osm"},
+		},
+		Data: map[string][]byte{"ca.crt": []byte("ca")},
+	}
+	a.Nil(c.informers.Add(informers.InformerKeySecret, secret, t))
+
+	actual, err := c.GetEgressSourceSecret(corev1.SecretReference{Name: "ca", Namespace: "test"})
+	a.Nil(err)
+	a.Equal(secret, actual)
+
+	actual, err = c.GetEgressSourceSecret(corev1.SecretReference{Name: "client-cert", Namespace: "test"})
+	a.Nil(err)
+	a.Equal(unlabeledSecret, actual)
+
+	_, err = c.GetEgressSourceSecret(corev1.SecretReference{Name: "missing", Namespace: "test"})
+	a.True(apierrors.IsNotFound(err))
+}
+
+func TestIsEgressSecret(t *testing.T) {
+	a := assert.New(t)
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakePolicyClient.NewSimpleClientset()))
+	a.Nil(err)
+	c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+
+	egressPolicy := &policyV1alpha1.Egress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "egress-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.EgressSpec{
+			Sources: []policyV1alpha1.EgressSourceSpec{
+				{
+					Kind:      "ServiceAccount",
+					Name:      "sa-1",
+					Namespace: "test",
+					MTLS: &policyV1alpha1.EgressSourceMTLSSpec{
+						Cert: &policyV1alpha1.EgressSourceCertSpec{
+							Secret: corev1.SecretReference{Name: "source-cert", Namespace: "certs"},
+						},
+					},
+				},
+			},
+			Hosts: []string{"foo.com"},
+			Ports: []policyV1alpha1.PortSpec{
+				{
+					Number:   80,
+					Protocol: "http",
+					TLS: &policyV1alpha1.EgressTLSSpec{
+						CASecret:         &corev1.SecretReference{Name: "ca"},
+						ClientCertSecret: &corev1.SecretReference{Name: "client-cert", Namespace: "certs"},
+					},
+				},
+			},
+		},
+	}
+	a.Nil(c.informers.Add(informers.InformerKeyEgress, egressPolicy, t))
+
+	a.ElementsMatch([]corev1.SecretReference{
+		{Name: "source-cert", Namespace: "certs"},
+		{Name: "ca", Namespace: "test"},
+		{Name: "client-cert", Namespace: "certs"},
+	}, GetEgressSecretReferences(egressPolicy))
+
+	a.True(c.isEgressSecret("test", "ca"))
+	a.True(c.isEgressSecret("certs", "client-cert"))
+	a.True(c.isEgressSecret("certs", "source-cert"))
+	a.False(c.isEgressSecret("certs", "ca"))
+	a.False(c.isEgressSecret("test", "other"))
+}
+
 func TestGetIngressBackendPolicy(t *testing.T) {
 	testCases := []struct {
 		name                   string
@@ -684,6 +790,350 @@ func TestListRetryPolicy(t *testing.T) {
 	}
 }
 
+func TestListFaultInjectionPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	allSources := &policyV1alpha1.FaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fault-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.FaultInjectionSpec{
+			Destination: policyV1alpha1.FaultInjectionSrcDstSpec{Kind: "Service", Name: "s1"},
+		},
+	}
+	sa1 := &policyV1alpha1.FaultInjection{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fault-2",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.FaultInjectionSpec{
+			Sources: []policyV1alpha1.FaultInjectionSrcDstSpec{
+				{Kind: "ServiceAccount", Name: "sa-1"},
+				{Kind: "ServiceAccount", Name: "sa-1", Namespace: "other"},
+			},
+			Destination: policyV1alpha1.FaultInjectionSrcDstSpec{Kind: "Service", Name: "s1"},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		source   identity.K8sServiceAccount
+		expected []*policyV1alpha1.FaultInjection
+	}{
+		{
+			name:     "source matching only policies without sources",
+			source:   identity.K8sServiceAccount{Name: "sa-2", Namespace: "test"},
+			expected: []*policyV1alpha1.FaultInjection{allSources},
+		},
+		{
+			name:     "source in the namespace of the policy",
+			source:   identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"},
+			expected: []*policyV1alpha1.FaultInjection{allSources, sa1},
+		},
+		{
+			name:     "source in an explicit namespace",
+			source:   identity.K8sServiceAccount{Name: "sa-1", Namespace: "other"},
+			expected: []*policyV1alpha1.FaultInjection{allSources, sa1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+			a.NotNil(c)
+
+			for _, faultInjection := range []*policyV1alpha1.FaultInjection{allSources, sa1} {
+				err := c.informers.Add(informers.InformerKeyFaultInjection, faultInjection, t)
+				a.Nil(err)
+			}
+
+			actual := c.ListFaultInjectionPolicies(tc.source)
+			a.ElementsMatch(tc.expected, actual)
+		})
+	}
+}
+
+func TestListTrafficMirrorPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	allSources := &policyV1alpha1.TrafficMirror{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirror-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.TrafficMirrorSpec{
+			Destination: policyV1alpha1.TrafficMirrorSrcDstSpec{Kind: "Service", Name: "s1"},
+			Backends:    []policyV1alpha1.TrafficMirrorBackendSpec{{Service: "s1-shadow", Percentage: 10}},
+		},
+	}
+	sa1 := &policyV1alpha1.TrafficMirror{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "mirror-2",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.TrafficMirrorSpec{
+			Sources: []policyV1alpha1.TrafficMirrorSrcDstSpec{
+				{Kind: "ServiceAccount", Name: "sa-1"},
+				{Kind: "ServiceAccount", Name: "sa-1", Namespace: "other"},
+			},
+			Destination: policyV1alpha1.TrafficMirrorSrcDstSpec{Kind: "Service", Name: "s1"},
+			Backends:    []policyV1alpha1.TrafficMirrorBackendSpec{{Service: "s1-shadow", Percentage: 10}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		source   identity.K8sServiceAccount
+		expected []*policyV1alpha1.TrafficMirror
+	}{
+		{
+			name:     "source matching only policies without sources",
+			source:   identity.K8sServiceAccount{Name: "sa-2", Namespace: "test"},
+			expected: []*policyV1alpha1.TrafficMirror{allSources},
+		},
+		{
+			name:     "source in the namespace of the policy",
+			source:   identity.K8sServiceAccount{Name: "sa-1", Namespace: "test"},
+			expected: []*policyV1alpha1.TrafficMirror{allSources, sa1},
+		},
+		{
+			name:     "source in an explicit namespace",
+			source:   identity.K8sServiceAccount{Name: "sa-1", Namespace: "other"},
+			expected: []*policyV1alpha1.TrafficMirror{allSources, sa1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+			a.NotNil(c)
+
+			for _, trafficMirror := range []*policyV1alpha1.TrafficMirror{allSources, sa1} {
+				err := c.informers.Add(informers.InformerKeyTrafficMirror, trafficMirror, t)
+				a.Nil(err)
+			}
+
+			actual := c.ListTrafficMirrorPolicies(tc.source)
+			a.ElementsMatch(tc.expected, actual)
+		})
+	}
+}
+
+func TestGetExternalService(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+	mockKubeController.EXPECT().IsMonitoredNamespace("other").Return(false).AnyTimes()
+
+	es := &policyV1alpha1.ExternalService{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "httpbin",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.ExternalServiceSpec{
+			Hosts: []string{"httpbin.org"},
+			Ports: []policyV1alpha1.ExternalServicePortSpec{
+				{Number: 80, Protocol: "http"},
+			},
+		},
+	}
+	unmonitored := es.DeepCopy()
+	unmonitored.Namespace = "other"
+
+	testCases := []struct {
+		name     string
+		svc      service.MeshService
+		expected *policyV1alpha1.ExternalService
+	}{
+		{
+			name:     "external service exists",
+			svc:      service.MeshService{Name: "httpbin", Namespace: "test", Port: 80},
+			expected: es,
+		},
+		{
+			name:     "no external service for the given service",
+			svc:      service.MeshService{Name: "s1", Namespace: "test", Port: 80},
+			expected: nil,
+		},
+		{
+			name:     "external service in an unmonitored namespace",
+			svc:      service.MeshService{Name: "httpbin", Namespace: "other", Port: 80},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+			a.NotNil(c)
+
+			err = c.informers.Add(informers.InformerKeyExternalService, es, t)
+			a.Nil(err)
+			err = c.informers.Add(informers.InformerKeyExternalService, unmonitored, t)
+			a.Nil(err)
+
+			a.Equal(tc.expected, c.GetExternalService(tc.svc))
+			a.ElementsMatch([]*policyV1alpha1.ExternalService{es}, c.ListExternalServices())
+		})
+	}
+}
+
+func TestGetRequestAuthenticationPolicy(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	s1 := &policyV1alpha1.RequestAuthentication{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authn-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.RequestAuthenticationSpec{
+			Destination: policyV1alpha1.RequestAuthenticationDestinationSpec{Kind: "Service", Name: "s1"},
+			JWTRules: []policyV1alpha1.JWTRuleSpec{
+				{Issuer: "https://issuer.example.com", JWKS: policyV1alpha1.JWKSSpec{URI: "https://issuer.example.com/jwks"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		svc      service.MeshService
+		expected *policyV1alpha1.RequestAuthentication
+	}{
+		{
+			name:     "policy for the destination service exists",
+			svc:      service.MeshService{Name: "s1", Namespace: "test"},
+			expected: s1,
+		},
+		{
+			name:     "no policy for the destination service",
+			svc:      service.MeshService{Name: "s2", Namespace: "test"},
+			expected: nil,
+		},
+		{
+			name:     "policy in a different namespace",
+			svc:      service.MeshService{Name: "s1", Namespace: "other"},
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+			a.NotNil(c)
+
+			err = c.informers.Add(informers.InformerKeyRequestAuthentication, s1, t)
+			a.Nil(err)
+
+			actual := c.GetRequestAuthenticationPolicy(tc.svc)
+			a.Equal(tc.expected, actual)
+		})
+	}
+}
+
+func TestListAuthorizationPolicies(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockKubeController := k8s.NewMockController(mockCtrl)
+	mockKubeController.EXPECT().IsMonitoredNamespace("test").Return(true).AnyTimes()
+
+	namespaceWide := &policyV1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authz-1",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.AuthorizationPolicySpec{
+			Action: policyV1alpha1.AuthorizationPolicyActionDeny,
+		},
+	}
+	sa1 := &policyV1alpha1.AuthorizationPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "authz-2",
+			Namespace: "test",
+		},
+		Spec: policyV1alpha1.AuthorizationPolicySpec{
+			Destination: &policyV1alpha1.AuthorizationPolicyDestinationSpec{Kind: "ServiceAccount", Name: "sa1"},
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		destination identity.K8sServiceAccount
+		expected    []*policyV1alpha1.AuthorizationPolicy
+	}{
+		{
+			name:        "namespace wide and destination policies",
+			destination: identity.K8sServiceAccount{Name: "sa1", Namespace: "test"},
+			expected:    []*policyV1alpha1.AuthorizationPolicy{namespaceWide, sa1},
+		},
+		{
+			name:        "namespace wide policy only",
+			destination: identity.K8sServiceAccount{Name: "sa2", Namespace: "test"},
+			expected:    []*policyV1alpha1.AuthorizationPolicy{namespaceWide},
+		},
+		{
+			name:        "no policy in the namespace",
+			destination: identity.K8sServiceAccount{Name: "sa1", Namespace: "other"},
+			expected:    nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assert.New(t)
+
+			fakeClient := fakePolicyClient.NewSimpleClientset()
+			informerCollection, err := informers.NewInformerCollection("osm", nil, informers.WithPolicyClient(fakeClient))
+			a.Nil(err)
+			c := NewPolicyController(informerCollection, nil, mockKubeController, nil)
+			a.NotNil(c)
+
+			for _, authorizationPolicy := range []*policyV1alpha1.AuthorizationPolicy{namespaceWide, sa1} {
+				err = c.informers.Add(informers.InformerKeyAuthorizationPolicy, authorizationPolicy, t)
+				a.Nil(err)
+			}
+
+			actual := c.ListAuthorizationPolicies(tc.destination)
+			a.ElementsMatch(tc.expected, actual)
+		})
+	}
+}
+
 func TestGetUpstreamTrafficSetting(t *testing.T) {
 	testCases := []struct {
 		name         string
@@ -66,6 +66,20 @@ func (mr *MockControllerMockRecorder) GetEgressSourceSecret(arg0 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressSourceSecret", reflect.TypeOf((*MockController)(nil).GetEgressSourceSecret), arg0)
 }
 
+// GetExternalService mocks base method.
+func (m *MockController) GetExternalService(arg0 service.MeshService) *v1alpha1.ExternalService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExternalService", arg0)
+	ret0, _ := ret[0].(*v1alpha1.ExternalService)
+	return ret0
+}
+
+// GetExternalService indicates an expected call of GetExternalService.
+func (mr *MockControllerMockRecorder) GetExternalService(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExternalService", reflect.TypeOf((*MockController)(nil).GetExternalService), arg0)
+}
+
 // GetIngressBackendPolicy mocks base method.
 func (m *MockController) GetIngressBackendPolicy(arg0 service.MeshService) *v1alpha1.IngressBackend {
 	m.ctrl.T.Helper()
@@ -80,6 +94,35 @@ func (mr *MockControllerMockRecorder) GetIngressBackendPolicy(arg0 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIngressBackendPolicy", reflect.TypeOf((*MockController)(nil).GetIngressBackendPolicy), arg0)
 }
 
+// GetRequestAuthenticationJWKSSecret mocks base method.
+func (m *MockController) GetRequestAuthenticationJWKSSecret(arg0 v1.SecretReference) (*v1.Secret, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationJWKSSecret", arg0)
+	ret0, _ := ret[0].(*v1.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRequestAuthenticationJWKSSecret indicates an expected call of GetRequestAuthenticationJWKSSecret.
+func (mr *MockControllerMockRecorder) GetRequestAuthenticationJWKSSecret(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationJWKSSecret", reflect.TypeOf((*MockController)(nil).GetRequestAuthenticationJWKSSecret), arg0)
+}
+
+// GetRequestAuthenticationPolicy mocks base method.
+func (m *MockController) GetRequestAuthenticationPolicy(arg0 service.MeshService) *v1alpha1.RequestAuthentication {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRequestAuthenticationPolicy", arg0)
+	ret0, _ := ret[0].(*v1alpha1.RequestAuthentication)
+	return ret0
+}
+
+// GetRequestAuthenticationPolicy indicates an expected call of GetRequestAuthenticationPolicy.
+func (mr *MockControllerMockRecorder) GetRequestAuthenticationPolicy(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRequestAuthenticationPolicy", reflect.TypeOf((*MockController)(nil).GetRequestAuthenticationPolicy), arg0)
+}
+
 // GetUpstreamTrafficSetting mocks base method.
 func (m *MockController) GetUpstreamTrafficSetting(arg0 UpstreamTrafficSettingGetOpt) *v1alpha1.UpstreamTrafficSetting {
 	m.ctrl.T.Helper()
@@ -94,6 +137,20 @@ func (mr *MockControllerMockRecorder) GetUpstreamTrafficSetting(arg0 interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpstreamTrafficSetting", reflect.TypeOf((*MockController)(nil).GetUpstreamTrafficSetting), arg0)
 }
 
+// ListAuthorizationPolicies mocks base method.
+func (m *MockController) ListAuthorizationPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.AuthorizationPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuthorizationPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.AuthorizationPolicy)
+	return ret0
+}
+
+// ListAuthorizationPolicies indicates an expected call of ListAuthorizationPolicies.
+func (mr *MockControllerMockRecorder) ListAuthorizationPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuthorizationPolicies", reflect.TypeOf((*MockController)(nil).ListAuthorizationPolicies), arg0)
+}
+
 // ListEgressGateways mocks base method.
 func (m *MockController) ListEgressGateways() []*v1alpha1.EgressGateway {
 	m.ctrl.T.Helper()
@@ -122,6 +179,34 @@ func (mr *MockControllerMockRecorder) ListEgressPoliciesForSourceIdentity(arg0 i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressPoliciesForSourceIdentity", reflect.TypeOf((*MockController)(nil).ListEgressPoliciesForSourceIdentity), arg0)
 }
 
+// ListExternalServices mocks base method.
+func (m *MockController) ListExternalServices() []*v1alpha1.ExternalService {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExternalServices")
+	ret0, _ := ret[0].([]*v1alpha1.ExternalService)
+	return ret0
+}
+
+// ListExternalServices indicates an expected call of ListExternalServices.
+func (mr *MockControllerMockRecorder) ListExternalServices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExternalServices", reflect.TypeOf((*MockController)(nil).ListExternalServices))
+}
+
+// ListFaultInjectionPolicies mocks base method.
+func (m *MockController) ListFaultInjectionPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.FaultInjection {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFaultInjectionPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.FaultInjection)
+	return ret0
+}
+
+// ListFaultInjectionPolicies indicates an expected call of ListFaultInjectionPolicies.
+func (mr *MockControllerMockRecorder) ListFaultInjectionPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFaultInjectionPolicies", reflect.TypeOf((*MockController)(nil).ListFaultInjectionPolicies), arg0)
+}
+
 // ListRetryPolicies mocks base method.
 func (m *MockController) ListRetryPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.Retry {
 	m.ctrl.T.Helper()
@@ -135,3 +220,17 @@ func (mr *MockControllerMockRecorder) ListRetryPolicies(arg0 interface{}) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRetryPolicies", reflect.TypeOf((*MockController)(nil).ListRetryPolicies), arg0)
 }
+
+// ListTrafficMirrorPolicies mocks base method.
+func (m *MockController) ListTrafficMirrorPolicies(arg0 identity.K8sServiceAccount) []*v1alpha1.TrafficMirror {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrafficMirrorPolicies", arg0)
+	ret0, _ := ret[0].([]*v1alpha1.TrafficMirror)
+	return ret0
+}
+
+// ListTrafficMirrorPolicies indicates an expected call of ListTrafficMirrorPolicies.
+func (mr *MockControllerMockRecorder) ListTrafficMirrorPolicies(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMirrorPolicies", reflect.TypeOf((*MockController)(nil).ListTrafficMirrorPolicies), arg0)
+}
@@ -37,12 +37,33 @@ type Controller interface {
 	// GetEgressSourceSecret returns the secret resource that matches the given options
 	GetEgressSourceSecret(corev1.SecretReference) (*corev1.Secret, error)
 
+	// ListExternalServices lists the ExternalService resources in the monitored namespaces
+	ListExternalServices() []*policyv1alpha1.ExternalService
+
+	// GetExternalService returns the ExternalService resource backing the given MeshService
+	GetExternalService(service.MeshService) *policyv1alpha1.ExternalService
+
 	// GetIngressBackendPolicy returns the IngressBackend policy for the given backend MeshService
 	GetIngressBackendPolicy(service.MeshService) *policyv1alpha1.IngressBackend
 
 	// ListRetryPolicies returns the Retry policies for the given source identity
 	ListRetryPolicies(identity.K8sServiceAccount) []*policyv1alpha1.Retry
 
+	// ListFaultInjectionPolicies returns the FaultInjection policies for the given source identity
+	ListFaultInjectionPolicies(identity.K8sServiceAccount) []*policyv1alpha1.FaultInjection
+
+	// ListTrafficMirrorPolicies returns the TrafficMirror policies for the given source identity
+	ListTrafficMirrorPolicies(identity.K8sServiceAccount) []*policyv1alpha1.TrafficMirror
+
+	// GetRequestAuthenticationPolicy returns the RequestAuthentication policy for the given destination MeshService
+	GetRequestAuthenticationPolicy(service.MeshService) *policyv1alpha1.RequestAuthentication
+
+	// ListAuthorizationPolicies returns the AuthorizationPolicy policies for the given destination identity
+	ListAuthorizationPolicies(identity.K8sServiceAccount) []*policyv1alpha1.AuthorizationPolicy
+
+	// GetRequestAuthenticationJWKSSecret returns the secret resource holding a JSON Web Key Set
+	GetRequestAuthenticationJWKSSecret(corev1.SecretReference) (*corev1.Secret, error)
+
 	// GetAccessControlPolicy returns the AccessControl policy for the given backend MeshService
 	GetAccessControlPolicy(service.MeshService) *policyv1alpha1.AccessControl
 
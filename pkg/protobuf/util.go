@@ -4,15 +4,25 @@ package protobuf
 import (
 	"github.com/golang/protobuf/proto"
 	"github.com/golang/protobuf/ptypes/any"
+	protov2 "google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
 // MustMarshalAny marshals a protobuf Message into an Any type. It panics if that operation fails.
 func MustMarshalAny(pb proto.Message) *any.Any {
-	msg, err := anypb.New(proto.MessageV2(pb))
+	msg, err := MarshalAny(proto.MessageV2(pb))
 	if err != nil {
 		panic(err.Error())
 	}
-
 	return msg
 }
+
+// MarshalAny marshals a protobuf Message into an Any type.
+// The Message is marshaled deterministically, so that the same Message is always marshaled to the same bytes.
+func MarshalAny(pb protov2.Message) (*anypb.Any, error) {
+	msg := new(anypb.Any)
+	if err := anypb.MarshalFrom(msg, pb, protov2.MarshalOptions{Deterministic: true}); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,280 @@
+// Package external implements the service.Provider and endpoint.Provider interfaces for the services
+// external to the mesh declared using ExternalService resources.
+package external
+
+import (
+	"net"
+	"time"
+
+	"github.com/openservicemesh/osm/pkg/announcements"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s/events"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+// Ensure interface compliance
+var _ endpoint.Provider = (*client)(nil)
+var _ service.Provider = (*client)(nil)
+
+// NewClient returns a client that provides the services and endpoints declared by ExternalService resources.
+// The hosts of the ExternalService resources are resolved again every dnsCacheTTL until the given stop channel
+// is closed, and the ExternalService resources whose hosts resolve to different IP addresses are announced as
+// updated on the given message broker.
+func NewClient(policyController policy.Controller, msgBroker *messaging.Broker, stop <-chan struct{}) *client { //nolint: revive // unexported-return
+	c := &client{
+		policyController: policyController,
+		dnsCache:         make(map[string]*dnsCacheEntry),
+	}
+
+	if msgBroker != nil {
+		go c.refreshResolvedHosts(msgBroker, stop)
+	}
+
+	return c
+}
+
+// GetID returns a string descriptor / identifier of the compute provider.
+// Required by interfaces: EndpointsProvider, ServiceProvider
+func (c *client) GetID() string {
+	return providerName
+}
+
+// ListEndpointsForService retrieves the list of IP addresses for the given service.
+// Only ExternalService resources with Static resolution have endpoints known to the control plane,
+// the endpoints of ExternalService resources with DNS resolution are resolved by the sidecars.
+func (c *client) ListEndpointsForService(svc service.MeshService) []endpoint.Endpoint {
+	externalService := c.policyController.GetExternalService(svc)
+	if externalService == nil || externalService.Spec.Resolution != policyv1alpha1.ExternalServiceResolutionStatic {
+		return nil
+	}
+
+	portSpec := getPortSpec(externalService, svc.Port)
+	if portSpec == nil {
+		return nil
+	}
+
+	var endpoints []endpoint.Endpoint
+	for _, endpointSpec := range externalService.Spec.Endpoints {
+		ip := net.ParseIP(endpointSpec.Address)
+		if ip == nil {
+			log.Error().Msgf("Error parsing endpoint IP address %s for ExternalService %s/%s",
+				endpointSpec.Address, externalService.Namespace, externalService.Name)
+			continue
+		}
+		weight := endpointSpec.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		endpoints = append(endpoints, endpoint.Endpoint{
+			IP:     ip,
+			Port:   endpoint.Port(getTargetPort(*portSpec)),
+			Weight: endpoint.Weight(weight),
+		})
+	}
+
+	return endpoints
+}
+
+// ListEndpointsForIdentity retrieves the list of IP addresses for the given service account.
+// Services external to the mesh do not have a service identity.
+func (c *client) ListEndpointsForIdentity(_ identity.ServiceIdentity) []endpoint.Endpoint {
+	return nil
+}
+
+// GetResolvableEndpointsForService returns the expected endpoints that are to be reached when the hosts of the
+// ExternalService corresponding to the given service are resolved by the applications
+func (c *client) GetResolvableEndpointsForService(svc service.MeshService) []endpoint.Endpoint {
+	externalService := c.policyController.GetExternalService(svc)
+	if externalService == nil || getPortSpec(externalService, svc.Port) == nil {
+		return nil
+	}
+
+	var ips []net.IP
+	for _, host := range externalService.Spec.Hosts {
+		ips = append(ips, c.resolve(host)...)
+	}
+	if externalService.Spec.Resolution == policyv1alpha1.ExternalServiceResolutionStatic {
+		for _, endpointSpec := range externalService.Spec.Endpoints {
+			if ip := net.ParseIP(endpointSpec.Address); ip != nil {
+				ips = append(ips, ip)
+			}
+		}
+	}
+
+	var endpoints []endpoint.Endpoint
+	for _, ip := range ips {
+		endpoints = append(endpoints, endpoint.Endpoint{
+			IP:   ip,
+			Port: endpoint.Port(svc.Port),
+		})
+	}
+
+	return endpoints
+}
+
+// resolve returns the IP addresses the given host resolves to, caching the result for dnsCacheTTL
+func (c *client) resolve(host string) []net.IP {
+	if ip := net.ParseIP(host); ip != nil {
+		return []net.IP{ip}
+	}
+
+	c.dnsCacheLock.Lock()
+	defer c.dnsCacheLock.Unlock()
+
+	if entry, ok := c.dnsCache[host]; ok && time.Now().Before(entry.expires) {
+		return entry.ips
+	}
+
+	ips, err := lookupIP(host)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Error resolving external host %s", host)
+		// Keep using the previously resolved IP addresses until the host can be resolved again
+		if entry, ok := c.dnsCache[host]; ok {
+			return entry.ips
+		}
+		return nil
+	}
+
+	c.dnsCache[host] = &dnsCacheEntry{ips: ips, expires: time.Now().Add(dnsCacheTTL)}
+	return ips
+}
+
+// refreshResolvedHosts resolves the hosts of the ExternalService resources again every dnsCacheTTL, and announces
+// the ExternalService resources whose hosts resolve to different IP addresses as updated
+func (c *client) refreshResolvedHosts(msgBroker *messaging.Broker, stop <-chan struct{}) {
+	ticker := time.NewTicker(dnsCacheTTL)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+
+		case <-ticker.C:
+			externalServices := c.policyController.ListExternalServices()
+
+			changedHosts := make(map[string]bool)
+			for _, externalService := range externalServices {
+				for _, host := range externalService.Spec.Hosts {
+					if _, refreshed := changedHosts[host]; !refreshed {
+						changedHosts[host] = c.refresh(host)
+					}
+				}
+			}
+
+			for _, externalService := range externalServices {
+				for _, host := range externalService.Spec.Hosts {
+					if changedHosts[host] {
+						log.Debug().Msgf("Host %s of ExternalService %s/%s resolves to different IP addresses",
+							host, externalService.Namespace, externalService.Name)
+						msgBroker.GetQueue().AddRateLimited(events.PubSubMessage{
+							Kind:   announcements.ExternalServiceUpdated,
+							OldObj: externalService,
+							NewObj: externalService,
+						})
+						break
+					}
+				}
+			}
+		}
+	}
+}
+
+// refresh resolves the given host again, and returns whether it resolves to different IP addresses than those
+// previously cached for it
+func (c *client) refresh(host string) bool {
+	if ip := net.ParseIP(host); ip != nil {
+		return false
+	}
+
+	ips, err := lookupIP(host)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Error resolving external host %s", host)
+		return false
+	}
+
+	c.dnsCacheLock.Lock()
+	defer c.dnsCacheLock.Unlock()
+
+	entry, cached := c.dnsCache[host]
+	c.dnsCache[host] = &dnsCacheEntry{ips: ips, expires: time.Now().Add(dnsCacheTTL)}
+
+	return cached && !equalIPs(entry.ips, ips)
+}
+
+// equalIPs returns whether the given lists of IP addresses contain the same IP addresses, regardless of their order
+func equalIPs(a, b []net.IP) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ips := make(map[string]int)
+	for _, ip := range a {
+		ips[ip.String()]++
+	}
+	for _, ip := range b {
+		if ips[ip.String()] == 0 {
+			return false
+		}
+		ips[ip.String()]--
+	}
+	return true
+}
+
+// GetServicesForServiceIdentity retrieves a list of services for the given service identity.
+// Services external to the mesh do not have a service identity.
+func (c *client) GetServicesForServiceIdentity(_ identity.ServiceIdentity) []service.MeshService {
+	return nil
+}
+
+// ListServices returns a list of services declared by ExternalService resources in monitored namespaces
+func (c *client) ListServices() []service.MeshService {
+	var services []service.MeshService
+	for _, externalService := range c.policyController.ListExternalServices() {
+		services = append(services, ExternalServiceToMeshServices(externalService)...)
+	}
+	return services
+}
+
+// ListServiceIdentitiesForService lists the service identities associated with the given mesh service.
+// Services external to the mesh do not have a service identity.
+func (c *client) ListServiceIdentitiesForService(_ service.MeshService) []identity.ServiceIdentity {
+	return nil
+}
+
+// ExternalServiceToMeshServices translates an ExternalService with one or more ports to one or more
+// MeshService objects per port.
+func ExternalServiceToMeshServices(externalService *policyv1alpha1.ExternalService) []service.MeshService {
+	var meshServices []service.MeshService
+	for _, portSpec := range externalService.Spec.Ports {
+		meshServices = append(meshServices, service.MeshService{
+			Namespace:  externalService.Namespace,
+			Name:       externalService.Name,
+			Port:       uint16(portSpec.Number),
+			TargetPort: uint16(getTargetPort(portSpec)),
+			Protocol:   portSpec.Protocol,
+		})
+	}
+	return meshServices
+}
+
+// getPortSpec returns the port spec of the ExternalService matching the given port number
+func getPortSpec(externalService *policyv1alpha1.ExternalService, port uint16) *policyv1alpha1.ExternalServicePortSpec {
+	for i := range externalService.Spec.Ports {
+		if externalService.Spec.Ports[i].Number == int(port) {
+			return &externalService.Spec.Ports[i]
+		}
+	}
+	return nil
+}
+
+// getTargetPort returns the port number of the endpoints for the given port spec
+func getTargetPort(portSpec policyv1alpha1.ExternalServicePortSpec) int {
+	if portSpec.TargetPort != 0 {
+		return portSpec.TargetPort
+	}
+	return portSpec.Number
+}
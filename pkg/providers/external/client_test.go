@@ -0,0 +1,229 @@
+package external
+
+import (
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/policy"
+	"github.com/openservicemesh/osm/pkg/service"
+)
+
+var (
+	dnsExternalService = &policyv1alpha1.ExternalService{
+		ObjectMeta: metav1.ObjectMeta{Name: "httpbin", Namespace: "test"},
+		Spec: policyv1alpha1.ExternalServiceSpec{
+			Hosts:      []string{"httpbin.org"},
+			Resolution: policyv1alpha1.ExternalServiceResolutionDNS,
+			Ports: []policyv1alpha1.ExternalServicePortSpec{
+				{Number: 80, Protocol: "http"},
+				{Number: 443, TargetPort: 8443, Protocol: "tcp"},
+			},
+		},
+	}
+
+	staticExternalService = &policyv1alpha1.ExternalService{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "test"},
+		Spec: policyv1alpha1.ExternalServiceSpec{
+			Hosts:      []string{"db.example.com"},
+			Resolution: policyv1alpha1.ExternalServiceResolutionStatic,
+			Ports: []policyv1alpha1.ExternalServicePortSpec{
+				{Number: 5432, TargetPort: 15432, Protocol: "tcp"},
+			},
+			Endpoints: []policyv1alpha1.ExternalServiceEndpointSpec{
+				{Address: "10.0.0.1", Weight: 3},
+				{Address: "10.0.0.2"},
+				{Address: "invalid"},
+			},
+		},
+	}
+
+	dnsMeshSvc    = service.MeshService{Name: "httpbin", Namespace: "test", Port: 80, TargetPort: 80, Protocol: "http"}
+	staticMeshSvc = service.MeshService{Name: "db", Namespace: "test", Port: 5432, TargetPort: 15432, Protocol: "tcp"}
+)
+
+func TestExternalServiceToMeshServices(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.ElementsMatch([]service.MeshService{
+		dnsMeshSvc,
+		{Name: "httpbin", Namespace: "test", Port: 443, TargetPort: 8443, Protocol: "tcp"},
+	}, ExternalServiceToMeshServices(dnsExternalService))
+}
+
+func TestListEndpointsForService(t *testing.T) {
+	testCases := []struct {
+		name            string
+		externalService *policyv1alpha1.ExternalService
+		svc             service.MeshService
+		expected        []endpoint.Endpoint
+	}{
+		{
+			name:            "static resolution returns the endpoints on the target port",
+			externalService: staticExternalService,
+			svc:             staticMeshSvc,
+			expected: []endpoint.Endpoint{
+				{IP: net.ParseIP("10.0.0.1"), Port: 15432, Weight: 3},
+				{IP: net.ParseIP("10.0.0.2"), Port: 15432, Weight: 1},
+			},
+		},
+		{
+			name:            "DNS resolution has no endpoints known to the control plane",
+			externalService: dnsExternalService,
+			svc:             dnsMeshSvc,
+			expected:        nil,
+		},
+		{
+			name:            "port not declared by the external service",
+			externalService: staticExternalService,
+			svc:             service.MeshService{Name: "db", Namespace: "test", Port: 80},
+			expected:        nil,
+		},
+		{
+			name:            "not an external service",
+			externalService: nil,
+			svc:             service.MeshService{Name: "bookstore", Namespace: "test", Port: 80},
+			expected:        nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().GetExternalService(tc.svc).Return(tc.externalService)
+
+			c := NewClient(mockPolicyController, nil, nil)
+			assert.Equal(tc.expected, c.ListEndpointsForService(tc.svc))
+		})
+	}
+}
+
+func TestGetResolvableEndpointsForService(t *testing.T) {
+	defer func(f func(string) ([]net.IP, error)) { lookupIP = f }(lookupIP)
+
+	testCases := []struct {
+		name            string
+		externalService *policyv1alpha1.ExternalService
+		svc             service.MeshService
+		lookupIP        func(string) ([]net.IP, error)
+		expected        []endpoint.Endpoint
+	}{
+		{
+			name:            "DNS resolution resolves the hosts",
+			externalService: dnsExternalService,
+			svc:             dnsMeshSvc,
+			lookupIP: func(host string) ([]net.IP, error) {
+				return []net.IP{net.ParseIP("1.1.1.1"), net.ParseIP("2.2.2.2")}, nil
+			},
+			expected: []endpoint.Endpoint{
+				{IP: net.ParseIP("1.1.1.1"), Port: 80},
+				{IP: net.ParseIP("2.2.2.2"), Port: 80},
+			},
+		},
+		{
+			name:            "static resolution includes the endpoint addresses",
+			externalService: staticExternalService,
+			svc:             staticMeshSvc,
+			lookupIP: func(host string) ([]net.IP, error) {
+				return nil, errors.New("no such host")
+			},
+			expected: []endpoint.Endpoint{
+				{IP: net.ParseIP("10.0.0.1"), Port: 5432},
+				{IP: net.ParseIP("10.0.0.2"), Port: 5432},
+			},
+		},
+		{
+			name:            "not an external service",
+			externalService: nil,
+			svc:             service.MeshService{Name: "bookstore", Namespace: "test", Port: 80},
+			expected:        nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			mockPolicyController := policy.NewMockController(mockCtrl)
+			mockPolicyController.EXPECT().GetExternalService(tc.svc).Return(tc.externalService)
+			lookupIP = tc.lookupIP
+
+			c := NewClient(mockPolicyController, nil, nil)
+			assert.Equal(tc.expected, c.GetResolvableEndpointsForService(tc.svc))
+		})
+	}
+}
+
+func TestResolveCachesAddresses(t *testing.T) {
+	assert := tassert.New(t)
+	defer func(f func(string) ([]net.IP, error)) { lookupIP = f }(lookupIP)
+
+	lookups := 0
+	lookupIP = func(host string) ([]net.IP, error) {
+		lookups++
+		if lookups > 1 {
+			return nil, errors.New("no such host")
+		}
+		return []net.IP{net.ParseIP("1.1.1.1")}, nil
+	}
+
+	c := NewClient(nil, nil, nil)
+	assert.Equal([]net.IP{net.ParseIP("1.1.1.1")}, c.resolve("httpbin.org"))
+	assert.Equal([]net.IP{net.ParseIP("1.1.1.1")}, c.resolve("httpbin.org"))
+	assert.Equal(1, lookups)
+
+	// Expired entries are kept when the host can no longer be resolved
+	c.dnsCache["httpbin.org"].expires = c.dnsCache["httpbin.org"].expires.Add(-2 * dnsCacheTTL)
+	assert.Equal([]net.IP{net.ParseIP("1.1.1.1")}, c.resolve("httpbin.org"))
+	assert.Equal(2, lookups)
+
+	// IP literals are not resolved
+	assert.Equal([]net.IP{net.ParseIP("3.3.3.3")}, c.resolve("3.3.3.3"))
+	assert.Equal(2, lookups)
+}
+
+func TestRefreshDetectsChangedAddresses(t *testing.T) {
+	assert := tassert.New(t)
+	defer func(f func(string) ([]net.IP, error)) { lookupIP = f }(lookupIP)
+
+	answers := [][]net.IP{
+		{net.ParseIP("1.1.1.1"), net.ParseIP("2.2.2.2")},
+		{net.ParseIP("2.2.2.2"), net.ParseIP("1.1.1.1")},
+		{net.ParseIP("3.3.3.3")},
+	}
+	lookups := 0
+	lookupIP = func(host string) ([]net.IP, error) {
+		if lookups >= len(answers) {
+			return nil, errors.New("no such host")
+		}
+		lookups++
+		return answers[lookups-1], nil
+	}
+
+	c := NewClient(nil, nil, nil)
+
+	// Hosts that were never resolved have not changed
+	assert.False(c.refresh("httpbin.org"))
+
+	// The order of the IP addresses does not matter
+	assert.False(c.refresh("httpbin.org"))
+
+	assert.True(c.refresh("httpbin.org"))
+	assert.Equal([]net.IP{net.ParseIP("3.3.3.3")}, c.resolve("httpbin.org"))
+
+	// The cached IP addresses are kept when the host can no longer be resolved
+	assert.False(c.refresh("httpbin.org"))
+	assert.Equal([]net.IP{net.ParseIP("3.3.3.3")}, c.resolve("httpbin.org"))
+
+	// IP literals are not resolved
+	assert.False(c.refresh("3.3.3.3"))
+	assert.Equal(3, lookups)
+}
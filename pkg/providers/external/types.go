@@ -0,0 +1,39 @@
+package external
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/openservicemesh/osm/pkg/logger"
+	"github.com/openservicemesh/osm/pkg/policy"
+)
+
+const (
+	// providerName is the name of the ExternalService client that implements service.Provider and endpoint.Provider interfaces
+	providerName = "external"
+
+	// dnsCacheTTL is the duration for which the IP addresses resolved for a hostname are cached
+	dnsCacheTTL = 30 * time.Second
+)
+
+var (
+	log = logger.New("external-provider")
+
+	// lookupIP resolves a hostname to its IP addresses, overridden in tests
+	lookupIP = net.LookupIP
+)
+
+// client is the type used to represent the ExternalService client for endpoints and service provider
+type client struct {
+	policyController policy.Controller
+
+	dnsCache     map[string]*dnsCacheEntry
+	dnsCacheLock sync.Mutex
+}
+
+// dnsCacheEntry is the type used to represent the IP addresses resolved for a hostname
+type dnsCacheEntry struct {
+	ips     []net.IP
+	expires time.Time
+}
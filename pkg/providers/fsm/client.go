@@ -245,8 +245,11 @@ func ServiceToMeshServices(c multicluster.Controller, svc corev1.Service) []serv
 		// Order of Preference is:
 		// 1. port.appProtocol field
 		// 2. protocol prefixed to port name (e.g. tcp-my-port)
-		// 3. default to http
+		// 3. default to udp for UDP ports, http otherwise
 		protocol := constants.ProtocolHTTP
+		if portSpec.Protocol == corev1.ProtocolUDP {
+			protocol = constants.ProtocolUDP
+		}
 		for _, p := range constants.SupportedProtocolsInMesh {
 			if strings.HasPrefix(portSpec.Name, p+"-") {
 				protocol = p
@@ -180,18 +180,24 @@ func (c *client) GetResolvableEndpointsForService(svc service.MeshService) []end
 		return c.ListEndpointsForService(svc)
 	}
 
-	// Cluster IP is present
-	ip := net.ParseIP(kubeService.Spec.ClusterIP)
-	if ip == nil {
-		log.Error().Msgf("[%s] Could not parse Cluster IP %s", c.GetID(), kubeService.Spec.ClusterIP)
-		return nil
+	// Cluster IP is present, a dual-stack service is assigned a cluster IP per IP family
+	clusterIPs := kubeService.Spec.ClusterIPs
+	if len(clusterIPs) == 0 {
+		clusterIPs = []string{kubeService.Spec.ClusterIP}
 	}
+	for _, clusterIP := range clusterIPs {
+		ip := net.ParseIP(clusterIP)
+		if ip == nil {
+			log.Error().Msgf("[%s] Could not parse Cluster IP %s", c.GetID(), clusterIP)
+			return nil
+		}
 
-	for _, svcPort := range kubeService.Spec.Ports {
-		endpoints = append(endpoints, endpoint.Endpoint{
-			IP:   ip,
-			Port: endpoint.Port(svcPort.Port),
-		})
+		for _, svcPort := range kubeService.Spec.Ports {
+			endpoints = append(endpoints, endpoint.Endpoint{
+				IP:   ip,
+				Port: endpoint.Port(svcPort.Port),
+			})
+		}
 	}
 
 	return endpoints
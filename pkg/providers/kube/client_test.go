@@ -199,6 +199,36 @@ var _ = Describe("Test Kube client Provider (w/o kubecontroller)", func() {
 		}))
 	})
 
+	It("GetResolvableEndpoints should properly return endpoints based on all the ClusterIPs of a dual-stack service", func() {
+		// If the service has a cluster IP per IP family, expect each cluster IP + port
+		mockKubeController.EXPECT().GetService(tests.BookbuyerService).Return(&corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      tests.BookbuyerService.Name,
+				Namespace: tests.BookbuyerService.Namespace,
+			},
+			Spec: corev1.ServiceSpec{
+				ClusterIP:  "192.168.0.1",
+				ClusterIPs: []string{"192.168.0.1", "fd00::1"},
+				Ports: []corev1.ServicePort{{
+					Name:     "servicePort",
+					Protocol: corev1.ProtocolTCP,
+					Port:     tests.ServicePort,
+				}},
+			},
+		})
+
+		Expect(c.GetResolvableEndpointsForService(tests.BookbuyerService)).To(Equal([]endpoint.Endpoint{
+			{
+				IP:   net.IPv4(192, 168, 0, 1),
+				Port: tests.ServicePort,
+			},
+			{
+				IP:   net.ParseIP("fd00::1"),
+				Port: tests.ServicePort,
+			},
+		}))
+	})
+
 	It("GetResolvableEndpoints should properly return actual endpoints without ClusterIP when ClusterIP is not set", func() {
 		// Expect the individual pod endpoints, when no cluster IP is assigned to the service
 		mockKubeController.EXPECT().GetService(meshSvc).Return(&corev1.Service{
@@ -52,26 +52,26 @@ func (cb *Callbacks) OnFetchResponse(req *discovery.DiscoveryRequest, resp *disc
 	// Unimplemented
 }
 
-// --- Delta stream types below. Callback interfaces still requires these to be defined
+// --- Delta stream types below
 
 // OnDeltaStreamOpen is called when a Delta stream is being opened
 func (cb *Callbacks) OnDeltaStreamOpen(_ context.Context, id int64, typ string) error {
-	// Unimplemented
-	return errUnsuportedXDSRequest
+	log.Debug().Msgf("OnDeltaStreamOpen id: %d typ: %s", id, typ)
+	return nil
 }
 
 // OnDeltaStreamClosed is called when a Delta stream is being closed
 func (cb *Callbacks) OnDeltaStreamClosed(id int64) {
-	// Unimplemented
+	log.Debug().Msgf("OnDeltaStreamClosed id: %d", id)
 }
 
 // OnStreamDeltaRequest is called when a Delta request comes on an open Delta stream
 func (cb *Callbacks) OnStreamDeltaRequest(a int64, req *discovery.DeltaDiscoveryRequest) error {
-	// Unimplemented
-	return errUnsuportedXDSRequest
+	log.Debug().Msgf("OnStreamDeltaRequest node: %s, type: %s, nonce: %s, subscribe: %s, unsubscribe: %s", req.GetNode().GetId(), req.TypeUrl, req.ResponseNonce, req.ResourceNamesSubscribe, req.ResourceNamesUnsubscribe)
+	return nil
 }
 
 // OnStreamDeltaResponse is called when a Delta request is getting responded to
 func (cb *Callbacks) OnStreamDeltaResponse(a int64, req *discovery.DeltaDiscoveryRequest, resp *discovery.DeltaDiscoveryResponse) {
-	// Unimplemented
+	log.Debug().Msgf("OnStreamDeltaResponse RESP: type: %s, v: %s, nonce: %s, NumResources: %d, NumRemovedResources: %d", resp.TypeUrl, resp.SystemVersionInfo, resp.Nonce, len(resp.Resources), len(resp.RemovedResources))
 }
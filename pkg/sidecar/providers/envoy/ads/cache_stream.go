@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 
+	mapset "github.com/deckarep/golang-set"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	"github.com/google/uuid"
@@ -14,24 +15,28 @@ import (
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/messaging"
 	"github.com/openservicemesh/osm/pkg/models"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 )
 
 // Routine which fulfills listening to proxy broadcasts
 func (s *Server) broadcastListener() {
-	// Register for proxy config updates broadcasted by the message broker
+	// Register for proxy config updates broadcasted by the message broker, and for the
+	// updates targeted to the proxies depending on the changed resources
 	proxyUpdatePubSub := s.msgBroker.GetProxyUpdatePubSub()
-	proxyUpdateChan := proxyUpdatePubSub.Sub(announcements.ProxyUpdate.String())
+	proxyUpdateChan := proxyUpdatePubSub.Sub(announcements.ProxyUpdate.String(), announcements.ProxyTargetedUpdate.String())
 	defer s.msgBroker.Unsub(proxyUpdatePubSub, proxyUpdateChan)
 
-	for {
-		<-proxyUpdateChan
-		s.allPodUpdater()
+	for msg := range proxyUpdateChan {
+		s.allPodUpdater(messaging.GetProxyUpdateTargets(msg))
 	}
 }
 
-func (s *Server) allPodUpdater() {
+// allPodUpdater queues a configuration update for the proxies of all the pods, or only for
+// the proxies of the pods with the given proxy UUIDs and the proxies without recorded dependencies
+// when they are specified.
+func (s *Server) allPodUpdater(proxyUUIDs mapset.Set) {
 	allpods := s.kubecontroller.ListPods()
 
 	for _, pod := range allpods {
@@ -41,6 +46,10 @@ func (s *Server) allPodUpdater() {
 				Msgf("Could not get proxy from pod %s/%s", pod.Namespace, pod.Name)
 			continue
 		}
+		// The proxies whose dependencies are not recorded yet are updated on every targeted update
+		if proxyUUIDs != nil && !proxyUUIDs.Contains(proxy.UUID.String()) && s.msgBroker.HasProxyDependencies(proxy.UUID.String()) {
+			continue
+		}
 
 		// Queue update for this proxy/pod
 		job := proxyResponseJob{
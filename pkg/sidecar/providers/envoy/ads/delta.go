@@ -0,0 +1,180 @@
+package ads
+
+import (
+	"fmt"
+
+	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+
+	"github.com/openservicemesh/osm/pkg/announcements"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/k8s/events"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/metricsstore"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+// DeltaAggregatedResources handles incremental (Delta) xDS streaming of the resources to the connected Envoy proxies.
+// Contrary to StreamAggregatedResources, only the resources that changed since they were last sent to the proxy
+// and the names of the removed ones are sent.
+// This is evaluated once per new Envoy proxy connecting and remains running for the duration of the gRPC socket.
+func (s *Server) DeltaAggregatedResources(server xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
+	proxy, err := s.connectProxy(server.Context())
+	if err != nil {
+		return err
+	}
+
+	s.proxyRegistry.RegisterProxy(proxy)
+
+	defer s.proxyRegistry.UnregisterProxy(proxy)
+
+	quit := make(chan struct{})
+	requests := make(chan *xds_discovery.DeltaDiscoveryRequest)
+
+	// This helper handles receiving messages from the connected Envoys
+	// and any gRPC error states.
+	go receiveDelta(requests, &server, proxy, quit)
+
+	// Subscribe to both broadcast and proxy UUID specific events
+	proxyUpdatePubSub := s.msgBroker.GetProxyUpdatePubSub()
+	proxyUpdateChan := proxyUpdatePubSub.Sub(announcements.ProxyUpdate.String(), messaging.GetPubSubTopicForProxyUUID(proxy.UUID.String()))
+	defer s.msgBroker.Unsub(proxyUpdatePubSub, proxyUpdateChan)
+
+	// Until its dependencies are recorded, the proxy is updated on every targeted update
+	s.msgBroker.AddProxy(proxy.UUID.String())
+	defer s.msgBroker.RemoveProxy(proxy.UUID.String())
+
+	// Register for certificate rotation updates
+	certPubSub := s.msgBroker.GetCertPubSub()
+	certRotateChan := certPubSub.Sub(announcements.CertificateRotated.String())
+	defer s.msgBroker.Unsub(certPubSub, certRotateChan)
+
+	newJob := func(typeURIs []envoy.TypeURI, deltaRequest *xds_discovery.DeltaDiscoveryRequest) *proxyDeltaResponseJob {
+		return &proxyDeltaResponseJob{
+			typeURIs:    typeURIs,
+			proxy:       proxy,
+			deltaStream: &server,
+			request:     deltaRequest,
+			xdsServer:   s,
+			done:        make(chan struct{}),
+		}
+	}
+
+	for {
+		select {
+		case <-quit:
+			log.Debug().Str("proxy", proxy.String()).Msgf("gRPC stream closed")
+			metricsstore.DefaultMetricsStore.ProxyConnectCount.Dec()
+			return nil
+
+		case deltaRequest, ok := <-requests:
+			if !ok {
+				log.Error().Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrGRPCStreamClosedByProxy)).Str("proxy", proxy.String()).
+					Msgf("gRPC stream closed by proxy %s!", proxy)
+				metricsstore.DefaultMetricsStore.ProxyConnectCount.Dec()
+				return errGrpcClosed
+			}
+			log.Debug().Str("proxy", proxy.String()).Msgf("Processing DeltaDiscoveryRequest %s", deltaReqToStr(deltaRequest))
+
+			metricsstore.DefaultMetricsStore.ProxyXDSRequestCount.WithLabelValues(proxy.UUID.String(), proxy.Identity.String(), deltaRequest.TypeUrl).Inc()
+
+			// This function call runs the Delta xDS proto state machine given DeltaDiscoveryRequest as input.
+			// It's output is the decision to reply or not to this request.
+			if !respondToDeltaRequest(proxy, deltaRequest) {
+				log.Debug().Str("proxy", proxy.String()).Msgf("Ignoring DeltaDiscoveryRequest %s that does not need to be responded to", deltaReqToStr(deltaRequest))
+				continue
+			}
+
+			<-s.workqueues.AddJob(newJob([]envoy.TypeURI{envoy.TypeURI(deltaRequest.TypeUrl)}, deltaRequest))
+
+		case <-proxyUpdateChan:
+			log.Info().Str("proxy", proxy.String()).Msg("Broadcast update received")
+
+			if !shouldPushUpdate(proxy) {
+				log.Error().Str("proxy", proxy.String()).Msg("Proxy has still not gone through init phase, not force-pushing new version")
+				continue
+			}
+
+			// Queue a delta update of all the verticals, only the resources that changed are sent.
+			// Do not send SDS, let envoy figure out what certs does it want.
+			<-s.workqueues.AddJob(newJob([]envoy.TypeURI{envoy.TypeCDS, envoy.TypeEDS, envoy.TypeLDS, envoy.TypeRDS}, nil))
+
+		case certRotateMsg := <-certRotateChan:
+			cert := certRotateMsg.(events.PubSubMessage).NewObj.(*certificate.Certificate)
+			if isCNforProxy(proxy, cert.GetCommonName()) {
+				log.Debug().Str("proxy", proxy.String()).Msg("Certificate has been updated for proxy")
+				<-s.workqueues.AddJob(newJob([]envoy.TypeURI{envoy.TypeSDS}, nil))
+			}
+		}
+	}
+}
+
+func deltaReqToStr(deltaReq *xds_discovery.DeltaDiscoveryRequest) string {
+	return fmt.Sprintf("[TypeUrl=%s], [nonce=%s], subscribe=[%v], unsubscribe=[%v]",
+		deltaReq.TypeUrl, deltaReq.ResponseNonce, deltaReq.ResourceNamesSubscribe, deltaReq.ResourceNamesUnsubscribe)
+}
+
+// respondToDeltaRequest updates the resources the given proxy is subscribed to given a DeltaDiscoveryRequest,
+// and assesses if the request should be responded with an xDS DeltaDiscoveryResponse.
+func respondToDeltaRequest(proxy *envoy.Proxy, deltaRequest *xds_discovery.DeltaDiscoveryRequest) bool {
+	typeURL, ok := envoy.ValidURI[deltaRequest.TypeUrl]
+	if !ok {
+		log.Error().Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrInvalidXDSTypeURI)).Str("proxy", proxy.String()).
+			Msgf("Unknown/Unsupported URI: %s", deltaRequest.TypeUrl)
+		return false
+	}
+
+	if typeURL == envoy.TypeEmptyURI {
+		log.Debug().Str("proxy", proxy.String()).Msg("Ignoring EmptyURI Type")
+		return false
+	}
+
+	// Handle NACK case
+	if deltaRequest.ErrorDetail != nil {
+		log.Error().Str("proxy", proxy.String()).Msgf("[NACK] err: \"%s\" for nonce %s",
+			deltaRequest.ErrorDetail, deltaRequest.ResponseNonce)
+		return false
+	}
+
+	// Update the subscribed resources. The proxy subscribes to all the resources of the wildcard TypeURIs,
+	// for which the subscribed resources purposefully remain empty at all times.
+	versions := proxy.GetResourceVersions(typeURL)
+	subscriptionChanged := false
+	if !envoy.IsWildcardTypeURI(typeURL) {
+		subscribedResources := proxy.GetSubscribedResources(typeURL).Clone()
+		for _, name := range deltaRequest.ResourceNamesSubscribe {
+			if subscribedResources.Add(name) {
+				subscriptionChanged = true
+			}
+		}
+		for _, name := range deltaRequest.ResourceNamesUnsubscribe {
+			subscribedResources.Remove(name)
+			delete(versions, name)
+		}
+		proxy.SetSubscribedResources(typeURL, subscribedResources)
+	}
+
+	// Handle first request for the TypeURI on the stream case, should always reply to empty nonce.
+	// When reconnecting, the proxy reports the versions of the resources it already has, which are
+	// then only sent if they changed.
+	if deltaRequest.ResponseNonce == "" {
+		log.Debug().Str("proxy", proxy.String()).Msgf("Empty nonce for %s, should be first message on stream (initial resources: %d)",
+			typeURL.Short(), len(deltaRequest.InitialResourceVersions))
+		for name, version := range deltaRequest.InitialResourceVersions {
+			versions[name] = version
+		}
+		proxy.SetResourceVersions(typeURL, versions)
+		return true
+	}
+	proxy.SetResourceVersions(typeURL, versions)
+
+	// Newly subscribed resources must be sent, otherwise this is an ACK
+	if subscriptionChanged {
+		log.Debug().Str("proxy", proxy.String()).Msgf("New %s resources subscribed to: %v, triggering update",
+			typeURL.Short(), deltaRequest.ResourceNamesSubscribe)
+		return true
+	}
+
+	log.Debug().Str("proxy", proxy.String()).Msgf("ACK received for %s, nonce: %s", typeURL.Short(), deltaRequest.ResponseNonce)
+	return false
+}
@@ -0,0 +1,327 @@
+package ads
+
+import (
+	"fmt"
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/server/stream/v3"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/openservicemesh/osm/pkg/catalog"
+	"github.com/openservicemesh/osm/pkg/certificate"
+	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/models"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/registry"
+	"github.com/openservicemesh/osm/pkg/tests"
+)
+
+func TestRespondToDeltaRequest(t *testing.T) {
+	testCases := []struct {
+		name                string
+		setup               func(*envoy.Proxy)
+		request             *xds_discovery.DeltaDiscoveryRequest
+		expectedRespond     bool
+		expectedSubscribed  []string
+		expectedVersions    map[string]string
+		expectedVersionType envoy.TypeURI
+	}{
+		{
+			name:            "unknown type URI",
+			request:         &xds_discovery.DeltaDiscoveryRequest{TypeUrl: "unknown"},
+			expectedRespond: false,
+		},
+		{
+			name: "NACK",
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:       envoy.TypeCDS.String(),
+				ResponseNonce: "1",
+				ErrorDetail:   &status.Status{Message: "error"},
+			},
+			expectedRespond: false,
+		},
+		{
+			name: "first wildcard request with initial resource versions",
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:                 envoy.TypeCDS.String(),
+				InitialResourceVersions: map[string]string{"A": "1"},
+			},
+			expectedRespond:     true,
+			expectedSubscribed:  []string{},
+			expectedVersions:    map[string]string{"A": "1"},
+			expectedVersionType: envoy.TypeCDS,
+		},
+		{
+			name: "first non-wildcard request",
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:                envoy.TypeEDS.String(),
+				ResourceNamesSubscribe: []string{"A", "B"},
+			},
+			expectedRespond:     true,
+			expectedSubscribed:  []string{"A", "B"},
+			expectedVersions:    map[string]string{},
+			expectedVersionType: envoy.TypeEDS,
+		},
+		{
+			name: "ACK",
+			setup: func(p *envoy.Proxy) {
+				p.SetSubscribedResources(envoy.TypeEDS, mapset.NewSetWith("A"))
+				p.SetResourceVersions(envoy.TypeEDS, map[string]string{"A": "1"})
+			},
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:       envoy.TypeEDS.String(),
+				ResponseNonce: "1",
+			},
+			expectedRespond:     false,
+			expectedSubscribed:  []string{"A"},
+			expectedVersions:    map[string]string{"A": "1"},
+			expectedVersionType: envoy.TypeEDS,
+		},
+		{
+			name: "new subscription and unsubscription",
+			setup: func(p *envoy.Proxy) {
+				p.SetSubscribedResources(envoy.TypeEDS, mapset.NewSetWith("A", "B"))
+				p.SetResourceVersions(envoy.TypeEDS, map[string]string{"A": "1", "B": "2"})
+			},
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:                  envoy.TypeEDS.String(),
+				ResponseNonce:            "1",
+				ResourceNamesSubscribe:   []string{"C"},
+				ResourceNamesUnsubscribe: []string{"B"},
+			},
+			expectedRespond:     true,
+			expectedSubscribed:  []string{"A", "C"},
+			expectedVersions:    map[string]string{"A": "1"},
+			expectedVersionType: envoy.TypeEDS,
+		},
+		{
+			name: "unsubscription only",
+			setup: func(p *envoy.Proxy) {
+				p.SetSubscribedResources(envoy.TypeEDS, mapset.NewSetWith("A", "B"))
+				p.SetResourceVersions(envoy.TypeEDS, map[string]string{"A": "1", "B": "2"})
+			},
+			request: &xds_discovery.DeltaDiscoveryRequest{
+				TypeUrl:                  envoy.TypeEDS.String(),
+				ResponseNonce:            "1",
+				ResourceNamesUnsubscribe: []string{"B"},
+			},
+			expectedRespond:     false,
+			expectedSubscribed:  []string{"A"},
+			expectedVersions:    map[string]string{"A": "1"},
+			expectedVersionType: envoy.TypeEDS,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			proxy := envoy.NewProxy(models.KindSidecar, uuid.New(), identity.New("svc-acc", "namespace"), nil)
+			if tc.setup != nil {
+				tc.setup(proxy)
+			}
+
+			assert.Equal(tc.expectedRespond, respondToDeltaRequest(proxy, tc.request))
+			if tc.expectedVersionType != "" {
+				assert.Equal(tc.expectedSubscribed, getResourceSliceFromMapset(proxy.GetSubscribedResources(tc.expectedVersionType)))
+				assert.Equal(tc.expectedVersions, proxy.GetResourceVersions(tc.expectedVersionType))
+			}
+		})
+	}
+}
+
+func TestSendDeltaDiscoveryResponse(t *testing.T) {
+	assert := tassert.New(t)
+
+	s := &Server{}
+	proxy := envoy.NewProxy(models.KindSidecar, uuid.New(), identity.New("svc-acc", "namespace"), nil)
+	server, responses := tests.NewFakeDeltaXDSServer(nil)
+
+	clusterA := &xds_cluster.Cluster{Name: "A"}
+	clusterB := &xds_cluster.Cluster{Name: "B"}
+
+	// First response sends all the resources
+	err := s.SendDeltaDiscoveryResponse(proxy, envoy.TypeCDS, &server, []types.Resource{clusterA, clusterB}, true)
+	assert.Nil(err)
+	assert.Len(*responses, 1)
+	assert.Len((*responses)[0].Resources, 2)
+	assert.Empty((*responses)[0].RemovedResources)
+	assert.Equal("1", (*responses)[0].SystemVersionInfo)
+	assert.Equal(proxy.GetLastSentNonce(envoy.TypeCDS), (*responses)[0].Nonce)
+	assert.Len(proxy.GetResourceVersions(envoy.TypeCDS), 2)
+
+	// No response is sent when no resource changed
+	err = s.SendDeltaDiscoveryResponse(proxy, envoy.TypeCDS, &server, []types.Resource{clusterA, clusterB}, false)
+	assert.Nil(err)
+	assert.Len(*responses, 1)
+
+	// Only the changed resources and the names of the removed ones are sent
+	clusterA = &xds_cluster.Cluster{Name: "A", AltStatName: "a"}
+	err = s.SendDeltaDiscoveryResponse(proxy, envoy.TypeCDS, &server, []types.Resource{clusterA}, false)
+	assert.Nil(err)
+	assert.Len(*responses, 2)
+	assert.Len((*responses)[1].Resources, 1)
+	assert.Equal("A", (*responses)[1].Resources[0].Name)
+	assert.Equal([]string{"B"}, (*responses)[1].RemovedResources)
+	assert.Equal("2", (*responses)[1].SystemVersionInfo)
+	assert.Len(proxy.GetResourceVersions(envoy.TypeCDS), 1)
+
+	// Non-wildcard resources are only sent if subscribed to, subscribed resources that do not exist are removed
+	proxy.SetSubscribedResources(envoy.TypeEDS, mapset.NewSetWith("A", "C"))
+	err = s.SendDeltaDiscoveryResponse(proxy, envoy.TypeEDS, &server, []types.Resource{
+		&xds_endpoint.ClusterLoadAssignment{ClusterName: "A"},
+		&xds_endpoint.ClusterLoadAssignment{ClusterName: "B"},
+	}, true)
+	assert.Nil(err)
+	assert.Len(*responses, 3)
+	assert.Len((*responses)[2].Resources, 1)
+	assert.Equal("A", (*responses)[2].Resources[0].Name)
+	assert.Equal([]string{"C"}, (*responses)[2].RemovedResources)
+}
+
+func TestSendDeltaDiscoveryResponseUnchangedMaps(t *testing.T) {
+	assert := tassert.New(t)
+
+	s := &Server{}
+	proxy := envoy.NewProxy(models.KindSidecar, uuid.New(), identity.New("svc-acc", "namespace"), nil)
+	server, responses := tests.NewFakeDeltaXDSServer(nil)
+
+	// newRouteConfiguration returns a resource embedding maps, both in the resource and in its Any messages
+	newRouteConfiguration := func() types.Resource {
+		fields := make(map[string]interface{})
+		for i := 0; i < 32; i++ {
+			fields[fmt.Sprintf("key-%d", i)] = fmt.Sprintf("value-%d", i)
+		}
+		config, err := structpb.NewStruct(fields)
+		assert.Nil(err)
+		typedConfig, err := protobuf.MarshalAny(config)
+		assert.Nil(err)
+		typedPerFilterConfig := make(map[string]*anypb.Any)
+		for i := 0; i < 8; i++ {
+			typedPerFilterConfig[fmt.Sprintf("filter-%d", i)] = typedConfig
+		}
+		return &xds_route.RouteConfiguration{
+			Name: "rds",
+			VirtualHosts: []*xds_route.VirtualHost{
+				{
+					Name:                 "vhost",
+					TypedPerFilterConfig: typedPerFilterConfig,
+				},
+			},
+		}
+	}
+
+	proxy.SetSubscribedResources(envoy.TypeRDS, mapset.NewSetWith("rds"))
+	err := s.SendDeltaDiscoveryResponse(proxy, envoy.TypeRDS, &server, []types.Resource{newRouteConfiguration()}, true)
+	assert.Nil(err)
+	assert.Len(*responses, 1)
+	assert.Len((*responses)[0].Resources, 1)
+
+	// The version of a resource whose content did not change does not change
+	for i := 0; i < 10; i++ {
+		err = s.SendDeltaDiscoveryResponse(proxy, envoy.TypeRDS, &server, []types.Resource{newRouteConfiguration()}, false)
+		assert.Nil(err)
+		assert.Len(*responses, 1)
+	}
+}
+
+func TestSendResponseDeltaSnapshotCache(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockCfg := configurator.NewMockConfigurator(mockCtrl)
+	mockCfg.EXPECT().IsDebugServerEnabled().Return(false).AnyTimes()
+
+	proxy := envoy.NewProxy(models.KindSidecar, uuid.New(), identity.New("svc-acc", "namespace"), nil)
+
+	endpointIP := "10.0.0.1"
+	s := &Server{
+		cfg:           mockCfg,
+		cacheEnabled:  true,
+		ch:            cache.NewSnapshotCache(false, cache.IDHash{}, &scLogger{}),
+		configVersion: make(map[string]uint64),
+		xdsHandlers: map[envoy.TypeURI]func(catalog.MeshCataloger, *envoy.Proxy, *xds_discovery.DiscoveryRequest, configurator.Configurator, *certificate.Manager, *registry.ProxyRegistry) ([]types.Resource, error){
+			envoy.TypeCDS: func(catalog.MeshCataloger, *envoy.Proxy, *xds_discovery.DiscoveryRequest, configurator.Configurator, *certificate.Manager, *registry.ProxyRegistry) ([]types.Resource, error) {
+				return []types.Resource{&xds_cluster.Cluster{Name: "A"}, &xds_cluster.Cluster{Name: "B"}}, nil
+			},
+			envoy.TypeEDS: func(catalog.MeshCataloger, *envoy.Proxy, *xds_discovery.DiscoveryRequest, configurator.Configurator, *certificate.Manager, *registry.ProxyRegistry) ([]types.Resource, error) {
+				return []types.Resource{
+					&xds_endpoint.ClusterLoadAssignment{
+						ClusterName: "A",
+						Endpoints: []*xds_endpoint.LocalityLbEndpoints{
+							{
+								LbEndpoints: []*xds_endpoint.LbEndpoint{
+									{
+										HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+											Endpoint: &xds_endpoint.Endpoint{
+												Address: envoy.GetAddress(endpointIP, 80),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+					&xds_endpoint.ClusterLoadAssignment{ClusterName: "B"},
+				}, nil
+			},
+		},
+	}
+
+	// watchEndpoints returns the delta response of the snapshot cache to the proxy subscribed to the given
+	// resource versions, or nil if no resource changed
+	watchEndpoints := func(state stream.StreamState) *cache.RawDeltaResponse {
+		responses := make(chan cache.DeltaResponse, 1)
+		cancel := s.ch.CreateDeltaWatch(&cache.DeltaRequest{
+			Node:    &xds_core.Node{Id: proxy.UUID.String()},
+			TypeUrl: envoy.TypeEDS.String(),
+		}, state, responses)
+		if cancel != nil {
+			cancel()
+			return nil
+		}
+		return (<-responses).(*cache.RawDeltaResponse)
+	}
+
+	// The resources of the snapshot are sent to the proxy subscribing to them
+	err := s.sendResponse(proxy, nil, nil, mockCfg, envoy.TypeCDS, envoy.TypeEDS)
+	assert.Nil(err)
+	response := watchEndpoints(stream.NewStreamState(false, map[string]string{"A": "", "B": ""}))
+	assert.NotNil(response)
+	assert.Len(response.Resources, 2)
+	versions := response.NextVersionMap
+
+	// Only the changed resources are sent once the endpoints changed
+	endpointIP = "10.0.0.2"
+	err = s.sendResponse(proxy, nil, nil, mockCfg, envoy.TypeCDS, envoy.TypeEDS)
+	assert.Nil(err)
+	state := stream.NewStreamState(false, nil)
+	state.SetResourceVersions(versions)
+	response = watchEndpoints(state)
+	assert.NotNil(response)
+	assert.Len(response.Resources, 1)
+	assert.Equal("A", cache.GetResourceName(response.Resources[0]))
+	assert.Empty(response.RemovedResources)
+	versions = response.NextVersionMap
+
+	// Nothing is sent when no resource changed
+	err = s.sendResponse(proxy, nil, nil, mockCfg, envoy.TypeCDS, envoy.TypeEDS)
+	assert.Nil(err)
+	state = stream.NewStreamState(false, nil)
+	state.SetResourceVersions(versions)
+	assert.Nil(watchEndpoints(state))
+}
@@ -0,0 +1,55 @@
+package ads
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/catalog"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/models"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/registry"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestRecordProxyDependencies(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	msgBroker := messaging.NewBroker(stop)
+
+	proxyIdentity := identity.K8sServiceAccount{Name: "bookstore", Namespace: "ns"}.ToServiceIdentity()
+	proxy := envoy.NewProxy(models.KindSidecar, uuid.New(), proxyIdentity, nil)
+	proxyServices := []service.MeshService{{Name: "bookstore", Namespace: "ns"}}
+	dependency := messaging.NewProxyDependency(messaging.ServiceDependency, "ns", "bookstore")
+
+	var mapperErr error
+	s := &Server{
+		catalog:   mockCatalog,
+		msgBroker: msgBroker,
+		proxyRegistry: registry.NewProxyRegistry(registry.ExplicitProxyServiceMapper(func(*envoy.Proxy) ([]service.MeshService, error) {
+			return proxyServices, mapperErr
+		}), nil),
+	}
+
+	// The dependencies are listed through the MeshCataloger interface, from the policy the clusters were generated from
+	outboundPolicy := &trafficpolicy.OutboundMeshTrafficPolicy{}
+	proxy.SetOutboundMeshTrafficPolicy(outboundPolicy)
+	mockCatalog.EXPECT().ListProxyDependencies(proxyIdentity, proxyServices, outboundPolicy).Return([]messaging.ProxyDependency{dependency}).Times(1)
+	s.recordProxyDependencies(proxy)
+	assert.True(msgBroker.HasProxyDependencies(proxy.UUID.String()))
+
+	// The dependencies are removed when the services of the proxy cannot be listed
+	mapperErr = errors.New("error")
+	s.recordProxyDependencies(proxy)
+	assert.False(msgBroker.HasProxyDependencies(proxy.UUID.String()))
+}
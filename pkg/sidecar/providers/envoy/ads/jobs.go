@@ -39,3 +39,35 @@ func (proxyJob *proxyResponseJob) Run() {
 func (proxyJob *proxyResponseJob) JobName() string {
 	return fmt.Sprintf("sendJob-%s", proxyJob.proxy.GetName())
 }
+
+// proxyDeltaResponseJob is the worker pool job implementation for a Proxy Delta response function
+// It takes the parameters of `server.sendDeltaResponse` and allows to queue it as a job on a workerpool
+type proxyDeltaResponseJob struct {
+	typeURIs    []envoy.TypeURI
+	proxy       *envoy.Proxy
+	deltaStream *xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+	request     *xds_discovery.DeltaDiscoveryRequest
+	xdsServer   *Server
+
+	// Optional waiter
+	done chan struct{}
+}
+
+// GetDoneCh returns the channel, which when closed, indicates the job has been finished.
+func (proxyJob *proxyDeltaResponseJob) GetDoneCh() <-chan struct{} {
+	return proxyJob.done
+}
+
+// Run implementation for `server.sendDeltaResponse` job
+func (proxyJob *proxyDeltaResponseJob) Run() {
+	err := (*proxyJob.xdsServer).sendDeltaResponse(proxyJob.proxy, proxyJob.deltaStream, proxyJob.request, proxyJob.typeURIs...)
+	if err != nil {
+		log.Error().Err(err).Str("proxy", proxyJob.proxy.String()).Msgf("Failed to create and send %v delta update to proxy", proxyJob.typeURIs)
+	}
+	close(proxyJob.done)
+}
+
+// JobName implementation for this job, for logging purposes
+func (proxyJob *proxyDeltaResponseJob) JobName() string {
+	return fmt.Sprintf("sendDeltaJob-%s", proxyJob.proxy.GetName())
+}
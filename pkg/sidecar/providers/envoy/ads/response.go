@@ -1,6 +1,7 @@
 package ads
 
 import (
+	"sort"
 	"strconv"
 	"time"
 
@@ -106,6 +107,10 @@ func (s *Server) sendResponse(proxy *envoy.Proxy, server *xds_discovery.Aggregat
 		}
 	}
 
+	if containsTypeURI(typeURIsToSend, envoy.TypeCDS) {
+		s.recordProxyDependencies(proxy)
+	}
+
 	isFullUpdate := len(typeURIsToSend) == len(envoy.XDSResponseOrder)
 	if isFullUpdate {
 		success := !thereWereErrors
@@ -115,6 +120,34 @@ func (s *Server) sendResponse(proxy *envoy.Proxy, server *xds_discovery.Aggregat
 	return nil
 }
 
+// recordProxyDependencies records the resources the configuration of the given proxy depends on with the message
+// broker, so that the proxy is only updated when one of these resources changes. The dependencies are recorded
+// when the clusters are generated, which happens when the proxy connects and on every full configuration update.
+func (s *Server) recordProxyDependencies(proxy *envoy.Proxy) {
+	if s.msgBroker == nil {
+		return
+	}
+
+	proxyServices, err := s.proxyRegistry.ListProxyServices(proxy)
+	if err != nil {
+		// Without the dependencies recorded, the proxy is updated by every targeted and broadcast event
+		s.msgBroker.RemoveProxyDependencies(proxy.UUID.String())
+		return
+	}
+	s.msgBroker.SetProxyDependencies(proxy.UUID.String(),
+		s.catalog.ListProxyDependencies(proxy.Identity, proxyServices, proxy.GetOutboundMeshTrafficPolicy()))
+}
+
+// containsTypeURI returns true if the given TypeURIs contain the given TypeURI
+func containsTypeURI(typeURIs []envoy.TypeURI, typeURI envoy.TypeURI) bool {
+	for _, t := range typeURIs {
+		if t == typeURI {
+			return true
+		}
+	}
+	return false
+}
+
 // SendDiscoveryResponse creates a new response for <proxy> given <resourcesToSend> and <request.TypeURI> and sends it
 func (s *Server) SendDiscoveryResponse(proxy *envoy.Proxy, request *xds_discovery.DiscoveryRequest, server *xds_discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer, resourcesToSend []types.Resource) error {
 	// request.Node is only available on the first Discovery Request; will be nil on the following
@@ -173,3 +206,145 @@ func (s *Server) SendDiscoveryResponse(proxy *envoy.Proxy, request *xds_discover
 
 	return nil
 }
+
+// sendDeltaResponse takes a set of TypeURIs which will be called to generate the xDS resources
+// for, and will have the resources that changed since they were last sent to the proxy sent on the Delta stream.
+// If no DeltaDiscoveryRequest is passed, the update is OSM driven and no response is sent for the
+// TypeURIs whose resources did not change.
+func (s *Server) sendDeltaResponse(proxy *envoy.Proxy, server *xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer, request *xds_discovery.DeltaDiscoveryRequest, typeURIsToSend ...envoy.TypeURI) error {
+	thereWereErrors := false
+
+	// A nil request indicates a change on mesh configuration, OSM will trigger an update
+	// for all proxy config (we generate a response with no direct request from envoy)
+	osmDrivenUpdate := request == nil
+
+	// Order is important: CDS, EDS, LDS, RDS
+	// See: https://github.com/envoyproxy/go-control-plane/issues/59
+	for _, typeURI := range typeURIsToSend {
+		// The verticals generate the resources for the resources subscribed to thus far.
+		// For CDS and LDS, this is always an empty slice (wildcard)
+		finalReq := &xds_discovery.DiscoveryRequest{
+			TypeUrl:       typeURI.String(),
+			ResourceNames: getResourceSliceFromMapset(proxy.GetSubscribedResources(typeURI)),
+		}
+		if !osmDrivenUpdate {
+			finalReq.Node = request.Node
+		}
+
+		// Generate the resources for this request
+		resources, err := s.getTypeResources(proxy, finalReq)
+		if err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrGeneratingReqResource)).Str("proxy", proxy.String()).
+				Msgf("Error generating response for typeURI: %s", typeURI.Short())
+			thereWereErrors = true
+			continue
+		}
+
+		if err := s.SendDeltaDiscoveryResponse(proxy, typeURI, server, resources, !osmDrivenUpdate); err != nil {
+			log.Error().Err(err).Str("proxy", proxy.String()).Msgf("Error sending DeltaDiscoveryResponse for typeUrl: %s", typeURI.Short())
+			thereWereErrors = true
+		}
+	}
+
+	if containsTypeURI(typeURIsToSend, envoy.TypeCDS) {
+		s.recordProxyDependencies(proxy)
+	}
+
+	isFullUpdate := len(typeURIsToSend) == len(envoy.XDSResponseOrder)
+	if isFullUpdate {
+		success := !thereWereErrors
+		xdsPathTimeTrack(time.Now(), envoy.TypeADS, proxy, success)
+	}
+
+	return nil
+}
+
+// SendDeltaDiscoveryResponse creates a new Delta response for <proxy> given <resources> and <typeURI> and sends it.
+// Only the resources whose version changed since they were last sent are sent, along with the names of the
+// resources that were removed. Unless <always> is set, no response is sent if no resource changed.
+func (s *Server) SendDeltaDiscoveryResponse(proxy *envoy.Proxy, typeURI envoy.TypeURI, server *xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer, resources []types.Resource, always bool) error {
+	response := &xds_discovery.DeltaDiscoveryResponse{
+		TypeUrl: typeURI.String(),
+	}
+
+	lastVersions := proxy.GetResourceVersions(typeURI)
+	versions := make(map[string]string, len(resources))
+	subscribedResources := proxy.GetSubscribedResources(typeURI)
+	for _, res := range resources {
+		name := cache.GetResourceName(res)
+
+		// Contrary to SotW, resources that have not been subscribed to are not sent, as they would not be
+		// unsubscribed from, and hence never removed, by the proxy.
+		if !envoy.IsWildcardTypeURI(typeURI) && !subscribedResources.Contains(name) {
+			log.Debug().Msgf("Proxy %s TypeURI %s - not sending unsubscribed/unrequested resource %s",
+				proxy.String(), typeURI.Short(), name)
+			continue
+		}
+
+		// The resources are marshaled deterministically so that their version only changes when their content
+		// changes. The Any messages they embed are marshaled deterministically with protobuf.MarshalAny.
+		marshaled, err := proto.MarshalOptions{Deterministic: true}.Marshal(res)
+		if err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
+				Msgf("Error marshalling resource %s for proxy %s", typeURI, proxy.GetName())
+			continue
+		}
+
+		// The version of a resource is the hash of its content
+		version := cache.HashResource(marshaled)
+		versions[name] = version
+		if lastVersions[name] == version {
+			continue
+		}
+
+		response.Resources = append(response.Resources, &xds_discovery.Resource{
+			Name:     name,
+			Version:  version,
+			Resource: &anypb.Any{TypeUrl: typeURI.String(), Value: marshaled},
+		})
+	}
+
+	for name := range lastVersions {
+		if _, ok := versions[name]; !ok {
+			response.RemovedResources = append(response.RemovedResources, name)
+		}
+	}
+	// The proxy is notified the resources it requested and that do not exist were removed,
+	// so that it does not wait for them
+	if always && !envoy.IsWildcardTypeURI(typeURI) {
+		for _, name := range getResourceSliceFromMapset(subscribedResources) {
+			_, sent := versions[name]
+			_, removed := lastVersions[name]
+			if !sent && !removed {
+				response.RemovedResources = append(response.RemovedResources, name)
+			}
+		}
+	}
+	sort.Strings(response.RemovedResources)
+
+	if !always && len(response.Resources) == 0 && len(response.RemovedResources) == 0 {
+		log.Trace().Str("proxy", proxy.String()).Msgf("No %s resource changed, not sending DeltaDiscoveryResponse", typeURI.Short())
+		return nil
+	}
+
+	response.SystemVersionInfo = strconv.FormatUint(proxy.IncrementLastSentVersion(typeURI), 10)
+	response.Nonce = proxy.SetNewNonce(typeURI)
+
+	// NOTE: Never log entire 'response' - will contain secrets!
+	log.Trace().Msgf("Constructed %s delta response: SystemVersionInfo=%s, changed=%d, removed=%d",
+		response.TypeUrl, response.SystemVersionInfo, len(response.Resources), len(response.RemovedResources))
+
+	// Send the response
+	if err := (*server).Send(response); err != nil {
+		metricsstore.DefaultMetricsStore.ProxyResponseSendErrorCount.WithLabelValues(proxy.UUID.String(), proxy.Identity.String(), string(typeURI)).Inc()
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrSendingDiscoveryResponse)).
+			Str("proxy", proxy.String()).Msgf("Error sending delta response for typeURI %s to proxy", typeURI.Short())
+		return err
+	}
+
+	// Sending delta response succeeded, record the versions of the resources the proxy now has
+	proxy.SetResourceVersions(typeURI, versions)
+	metricsstore.DefaultMetricsStore.ProxyResponseSendSuccessCount.WithLabelValues(proxy.UUID.String(), proxy.Identity.String(), string(typeURI)).Inc()
+
+	return nil
+}
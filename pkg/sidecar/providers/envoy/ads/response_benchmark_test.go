@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
 
 	mapset "github.com/deckarep/golang-set"
 	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	"github.com/envoyproxy/go-control-plane/pkg/cache/v3"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	k8sClientFake "k8s.io/client-go/kubernetes/fake"
@@ -24,6 +26,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
+	"github.com/openservicemesh/osm/pkg/gatewayapi"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/k8s/informers"
 	"github.com/openservicemesh/osm/pkg/logger"
@@ -44,8 +47,13 @@ import (
 var (
 	proxy           *envoy.Proxy
 	server          xds_discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer
+	responses       *[]*xds_discovery.DiscoveryResponse
+	deltaServer     xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+	deltaResponses  *[]*xds_discovery.DeltaDiscoveryResponse
 	osmConfigurator *configurator.Client
 	adsServer       *Server
+
+	informerCollection *informers.InformerCollection
 )
 
 func setupTestServer(b *testing.B) {
@@ -56,7 +64,8 @@ func setupTestServer(b *testing.B) {
 	policyClient := policyFake.NewSimpleClientset()
 	pluginClient := pluginFake.NewSimpleClientset()
 	multiclusterClient := multiclusterFake.NewSimpleClientset()
-	informerCollection, err := informers.NewInformerCollection(tests.MeshName, stop,
+	var err error
+	informerCollection, err = informers.NewInformerCollection(tests.MeshName, stop,
 		informers.WithKubeClient(kubeClient),
 		informers.WithConfigClient(configClient, tests.OsmMeshConfigName, tests.OsmNamespace),
 		informers.WithMultiClusterClient(multiclusterClient),
@@ -68,6 +77,7 @@ func setupTestServer(b *testing.B) {
 	policyController := policy.NewPolicyController(informerCollection, kubeClient, kubeController, msgBroker)
 	pluginController := plugin.NewPluginController(informerCollection, kubeClient, kubeController, msgBroker)
 	multiclusterController := multicluster.NewMultiClusterController(informerCollection, kubeClient, kubeController, msgBroker)
+	gatewayAPIController := gatewayapi.NewGatewayAPIController(informerCollection, kubeController, msgBroker)
 	osmConfigurator = configurator.NewConfigurator(informerCollection, tests.OsmNamespace, tests.OsmMeshConfigName, msgBroker)
 	kubeProvider := kube.NewClient(kubeController, osmConfigurator)
 
@@ -120,7 +130,8 @@ func setupTestServer(b *testing.B) {
 
 	certPEM, _ := certManager.IssueCertificate(proxySvcAccount.ToServiceIdentity().String(), certificate.Service)
 	cert, _ := certificate.DecodePEMCertificate(certPEM.GetCertificateChain())
-	server, _ = tests.NewFakeXDSServer(cert, nil, nil)
+	server, responses = tests.NewFakeXDSServer(cert, nil, nil)
+	deltaServer, deltaResponses = tests.NewFakeDeltaXDSServer(cert)
 
 	proxyUUID := uuid.New()
 	labels := map[string]string{constants.SidecarUniqueIDLabelName: proxyUUID.String()}
@@ -132,6 +143,7 @@ func setupTestServer(b *testing.B) {
 		policyController,
 		pluginController,
 		multiclusterController,
+		gatewayAPIController,
 		stop,
 		osmConfigurator,
 		[]service.Provider{kubeProvider},
@@ -166,6 +178,10 @@ func setupTestServer(b *testing.B) {
 	if err != nil {
 		b.Fatalf("Failed to create service: %v", err)
 	}
+	// The service is added to the informer so that the resources generated do not change once it is watched
+	if err := informerCollection.Update(informers.InformerKeyService, svc, &testing.T{}); err != nil {
+		b.Fatalf("Failed to add service to informer collection: %s", err)
+	}
 
 	proxy = envoy.NewProxy(models.KindSidecar, proxyUUID, proxySvcAccount.ToServiceIdentity(), nil)
 
@@ -203,3 +219,113 @@ func BenchmarkSendXDSResponse(b *testing.B) {
 		})
 	}
 }
+
+// BenchmarkSendXDSEndpointUpdate benchmarks the OSM driven updates of all the verticals when only the
+// endpoints of an upstream service changed, reporting the number of bytes sent to the proxy per update
+// with SotW and Delta xDS. The responses are marshalled as they would be by gRPC when sent.
+func BenchmarkSendXDSEndpointUpdate(b *testing.B) {
+	typeURIs := []envoy.TypeURI{envoy.TypeCDS, envoy.TypeEDS, envoy.TypeLDS, envoy.TypeRDS}
+
+	if err := logger.SetLogLevel("error"); err != nil {
+		b.Logf("Failed to set log level to error: %s", err)
+	}
+
+	b.Run("SotW", func(b *testing.B) {
+		setupTestServer(b)
+		setUpstreamEndpoints(b, 0)
+
+		sentBytes := 0
+		b.ResetTimer()
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			setUpstreamEndpoints(b, i+1)
+
+			*responses = nil
+			if err := adsServer.sendResponse(proxy, &server, nil, osmConfigurator, typeURIs...); err != nil {
+				b.Fatalf("Failed to send response: %s", err)
+			}
+			for _, response := range *responses {
+				sentBytes += marshalResponse(b, response)
+			}
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(sentBytes)/float64(b.N), "sent-bytes/op")
+	})
+
+	b.Run("Delta", func(b *testing.B) {
+		setupTestServer(b)
+		setUpstreamEndpoints(b, 0)
+
+		// The proxy subscribes to the load assignments of the clusters it received
+		loadAssignments, err := adsServer.getTypeResources(proxy, &xds_discovery.DiscoveryRequest{TypeUrl: envoy.TypeEDS.String()})
+		if err != nil || len(loadAssignments) == 0 {
+			b.Fatalf("Failed to get the cluster load assignments: %v", err)
+		}
+		clusterNames := mapset.NewSet()
+		for _, loadAssignment := range loadAssignments {
+			clusterNames.Add(cache.GetResourceName(loadAssignment))
+		}
+		proxy.SetSubscribedResources(envoy.TypeEDS, clusterNames)
+
+		// Initial update sending all the resources
+		if err := adsServer.sendDeltaResponse(proxy, &deltaServer, nil, typeURIs...); err != nil {
+			b.Fatalf("Failed to send delta response: %s", err)
+		}
+
+		sentBytes := 0
+		b.ResetTimer()
+		b.StartTimer()
+		for i := 0; i < b.N; i++ {
+			setUpstreamEndpoints(b, i+1)
+
+			*deltaResponses = nil
+			if err := adsServer.sendDeltaResponse(proxy, &deltaServer, nil, typeURIs...); err != nil {
+				b.Fatalf("Failed to send delta response: %s", err)
+			}
+			if len(*deltaResponses) != 1 || len((*deltaResponses)[0].Resources) != 1 {
+				b.Fatalf("Expected the changed cluster load assignment to be sent, got %d responses", len(*deltaResponses))
+			}
+			for _, response := range *deltaResponses {
+				sentBytes += marshalResponse(b, response)
+			}
+		}
+		b.StopTimer()
+		b.ReportMetric(float64(sentBytes)/float64(b.N), "sent-bytes/op")
+	})
+}
+
+// setUpstreamEndpoints sets the endpoint of the upstream service of the proxy to an IP address derived from the given index
+func setUpstreamEndpoints(b *testing.B, index int) {
+	svc := tests.NewServiceFixture(tests.BookstoreV2ServiceName, tests.Namespace, nil)
+	if err := informerCollection.Update(informers.InformerKeyService, svc, &testing.T{}); err != nil {
+		b.Fatalf("Failed to set upstream service: %s", err)
+	}
+
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      tests.BookstoreV2ServiceName,
+			Namespace: tests.Namespace,
+		},
+		Subsets: []corev1.EndpointSubset{
+			{
+				Addresses: []corev1.EndpointAddress{
+					{IP: fmt.Sprintf("10.1.%d.%d", (index/256)%256, index%256)},
+				},
+				Ports: []corev1.EndpointPort{
+					{Name: "servicePort", Port: tests.ServicePort, Protocol: corev1.ProtocolTCP},
+				},
+			},
+		},
+	}
+	if err := informerCollection.Update(informers.InformerKeyEndpoints, endpoints, &testing.T{}); err != nil {
+		b.Fatalf("Failed to set upstream endpoints: %s", err)
+	}
+}
+
+func marshalResponse(b *testing.B, response proto.Message) int {
+	bytes, err := proto.Marshal(response)
+	if err != nil {
+		b.Fatalf("Failed to marshal response: %s", err)
+	}
+	return len(bytes)
+}
@@ -98,8 +98,3 @@ func (s *Server) Start(ctx context.Context, cancel context.CancelFunc, port int,
 
 	return nil
 }
-
-// DeltaAggregatedResources implements discovery.AggregatedDiscoveryServiceServer
-func (s *Server) DeltaAggregatedResources(xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer) error {
-	panic("NotImplemented")
-}
@@ -1,6 +1,7 @@
 package ads
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strconv"
@@ -26,35 +27,8 @@ import (
 // StreamAggregatedResources handles streaming of the clusters to the connected Envoy proxies
 // This is evaluated once per new Envoy proxy connecting and remains running for the duration of the gRPC socket.
 func (s *Server) StreamAggregatedResources(server xds_discovery.AggregatedDiscoveryService_StreamAggregatedResourcesServer) error {
-	// When a new Envoy proxy connects, ValidateClient would ensure that it has a valid certificate,
-	// and the Subject CN is in the allowedCommonNames set.
-	certCommonName, certSerialNumber, err := utils.ValidateClient(server.Context())
-	if err != nil {
-		return fmt.Errorf("Could not start Aggregated Discovery Service gRPC stream for newly connected Envoy proxy: %w", err)
-	}
-
-	// If maxDataPlaneConnections is enabled i.e. not 0, then check that the number of Envoy connections is less than maxDataPlaneConnections
-	if s.cfg.GetMaxDataPlaneConnections() > 0 && s.proxyRegistry.GetConnectedProxyCount() >= s.cfg.GetMaxDataPlaneConnections() {
-		metricsstore.DefaultMetricsStore.ProxyMaxConnectionsRejected.Inc()
-		return errTooManyConnections
-	}
-
-	log.Trace().Msgf("Envoy with certificate SerialNumber=%s connected", certSerialNumber)
-	metricsstore.DefaultMetricsStore.ProxyConnectCount.Inc()
-
-	kind, proxyUUID, si, err := getCertificateCommonNameMeta(certCommonName)
+	proxy, err := s.connectProxy(server.Context())
 	if err != nil {
-		return fmt.Errorf("error parsing certificate common name %s: %w", certCommonName, err)
-	}
-
-	// This is the Envoy proxy that just connected to the control plane.
-	// NOTE: This is step 1 of the registration. At this point we do not yet have context on the Pod.
-	//       Details on which Pod this Envoy is fronting will arrive via xDS in the NODE_ID string.
-	proxy := envoy.NewProxy(kind, proxyUUID, si, utils.GetIPFromContext(server.Context()))
-
-	if err := s.recordPodMetadata(proxy); err == errServiceAccountMismatch {
-		// Service Account mismatch
-		log.Error().Err(err).Str("proxy", proxy.String()).Msg("Mismatched service account for proxy")
 		return err
 	}
 
@@ -74,6 +48,10 @@ func (s *Server) StreamAggregatedResources(server xds_discovery.AggregatedDiscov
 	proxyUpdateChan := proxyUpdatePubSub.Sub(announcements.ProxyUpdate.String(), messaging.GetPubSubTopicForProxyUUID(proxy.UUID.String()))
 	defer s.msgBroker.Unsub(proxyUpdatePubSub, proxyUpdateChan)
 
+	// Until its dependencies are recorded, the proxy is updated on every targeted update
+	s.msgBroker.AddProxy(proxy.UUID.String())
+	defer s.msgBroker.RemoveProxy(proxy.UUID.String())
+
 	// Register for certificate rotation updates
 	certPubSub := s.msgBroker.GetCertPubSub()
 	certRotateChan := certPubSub.Sub(announcements.CertificateRotated.String())
@@ -149,6 +127,44 @@ func (s *Server) StreamAggregatedResources(server xds_discovery.AggregatedDiscov
 	}
 }
 
+// connectProxy validates the certificate of an Envoy proxy opening an xDS stream with the given context
+// and returns the corresponding proxy
+func (s *Server) connectProxy(ctx context.Context) (*envoy.Proxy, error) {
+	// When a new Envoy proxy connects, ValidateClient would ensure that it has a valid certificate,
+	// and the Subject CN is in the allowedCommonNames set.
+	certCommonName, certSerialNumber, err := utils.ValidateClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Could not start Aggregated Discovery Service gRPC stream for newly connected Envoy proxy: %w", err)
+	}
+
+	// If maxDataPlaneConnections is enabled i.e. not 0, then check that the number of Envoy connections is less than maxDataPlaneConnections
+	if s.cfg.GetMaxDataPlaneConnections() > 0 && s.proxyRegistry.GetConnectedProxyCount() >= s.cfg.GetMaxDataPlaneConnections() {
+		metricsstore.DefaultMetricsStore.ProxyMaxConnectionsRejected.Inc()
+		return nil, errTooManyConnections
+	}
+
+	log.Trace().Msgf("Envoy with certificate SerialNumber=%s connected", certSerialNumber)
+	metricsstore.DefaultMetricsStore.ProxyConnectCount.Inc()
+
+	kind, proxyUUID, si, err := getCertificateCommonNameMeta(certCommonName)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing certificate common name %s: %w", certCommonName, err)
+	}
+
+	// This is the Envoy proxy that just connected to the control plane.
+	// NOTE: This is step 1 of the registration. At this point we do not yet have context on the Pod.
+	//       Details on which Pod this Envoy is fronting will arrive via xDS in the NODE_ID string.
+	proxy := envoy.NewProxy(kind, proxyUUID, si, utils.GetIPFromContext(ctx))
+
+	if err := s.recordPodMetadata(proxy); err == errServiceAccountMismatch {
+		// Service Account mismatch
+		log.Error().Err(err).Str("proxy", proxy.String()).Msg("Mismatched service account for proxy")
+		return nil, err
+	}
+
+	return proxy, nil
+}
+
 // shouldPushUpdate handles allowing new updates to envoy from control-plane driven config changes.
 // Its use is to make sure we don't unintentintionally push new versions if at least a first request has not arrived yet.
 func shouldPushUpdate(proxy *envoy.Proxy) bool {
@@ -386,6 +402,7 @@ func (s *Server) recordPodMetadata(p *envoy.Proxy) error {
 		},
 		WorkloadKind: workloadKind,
 		WorkloadName: workloadName,
+		Labels:       pod.Labels,
 	}
 
 	// Verify Service account matches (cert to pod Service Account)
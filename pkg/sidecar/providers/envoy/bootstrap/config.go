@@ -184,6 +184,11 @@ func (b *Builder) Build() (*xds_bootstrap.Bootstrap, error) {
 		return nil, err
 	}
 
+	adsAPIType := xds_core.ApiConfigSource_GRPC
+	if b.DeltaXDS {
+		adsAPIType = xds_core.ApiConfigSource_DELTA_GRPC
+	}
+
 	bootstrap := &xds_bootstrap.Bootstrap{
 		Node: &xds_core.Node{
 			Id: b.NodeID,
@@ -210,7 +215,7 @@ func (b *Builder) Build() (*xds_bootstrap.Bootstrap, error) {
 		},
 		DynamicResources: &xds_bootstrap.Bootstrap_DynamicResources{
 			AdsConfig: &xds_core.ApiConfigSource{
-				ApiType:             xds_core.ApiConfigSource_GRPC,
+				ApiType:             adsAPIType,
 				TransportApiVersion: xds_core.ApiVersion_V3,
 				GrpcServices: []*xds_core.GrpcService{
 					{
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	tassert "github.com/stretchr/testify/assert"
 
 	tresorFake "github.com/openservicemesh/osm/pkg/certificate/providers/tresor/fake"
@@ -657,3 +658,19 @@ static_resources:
 		"expected:\n%s\n--------OR----------\n%s\n"+
 		"actual  :\n%s\n", expectedYAML, reversedExpectedYAML, actualYAML))
 }
+
+func TestBuildWithDeltaXDS(t *testing.T) {
+	assert := tassert.New(t)
+	cert := tresorFake.NewFakeCertificate()
+
+	b := &Builder{
+		NodeID:   cert.GetCommonName().String(),
+		XDSHost:  "osm-controller.osm-system.svc.cluster.local",
+		XDSPort:  15128,
+		DeltaXDS: true,
+	}
+
+	bootstrapConfig, err := b.Build()
+	assert.Nil(err)
+	assert.Equal(xds_core.ApiConfigSource_DELTA_GRPC, bootstrapConfig.DynamicResources.AdsConfig.ApiType)
+}
@@ -32,4 +32,7 @@ type Builder struct {
 	ECDHCurves []string
 
 	OriginalHealthProbes models.HealthProbes
+
+	// DeltaXDS defines if the proxy uses incremental (Delta) xDS
+	DeltaXDS bool
 }
@@ -9,11 +9,12 @@ import (
 	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
 	extensions_upstream_http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -23,6 +24,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -34,9 +36,13 @@ var replacer = strings.NewReplacer(".", "_", ":", "_")
 // getUpstreamServiceCluster returns an Envoy Cluster corresponding to the given upstream service
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
 func getUpstreamServiceCluster(downstreamIdentity identity.ServiceIdentity, config trafficpolicy.MeshClusterConfig, sidecarSpec configv1alpha2.SidecarSpec) *xds_cluster.Cluster {
+	if config.ExternalService != nil {
+		return getExternalServiceCluster(config)
+	}
+
 	httpProtocolOptions := getDefaultHTTPProtocolOptions()
 
-	marshalledUpstreamTLSContext, err := anypb.New(
+	marshalledUpstreamTLSContext, err := protobuf.MarshalAny(
 		envoy.GetUpstreamTLSContext(downstreamIdentity, config.Service, sidecarSpec))
 	if err != nil {
 		log.Error().Err(err).Msgf("Error marshalling UpstreamTLSContext for upstream cluster %s", config.Name)
@@ -63,6 +69,9 @@ func getUpstreamServiceCluster(downstreamIdentity identity.ServiceIdentity, conf
 	}
 
 	applyUpstreamTrafficSetting(config.UpstreamTrafficSetting, upstreamCluster, httpProtocolOptions)
+	if config.UpstreamTrafficSetting != nil {
+		applyLoadBalancer(config.UpstreamTrafficSetting.Spec.LoadBalancer, upstreamCluster)
+	}
 
 	typedHTTPProtocolOptions, err := getTypedHTTPProtocolOptions(httpProtocolOptions)
 	if err != nil {
@@ -74,6 +83,79 @@ func getUpstreamServiceCluster(downstreamIdentity identity.ServiceIdentity, conf
 	return upstreamCluster
 }
 
+// getExternalServiceCluster returns an Envoy Cluster corresponding to a service external to the mesh.
+// The external service does not participate in mesh mTLS and does not serve the sidecar health check endpoint.
+// With DNS resolution the endpoint addresses are resolved by Envoy, while with Static resolution the
+// endpoints are discovered using EDS.
+func getExternalServiceCluster(config trafficpolicy.MeshClusterConfig) *xds_cluster.Cluster {
+	httpProtocolOptions := getDefaultHTTPProtocolOptions()
+
+	upstreamCluster := &xds_cluster.Cluster{
+		Name:     config.Name,
+		LbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
+	}
+
+	if config.ExternalService.Spec.Resolution == policyv1alpha1.ExternalServiceResolutionStatic {
+		upstreamCluster.ClusterDiscoveryType = &xds_cluster.Cluster_Type{Type: xds_cluster.Cluster_EDS}
+		upstreamCluster.EdsClusterConfig = &xds_cluster.Cluster_EdsClusterConfig{EdsConfig: envoy.GetADSConfigSource()}
+	} else {
+		upstreamCluster.ClusterDiscoveryType = &xds_cluster.Cluster_Type{Type: xds_cluster.Cluster_STRICT_DNS}
+		upstreamCluster.RespectDnsTtl = true
+		upstreamCluster.LoadAssignment = &xds_endpoint.ClusterLoadAssignment{
+			ClusterName: config.Name,
+			Endpoints: []*xds_endpoint.LocalityLbEndpoints{
+				{
+					LbEndpoints: getExternalServiceLbEndpoints(config.ExternalService, config.Service.TargetPort),
+				},
+			},
+		}
+	}
+
+	applyUpstreamTrafficSetting(config.UpstreamTrafficSetting, upstreamCluster, httpProtocolOptions)
+	if config.UpstreamTrafficSetting != nil {
+		applyLoadBalancer(config.UpstreamTrafficSetting.Spec.LoadBalancer, upstreamCluster)
+	}
+
+	typedHTTPProtocolOptions, err := getTypedHTTPProtocolOptions(httpProtocolOptions)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error getting typed HTTP protocol options for external service cluster %s", upstreamCluster.Name)
+		return nil
+	}
+	upstreamCluster.TypedExtensionProtocolOptions = typedHTTPProtocolOptions
+
+	return upstreamCluster
+}
+
+// getExternalServiceLbEndpoints returns the endpoints resolved using DNS for the given external service.
+// The hosts of the external service are used as endpoint addresses when its endpoints are unspecified.
+func getExternalServiceLbEndpoints(externalService *policyv1alpha1.ExternalService, port uint16) []*xds_endpoint.LbEndpoint {
+	endpointSpecs := externalService.Spec.Endpoints
+	if len(endpointSpecs) == 0 {
+		for _, host := range externalService.Spec.Hosts {
+			endpointSpecs = append(endpointSpecs, policyv1alpha1.ExternalServiceEndpointSpec{Address: host})
+		}
+	}
+
+	var lbEndpoints []*xds_endpoint.LbEndpoint
+	for _, endpointSpec := range endpointSpecs {
+		weight := uint32(endpointSpec.Weight)
+		if weight == 0 {
+			weight = 1
+		}
+		lbEndpoints = append(lbEndpoints, &xds_endpoint.LbEndpoint{
+			HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+				Endpoint: &xds_endpoint.Endpoint{
+					Address: envoy.GetAddress(endpointSpec.Address, uint32(port)),
+				},
+			},
+			LoadBalancingWeight: &wrappers.UInt32Value{
+				Value: weight,
+			},
+		})
+	}
+	return lbEndpoints
+}
+
 func enableHealthChecksOnCluster(cluster *xds_cluster.Cluster, upstreamSvc service.MeshService) {
 	cluster.HealthChecks = []*xds_core.HealthCheck{
 		{
@@ -251,6 +333,21 @@ func getDNSResolvableEgressCluster(config *trafficpolicy.EgressClusterConfig) (*
 		},
 	}
 
+	if config.TLS != nil {
+		marshalledUpstreamTLSContext, err := protobuf.MarshalAny(getEgressUpstreamTLSContext(config.TLS))
+		if err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
+				Msgf("Error marshalling UpstreamTLSContext for egress cluster %s", upstreamCluster.Name)
+			return nil, err
+		}
+		upstreamCluster.TransportSocket = &xds_core.TransportSocket{
+			Name: config.Name,
+			ConfigType: &xds_core.TransportSocket_TypedConfig{
+				TypedConfig: marshalledUpstreamTLSContext,
+			},
+		}
+	}
+
 	applyUpstreamTrafficSetting(config.UpstreamTrafficSetting, upstreamCluster, httpProtocolOptions)
 
 	typedHTTPProtocolOptions, err := getTypedHTTPProtocolOptions(httpProtocolOptions)
@@ -263,6 +360,60 @@ func getDNSResolvableEgressCluster(config *trafficpolicy.EgressClusterConfig) (*
 	return upstreamCluster, nil
 }
 
+// getEgressUpstreamTLSContext returns the UpstreamTlsContext used to originate TLS to an external cluster.
+// The certificates are inlined since they are not issued by the mesh, and the certificate of the external
+// cluster is only validated when a trusted CA is specified.
+func getEgressUpstreamTLSContext(tlsConfig *trafficpolicy.EgressTLSConfig) *xds_auth.UpstreamTlsContext {
+	commonTLSContext := &xds_auth.CommonTlsContext{}
+
+	if tlsConfig.MinProtocolVersion != "" {
+		commonTLSContext.TlsParams = &xds_auth.TlsParameters{
+			TlsMinimumProtocolVersion: xds_auth.TlsParameters_TlsProtocol(xds_auth.TlsParameters_TlsProtocol_value[tlsConfig.MinProtocolVersion]),
+		}
+	}
+
+	if len(tlsConfig.ClientCertificate) > 0 {
+		commonTLSContext.TlsCertificates = []*xds_auth.TlsCertificate{{
+			CertificateChain: &xds_core.DataSource{
+				Specifier: &xds_core.DataSource_InlineBytes{
+					InlineBytes: tlsConfig.ClientCertificate,
+				},
+			},
+			PrivateKey: &xds_core.DataSource{
+				Specifier: &xds_core.DataSource_InlineBytes{
+					InlineBytes: tlsConfig.ClientPrivateKey,
+				},
+			},
+		}}
+	}
+
+	if len(tlsConfig.TrustedCA) > 0 {
+		var matchSANs []*xds_matcher.StringMatcher
+		for _, san := range tlsConfig.SubjectAltNames {
+			matchSANs = append(matchSANs, &xds_matcher.StringMatcher{
+				MatchPattern: &xds_matcher.StringMatcher_Exact{
+					Exact: san,
+				},
+			})
+		}
+		commonTLSContext.ValidationContextType = &xds_auth.CommonTlsContext_ValidationContext{
+			ValidationContext: &xds_auth.CertificateValidationContext{
+				TrustedCa: &xds_core.DataSource{
+					Specifier: &xds_core.DataSource_InlineBytes{
+						InlineBytes: tlsConfig.TrustedCA,
+					},
+				},
+				MatchSubjectAltNames: matchSANs,
+			},
+		}
+	}
+
+	return &xds_auth.UpstreamTlsContext{
+		CommonTlsContext: commonTLSContext,
+		Sni:              tlsConfig.SNI,
+	}
+}
+
 // getOriginalDestinationEgressCluster returns an Envoy cluster that routes traffic to its original destination.
 // The original destination is the original IP address and port prior to being redirected to the sidecar proxy.
 func getOriginalDestinationEgressCluster(name string, upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting) (*xds_cluster.Cluster, error) {
@@ -324,7 +475,7 @@ func getDefaultHTTPProtocolOptions() *extensions_upstream_http.HttpProtocolOptio
 }
 
 func getTypedHTTPProtocolOptions(httpProtocolOptions *extensions_upstream_http.HttpProtocolOptions) (map[string]*any.Any, error) {
-	marshalledHTTPProtocolOptions, err := anypb.New(httpProtocolOptions)
+	marshalledHTTPProtocolOptions, err := protobuf.MarshalAny(httpProtocolOptions)
 	if err != nil {
 		return nil, err
 	}
@@ -394,4 +545,56 @@ func applyUpstreamTrafficSetting(upstreamTrafficSetting *policyv1alpha1.Upstream
 			upstreamCluster.MaxRequestsPerConnection = wrapperspb.UInt32(*connectionSettings.HTTP.MaxRequestsPerConnection)
 		}
 	}
+
+	// Apply outlier detection settings
+	if connectionSettings.OutlierDetection != nil {
+		upstreamCluster.OutlierDetection = getOutlierDetection(connectionSettings.OutlierDetection)
+	}
+}
+
+// getOutlierDetection returns the outlier detection config for the given outlier detection settings.
+// Unspecified settings are left unset so that Envoy's defaults apply.
+func getOutlierDetection(outlierDetection *policyv1alpha1.OutlierDetectionSpec) *xds_cluster.OutlierDetection {
+	od := &xds_cluster.OutlierDetection{}
+
+	if outlierDetection.ConsecutiveErrors != nil {
+		od.Consecutive_5Xx = wrapperspb.UInt32(*outlierDetection.ConsecutiveErrors)
+	}
+	if outlierDetection.Interval != nil {
+		od.Interval = durationpb.New(outlierDetection.Interval.Duration)
+	}
+	if outlierDetection.BaseEjectionTime != nil {
+		od.BaseEjectionTime = durationpb.New(outlierDetection.BaseEjectionTime.Duration)
+	}
+	if outlierDetection.MaxEjectionPercent != nil {
+		od.MaxEjectionPercent = wrapperspb.UInt32(*outlierDetection.MaxEjectionPercent)
+	}
+
+	return od
+}
+
+// applyLoadBalancer applies the load balancing policy for the given load balancer settings to the cluster.
+// The key hashed on by the consistent hashing policies is configured on the routes to the cluster.
+func applyLoadBalancer(loadBalancer *policyv1alpha1.LoadBalancerSpec, upstreamCluster *xds_cluster.Cluster) {
+	if loadBalancer == nil {
+		return
+	}
+
+	lbType := loadBalancer.Type
+	if lbType == "" && loadBalancer.SessionAffinity != nil {
+		lbType = policyv1alpha1.RingHashLoadBalancer
+	}
+
+	switch lbType {
+	case policyv1alpha1.LeastRequestLoadBalancer:
+		upstreamCluster.LbPolicy = xds_cluster.Cluster_LEAST_REQUEST
+	case policyv1alpha1.RingHashLoadBalancer:
+		upstreamCluster.LbPolicy = xds_cluster.Cluster_RING_HASH
+	case policyv1alpha1.MaglevLoadBalancer:
+		upstreamCluster.LbPolicy = xds_cluster.Cluster_MAGLEV
+	case policyv1alpha1.RandomLoadBalancer:
+		upstreamCluster.LbPolicy = xds_cluster.Cluster_RANDOM
+	default:
+		upstreamCluster.LbPolicy = xds_cluster.Cluster_ROUND_ROBIN
+	}
 }
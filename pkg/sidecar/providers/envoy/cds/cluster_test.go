@@ -8,8 +8,11 @@ import (
 	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -38,6 +41,8 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 		name                            string
 		clusterConfig                   trafficpolicy.MeshClusterConfig
 		expectedCircuitBreakerThreshold *xds_cluster.CircuitBreakers
+		expectedOutlierDetection        *xds_cluster.OutlierDetection
+		expectedLbPolicy                xds_cluster.Cluster_LbPolicy
 	}{
 		{
 			name: "EDS based cluster adds health checks when configured",
@@ -89,6 +94,77 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Cluster with outlier detection",
+			clusterConfig: trafficpolicy.MeshClusterConfig{
+				Name:    "default/bookstore-v1_14001",
+				Service: upstreamSvc,
+				UpstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+							OutlierDetection: &policyv1alpha1.OutlierDetectionSpec{
+								ConsecutiveErrors:  &thresholdUintVal,
+								Interval:           thresholdDuration,
+								BaseEjectionTime:   thresholdDuration,
+								MaxEjectionPercent: &thresholdUintVal,
+							},
+						},
+					},
+				},
+			},
+			expectedOutlierDetection: &xds_cluster.OutlierDetection{
+				Consecutive_5Xx:    wrapperspb.UInt32(thresholdUintVal),
+				Interval:           durationpb.New(thresholdDuration.Duration),
+				BaseEjectionTime:   durationpb.New(thresholdDuration.Duration),
+				MaxEjectionPercent: wrapperspb.UInt32(thresholdUintVal),
+			},
+		},
+		{
+			name: "Cluster with least request load balancer",
+			clusterConfig: trafficpolicy.MeshClusterConfig{
+				Name:    "default/bookstore-v1_14001",
+				Service: upstreamSvc,
+				UpstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						LoadBalancer: &policyv1alpha1.LoadBalancerSpec{
+							Type: policyv1alpha1.LeastRequestLoadBalancer,
+						},
+					},
+				},
+			},
+			expectedLbPolicy: xds_cluster.Cluster_LEAST_REQUEST,
+		},
+		{
+			name: "Cluster with maglev load balancer",
+			clusterConfig: trafficpolicy.MeshClusterConfig{
+				Name:    "default/bookstore-v1_14001",
+				Service: upstreamSvc,
+				UpstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						LoadBalancer: &policyv1alpha1.LoadBalancerSpec{
+							Type:           policyv1alpha1.MaglevLoadBalancer,
+							ConsistentHash: &policyv1alpha1.ConsistentHashSpec{Header: "x-user"},
+						},
+					},
+				},
+			},
+			expectedLbPolicy: xds_cluster.Cluster_MAGLEV,
+		},
+		{
+			name: "Cluster with session affinity defaults to ring hash load balancer",
+			clusterConfig: trafficpolicy.MeshClusterConfig{
+				Name:    "default/bookstore-v1_14001",
+				Service: upstreamSvc,
+				UpstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						LoadBalancer: &policyv1alpha1.LoadBalancerSpec{
+							SessionAffinity: &policyv1alpha1.SessionAffinitySpec{},
+						},
+					},
+				},
+			},
+			expectedLbPolicy: xds_cluster.Cluster_RING_HASH,
+		},
 		{
 			name: "Cluster without circuit breaker but with valid UpstreamTrafficSetting should not error/panic",
 			clusterConfig: trafficpolicy.MeshClusterConfig{
@@ -117,6 +193,9 @@ func TestGetUpstreamServiceCluster(t *testing.T) {
 			if tc.expectedCircuitBreakerThreshold != nil {
 				assert.Equal(tc.expectedCircuitBreakerThreshold, remoteCluster.CircuitBreakers)
 			}
+
+			assert.Equal(tc.expectedOutlierDetection, remoteCluster.OutlierDetection)
+			assert.Equal(tc.expectedLbPolicy, remoteCluster.LbPolicy)
 		})
 	}
 }
@@ -507,3 +586,203 @@ func TestFormatAltStatNameForPrometheus(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEgressUpstreamTLSContext(t *testing.T) {
+	testCases := []struct {
+		name               string
+		tlsConfig          *trafficpolicy.EgressTLSConfig
+		expectedTLSContext *xds_auth.UpstreamTlsContext
+	}{
+		{
+			name: "TLS origination without validation",
+			tlsConfig: &trafficpolicy.EgressTLSConfig{
+				SNI:             "foo.com",
+				SubjectAltNames: []string{"foo.com"},
+			},
+			expectedTLSContext: &xds_auth.UpstreamTlsContext{
+				CommonTlsContext: &xds_auth.CommonTlsContext{},
+				Sni:              "foo.com",
+			},
+		},
+		{
+			name: "TLS origination with validation and client certificate",
+			tlsConfig: &trafficpolicy.EgressTLSConfig{
+				SNI:                "foo.com",
+				MinProtocolVersion: "TLSv1_2",
+				SubjectAltNames:    []string{"foo.com", "api.foo.com"},
+				TrustedCA:          []byte("ca"),
+				ClientCertificate:  []byte("cert"),
+				ClientPrivateKey:   []byte("key"),
+			},
+			expectedTLSContext: &xds_auth.UpstreamTlsContext{
+				CommonTlsContext: &xds_auth.CommonTlsContext{
+					TlsParams: &xds_auth.TlsParameters{
+						TlsMinimumProtocolVersion: xds_auth.TlsParameters_TLSv1_2,
+					},
+					TlsCertificates: []*xds_auth.TlsCertificate{{
+						CertificateChain: &xds_core.DataSource{
+							Specifier: &xds_core.DataSource_InlineBytes{InlineBytes: []byte("cert")},
+						},
+						PrivateKey: &xds_core.DataSource{
+							Specifier: &xds_core.DataSource_InlineBytes{InlineBytes: []byte("key")},
+						},
+					}},
+					ValidationContextType: &xds_auth.CommonTlsContext_ValidationContext{
+						ValidationContext: &xds_auth.CertificateValidationContext{
+							TrustedCa: &xds_core.DataSource{
+								Specifier: &xds_core.DataSource_InlineBytes{InlineBytes: []byte("ca")},
+							},
+							MatchSubjectAltNames: []*xds_matcher.StringMatcher{
+								{MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: "foo.com"}},
+								{MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: "api.foo.com"}},
+							},
+						},
+					},
+				},
+				Sni: "foo.com",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual := getEgressUpstreamTLSContext(tc.tlsConfig)
+			assert.True(proto.Equal(tc.expectedTLSContext, actual))
+
+			cluster, err := getDNSResolvableEgressCluster(&trafficpolicy.EgressClusterConfig{
+				Name: "foo.com:80",
+				Host: "foo.com",
+				Port: 443,
+				TLS:  tc.tlsConfig,
+			})
+			assert.NoError(err)
+			assert.Equal("foo.com:80", cluster.TransportSocket.Name)
+			assert.Equal(envoy.GetAddress("foo.com", 443), cluster.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address)
+
+			tlsContext := &xds_auth.UpstreamTlsContext{}
+			assert.NoError(cluster.TransportSocket.GetTypedConfig().UnmarshalTo(tlsContext))
+			assert.True(proto.Equal(tc.expectedTLSContext, tlsContext))
+		})
+	}
+}
+
+func TestGetExternalServiceCluster(t *testing.T) {
+	var maxConnections uint32 = 10
+	upstreamSvc := service.MeshService{
+		Namespace:  "test",
+		Name:       "httpbin",
+		Port:       80,
+		TargetPort: 8080,
+		Protocol:   constants.ProtocolHTTP,
+	}
+
+	testCases := []struct {
+		name                  string
+		externalService       *policyv1alpha1.ExternalService
+		upstreamTraffic       *policyv1alpha1.UpstreamTrafficSetting
+		expectedDiscoveryType xds_cluster.Cluster_DiscoveryType
+		expectedLbEndpoints   []*xds_endpoint.LbEndpoint
+	}{
+		{
+			name: "DNS resolution uses the hosts as endpoints when unspecified",
+			externalService: &policyv1alpha1.ExternalService{
+				Spec: policyv1alpha1.ExternalServiceSpec{
+					Hosts:      []string{"httpbin.org"},
+					Resolution: policyv1alpha1.ExternalServiceResolutionDNS,
+				},
+			},
+			expectedDiscoveryType: xds_cluster.Cluster_STRICT_DNS,
+			expectedLbEndpoints: []*xds_endpoint.LbEndpoint{{
+				HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+					Endpoint: &xds_endpoint.Endpoint{Address: envoy.GetAddress("httpbin.org", 8080)},
+				},
+				LoadBalancingWeight: &wrappers.UInt32Value{Value: 1},
+			}},
+		},
+		{
+			name: "DNS resolution with weighted endpoints and circuit breaking",
+			externalService: &policyv1alpha1.ExternalService{
+				Spec: policyv1alpha1.ExternalServiceSpec{
+					Hosts:      []string{"httpbin.org"},
+					Resolution: policyv1alpha1.ExternalServiceResolutionDNS,
+					Endpoints: []policyv1alpha1.ExternalServiceEndpointSpec{
+						{Address: "eu.httpbin.org", Weight: 3},
+						{Address: "us.httpbin.org"},
+					},
+				},
+			},
+			upstreamTraffic: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					ConnectionSettings: &policyv1alpha1.ConnectionSettingsSpec{
+						TCP: &policyv1alpha1.TCPConnectionSettings{MaxConnections: &maxConnections},
+					},
+				},
+			},
+			expectedDiscoveryType: xds_cluster.Cluster_STRICT_DNS,
+			expectedLbEndpoints: []*xds_endpoint.LbEndpoint{
+				{
+					HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+						Endpoint: &xds_endpoint.Endpoint{Address: envoy.GetAddress("eu.httpbin.org", 8080)},
+					},
+					LoadBalancingWeight: &wrappers.UInt32Value{Value: 3},
+				},
+				{
+					HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+						Endpoint: &xds_endpoint.Endpoint{Address: envoy.GetAddress("us.httpbin.org", 8080)},
+					},
+					LoadBalancingWeight: &wrappers.UInt32Value{Value: 1},
+				},
+			},
+		},
+		{
+			name: "Static resolution uses EDS",
+			externalService: &policyv1alpha1.ExternalService{
+				Spec: policyv1alpha1.ExternalServiceSpec{
+					Hosts:      []string{"httpbin.org"},
+					Resolution: policyv1alpha1.ExternalServiceResolutionStatic,
+					Endpoints: []policyv1alpha1.ExternalServiceEndpointSpec{
+						{Address: "10.0.0.1"},
+					},
+				},
+			},
+			expectedDiscoveryType: xds_cluster.Cluster_EDS,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			cluster := getUpstreamServiceCluster(tests.BookbuyerServiceIdentity, trafficpolicy.MeshClusterConfig{
+				Name:                            upstreamSvc.SidecarClusterName(),
+				Service:                         upstreamSvc,
+				EnableSidecarActiveHealthChecks: true,
+				UpstreamTrafficSetting:          tc.upstreamTraffic,
+				ExternalService:                 tc.externalService,
+			}, configv1alpha2.SidecarSpec{})
+			assert.NotNil(cluster)
+
+			// External services do not use mesh mTLS and health checks
+			assert.Nil(cluster.TransportSocket)
+			assert.Nil(cluster.HealthChecks)
+			assert.Equal(tc.expectedDiscoveryType, cluster.GetType())
+
+			if tc.expectedDiscoveryType == xds_cluster.Cluster_EDS {
+				assert.NotNil(cluster.EdsClusterConfig)
+				assert.Nil(cluster.LoadAssignment)
+			} else {
+				assert.Len(cluster.LoadAssignment.Endpoints, 1)
+				assert.Len(cluster.LoadAssignment.Endpoints[0].LbEndpoints, len(tc.expectedLbEndpoints))
+				for i, expected := range tc.expectedLbEndpoints {
+					assert.True(proto.Equal(expected, cluster.LoadAssignment.Endpoints[0].LbEndpoints[i]))
+				}
+			}
+
+			if tc.upstreamTraffic != nil {
+				assert.Equal(maxConnections, cluster.CircuitBreakers.Thresholds[0].MaxConnections.GetValue())
+			}
+		})
+	}
+}
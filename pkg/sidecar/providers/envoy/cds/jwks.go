@@ -0,0 +1,107 @@
+package cds
+
+import (
+	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// systemTrustedCAFile is the file holding the system trusted CA certificates in the sidecar image,
+	// used to validate the certificates of the servers remote JSON Web Key Sets are fetched from
+	systemTrustedCAFile = "/etc/ssl/certs/ca-certificates.crt"
+)
+
+// getJWKSClusters returns the clusters the remote JSON Web Key Sets used to authenticate
+// the requests matching the given inbound traffic matches are fetched from
+func getJWKSClusters(trafficMatches []*trafficpolicy.TrafficMatch) []*xds_cluster.Cluster {
+	var clusters []*xds_cluster.Cluster
+	clusterSet := make(map[string]bool)
+
+	for _, trafficMatch := range trafficMatches {
+		if trafficMatch.JWTAuthentication == nil {
+			continue
+		}
+		for _, provider := range trafficMatch.JWTAuthentication.Providers {
+			if provider.RemoteJWKSURI == "" {
+				continue
+			}
+			jwksCluster, err := envoy.GetJWKSCluster(provider.RemoteJWKSURI)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error getting JWKS cluster for JWT provider %s", provider.Name)
+				continue
+			}
+			if clusterSet[jwksCluster.Name] {
+				continue
+			}
+			cluster, err := getJWKSCluster(jwksCluster)
+			if err != nil {
+				log.Error().Err(err).Msgf("Error building JWKS cluster %s", jwksCluster.Name)
+				continue
+			}
+			clusterSet[jwksCluster.Name] = true
+			clusters = append(clusters, cluster)
+		}
+	}
+
+	return clusters
+}
+
+// getJWKSCluster returns the Envoy cluster for the given JWKS cluster
+func getJWKSCluster(jwksCluster *envoy.JWKSCluster) (*xds_cluster.Cluster, error) {
+	cluster := &xds_cluster.Cluster{
+		Name:        jwksCluster.Name,
+		AltStatName: replacer.Replace(jwksCluster.Name),
+		ClusterDiscoveryType: &xds_cluster.Cluster_Type{
+			Type: xds_cluster.Cluster_LOGICAL_DNS,
+		},
+		LbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
+		LoadAssignment: &xds_endpoint.ClusterLoadAssignment{
+			ClusterName: jwksCluster.Name,
+			Endpoints: []*xds_endpoint.LocalityLbEndpoints{
+				{
+					LbEndpoints: []*xds_endpoint.LbEndpoint{{
+						HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
+							Endpoint: &xds_endpoint.Endpoint{
+								Address: envoy.GetAddress(jwksCluster.Host, jwksCluster.Port),
+							},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	if !jwksCluster.TLS {
+		return cluster, nil
+	}
+
+	marshalledUpstreamTLSContext, err := protobuf.MarshalAny(&xds_auth.UpstreamTlsContext{
+		Sni: jwksCluster.Host,
+		CommonTlsContext: &xds_auth.CommonTlsContext{
+			ValidationContextType: &xds_auth.CommonTlsContext_ValidationContext{
+				ValidationContext: &xds_auth.CertificateValidationContext{
+					TrustedCa: &xds_core.DataSource{
+						Specifier: &xds_core.DataSource_Filename{Filename: systemTrustedCAFile},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	cluster.TransportSocket = &xds_core.TransportSocket{
+		Name: jwksCluster.Name,
+		ConfigType: &xds_core.TransportSocket_TypedConfig{
+			TypedConfig: marshalledUpstreamTLSContext,
+		},
+	}
+
+	return cluster, nil
+}
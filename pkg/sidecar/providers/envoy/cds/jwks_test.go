@@ -0,0 +1,55 @@
+package cds
+
+import (
+	"testing"
+
+	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
+	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetJWKSClusters(t *testing.T) {
+	assert := tassert.New(t)
+
+	trafficMatches := []*trafficpolicy.TrafficMatch{
+		{Name: "inbound_ns/s1_80_http"},
+		{
+			Name: "inbound_ns/s2_80_http",
+			JWTAuthentication: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{
+					{Name: "ns/authn/0", LocalJWKS: "{}"},
+					{Name: "ns/authn/1", RemoteJWKSURI: "https://issuer.example.com/.well-known/jwks.json"},
+					{Name: "ns/authn/2", RemoteJWKSURI: "http://jwks.auth:8080/keys"},
+					{Name: "ns/authn/3", RemoteJWKSURI: "file:///jwks.json"},
+				},
+			},
+		},
+		{
+			Name: "inbound_ns/s3_80_http",
+			JWTAuthentication: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{
+					{Name: "ns/authn/0", RemoteJWKSURI: "https://issuer.example.com/keys"},
+				},
+			},
+		},
+	}
+
+	clusters := getJWKSClusters(trafficMatches)
+	assert.Len(clusters, 2)
+
+	httpsCluster := clusters[0]
+	assert.Equal("jwks|issuer.example.com:443", httpsCluster.Name)
+	assert.Equal(xds_cluster.Cluster_LOGICAL_DNS, httpsCluster.GetType())
+	assert.Equal("issuer.example.com", httpsCluster.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress().Address)
+	upstreamTLSContext := &xds_auth.UpstreamTlsContext{}
+	assert.Nil(httpsCluster.TransportSocket.GetTypedConfig().UnmarshalTo(upstreamTLSContext))
+	assert.Equal("issuer.example.com", upstreamTLSContext.Sni)
+	assert.Equal(systemTrustedCAFile, upstreamTLSContext.CommonTlsContext.GetValidationContext().TrustedCa.GetFilename())
+
+	httpCluster := clusters[1]
+	assert.Equal("jwks|jwks.auth:8080", httpCluster.Name)
+	assert.Equal(uint32(8080), httpCluster.LoadAssignment.Endpoints[0].LbEndpoints[0].GetEndpoint().Address.GetSocketAddress().GetPortValue())
+	assert.Nil(httpCluster.TransportSocket)
+}
@@ -6,6 +6,7 @@ import (
 	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
@@ -21,6 +22,8 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 
 	// Build upstream clusters based on allowed outbound traffic policies
 	outboundMeshTrafficPolicy := meshCatalog.GetOutboundMeshTrafficPolicy(proxy.Identity)
+	// The policy is kept to list the dependencies of the proxy without computing it again
+	proxy.SetOutboundMeshTrafficPolicy(outboundMeshTrafficPolicy)
 	if outboundMeshTrafficPolicy != nil {
 		clusters = append(clusters, upstreamClustersFromClusterConfigs(proxy.Identity, outboundMeshTrafficPolicy.ClustersConfigs, cfg.GetMeshConfig().Spec.Sidecar)...)
 	}
@@ -35,6 +38,7 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 	inboundMeshTrafficPolicy := meshCatalog.GetInboundMeshTrafficPolicy(proxy.Identity, proxyServices)
 	if inboundMeshTrafficPolicy != nil {
 		clusters = append(clusters, localClustersFromClusterConfigs(inboundMeshTrafficPolicy.ClustersConfigs)...)
+		clusters = append(clusters, getJWKSClusters(inboundMeshTrafficPolicy.TrafficMatches)...)
 	}
 
 	// Add egress clusters based on applied policies
@@ -70,6 +74,11 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 		clusters = append(clusters, getTracingCluster(cfg))
 	}
 
+	// Add an outbound remote logging cluster (from localhost to the OpenTelemetry collector)
+	if cfg.IsRemoteLoggingEnabled() && cfg.GetRemoteLoggingProtocol() == configv1alpha2.TelemetryProtocolOTLPGRPC {
+		clusters = append(clusters, getRemoteLoggingCluster(cfg))
+	}
+
 	return removeDups(clusters), nil
 }
 
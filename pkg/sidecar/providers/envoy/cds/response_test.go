@@ -99,6 +99,8 @@ func TestNewResponse(t *testing.T) {
 	mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().IsEgressEnabled().Return(true).AnyTimes()
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(true).AnyTimes()
+	mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
+	mockConfigurator.EXPECT().IsRemoteLoggingEnabled().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingHost().Return(constants.DefaultTracingHost).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingPort().Return(constants.DefaultTracingPort).AnyTimes()
 	mockConfigurator.EXPECT().GetFeatureFlags().Return(configv1alpha2.FeatureFlags{}).AnyTimes()
@@ -447,6 +449,7 @@ func TestNewResponseGetEgressTrafficPolicyError(t *testing.T) {
 	meshCatalog.EXPECT().GetKubeController().Return(mockKubeController).AnyTimes()
 	cfg.EXPECT().IsEgressEnabled().Return(false).Times(1)
 	cfg.EXPECT().IsTracingEnabled().Return(false).Times(1)
+	cfg.EXPECT().IsRemoteLoggingEnabled().Return(false).Times(1)
 	cfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
 
 	pod := tests.NewPodFixture("ns", "pod-1", "svcacc", map[string]string{
@@ -483,6 +486,7 @@ func TestNewResponseGetEgressTrafficPolicyNotEmpty(t *testing.T) {
 	}, nil).Times(1)
 	cfg.EXPECT().IsEgressEnabled().Return(false).Times(1)
 	cfg.EXPECT().IsTracingEnabled().Return(false).Times(1)
+	cfg.EXPECT().IsRemoteLoggingEnabled().Return(false).Times(1)
 	cfg.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
 
 	pod := tests.NewPodFixture("ns", "pod-1", "svcacc", map[string]string{
@@ -3,28 +3,43 @@ package cds
 import (
 	xds_cluster "github.com/envoyproxy/go-control-plane/envoy/config/cluster/v3"
 	xds_endpoint "github.com/envoyproxy/go-control-plane/envoy/config/endpoint/v3"
+	extensions_upstream_http "github.com/envoyproxy/go-control-plane/envoy/extensions/upstreams/http/v3"
+	"github.com/golang/protobuf/ptypes/any"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 )
 
 func getTracingCluster(cfg configurator.Configurator) *xds_cluster.Cluster {
-	return &xds_cluster.Cluster{
-		Name:        constants.SidecarTracingCluster,
-		AltStatName: constants.SidecarTracingCluster,
+	return getTelemetryCluster(constants.SidecarTracingCluster, cfg.GetTracingHost(), cfg.GetTracingPort(),
+		cfg.GetTracingProtocol() == configv1alpha2.TelemetryProtocolOTLPGRPC)
+}
+
+// getRemoteLoggingCluster returns the cluster the OpenTelemetry access logger exports the access logs to.
+// Envoy only exports access logs over OTLP/gRPC.
+func getRemoteLoggingCluster(cfg configurator.Configurator) *xds_cluster.Cluster {
+	return getTelemetryCluster(constants.SidecarRemoteLoggingCluster, cfg.GetRemoteLoggingHost(), cfg.GetRemoteLoggingPort(), true)
+}
+
+func getTelemetryCluster(clusterName string, host string, port uint32, grpc bool) *xds_cluster.Cluster {
+	cluster := &xds_cluster.Cluster{
+		Name:        clusterName,
+		AltStatName: clusterName,
 		ClusterDiscoveryType: &xds_cluster.Cluster_Type{
 			Type: xds_cluster.Cluster_LOGICAL_DNS,
 		},
 		LbPolicy: xds_cluster.Cluster_ROUND_ROBIN,
 		LoadAssignment: &xds_endpoint.ClusterLoadAssignment{
-			ClusterName: constants.SidecarTracingCluster,
+			ClusterName: clusterName,
 			Endpoints: []*xds_endpoint.LocalityLbEndpoints{
 				{
 					LbEndpoints: []*xds_endpoint.LbEndpoint{{
 						HostIdentifier: &xds_endpoint.LbEndpoint_Endpoint{
 							Endpoint: &xds_endpoint.Endpoint{
-								Address: envoy.GetAddress(cfg.GetTracingHost(), cfg.GetTracingPort()),
+								Address: envoy.GetAddress(host, port),
 							},
 						},
 					}},
@@ -32,4 +47,19 @@ func getTracingCluster(cfg configurator.Configurator) *xds_cluster.Cluster {
 			},
 		},
 	}
+
+	// OTLP/gRPC collectors require HTTP/2
+	if grpc {
+		cluster.TypedExtensionProtocolOptions = map[string]*any.Any{
+			"envoy.extensions.upstreams.http.v3.HttpProtocolOptions": protobuf.MustMarshalAny(&extensions_upstream_http.HttpProtocolOptions{
+				UpstreamProtocolOptions: &extensions_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_{
+					ExplicitHttpConfig: &extensions_upstream_http.HttpProtocolOptions_ExplicitHttpConfig{
+						ProtocolConfig: &extensions_upstream_http.HttpProtocolOptions_ExplicitHttpConfig_Http2ProtocolOptions{},
+					},
+				},
+			}),
+		}
+	}
+
+	return cluster
 }
@@ -5,6 +5,7 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 )
@@ -22,11 +23,36 @@ var _ = Describe("Test CDS Tracing Configuration", func() {
 		It("Returns Tracing cluster config", func() {
 			mockConfigurator.EXPECT().GetTracingHost().Return(constants.DefaultTracingHost).Times(1)
 			mockConfigurator.EXPECT().GetTracingPort().Return(constants.DefaultTracingPort).Times(1)
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).Times(1)
 
 			actual := *getTracingCluster(mockConfigurator)
 			Expect(actual.Name).To(Equal(constants.SidecarTracingCluster))
 			Expect(actual.AltStatName).To(Equal(constants.SidecarTracingCluster))
 			Expect(len(actual.GetLoadAssignment().GetEndpoints())).To(Equal(1))
+			Expect(actual.TypedExtensionProtocolOptions).To(BeNil())
+		})
+
+		It("Returns an HTTP/2 Tracing cluster config for OTLP/gRPC", func() {
+			mockConfigurator.EXPECT().GetTracingHost().Return("otel-collector").Times(1)
+			mockConfigurator.EXPECT().GetTracingPort().Return(uint32(4317)).Times(1)
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolOTLPGRPC).Times(1)
+
+			actual := getTracingCluster(mockConfigurator)
+			Expect(actual.Name).To(Equal(constants.SidecarTracingCluster))
+			Expect(actual.TypedExtensionProtocolOptions).To(HaveKey("envoy.extensions.upstreams.http.v3.HttpProtocolOptions"))
+		})
+	})
+
+	Context("Test getRemoteLoggingCluster()", func() {
+		It("Returns an HTTP/2 Remote logging cluster config", func() {
+			mockConfigurator.EXPECT().GetRemoteLoggingHost().Return("otel-collector").Times(1)
+			mockConfigurator.EXPECT().GetRemoteLoggingPort().Return(uint32(4317)).Times(1)
+
+			actual := getRemoteLoggingCluster(mockConfigurator)
+			Expect(actual.Name).To(Equal(constants.SidecarRemoteLoggingCluster))
+			Expect(actual.AltStatName).To(Equal(constants.SidecarRemoteLoggingCluster))
+			Expect(len(actual.GetLoadAssignment().GetEndpoints())).To(Equal(1))
+			Expect(actual.TypedExtensionProtocolOptions).To(HaveKey("envoy.extensions.upstreams.http.v3.HttpProtocolOptions"))
 		})
 	})
 })
@@ -51,6 +51,8 @@ func createEnvoyBootstrapConfig(ctx *driver.InjectorContext, name, namespace, os
 		TLSMaxProtocolVersion: ctx.Configurator.GetMeshConfig().Spec.Sidecar.TLSMaxProtocolVersion,
 		CipherSuites:          ctx.Configurator.GetMeshConfig().Spec.Sidecar.CipherSuites,
 		ECDHCurves:            ctx.Configurator.GetMeshConfig().Spec.Sidecar.ECDHCurves,
+
+		DeltaXDS: ctx.Configurator.GetFeatureFlags().EnableDeltaXDS,
 	}
 	bootstrapConfig, err := builder.Build()
 	if err != nil {
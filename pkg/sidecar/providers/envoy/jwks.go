@@ -0,0 +1,65 @@
+package envoy
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// jwksClusterPrefix is the prefix of the clusters remote JSON Web Key Sets are fetched from
+	jwksClusterPrefix = "jwks"
+)
+
+// JWKSCluster is the upstream cluster a remote JSON Web Key Set is fetched from
+type JWKSCluster struct {
+	// Name is the name of the cluster
+	Name string
+
+	// Host is the hostname serving the JSON Web Key Set
+	Host string
+
+	// Port is the port serving the JSON Web Key Set
+	Port uint32
+
+	// TLS defines whether the JSON Web Key Set is fetched over TLS
+	TLS bool
+}
+
+// GetJWKSCluster returns the cluster the JSON Web Key Set at the given URI is fetched from
+func GetJWKSCluster(uri string) (*JWKSCluster, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JWKS URI %s: %w", uri, err)
+	}
+
+	var port uint32
+	switch u.Scheme {
+	case "http":
+		port = 80
+	case "https":
+		port = 443
+	default:
+		return nil, fmt.Errorf("invalid JWKS URI %s: unsupported scheme %q", uri, u.Scheme)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return nil, fmt.Errorf("invalid JWKS URI %s: missing host", uri)
+	}
+	if p := u.Port(); p != "" {
+		parsedPort, err := strconv.ParseUint(p, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JWKS URI %s: %w", uri, err)
+		}
+		port = uint32(parsedPort)
+	}
+
+	return &JWKSCluster{
+		Name: fmt.Sprintf("%s|%s", jwksClusterPrefix, net.JoinHostPort(host, strconv.Itoa(int(port)))),
+		Host: host,
+		Port: port,
+		TLS:  u.Scheme == "https",
+	}, nil
+}
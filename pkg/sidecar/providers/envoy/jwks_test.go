@@ -0,0 +1,47 @@
+package envoy
+
+import (
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+)
+
+func TestGetJWKSCluster(t *testing.T) {
+	testCases := []struct {
+		name        string
+		uri         string
+		expected    *JWKSCluster
+		expectedErr bool
+	}{
+		{
+			name:     "https URI with the default port",
+			uri:      "https://issuer.example.com/.well-known/jwks.json",
+			expected: &JWKSCluster{Name: "jwks|issuer.example.com:443", Host: "issuer.example.com", Port: 443, TLS: true},
+		},
+		{
+			name:     "http URI with an explicit port",
+			uri:      "http://jwks.auth.svc.cluster.local:8080/keys",
+			expected: &JWKSCluster{Name: "jwks|jwks.auth.svc.cluster.local:8080", Host: "jwks.auth.svc.cluster.local", Port: 8080},
+		},
+		{
+			name:        "unsupported scheme",
+			uri:         "file:///etc/jwks.json",
+			expectedErr: true,
+		},
+		{
+			name:        "missing host",
+			uri:         "https:///jwks.json",
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			actual, err := GetJWKSCluster(tc.uri)
+			assert.Equal(tc.expectedErr, err != nil)
+			assert.Equal(tc.expected, actual)
+		})
+	}
+}
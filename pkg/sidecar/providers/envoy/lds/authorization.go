@@ -0,0 +1,135 @@
+package lds
+
+import (
+	"fmt"
+	"strings"
+
+	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rbac"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// authorizationActions is the order in which the RBAC filters of the AuthorizationPolicy actions are applied:
+// the traffic matching a DENY policy is denied first, and the remaining traffic is audited before
+// the ALLOW policies are enforced.
+var authorizationActions = []policyv1alpha1.AuthorizationPolicyAction{
+	policyv1alpha1.AuthorizationPolicyActionDeny,
+	policyv1alpha1.AuthorizationPolicyActionAudit,
+	policyv1alpha1.AuthorizationPolicyActionAllow,
+}
+
+// getAuthorizationTrafficTargets returns the inbound traffic targets derived from AuthorizationPolicy policies
+func (lb *listenerBuilder) getAuthorizationTrafficTargets() ([]trafficpolicy.TrafficTargetWithRoutes, error) {
+	if !lb.cfg.GetFeatureFlags().EnableAuthorizationPolicy {
+		return nil, nil
+	}
+
+	trafficTargets, err := lb.meshCatalog.ListInboundTrafficTargetsWithRoutes(lb.serviceIdentity)
+	if err != nil {
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrGettingInboundTrafficTargets)).
+			Msgf("Error listing allowed inbound traffic targets for proxy identity %s", lb.serviceIdentity)
+		return nil, err
+	}
+
+	var authorizationTrafficTargets []trafficpolicy.TrafficTargetWithRoutes
+	for _, trafficTarget := range trafficTargets {
+		if trafficTarget.IsAuthorizationPolicy() {
+			authorizationTrafficTargets = append(authorizationTrafficTargets, trafficTarget)
+		}
+	}
+
+	return authorizationTrafficTargets, nil
+}
+
+// buildAuthorizationRBACs builds an RBAC config per action of the given AuthorizationPolicy traffic targets
+func buildAuthorizationRBACs(trafficTargets []trafficpolicy.TrafficTargetWithRoutes, trustDomain string, spiffeEnabled bool, forHTTP bool) (map[policyv1alpha1.AuthorizationPolicyAction]*xds_rbac.RBAC, error) {
+	rbacs := make(map[policyv1alpha1.AuthorizationPolicyAction]*xds_rbac.RBAC)
+
+	for _, trafficTarget := range trafficTargets {
+		policies, err := rbac.BuildAuthorizationPolicies(trafficTarget, trustDomain, spiffeEnabled, forHTTP)
+		if err != nil {
+			return nil, err
+		}
+
+		rules, ok := rbacs[trafficTarget.Action]
+		if !ok {
+			rules = &xds_rbac.RBAC{
+				Action:   rbac.GetAuthorizationAction(trafficTarget.Action),
+				Policies: make(map[string]*xds_rbac.Policy),
+			}
+			rbacs[trafficTarget.Action] = rules
+		}
+		for name, policy := range policies {
+			rules.Policies[name] = policy
+		}
+	}
+
+	return rbacs, nil
+}
+
+// buildAuthorizationNetworkFilters builds the network RBAC filters enforcing the given AuthorizationPolicy traffic targets on TCP traffic
+func buildAuthorizationNetworkFilters(trafficTargets []trafficpolicy.TrafficTargetWithRoutes, trustDomain string, spiffeEnabled bool) ([]*xds_listener.Filter, error) {
+	rbacs, err := buildAuthorizationRBACs(trafficTargets, trustDomain, spiffeEnabled, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []*xds_listener.Filter
+	for _, action := range authorizationActions {
+		rules, ok := rbacs[action]
+		if !ok {
+			continue
+		}
+		actionName := strings.ToLower(string(action))
+
+		marshalledRBAC, err := protobuf.MarshalAny(&xds_network_rbac.RBAC{
+			StatPrefix: fmt.Sprintf("authz-%s-", actionName), // will be displayed as authz-<action>-rbac.<path>
+			Rules:      rules,
+		})
+		if err != nil {
+			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
+				Msgf("Error marshalling %s authorization RBAC policy", action)
+			return nil, err
+		}
+		filters = append(filters, &xds_listener.Filter{
+			Name:       fmt.Sprintf("%s_%s", envoy.L4AuthzFilterNamePrefix, actionName),
+			ConfigType: &xds_listener.Filter_TypedConfig{TypedConfig: marshalledRBAC},
+		})
+	}
+
+	return filters, nil
+}
+
+// getAuthorizationHTTPFilters returns the HTTP RBAC filters enforcing the given AuthorizationPolicy traffic targets on HTTP traffic.
+// The filters are named distinctly from the RBAC filter configured per route so that the per route config does not override them.
+func getAuthorizationHTTPFilters(trafficTargets []trafficpolicy.TrafficTargetWithRoutes, trustDomain string, spiffeEnabled bool) ([]*xds_hcm.HttpFilter, error) {
+	rbacs, err := buildAuthorizationRBACs(trafficTargets, trustDomain, spiffeEnabled, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var filters []*xds_hcm.HttpFilter
+	for _, action := range authorizationActions {
+		rules, ok := rbacs[action]
+		if !ok {
+			continue
+		}
+
+		filter, err := getHTTPFilter(fmt.Sprintf("%s_%s", envoy.HTTPAuthzFilterNamePrefix, strings.ToLower(string(action))), &xds_http_rbac.RBAC{Rules: rules})
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
@@ -0,0 +1,101 @@
+package lds
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetAuthorizationHTTPFilters(t *testing.T) {
+	assert := tassert.New(t)
+
+	trafficTargets := []trafficpolicy.TrafficTargetWithRoutes{
+		{
+			Name:   "ns/allow",
+			Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+			AuthorizationRules: []trafficpolicy.AuthorizationRule{
+				{From: []trafficpolicy.AuthorizationSource{{Identities: []identity.ServiceIdentity{"sa-1.ns"}}}},
+			},
+		},
+		{
+			Name:   "ns/deny",
+			Action: policyv1alpha1.AuthorizationPolicyActionDeny,
+			AuthorizationRules: []trafficpolicy.AuthorizationRule{
+				{To: []policyv1alpha1.AuthorizationOperationSpec{{Paths: []string{"/admin"}}}},
+			},
+		},
+		{
+			Name:   "ns/deny-other",
+			Action: policyv1alpha1.AuthorizationPolicyActionDeny,
+			AuthorizationRules: []trafficpolicy.AuthorizationRule{
+				{From: []trafficpolicy.AuthorizationSource{{Namespaces: []string{"other"}}}},
+			},
+		},
+	}
+
+	filters, err := getAuthorizationHTTPFilters(trafficTargets, "cluster.local", false)
+	assert.Nil(err)
+	assert.Len(filters, 2)
+
+	// DENY filter is applied before the ALLOW filter
+	assert.Equal("http_authz_deny", filters[0].Name)
+	deny := &xds_http_rbac.RBAC{}
+	assert.Nil(filters[0].GetTypedConfig().UnmarshalTo(deny))
+	assert.Equal(xds_rbac.RBAC_DENY, deny.Rules.Action)
+	assert.Len(deny.Rules.Policies, 2)
+	assert.Contains(deny.Rules.Policies, "ns/deny/0")
+	assert.Contains(deny.Rules.Policies, "ns/deny-other/0")
+
+	assert.Equal("http_authz_allow", filters[1].Name)
+	allow := &xds_http_rbac.RBAC{}
+	assert.Nil(filters[1].GetTypedConfig().UnmarshalTo(allow))
+	assert.Equal(xds_rbac.RBAC_ALLOW, allow.Rules.Action)
+	assert.Len(allow.Rules.Policies, 1)
+}
+
+func TestBuildAuthorizationNetworkFilters(t *testing.T) {
+	assert := tassert.New(t)
+
+	trafficTargets := []trafficpolicy.TrafficTargetWithRoutes{
+		{
+			Name:   "ns/audit",
+			Action: policyv1alpha1.AuthorizationPolicyActionAudit,
+			AuthorizationRules: []trafficpolicy.AuthorizationRule{
+				{To: []policyv1alpha1.AuthorizationOperationSpec{{Ports: []uint16{9090}}}},
+			},
+		},
+		{
+			Name:   "ns/allow",
+			Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+			AuthorizationRules: []trafficpolicy.AuthorizationRule{
+				// HTTP conditions are not matched by TCP traffic
+				{To: []policyv1alpha1.AuthorizationOperationSpec{{Methods: []string{"GET"}}}},
+			},
+		},
+	}
+
+	filters, err := buildAuthorizationNetworkFilters(trafficTargets, "cluster.local", false)
+	assert.Nil(err)
+	assert.Len(filters, 2)
+
+	assert.Equal("l4_authz_audit", filters[0].Name)
+	audit := &xds_network_rbac.RBAC{}
+	assert.Nil(filters[0].GetTypedConfig().UnmarshalTo(audit))
+	assert.Equal(xds_rbac.RBAC_LOG, audit.Rules.Action)
+	assert.Equal("authz-audit-", audit.StatPrefix)
+	assert.Len(audit.Rules.Policies, 1)
+
+	// An ALLOW filter without policies denies all the TCP traffic
+	assert.Equal("l4_authz_allow", filters[1].Name)
+	allow := &xds_network_rbac.RBAC{}
+	assert.Nil(filters[1].GetTypedConfig().UnmarshalTo(allow))
+	assert.Equal(xds_rbac.RBAC_ALLOW, allow.Rules.Action)
+	assert.Empty(allow.Rules.Policies)
+}
@@ -6,11 +6,11 @@ import (
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	xds_tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rds/route"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -51,8 +51,10 @@ func (lb *listenerBuilder) getEgressFilterChainsForMatches(matches []*trafficpol
 	return filterChains
 }
 
+// getEgressHTTPFilterChain returns the filter chain for the plain HTTP egress traffic on the matched port.
+// TLS origination to the external hosts, if any, is configured on the clusters the HTTP routes point to.
 func (lb *listenerBuilder) getEgressHTTPFilterChain(match trafficpolicy.TrafficMatch) (*xds_listener.FilterChain, error) {
-	filter, err := lb.getOutboundHTTPFilter(route.GetEgressRouteConfigNameForPort(match.DestinationPort))
+	filter, err := lb.getOutboundHTTPFilter(route.GetEgressRouteConfigNameForPort(match.DestinationPort), false)
 	if err != nil {
 		log.Error().Err(err).Msgf("Error building HTTP filter chain for destination port [%d]", match.DestinationPort)
 		return nil, err
@@ -74,9 +76,10 @@ func (lb *listenerBuilder) getEgressTCPFilterChain(match trafficpolicy.TrafficMa
 	tcpProxy := &xds_tcp_proxy.TcpProxy{
 		StatPrefix:       fmt.Sprintf("%s.%d", egressTCPProxyStatPrefix, match.DestinationPort),
 		ClusterSpecifier: &xds_tcp_proxy.TcpProxy_Cluster{Cluster: match.Cluster},
+		AccessLog:        lb.outboundAccessLogs,
 	}
 
-	marshalledTCPProxy, err := anypb.New(tcpProxy)
+	marshalledTCPProxy, err := protobuf.MarshalAny(tcpProxy)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling TcpProxy for TrafficMatch %v", match)
@@ -56,6 +56,8 @@ func TestGetEgressHTTPFilterChain(t *testing.T) {
 			}
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
+			mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 			mockConfigurator.EXPECT().GetFeatureFlags().Return(configv1alpha2.FeatureFlags{
 				EnableEgressPolicy: true,
 				EnableWASMStats:    false}).AnyTimes()
@@ -231,6 +233,8 @@ func TestGetEgressFilterChainsForMatches(t *testing.T) {
 			}
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
+			mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 			mockConfigurator.EXPECT().GetFeatureFlags().Return(configv1alpha2.FeatureFlags{
 				EnableEgressPolicy: true,
 				EnableWASMStats:    false,
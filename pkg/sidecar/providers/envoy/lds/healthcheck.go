@@ -7,9 +7,9 @@ import (
 	xds_health_check "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/health_check/v3"
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 )
 
@@ -32,7 +32,7 @@ func getHealthCheckFilter() (*xds_hcm.HttpFilter, error) {
 		},
 	}
 
-	hcAny, err := anypb.New(hc)
+	hcAny, err := protobuf.MarshalAny(hc)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling health check filter: %w", err)
 	}
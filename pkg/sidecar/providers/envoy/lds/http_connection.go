@@ -3,16 +3,22 @@ package lds
 import (
 	"fmt"
 
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_grpc_stats "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_stats/v3"
+	xds_grpc_web "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_web/v3"
 	xds_local_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/local_ratelimit/v3"
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/wrappers"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 // connectionDirection defines, for filter terms, the direction of a connection from
@@ -41,10 +47,28 @@ type httpConnManagerOptions struct {
 	wasmStatsHeaders         map[string]string
 	extAuthConfig            *auth.ExtAuthConfig
 	enableActiveHealthChecks bool
+	httpGlobalRateLimit      *policyv1alpha1.HTTPGlobalRateLimitSpec
+	enableFaultInjection     bool
+	jwtAuthentication        *trafficpolicy.JWTAuthentication
+
+	// grpc indicates the traffic is gRPC, gRPC-Web requests are bridged to gRPC
+	// on inbound connections and gRPC status stats are emitted
+	grpc bool
+
+	// Authorization options
+	authorizationTrafficTargets []trafficpolicy.TrafficTargetWithRoutes
+	trustDomain                 string
+	spiffeEnabled               bool
 
 	// Tracing options
-	enableTracing      bool
-	tracingAPIEndpoint string
+	enableTracing          bool
+	tracingProtocol        configv1alpha2.TelemetryProtocol
+	tracingAPIEndpoint     string
+	tracingSampledFraction float32
+
+	// Telemetry options
+	telemetryAttributes map[string]string
+	accessLogs          []*xds_accesslog_filter.AccessLog
 }
 
 func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, error) {
@@ -84,7 +108,7 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 				RouteConfigName: options.rdsRoutConfigName,
 			},
 		},
-		AccessLog: envoy.GetAccessLog(),
+		AccessLog: options.accessLogs,
 		UpgradeConfigs: []*xds_hcm.HttpConnectionManager_UpgradeConfig{
 			{
 				UpgradeType: websocketUpgradeType,
@@ -92,22 +116,66 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 		},
 	}
 
+	// For inbound connections, add the RBAC filters of the AuthorizationPolicy policies.
+	// They precede the other filters so that unauthorized requests are rejected early.
+	if options.direction == inbound && len(options.authorizationTrafficTargets) > 0 {
+		authorizationFilters, err := getAuthorizationHTTPFilters(options.authorizationTrafficTargets, options.trustDomain, options.spiffeEnabled)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting authorization filters for HTTP connection manager: %w", err)
+		}
+		connManager.HttpFilters = append(authorizationFilters, connManager.HttpFilters...)
+	}
+
+	// For inbound connections, add the JWT authentication filters. They must precede
+	// the other filters so that unauthenticated requests are rejected first.
+	if options.direction == inbound && options.jwtAuthentication != nil {
+		jwtAuthnFilters, err := getJWTAuthnHTTPFilters(options.jwtAuthentication)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting JWT authentication filters for HTTP connection manager: %w", err)
+		}
+		connManager.HttpFilters = append(jwtAuthnFilters, connManager.HttpFilters...)
+	}
+
+	// For inbound connections, add the global rate limit filter
+	if options.direction == inbound && options.httpGlobalRateLimit != nil {
+		rateLimitFilter, err := getHTTPGlobalRateLimitFilter(options.httpGlobalRateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("Error getting global rate limit filter for HTTP connection manager: %w", err)
+		}
+		connManager.HttpFilters = append(connManager.HttpFilters, rateLimitFilter)
+	}
+
 	// For inbound connections, add the Authz filter
 	if options.direction == inbound && options.extAuthConfig != nil {
 		connManager.HttpFilters = append(connManager.HttpFilters, getExtAuthzHTTPFilter(options.extAuthConfig))
 	}
 
+	// For outbound connections, add the fault filter. Since no faults are defined here,
+	// the filter is disabled at the listener level. The faults are configured at the Route level.
+	if options.direction == outbound && options.enableFaultInjection {
+		connManager.HttpFilters = append(connManager.HttpFilters, &xds_hcm.HttpFilter{
+			Name: envoy.HTTPFaultFilterName,
+			ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+				TypedConfig: &any.Any{
+					TypeUrl: envoy.HTTPFaultFilterTypeURL,
+				},
+			},
+		})
+	}
+
 	// Enable tracing if requested
 	if options.enableTracing {
-		tracing, err := getHTTPTracingConfig(options.tracingAPIEndpoint)
+		tracing, err := getHTTPTracingConfig(options.tracingProtocol, options.tracingAPIEndpoint, options.tracingSampledFraction, options.telemetryAttributes)
 		if err != nil {
 			return nil, fmt.Errorf("Error getting tracing config for HTTP connection manager: %w", err)
 		}
 
-		connManager.GenerateRequestId = &wrappers.BoolValue{
-			Value: true,
+		if tracing != nil {
+			connManager.GenerateRequestId = &wrappers.BoolValue{
+				Value: true,
+			}
+			connManager.Tracing = tracing
 		}
-		connManager.Tracing = tracing
 	}
 
 	// Configure WASM stats headers if provided
@@ -120,6 +188,10 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 		connManager.LocalReplyConfig = wasmLocalReplyConfig
 	}
 
+	if options.grpc {
+		connManager.HttpFilters = append(connManager.HttpFilters, getGRPCHTTPFilters(options.direction)...)
+	}
+
 	if options.enableActiveHealthChecks {
 		hc, err := getHealthCheckFilter()
 		if err != nil {
@@ -141,6 +213,34 @@ func (options httpConnManagerOptions) build() (*xds_hcm.HttpConnectionManager, e
 	return connManager, nil
 }
 
+// getGRPCHTTPFilters returns the HTTP filters of gRPC traffic. On inbound connections, gRPC-Web requests
+// are bridged to gRPC. The gRPC stats filter emits the per method stats of the gRPC calls, including
+// the stats of their gRPC statuses.
+func getGRPCHTTPFilters(direction connectionDirection) []*xds_hcm.HttpFilter {
+	var filters []*xds_hcm.HttpFilter
+	if direction == inbound {
+		filters = append(filters, &xds_hcm.HttpFilter{
+			Name: envoy.HTTPGRPCWebFilterName,
+			ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+				TypedConfig: protobuf.MustMarshalAny(&xds_grpc_web.GrpcWeb{}),
+			},
+		})
+	}
+	filters = append(filters, &xds_hcm.HttpFilter{
+		Name: envoy.HTTPGRPCStatsFilterName,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: protobuf.MustMarshalAny(&xds_grpc_stats.FilterConfig{
+				EmitFilterState: true,
+				PerMethodStatSpecifier: &xds_grpc_stats.FilterConfig_StatsForAllMethods{
+					StatsForAllMethods: &wrappers.BoolValue{Value: true},
+				},
+				EnableUpstreamStats: direction == outbound,
+			}),
+		},
+	})
+	return filters
+}
+
 func getPrometheusConnectionManager() *xds_hcm.HttpConnectionManager {
 	return &xds_hcm.HttpConnectionManager{
 		StatPrefix: prometheusHTTPConnManagerStatPrefix,
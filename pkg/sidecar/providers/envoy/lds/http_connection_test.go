@@ -7,8 +7,10 @@ import (
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	"github.com/stretchr/testify/assert"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/auth"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 func TestHTTPConnbuild(t *testing.T) {
@@ -58,6 +60,33 @@ func TestHTTPConnbuild(t *testing.T) {
 				a.Equal("envoy.tracers.zipkin", connManager.Tracing.Provider.Name)
 			},
 		},
+		{
+			name: "OpenTelemetry tracing config when tracing is enabled with OTLP/gRPC",
+			option: httpConnManagerOptions{
+				enableTracing:       true,
+				tracingProtocol:     configv1alpha2.TelemetryProtocolOTLPGRPC,
+				telemetryAttributes: map[string]string{"service.name": "sa", "k8s.pod.name": "pod"},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.NotNil(connManager.Tracing)
+				a.Equal("envoy.tracers.opentelemetry", connManager.Tracing.Provider.Name)
+				a.Len(connManager.Tracing.CustomTags, 2)
+				a.Equal("k8s.pod.name", connManager.Tracing.CustomTags[0].Tag)
+				a.Equal("pod", connManager.Tracing.CustomTags[0].GetLiteral().Value)
+				a.Equal("service.name", connManager.Tracing.CustomTags[1].Tag)
+			},
+		},
+		{
+			name: "no tracing config when tracing is enabled with OTLP/HTTP",
+			option: httpConnManagerOptions{
+				enableTracing:   true,
+				tracingProtocol: configv1alpha2.TelemetryProtocolOTLPHTTP,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Nil(connManager.Tracing)
+				a.Nil(connManager.GenerateRequestId)
+			},
+		},
 		{
 			name: "tracing config when tracing is disabled",
 			option: httpConnManagerOptions{
@@ -67,6 +96,57 @@ func TestHTTPConnbuild(t *testing.T) {
 				a.Nil(connManager.Tracing)
 			},
 		},
+		{
+			name:   "no access logs when unset",
+			option: httpConnManagerOptions{},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Empty(connManager.AccessLog)
+			},
+		},
+		{
+			name: "access logs when set",
+			option: httpConnManagerOptions{
+				accessLogs: append(envoy.GetAccessLog(), envoy.GetOpenTelemetryAccessLog("cluster", nil)),
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Len(connManager.AccessLog, 2)
+				a.Equal(envoy.AccessLoggerName, connManager.AccessLog[0].Name)
+				a.Equal(envoy.OpenTelemetryAccessLoggerName, connManager.AccessLog[1].Name)
+			},
+		},
+		{
+			name: "gRPC filters for inbound gRPC traffic",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				grpc:      true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(contains(connManager.HttpFilters, envoy.HTTPGRPCWebFilterName))
+				a.True(contains(connManager.HttpFilters, envoy.HTTPGRPCStatsFilterName))
+				a.Equal(envoy.HTTPRouterFilterName, connManager.HttpFilters[len(connManager.HttpFilters)-1].Name)
+			},
+		},
+		{
+			name: "gRPC stats filter for outbound gRPC traffic",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				grpc:      true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPGRPCWebFilterName))
+				a.True(contains(connManager.HttpFilters, envoy.HTTPGRPCStatsFilterName))
+			},
+		},
+		{
+			name: "no gRPC filters for HTTP traffic",
+			option: httpConnManagerOptions{
+				direction: inbound,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPGRPCWebFilterName))
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPGRPCStatsFilterName))
+			},
+		},
 		{
 			name: "WASM config when WASM stats headers are unset",
 			option: httpConnManagerOptions{
@@ -137,6 +217,50 @@ func TestHTTPConnbuild(t *testing.T) {
 				a.True(notContains(connManager.HttpFilters, envoy.HTTPHealthCheckFilterName))
 			},
 		},
+		{
+			name: "fault filter present for outbound when enabled",
+			option: httpConnManagerOptions{
+				direction:            outbound,
+				enableFaultInjection: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(contains(connManager.HttpFilters, envoy.HTTPFaultFilterName))
+			},
+		},
+		{
+			name: "fault filter absent for inbound when enabled",
+			option: httpConnManagerOptions{
+				direction:            inbound,
+				enableFaultInjection: true,
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPFaultFilterName))
+			},
+		},
+		{
+			name: "JWT authentication filters present first for inbound when set",
+			option: httpConnManagerOptions{
+				direction: inbound,
+				jwtAuthentication: &trafficpolicy.JWTAuthentication{
+					Providers: []*trafficpolicy.JWTProvider{{Name: "ns/authn/0", Issuer: "issuer", LocalJWKS: "{}"}},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.Equal(envoy.HTTPJWTAuthnFilterName, connManager.HttpFilters[0].Name)
+			},
+		},
+		{
+			name: "JWT authentication filters absent for outbound when set",
+			option: httpConnManagerOptions{
+				direction: outbound,
+				jwtAuthentication: &trafficpolicy.JWTAuthentication{
+					Providers: []*trafficpolicy.JWTProvider{{Name: "ns/authn/0", Issuer: "issuer", LocalJWKS: "{}"}},
+				},
+			},
+			assertFunc: func(a *assert.Assertions, connManager *xds_hcm.HttpConnectionManager) {
+				a.True(notContains(connManager.HttpFilters, envoy.HTTPJWTAuthnFilterName))
+			},
+		},
 		{
 			name:   "websocket upgrade config present",
 			option: httpConnManagerOptions{},
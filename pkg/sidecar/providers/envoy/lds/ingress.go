@@ -6,12 +6,12 @@ import (
 
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rds/route"
@@ -58,14 +58,20 @@ func (lb *listenerBuilder) getIngressFilterChainFromTrafficMatch(trafficMatch *t
 		extAuthConfig:    lb.getExtAuthConfig(),
 
 		// Tracing options
-		enableTracing:      lb.cfg.IsTracingEnabled(),
-		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+		enableTracing:          lb.cfg.IsTracingEnabled(),
+		tracingProtocol:        lb.cfg.GetTracingProtocol(),
+		tracingAPIEndpoint:     lb.cfg.GetTracingEndpoint(),
+		tracingSampledFraction: lb.cfg.GetTracingSampledFraction(),
+
+		// Telemetry options
+		telemetryAttributes: lb.telemetryAttributes,
+		accessLogs:          lb.inboundAccessLogs,
 	}.build()
 	if err != nil {
 		return nil, fmt.Errorf("Error building inbound HTTP connection manager for proxy with identity %s, traffic match: %v ", lb.serviceIdentity, trafficMatch)
 	}
 
-	marshalledIngressConnManager, err := anypb.New(ingressConnManager)
+	marshalledIngressConnManager, err := protobuf.MarshalAny(ingressConnManager)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshalling ingress HttpConnectionManager object for proxy with identity %s", lb.serviceIdentity)
 	}
@@ -113,7 +119,7 @@ func (lb *listenerBuilder) getIngressFilterChainFromTrafficMatch(trafficMatch *t
 		filterChain.FilterChainMatch.TransportProtocol = envoy.TransportProtocolTLS
 		filterChain.FilterChainMatch.ServerNames = trafficMatch.ServerNames
 
-		marshalledDownstreamTLSContext, err := anypb.New(envoy.GetDownstreamTLSContext(lb.serviceIdentity, !trafficMatch.SkipClientCertValidation, sidecarSpec))
+		marshalledDownstreamTLSContext, err := protobuf.MarshalAny(envoy.GetDownstreamTLSContext(lb.serviceIdentity, !trafficMatch.SkipClientCertValidation, sidecarSpec))
 		if err != nil {
 			return nil, fmt.Errorf("Error marshalling DownstreamTLSContext in ingress filter chain for proxy with identity %s", lb.serviceIdentity)
 		}
@@ -84,6 +84,8 @@ func TestGetIngressFilterChains(t *testing.T) {
 			mockCatalog.EXPECT().GetIngressTrafficPolicy(testSvc).Return(tc.ingressPolicy, nil)
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("test").AnyTimes()
+			mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 			mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 				Enable: false,
 			}).AnyTimes()
@@ -175,6 +177,8 @@ func TestGetIngressFilterChainFromTrafficMatch(t *testing.T) {
 
 			mockConfigurator.EXPECT().IsTracingEnabled().Return(false)
 			mockConfigurator.EXPECT().GetTracingEndpoint().Return("test")
+			mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1))
+			mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin)
 			mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 				Enable: false,
 			})
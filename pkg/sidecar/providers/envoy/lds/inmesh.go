@@ -12,13 +12,13 @@ import (
 	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
 
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
 	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rds/route"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -52,6 +52,10 @@ func (lb *listenerBuilder) getInboundMeshFilterChains(trafficMatches []*trafficp
 				filterChains = append(filterChains, filterChainForPort)
 			}
 
+		case constants.ProtocolUDP:
+			// UDP traffic is not intercepted for Envoy sidecars
+			log.Trace().Msgf("Skipping inbound filter chain for UDP traffic match %s", match.Name)
+
 		default:
 			log.Error().Msgf("Cannot build inbound filter chain, unsupported protocol %s for traffic match %s", match.DestinationProtocol, match.Name)
 		}
@@ -67,7 +71,17 @@ func (lb *listenerBuilder) getInboundHTTPFilters(trafficMatch *trafficpolicy.Tra
 
 	var filters []*xds_listener.Filter
 
-	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must be the first filter in the list of filters.
+	// Deny the connections presenting revoked certificates, before any other filter processes them
+	revocationFilter, err := buildRevocationFilter(lb.cfg.GetRevokedCertificates())
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying revocation filter for traffic match %s", trafficMatch.Name)
+		return nil, err
+	}
+	if revocationFilter != nil {
+		filters = append(filters, revocationFilter)
+	}
+
+	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must precede the other filters in the list of filters.
 	if !lb.cfg.IsPermissiveTrafficPolicyMode() {
 		// Apply RBAC policies on the inbound filters based on configured policies
 		rbacFilter, err := lb.buildRBACFilter()
@@ -75,10 +89,16 @@ func (lb *listenerBuilder) getInboundHTTPFilters(trafficMatch *trafficpolicy.Tra
 			log.Error().Err(err).Msgf("Error applying RBAC filter for traffic match %s", trafficMatch.Name)
 			return nil, err
 		}
-		// RBAC filter should be the very first filter in the filter chain
+		// RBAC filter should only be preceded by the revocation filter in the filter chain
 		filters = append(filters, rbacFilter)
 	}
 
+	// AuthorizationPolicy policies are enforced on HTTP requests by HTTP RBAC filters
+	authorizationTrafficTargets, err := lb.getAuthorizationTrafficTargets()
+	if err != nil {
+		return nil, err
+	}
+
 	// Apply the network level local rate limit filter if configured for the TrafficMatch
 	if trafficMatch.RateLimit != nil && trafficMatch.RateLimit.Local != nil && trafficMatch.RateLimit.Local.TCP != nil {
 		rateLimitFilter, err := buildTCPLocalRateLimitFilter(trafficMatch.RateLimit.Local.TCP, trafficMatch.Name)
@@ -88,6 +108,20 @@ func (lb *listenerBuilder) getInboundHTTPFilters(trafficMatch *trafficpolicy.Tra
 		filters = append(filters, rateLimitFilter)
 	}
 
+	// Apply the network level global rate limit filter if configured for the TrafficMatch
+	if trafficMatch.RateLimit != nil && trafficMatch.RateLimit.Global != nil && trafficMatch.RateLimit.Global.TCP != nil {
+		rateLimitFilter, err := buildTCPGlobalRateLimitFilter(trafficMatch.RateLimit.Global.TCP, trafficMatch.Name)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, rateLimitFilter)
+	}
+
+	var httpGlobalRateLimit *policyv1alpha1.HTTPGlobalRateLimitSpec
+	if trafficMatch.RateLimit != nil && trafficMatch.RateLimit.Global != nil {
+		httpGlobalRateLimit = trafficMatch.RateLimit.Global.HTTP
+	}
+
 	// Build the HTTP Connection Manager filter from its options
 	inboundConnManager, err := httpConnManagerOptions{
 		direction:         inbound,
@@ -97,16 +131,30 @@ func (lb *listenerBuilder) getInboundHTTPFilters(trafficMatch *trafficpolicy.Tra
 		wasmStatsHeaders:         lb.getWASMStatsHeaders(),
 		extAuthConfig:            lb.getExtAuthConfig(),
 		enableActiveHealthChecks: lb.cfg.GetFeatureFlags().EnableSidecarActiveHealthChecks,
+		httpGlobalRateLimit:      httpGlobalRateLimit,
+		jwtAuthentication:        trafficMatch.JWTAuthentication,
+		grpc:                     strings.EqualFold(trafficMatch.DestinationProtocol, constants.ProtocolGRPC),
+
+		// Authorization options
+		authorizationTrafficTargets: authorizationTrafficTargets,
+		trustDomain:                 lb.trustDomain,
+		spiffeEnabled:               lb.spiffeEnabled,
 
 		// Tracing options
-		enableTracing:      lb.cfg.IsTracingEnabled(),
-		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+		enableTracing:          lb.cfg.IsTracingEnabled(),
+		tracingProtocol:        lb.cfg.GetTracingProtocol(),
+		tracingAPIEndpoint:     lb.cfg.GetTracingEndpoint(),
+		tracingSampledFraction: lb.cfg.GetTracingSampledFraction(),
+
+		// Telemetry options
+		telemetryAttributes: lb.telemetryAttributes,
+		accessLogs:          lb.inboundAccessLogs,
 	}.build()
 	if err != nil {
 		return nil, fmt.Errorf("Error building inbound HTTP connection manager for proxy with identity %s and traffic match %s: %w", lb.serviceIdentity, trafficMatch.Name, err)
 	}
 
-	marshalledInboundConnManager, err := anypb.New(inboundConnManager)
+	marshalledInboundConnManager, err := protobuf.MarshalAny(inboundConnManager)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshalling inbound HTTP connection manager for proxy with identity %s and traffic match %s: %w", lb.serviceIdentity, trafficMatch.Name, err)
 	}
@@ -134,7 +182,7 @@ func (lb *listenerBuilder) getInboundMeshHTTPFilterChain(trafficMatch *trafficpo
 	}
 
 	// Construct downstream TLS context
-	marshalledDownstreamTLSContext, err := anypb.New(envoy.GetDownstreamTLSContext(lb.serviceIdentity, true /* mTLS */, lb.cfg.GetMeshConfig().Spec.Sidecar))
+	marshalledDownstreamTLSContext, err := protobuf.MarshalAny(envoy.GetDownstreamTLSContext(lb.serviceIdentity, true /* mTLS */, lb.cfg.GetMeshConfig().Spec.Sidecar))
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling DownstreamTLSContext for traffic match %s", trafficMatch.Name)
@@ -187,7 +235,7 @@ func (lb *listenerBuilder) getInboundMeshTCPFilterChain(trafficMatch *trafficpol
 	}
 
 	// Construct downstream TLS context
-	marshalledDownstreamTLSContext, err := anypb.New(envoy.GetDownstreamTLSContext(lb.serviceIdentity, true /* mTLS */, lb.cfg.GetMeshConfig().Spec.Sidecar))
+	marshalledDownstreamTLSContext, err := protobuf.MarshalAny(envoy.GetDownstreamTLSContext(lb.serviceIdentity, true /* mTLS */, lb.cfg.GetMeshConfig().Spec.Sidecar))
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling DownstreamTLSContext for traffic match %s", trafficMatch.Name)
@@ -229,7 +277,17 @@ func (lb *listenerBuilder) getInboundTCPFilters(trafficMatch *trafficpolicy.Traf
 
 	var filters []*xds_listener.Filter
 
-	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must be the first filter in the list of filters.
+	// Deny the connections presenting revoked certificates, before any other filter processes them
+	revocationFilter, err := buildRevocationFilter(lb.cfg.GetRevokedCertificates())
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying revocation filter for traffic match %s", trafficMatch.Name)
+		return nil, err
+	}
+	if revocationFilter != nil {
+		filters = append(filters, revocationFilter)
+	}
+
+	// Apply an RBAC filter when permissive mode is disabled. The RBAC filter must precede the other filters in the list of filters.
 	if !lb.cfg.IsPermissiveTrafficPolicyMode() {
 		// Apply RBAC policies on the inbound filters based on configured policies
 		rbacFilter, err := lb.buildRBACFilter()
@@ -237,10 +295,22 @@ func (lb *listenerBuilder) getInboundTCPFilters(trafficMatch *trafficpolicy.Traf
 			log.Error().Err(err).Msgf("Error applying RBAC filter for traffic match %s", trafficMatch.Name)
 			return nil, err
 		}
-		// RBAC filter should be the very first filter in the filter chain
+		// RBAC filter should only be preceded by the revocation filter in the filter chain
 		filters = append(filters, rbacFilter)
 	}
 
+	// Apply the RBAC filters of the AuthorizationPolicy policies
+	authorizationTrafficTargets, err := lb.getAuthorizationTrafficTargets()
+	if err != nil {
+		return nil, err
+	}
+	authorizationFilters, err := buildAuthorizationNetworkFilters(authorizationTrafficTargets, lb.trustDomain, lb.spiffeEnabled)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error applying authorization RBAC filters for traffic match %s", trafficMatch.Name)
+		return nil, err
+	}
+	filters = append(filters, authorizationFilters...)
+
 	// Apply the network level local rate limit filter if configured for the TrafficMatch
 	if trafficMatch.RateLimit != nil && trafficMatch.RateLimit.Local != nil && trafficMatch.RateLimit.Local.TCP != nil {
 		rateLimitFilter, err := buildTCPLocalRateLimitFilter(trafficMatch.RateLimit.Local.TCP, trafficMatch.Name)
@@ -250,12 +320,22 @@ func (lb *listenerBuilder) getInboundTCPFilters(trafficMatch *trafficpolicy.Traf
 		filters = append(filters, rateLimitFilter)
 	}
 
+	// Apply the network level global rate limit filter if configured for the TrafficMatch
+	if trafficMatch.RateLimit != nil && trafficMatch.RateLimit.Global != nil && trafficMatch.RateLimit.Global.TCP != nil {
+		rateLimitFilter, err := buildTCPGlobalRateLimitFilter(trafficMatch.RateLimit.Global.TCP, trafficMatch.Name)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, rateLimitFilter)
+	}
+
 	// Apply the TCP Proxy Filter
 	tcpProxy := &xds_tcp_proxy.TcpProxy{
 		StatPrefix:       fmt.Sprintf("%s.%s", inboundMeshTCPProxyStatPrefix, trafficMatch.Cluster),
 		ClusterSpecifier: &xds_tcp_proxy.TcpProxy_Cluster{Cluster: trafficMatch.Cluster},
+		AccessLog:        lb.inboundAccessLogs,
 	}
-	marshalledTCPProxy, err := anypb.New(tcpProxy)
+	marshalledTCPProxy, err := protobuf.MarshalAny(tcpProxy)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling TcpProxy object for egress HTTPS filter chain")
@@ -296,7 +376,7 @@ func buildTCPLocalRateLimitFilter(config *policyv1alpha1.TCPLocalRateLimitSpec,
 		},
 	}
 
-	marshalledConfig, err := anypb.New(rateLimit)
+	marshalledConfig, err := protobuf.MarshalAny(rateLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -310,7 +390,7 @@ func buildTCPLocalRateLimitFilter(config *policyv1alpha1.TCPLocalRateLimitSpec,
 }
 
 // getOutboundHTTPFilter returns an HTTP connection manager network filter used to filter outbound HTTP traffic for the given route configuration
-func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string) (*xds_listener.Filter, error) {
+func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string, grpc bool) (*xds_listener.Filter, error) {
 	var marshalledFilter *any.Any
 	var err error
 
@@ -320,18 +400,26 @@ func (lb *listenerBuilder) getOutboundHTTPFilter(routeConfigName string) (*xds_l
 		rdsRoutConfigName: routeConfigName,
 
 		// Additional filters
-		wasmStatsHeaders: lb.statsHeaders,
-		extAuthConfig:    nil, // Ext auth is not configured for outbound connections
+		wasmStatsHeaders:     lb.statsHeaders,
+		extAuthConfig:        nil, // Ext auth is not configured for outbound connections
+		enableFaultInjection: lb.cfg.GetFeatureFlags().EnableFaultInjectionPolicy,
+		grpc:                 grpc,
 
 		// Tracing options
-		enableTracing:      lb.cfg.IsTracingEnabled(),
-		tracingAPIEndpoint: lb.cfg.GetTracingEndpoint(),
+		enableTracing:          lb.cfg.IsTracingEnabled(),
+		tracingProtocol:        lb.cfg.GetTracingProtocol(),
+		tracingAPIEndpoint:     lb.cfg.GetTracingEndpoint(),
+		tracingSampledFraction: lb.cfg.GetTracingSampledFraction(),
+
+		// Telemetry options
+		telemetryAttributes: lb.telemetryAttributes,
+		accessLogs:          lb.outboundAccessLogs,
 	}.build()
 	if err != nil {
 		return nil, fmt.Errorf("Error building outbound HTTP connection manager for proxy identity %s", lb.serviceIdentity)
 	}
 
-	marshalledFilter, err = anypb.New(outboundConnManager)
+	marshalledFilter, err = protobuf.MarshalAny(outboundConnManager)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshalling outbound HTTP connection manager for proxy identity %s", lb.serviceIdentity)
 	}
@@ -371,7 +459,8 @@ func (lb *listenerBuilder) getOutboundFilterChainMatchForService(trafficMatch tr
 
 func (lb *listenerBuilder) getOutboundHTTPFilterChainForService(trafficMatch trafficpolicy.TrafficMatch) (*xds_listener.FilterChain, error) {
 	// Get HTTP filter for service
-	filter, err := lb.getOutboundHTTPFilter(route.GetOutboundMeshRouteConfigNameForPort(trafficMatch.DestinationPort))
+	filter, err := lb.getOutboundHTTPFilter(route.GetOutboundMeshRouteConfigNameForPort(trafficMatch.DestinationPort),
+		strings.EqualFold(trafficMatch.DestinationProtocol, constants.ProtocolGRPC))
 	if err != nil {
 		log.Error().Err(err).Msgf("Error getting HTTP filter for traffic match %s", trafficMatch.Name)
 		return nil, err
@@ -416,6 +505,7 @@ func (lb *listenerBuilder) getOutboundTCPFilterChainForService(trafficMatch traf
 func (lb *listenerBuilder) getOutboundTCPFilter(trafficMatch trafficpolicy.TrafficMatch) (*xds_listener.Filter, error) {
 	tcpProxy := &xds_tcp_proxy.TcpProxy{
 		StatPrefix: fmt.Sprintf("%s_%s", outboundMeshTCPProxyStatPrefix, trafficMatch.Name),
+		AccessLog:  lb.outboundAccessLogs,
 	}
 
 	if len(trafficMatch.WeightedClusters) == 0 {
@@ -439,7 +529,7 @@ func (lb *listenerBuilder) getOutboundTCPFilter(trafficMatch trafficpolicy.Traff
 		}
 	}
 
-	marshalledTCPProxy, err := anypb.New(tcpProxy)
+	marshalledTCPProxy, err := protobuf.MarshalAny(tcpProxy)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling TcpProxy object needed by outbound TCP filter for traffic match %s", trafficMatch.Name)
@@ -482,6 +572,10 @@ func (lb *listenerBuilder) getOutboundFilterChainPerUpstream() []*xds_listener.F
 				filterChains = append(filterChains, tcpFilterChain)
 			}
 
+		case constants.ProtocolUDP:
+			// UDP traffic is not intercepted for Envoy sidecars
+			log.Trace().Msgf("Skipping outbound filter chain for UDP traffic match %s", trafficMatch.Name)
+
 		default:
 			log.Error().Msgf("Cannot build outbound filter chain, unsupported protocol %s for traffic match %s", trafficMatch.DestinationProtocol, trafficMatch.Name)
 		}
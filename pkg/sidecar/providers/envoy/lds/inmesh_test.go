@@ -34,6 +34,8 @@ func TestGetOutboundHTTPFilterChainForService(t *testing.T) {
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
+	mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+	mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
 	}).AnyTimes()
@@ -211,6 +213,8 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
+	mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+	mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
 	}).AnyTimes()
@@ -226,9 +230,10 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name           string
-		permissiveMode bool
-		trafficMatch   *trafficpolicy.TrafficMatch
+		name                string
+		permissiveMode      bool
+		revokedCertificates []configv1alpha2.RevokedCertificate
+		trafficMatch        *trafficpolicy.TrafficMatch
 
 		expectedFilterChainMatch *xds_listener.FilterChainMatch
 		expectedFilterNames      []string
@@ -252,6 +257,25 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 			expectedFilterNames: []string{envoy.L4RBACFilterName, envoy.HTTPConnectionManagerFilterName},
 			expectError:         false,
 		},
+		{
+			name:                "inbound HTTP filter chain with revoked certificates",
+			permissiveMode:      false,
+			revokedCertificates: []configv1alpha2.RevokedCertificate{{SerialNumber: "1234", Fingerprint: "abcd"}},
+			trafficMatch: &trafficpolicy.TrafficMatch{
+				Name:                "inbound_ns1/svc1_80_http",
+				DestinationPort:     80,
+				DestinationProtocol: "http",
+				ServerNames:         []string{"svc1.ns1.svc.cluster.local"},
+			},
+			expectedFilterChainMatch: &xds_listener.FilterChainMatch{
+				DestinationPort:      &wrapperspb.UInt32Value{Value: 80},
+				ServerNames:          []string{"svc1.ns1.svc.cluster.local"},
+				TransportProtocol:    "tls",
+				ApplicationProtocols: []string{"osm"},
+			},
+			expectedFilterNames: []string{envoy.L4RevocationFilterName, envoy.L4RBACFilterName, envoy.HTTPConnectionManagerFilterName},
+			expectError:         false,
+		},
 		{
 			name:           "inbound HTTP filter chain with permissive mode enabled",
 			permissiveMode: true,
@@ -314,6 +338,7 @@ func TestGetInboundMeshHTTPFilterChain(t *testing.T) {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
 			assert := tassert.New(t)
 
+			mockConfigurator.EXPECT().GetRevokedCertificates().Return(tc.revokedCertificates).Times(1)
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
 			if !tc.permissiveMode {
 				// mock catalog calls used to build the RBAC filter
@@ -342,9 +367,12 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 	// Mock calls used to build the HTTP connection manager
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("test-api").AnyTimes()
+	mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+	mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
 	}).AnyTimes()
+	mockConfigurator.EXPECT().GetFeatureFlags().Return(configv1alpha2.FeatureFlags{}).AnyTimes()
 	mockConfigurator.EXPECT().GetMeshConfig().AnyTimes()
 
 	lb := &listenerBuilder{
@@ -354,9 +382,10 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name           string
-		permissiveMode bool
-		trafficMatch   *trafficpolicy.TrafficMatch
+		name                string
+		permissiveMode      bool
+		revokedCertificates []configv1alpha2.RevokedCertificate
+		trafficMatch        *trafficpolicy.TrafficMatch
 
 		expectedFilterChainMatch *xds_listener.FilterChainMatch
 		expectedFilterNames      []string
@@ -380,6 +409,25 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 			expectedFilterNames: []string{envoy.L4RBACFilterName, envoy.TCPProxyFilterName},
 			expectError:         false,
 		},
+		{
+			name:                "inbound TCP filter chain with revoked certificates",
+			permissiveMode:      false,
+			revokedCertificates: []configv1alpha2.RevokedCertificate{{SerialNumber: "1234", Fingerprint: "abcd"}},
+			trafficMatch: &trafficpolicy.TrafficMatch{
+				Name:                "inbound_ns1/svc1_80_tcp",
+				DestinationPort:     80,
+				DestinationProtocol: "tcp",
+				ServerNames:         []string{"svc1.ns1.svc.cluster.local"},
+			},
+			expectedFilterChainMatch: &xds_listener.FilterChainMatch{
+				DestinationPort:      &wrapperspb.UInt32Value{Value: 80},
+				ServerNames:          []string{"svc1.ns1.svc.cluster.local"},
+				TransportProtocol:    "tls",
+				ApplicationProtocols: []string{"osm"},
+			},
+			expectedFilterNames: []string{envoy.L4RevocationFilterName, envoy.L4RBACFilterName, envoy.TCPProxyFilterName},
+			expectError:         false,
+		},
 		{
 			name:           "inbound TCP filter chain with permissive mode enabled",
 			permissiveMode: true,
@@ -442,6 +490,7 @@ func TestGetInboundMeshTCPFilterChain(t *testing.T) {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
 			assert := tassert.New(t)
 
+			mockConfigurator.EXPECT().GetRevokedCertificates().Return(tc.revokedCertificates).Times(1)
 			mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(tc.permissiveMode).Times(1)
 			if !tc.permissiveMode {
 				// mock catalog calls used to build the RBAC filter
@@ -467,7 +516,7 @@ func TestGetOutboundFilterChainMatchForService(t *testing.T) {
 	mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
 	mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
 
-	lb := newListenerBuilder(mockCatalog, tests.BookbuyerServiceIdentity, mockConfigurator, nil, "cluster.local")
+	lb := newListenerBuilder(mockCatalog, tests.BookbuyerServiceIdentity, mockConfigurator, nil, "cluster.local", nil)
 
 	testCases := []struct {
 		name                     string
@@ -642,7 +691,7 @@ func TestGetOutboundTCPFilter(t *testing.T) {
 			mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
 			mockConfigurator := configurator.NewMockConfigurator(mockCtrl)
 
-			lb := newListenerBuilder(mockCatalog, tests.BookbuyerServiceIdentity, mockConfigurator, nil, "cluster.local")
+			lb := newListenerBuilder(mockCatalog, tests.BookbuyerServiceIdentity, mockConfigurator, nil, "cluster.local", nil)
 			filter, err := lb.getOutboundTCPFilter(tc.trafficMatch)
 
 			assert := tassert.New(t)
@@ -672,6 +721,8 @@ func TestGetOutboundHTTPFilter(t *testing.T) {
 
 	mockConfigurator.EXPECT().IsTracingEnabled()
 	mockConfigurator.EXPECT().GetTracingEndpoint()
+	mockConfigurator.EXPECT().GetTracingSampledFraction()
+	mockConfigurator.EXPECT().GetTracingProtocol()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
 	}).AnyTimes()
@@ -679,7 +730,7 @@ func TestGetOutboundHTTPFilter(t *testing.T) {
 		EnableWASMStats: false,
 	}).AnyTimes()
 
-	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName)
+	filter, err := lb.getOutboundHTTPFilter(route.OutboundRouteConfigName, false)
 	assert.NoError(err)
 	assert.Equal(filter.Name, envoy.HTTPConnectionManagerFilterName)
 }
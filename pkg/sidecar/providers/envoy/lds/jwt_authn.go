@@ -0,0 +1,272 @@
+package lds
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_jwt_authn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	xds_lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// jwtPayloadMetadataKey is the key the jwt_authn filter stores the payload of a verified token under
+	jwtPayloadMetadataKey = "jwt_payload"
+
+	// jwtClaimToHeadersFilterName is the name of the Lua filter copying the claims of a verified token to request headers
+	jwtClaimToHeadersFilterName = "http_jwt_claim_to_headers"
+
+	jwksFetchTimeout  = 5 * time.Second
+	jwksCacheDuration = 5 * time.Minute
+)
+
+// getJWTAuthnHTTPFilters returns the HTTP filters authenticating the requests with the given JWT providers,
+// and authorizing the authenticated requests with the given claim based rules
+func getJWTAuthnHTTPFilters(jwtAuthn *trafficpolicy.JWTAuthentication) ([]*xds_hcm.HttpFilter, error) {
+	if len(jwtAuthn.Providers) == 0 {
+		// None of the JWT providers could be resolved, deny all the requests
+		denyAll, err := getJWTRBACHTTPFilter(&xds_rbac.RBAC{Action: xds_rbac.RBAC_ALLOW})
+		if err != nil {
+			return nil, err
+		}
+		return []*xds_hcm.HttpFilter{denyAll}, nil
+	}
+
+	jwtAuthnConfig := &xds_jwt_authn.JwtAuthentication{
+		Providers: make(map[string]*xds_jwt_authn.JwtProvider),
+	}
+	var requirements []*xds_jwt_authn.JwtRequirement
+	for _, provider := range jwtAuthn.Providers {
+		jwtProvider, err := getJWTProvider(provider)
+		if err != nil {
+			return nil, err
+		}
+		jwtAuthnConfig.Providers[provider.Name] = jwtProvider
+		requirements = append(requirements, &xds_jwt_authn.JwtRequirement{
+			RequiresType: &xds_jwt_authn.JwtRequirement_ProviderName{ProviderName: provider.Name},
+		})
+	}
+
+	// A request is authenticated when its token is verified by any of the providers
+	requirement := requirements[0]
+	if len(requirements) > 1 {
+		requirement = &xds_jwt_authn.JwtRequirement{
+			RequiresType: &xds_jwt_authn.JwtRequirement_RequiresAny{
+				RequiresAny: &xds_jwt_authn.JwtRequirementOrList{Requirements: requirements},
+			},
+		}
+	}
+	jwtAuthnConfig.Rules = []*xds_jwt_authn.RequirementRule{
+		{
+			Match: &xds_route.RouteMatch{
+				PathSpecifier: &xds_route.RouteMatch_Prefix{Prefix: "/"},
+			},
+			RequirementType: &xds_jwt_authn.RequirementRule_Requires{Requires: requirement},
+		},
+	}
+
+	jwtAuthnFilter, err := getHTTPFilter(envoy.HTTPJWTAuthnFilterName, jwtAuthnConfig)
+	if err != nil {
+		return nil, err
+	}
+	filters := []*xds_hcm.HttpFilter{jwtAuthnFilter}
+
+	if len(jwtAuthn.Rules) > 0 {
+		rbacFilter, err := getJWTRBACHTTPFilter(getJWTClaimsRBAC(jwtAuthn.Rules))
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, rbacFilter)
+	}
+
+	claimToHeadersFilter, err := getJWTClaimToHeadersHTTPFilter(jwtAuthn.Providers)
+	if err != nil {
+		return nil, err
+	}
+	if claimToHeadersFilter != nil {
+		filters = append(filters, claimToHeadersFilter)
+	}
+
+	return filters, nil
+}
+
+// getJWTProvider returns the jwt_authn provider for the given JWT provider
+func getJWTProvider(provider *trafficpolicy.JWTProvider) (*xds_jwt_authn.JwtProvider, error) {
+	jwtProvider := &xds_jwt_authn.JwtProvider{
+		Issuer:            provider.Issuer,
+		Audiences:         provider.Audiences,
+		Forward:           provider.ForwardOriginalToken,
+		PayloadInMetadata: jwtPayloadMetadataKey,
+	}
+
+	if provider.LocalJWKS != "" {
+		jwtProvider.JwksSourceSpecifier = &xds_jwt_authn.JwtProvider_LocalJwks{
+			LocalJwks: &xds_core.DataSource{
+				Specifier: &xds_core.DataSource_InlineString{InlineString: provider.LocalJWKS},
+			},
+		}
+		return jwtProvider, nil
+	}
+
+	jwksCluster, err := envoy.GetJWKSCluster(provider.RemoteJWKSURI)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting JWKS cluster for JWT provider %s: %w", provider.Name, err)
+	}
+	jwtProvider.JwksSourceSpecifier = &xds_jwt_authn.JwtProvider_RemoteJwks{
+		RemoteJwks: &xds_jwt_authn.RemoteJwks{
+			HttpUri: &xds_core.HttpUri{
+				Uri:              provider.RemoteJWKSURI,
+				HttpUpstreamType: &xds_core.HttpUri_Cluster{Cluster: jwksCluster.Name},
+				Timeout:          durationpb.New(jwksFetchTimeout),
+			},
+			CacheDuration: durationpb.New(jwksCacheDuration),
+		},
+	}
+	return jwtProvider, nil
+}
+
+// getJWTClaimsRBAC returns the RBAC rules allowing the requests whose token matches any of the given rules
+func getJWTClaimsRBAC(rules []policyv1alpha1.JWTAuthorizationRuleSpec) *xds_rbac.RBAC {
+	rbac := &xds_rbac.RBAC{
+		Action:   xds_rbac.RBAC_ALLOW,
+		Policies: make(map[string]*xds_rbac.Policy),
+	}
+
+	for idx, rule := range rules {
+		var claimPrincipals []*xds_rbac.Principal
+		for _, claim := range rule.Claims {
+			var valuePrincipals []*xds_rbac.Principal
+			for _, value := range claim.Values {
+				stringMatcher := &xds_matcher.ValueMatcher{
+					MatchPattern: &xds_matcher.ValueMatcher_StringMatch{
+						StringMatch: &xds_matcher.StringMatcher{
+							MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: value},
+						},
+					},
+				}
+				// The claim matches if it is equal to the value, or if it is a list containing the value
+				valuePrincipals = append(valuePrincipals,
+					getJWTClaimPrincipal(claim.Name, stringMatcher),
+					getJWTClaimPrincipal(claim.Name, &xds_matcher.ValueMatcher{
+						MatchPattern: &xds_matcher.ValueMatcher_ListMatch{
+							ListMatch: &xds_matcher.ListMatcher{
+								MatchPattern: &xds_matcher.ListMatcher_OneOf{OneOf: stringMatcher},
+							},
+						},
+					}))
+			}
+			claimPrincipals = append(claimPrincipals, &xds_rbac.Principal{
+				Identifier: &xds_rbac.Principal_OrIds{OrIds: &xds_rbac.Principal_Set{Ids: valuePrincipals}},
+			})
+		}
+
+		rbac.Policies[fmt.Sprintf("jwt-claims-%d", idx)] = &xds_rbac.Policy{
+			Permissions: []*xds_rbac.Permission{
+				{Rule: &xds_rbac.Permission_Any{Any: true}},
+			},
+			Principals: []*xds_rbac.Principal{
+				{Identifier: &xds_rbac.Principal_AndIds{AndIds: &xds_rbac.Principal_Set{Ids: claimPrincipals}}},
+			},
+		}
+	}
+
+	return rbac
+}
+
+// getJWTClaimPrincipal returns an RBAC principal matching the given claim of a verified token
+func getJWTClaimPrincipal(claim string, valueMatcher *xds_matcher.ValueMatcher) *xds_rbac.Principal {
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_Metadata{
+			Metadata: &xds_matcher.MetadataMatcher{
+				Filter: envoy.HTTPJWTAuthnFilterName,
+				Path: []*xds_matcher.MetadataMatcher_PathSegment{
+					{Segment: &xds_matcher.MetadataMatcher_PathSegment_Key{Key: jwtPayloadMetadataKey}},
+					{Segment: &xds_matcher.MetadataMatcher_PathSegment_Key{Key: claim}},
+				},
+				Value: valueMatcher,
+			},
+		},
+	}
+}
+
+// getJWTRBACHTTPFilter returns the HTTP RBAC filter for the given claim based rules.
+// The filter uses a name distinct from the per route RBAC filter so that it is not
+// overridden by the RBAC config of the routes.
+func getJWTRBACHTTPFilter(rbac *xds_rbac.RBAC) (*xds_hcm.HttpFilter, error) {
+	return getHTTPFilter(envoy.HTTPJWTRBACFilterName, &xds_http_rbac.RBAC{Rules: rbac})
+}
+
+// getJWTClaimToHeadersHTTPFilter returns the Lua filter copying the claims of a verified token
+// to request headers, or nil if none of the providers copies claims to headers
+func getJWTClaimToHeadersHTTPFilter(providers []*trafficpolicy.JWTProvider) (*xds_hcm.HttpFilter, error) {
+	headers := make(map[string]bool)
+	code := &strings.Builder{}
+	for _, provider := range providers {
+		if len(provider.OutputClaimToHeaders) == 0 {
+			continue
+		}
+		code.WriteString(fmt.Sprintf("  if payload[\"iss\"] == %q then\n", provider.Issuer))
+		for _, claimToHeader := range provider.OutputClaimToHeaders {
+			headers[claimToHeader.Header] = true
+			code.WriteString(fmt.Sprintf("    copy(request_handle, payload[%q], %q)\n", claimToHeader.Claim, claimToHeader.Header))
+		}
+		code.WriteString("  end\n")
+	}
+	if len(headers) == 0 {
+		return nil, nil
+	}
+
+	// The headers set by the downstream are removed so that they cannot be spoofed
+	var sortedHeaders []string
+	for header := range headers {
+		sortedHeaders = append(sortedHeaders, header)
+	}
+	sort.Strings(sortedHeaders)
+	luaCode := &strings.Builder{}
+	luaCode.WriteString("--\nfunction copy(request_handle, value, header)\n")
+	luaCode.WriteString("  if value ~= nil and type(value) ~= \"table\" then\n")
+	luaCode.WriteString("    request_handle:headers():replace(header, tostring(value))\n")
+	luaCode.WriteString("  end\nend\n")
+	luaCode.WriteString("function envoy_on_request(request_handle)\n")
+	for _, header := range sortedHeaders {
+		luaCode.WriteString(fmt.Sprintf("  request_handle:headers():remove(%q)\n", header))
+	}
+	luaCode.WriteString(fmt.Sprintf("  local metadata = request_handle:streamInfo():dynamicMetadata():get(%q)\n", envoy.HTTPJWTAuthnFilterName))
+	luaCode.WriteString("  if metadata == nil then\n    return\n  end\n")
+	luaCode.WriteString(fmt.Sprintf("  local payload = metadata[%q]\n", jwtPayloadMetadataKey))
+	luaCode.WriteString("  if payload == nil then\n    return\n  end\n")
+	luaCode.WriteString(code.String())
+	luaCode.WriteString("end")
+
+	return getHTTPFilter(jwtClaimToHeadersFilterName, &xds_lua.Lua{InlineCode: luaCode.String()})
+}
+
+// getHTTPFilter returns an HTTP filter with the given name and typed config
+func getHTTPFilter(name string, config proto.Message) (*xds_hcm.HttpFilter, error) {
+	marshalledConfig, err := protobuf.MarshalAny(config)
+	if err != nil {
+		return nil, fmt.Errorf("Error marshalling %s filter config: %w", name, err)
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: name,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledConfig,
+		},
+	}, nil
+}
@@ -0,0 +1,146 @@
+package lds
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_jwt_authn "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/jwt_authn/v3"
+	xds_lua "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// testJWKS is a local stand-in for the JSON Web Key Set of an issuer
+const testJWKS = `{"keys":[{"kty":"oct","kid":"test","alg":"HS256","k":"c2VjcmV0"}]}`
+
+func TestGetJWTAuthnHTTPFilters(t *testing.T) {
+	localProvider := &trafficpolicy.JWTProvider{
+		Name:      "ns/authn/0",
+		Issuer:    "https://local.example.com",
+		Audiences: []string{"s1"},
+		LocalJWKS: testJWKS,
+		OutputClaimToHeaders: []policyv1alpha1.ClaimToHeaderSpec{
+			{Claim: "sub", Header: "x-jwt-sub"},
+		},
+	}
+	remoteProvider := &trafficpolicy.JWTProvider{
+		Name:                 "ns/authn/1",
+		Issuer:               "https://remote.example.com",
+		RemoteJWKSURI:        "https://remote.example.com/.well-known/jwks.json",
+		ForwardOriginalToken: true,
+	}
+
+	testCases := []struct {
+		name                string
+		jwtAuthn            *trafficpolicy.JWTAuthentication
+		expectedFilterNames []string
+		expectErr           bool
+		assertFunc          func(*tassert.Assertions, []*xds_hcm.HttpFilter)
+	}{
+		{
+			name:                "no provider denies all requests",
+			jwtAuthn:            &trafficpolicy.JWTAuthentication{},
+			expectedFilterNames: []string{envoy.HTTPJWTRBACFilterName},
+			assertFunc: func(a *tassert.Assertions, filters []*xds_hcm.HttpFilter) {
+				rbac := &xds_http_rbac.RBAC{}
+				a.Nil(filters[0].GetTypedConfig().UnmarshalTo(rbac))
+				a.Equal(xds_rbac.RBAC_ALLOW, rbac.Rules.Action)
+				a.Empty(rbac.Rules.Policies)
+			},
+		},
+		{
+			name: "local and remote JWKS providers",
+			jwtAuthn: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{localProvider, remoteProvider},
+			},
+			expectedFilterNames: []string{envoy.HTTPJWTAuthnFilterName, jwtClaimToHeadersFilterName},
+			assertFunc: func(a *tassert.Assertions, filters []*xds_hcm.HttpFilter) {
+				jwtAuthn := &xds_jwt_authn.JwtAuthentication{}
+				a.Nil(filters[0].GetTypedConfig().UnmarshalTo(jwtAuthn))
+				a.Len(jwtAuthn.Providers, 2)
+
+				local := jwtAuthn.Providers["ns/authn/0"]
+				a.Equal("https://local.example.com", local.Issuer)
+				a.Equal([]string{"s1"}, local.Audiences)
+				a.Equal(testJWKS, local.GetLocalJwks().GetInlineString())
+				a.Equal(jwtPayloadMetadataKey, local.PayloadInMetadata)
+
+				remote := jwtAuthn.Providers["ns/authn/1"]
+				a.True(remote.Forward)
+				a.Equal("https://remote.example.com/.well-known/jwks.json", remote.GetRemoteJwks().HttpUri.Uri)
+				a.Equal("jwks|remote.example.com:443", remote.GetRemoteJwks().HttpUri.GetCluster())
+
+				a.Len(jwtAuthn.Rules, 1)
+				a.Len(jwtAuthn.Rules[0].GetRequires().GetRequiresAny().Requirements, 2)
+
+				lua := &xds_lua.Lua{}
+				a.Nil(filters[1].GetTypedConfig().UnmarshalTo(lua))
+				a.Contains(lua.InlineCode, `request_handle:headers():remove("x-jwt-sub")`)
+				a.Contains(lua.InlineCode, `if payload["iss"] == "https://local.example.com" then`)
+				a.Contains(lua.InlineCode, `copy(request_handle, payload["sub"], "x-jwt-sub")`)
+			},
+		},
+		{
+			name: "claim based authorization rules",
+			jwtAuthn: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{remoteProvider},
+				Rules: []policyv1alpha1.JWTAuthorizationRuleSpec{
+					{Claims: []policyv1alpha1.JWTClaimMatchSpec{
+						{Name: "groups", Values: []string{"admin", "dev"}},
+						{Name: "scope", Values: []string{"read"}},
+					}},
+				},
+			},
+			expectedFilterNames: []string{envoy.HTTPJWTAuthnFilterName, envoy.HTTPJWTRBACFilterName},
+			assertFunc: func(a *tassert.Assertions, filters []*xds_hcm.HttpFilter) {
+				jwtAuthn := &xds_jwt_authn.JwtAuthentication{}
+				a.Nil(filters[0].GetTypedConfig().UnmarshalTo(jwtAuthn))
+				a.Equal("ns/authn/1", jwtAuthn.Rules[0].GetRequires().GetProviderName())
+
+				rbac := &xds_http_rbac.RBAC{}
+				a.Nil(filters[1].GetTypedConfig().UnmarshalTo(rbac))
+				a.Len(rbac.Rules.Policies, 1)
+				claims := rbac.Rules.Policies["jwt-claims-0"].Principals[0].GetAndIds().Ids
+				a.Len(claims, 2)
+				// Each value is matched as a string or as an item of a list
+				a.Len(claims[0].GetOrIds().Ids, 4)
+				a.Len(claims[1].GetOrIds().Ids, 2)
+				metadata := claims[1].GetOrIds().Ids[0].GetMetadata()
+				a.Equal(envoy.HTTPJWTAuthnFilterName, metadata.Filter)
+				a.Equal("scope", metadata.Path[1].GetKey())
+				a.Equal("read", metadata.Value.GetStringMatch().GetExact())
+			},
+		},
+		{
+			name: "invalid remote JWKS URI",
+			jwtAuthn: &trafficpolicy.JWTAuthentication{
+				Providers: []*trafficpolicy.JWTProvider{{Name: "ns/authn/0", Issuer: "issuer", RemoteJWKSURI: "file:///jwks.json"}},
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			filters, err := getJWTAuthnHTTPFilters(tc.jwtAuthn)
+			a.Equal(tc.expectErr, err != nil)
+			if tc.expectErr {
+				return
+			}
+
+			var filterNames []string
+			for _, filter := range filters {
+				filterNames = append(filterNames, filter.Name)
+			}
+			a.Equal(tc.expectedFilterNames, filterNames)
+			tc.assertFunc(a, filters)
+		})
+	}
+}
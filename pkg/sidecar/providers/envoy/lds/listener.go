@@ -4,16 +4,18 @@ import (
 	"fmt"
 
 	mapset "github.com/deckarep/golang-set"
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	xds_tcp_proxy "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/tcp_proxy/v3"
 	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	"github.com/golang/protobuf/ptypes/any"
-	"google.golang.org/protobuf/types/known/anypb"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -35,7 +37,7 @@ func (lb *listenerBuilder) newOutboundListener() (*xds_listener.Listener, error)
 
 	listener := &xds_listener.Listener{
 		Name:             OutboundListenerName,
-		Address:          envoy.GetAddress(constants.WildcardIPAddr, constants.SidecarOutboundListenerPort),
+		Address:          lb.getListenerAddress(constants.SidecarOutboundListenerPort),
 		TrafficDirection: xds_core.TrafficDirection_OUTBOUND,
 		FilterChains:     serviceFilterChains,
 		ListenerFilters: []*xds_listener.ListenerFilter{
@@ -50,7 +52,7 @@ func (lb *listenerBuilder) newOutboundListener() (*xds_listener.Listener, error)
 				},
 			},
 		},
-		AccessLog: envoy.GetAccessLog(),
+		AccessLog: lb.outboundAccessLogs,
 	}
 
 	// Create a default passthrough filter chain when global egress is enabled.
@@ -58,7 +60,7 @@ func (lb *listenerBuilder) newOutboundListener() (*xds_listener.Listener, error)
 	// mesh (SMI or permissive mode) or egress traffic policies. Traffic matching this default
 	// passthrough filter chain will be allowed to passthrough to its original destination.
 	if lb.cfg.IsEgressEnabled() {
-		egressFilterChain, err := getDefaultPassthroughFilterChain()
+		egressFilterChain, err := getDefaultPassthroughFilterChain(lb.outboundAccessLogs)
 		if err != nil {
 			log.Error().Err(err).Msgf("Error getting filter chain for Egress")
 			return nil, err
@@ -124,10 +126,10 @@ func (lb *listenerBuilder) newOutboundListener() (*xds_listener.Listener, error)
 	return listener, nil
 }
 
-func newInboundListener() *xds_listener.Listener {
+func (lb *listenerBuilder) newInboundListener() *xds_listener.Listener {
 	return &xds_listener.Listener{
 		Name:             InboundListenerName,
-		Address:          envoy.GetAddress(constants.WildcardIPAddr, constants.SidecarInboundListenerPort),
+		Address:          lb.getListenerAddress(constants.SidecarInboundListenerPort),
 		TrafficDirection: xds_core.TrafficDirection_INBOUND,
 		FilterChains:     []*xds_listener.FilterChain{},
 		ListenerFilters: []*xds_listener.ListenerFilter{
@@ -151,12 +153,40 @@ func newInboundListener() *xds_listener.Listener {
 				},
 			},
 		},
-		AccessLog: envoy.GetAccessLog(),
+		AccessLog: lb.inboundAccessLogs,
 	}
 }
 
+// getListenerAddress returns the address of a listener intercepting the traffic on the given port, which
+// accepts the IPv6 connections in addition to the IPv4 ones when the pod of the proxy has an IPv6 address
+func (lb *listenerBuilder) getListenerAddress(port uint32) *xds_core.Address {
+	if lb.dualStack {
+		return envoy.GetDualStackAddress(port)
+	}
+	return envoy.GetAddress(constants.WildcardIPAddr, port)
+}
+
+// getAccessLogs returns the access logs of the inbound and outbound traffic, written to stdout as configured by
+// the given spec and the given attributes identifying the proxy, and also exported to the OpenTelemetry collector
+// when the given OpenTelemetry access log is not nil
+func getAccessLogs(spec configv1alpha2.AccessLoggingSpec, attributes map[string]string, otelAccessLog *xds_accesslog_filter.AccessLog) (inboundAccessLogs, outboundAccessLogs []*xds_accesslog_filter.AccessLog) {
+	if stdoutAccessLog := envoy.GetStdoutAccessLog(spec, attributes); stdoutAccessLog != nil {
+		if spec.Inbound == nil || *spec.Inbound {
+			inboundAccessLogs = append(inboundAccessLogs, stdoutAccessLog)
+		}
+		if spec.Outbound == nil || *spec.Outbound {
+			outboundAccessLogs = append(outboundAccessLogs, stdoutAccessLog)
+		}
+	}
+	if otelAccessLog != nil {
+		inboundAccessLogs = append(inboundAccessLogs, otelAccessLog)
+		outboundAccessLogs = append(outboundAccessLogs, otelAccessLog)
+	}
+	return inboundAccessLogs, outboundAccessLogs
+}
+
 func buildPrometheusListener(connManager *xds_hcm.HttpConnectionManager) (*xds_listener.Listener, error) {
-	marshalledConnManager, err := anypb.New(connManager)
+	marshalledConnManager, err := protobuf.MarshalAny(connManager)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling HttpConnectionManager object")
@@ -184,12 +214,14 @@ func buildPrometheusListener(connManager *xds_hcm.HttpConnectionManager) (*xds_l
 
 // getDefaultPassthroughFilterChain returns a filter chain that matches any traffic, allowing such
 // traffic to be proxied to its original destination via the OutboundPassthroughCluster.
-func getDefaultPassthroughFilterChain() (*xds_listener.FilterChain, error) {
+// The given access logs are written for the proxied traffic.
+func getDefaultPassthroughFilterChain(accessLogs []*xds_accesslog_filter.AccessLog) (*xds_listener.FilterChain, error) {
 	tcpProxy := &xds_tcp_proxy.TcpProxy{
 		StatPrefix:       fmt.Sprintf("%s.%s", egressTCPProxyStatPrefix, envoy.OutboundPassthroughCluster),
 		ClusterSpecifier: &xds_tcp_proxy.TcpProxy_Cluster{Cluster: envoy.OutboundPassthroughCluster},
+		AccessLog:        accessLogs,
 	}
-	marshalledTCPProxy, err := anypb.New(tcpProxy)
+	marshalledTCPProxy, err := protobuf.MarshalAny(tcpProxy)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling TcpProxy object for egress HTTPS filter chain")
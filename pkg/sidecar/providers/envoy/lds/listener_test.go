@@ -3,6 +3,7 @@ package lds
 import (
 	"testing"
 
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
 	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
@@ -39,7 +40,8 @@ var _ = Describe("Construct inbound listeners", func() {
 
 	Context("Test creation of inbound listener", func() {
 		It("Tests the inbound listener config", func() {
-			listener := newInboundListener()
+			lb := &listenerBuilder{inboundAccessLogs: envoy.GetAccessLog()}
+			listener := lb.newInboundListener()
 			Expect(listener.Address).To(Equal(envoy.GetAddress(constants.WildcardIPAddr, constants.SidecarInboundListenerPort)))
 			Expect(listener.AccessLog).NotTo(BeEmpty())
 			Expect(len(listener.ListenerFilters)).To(Equal(2)) // TlsInspector, OriginalDestination listener filter
@@ -194,7 +196,8 @@ func TestNewOutboundListener(t *testing.T) {
 		EnableEgressPolicy: true,
 	}).Times(1)
 
-	lb := newListenerBuilder(meshCatalog, identity, cfg, nil, "cluster.local")
+	lb := newListenerBuilder(meshCatalog, identity, cfg, nil, "cluster.local", nil)
+	lb.inboundAccessLogs, lb.outboundAccessLogs = getAccessLogs(configv1alpha2.AccessLoggingSpec{}, nil, nil)
 
 	assert := tassert.New(t)
 	listener, err := lb.newOutboundListener()
@@ -214,3 +217,73 @@ func TestNewOutboundListener(t *testing.T) {
 	assert.Equal(envoy.HTTPInspectorFilterName, listener.ListenerFilters[2].Name)
 	assert.Equal(listener.ListenerFilters[1].FilterDisabled, listener.ListenerFilters[2].FilterDisabled)
 }
+
+func TestGetListenerAddress(t *testing.T) {
+	assert := tassert.New(t)
+
+	lb := &listenerBuilder{}
+	address := lb.getListenerAddress(constants.SidecarInboundListenerPort).GetSocketAddress()
+	assert.Equal(constants.WildcardIPAddr, address.Address)
+	assert.False(address.Ipv4Compat)
+	assert.Equal(uint32(constants.SidecarInboundListenerPort), address.GetPortValue())
+
+	lb.dualStack = true
+	address = lb.getListenerAddress(constants.SidecarInboundListenerPort).GetSocketAddress()
+	assert.Equal(constants.WildcardIPv6Addr, address.Address)
+	assert.True(address.Ipv4Compat)
+	assert.Equal(uint32(constants.SidecarInboundListenerPort), address.GetPortValue())
+}
+
+func TestGetAccessLogs(t *testing.T) {
+	disabled := false
+	otelAccessLog := envoy.GetOpenTelemetryAccessLog(constants.SidecarRemoteLoggingCluster, nil)
+
+	testCases := []struct {
+		name             string
+		spec             configv1alpha2.AccessLoggingSpec
+		otelAccessLog    bool
+		expectedInbound  []string
+		expectedOutbound []string
+	}{
+		{
+			name:             "access logs enabled by default",
+			spec:             configv1alpha2.AccessLoggingSpec{},
+			expectedInbound:  []string{envoy.AccessLoggerName},
+			expectedOutbound: []string{envoy.AccessLoggerName},
+		},
+		{
+			name:             "inbound access logs disabled",
+			spec:             configv1alpha2.AccessLoggingSpec{Inbound: &disabled},
+			expectedOutbound: []string{envoy.AccessLoggerName},
+		},
+		{
+			name:             "outbound access logs disabled with OpenTelemetry access logs",
+			spec:             configv1alpha2.AccessLoggingSpec{Outbound: &disabled},
+			otelAccessLog:    true,
+			expectedInbound:  []string{envoy.AccessLoggerName, envoy.OpenTelemetryAccessLoggerName},
+			expectedOutbound: []string{envoy.OpenTelemetryAccessLoggerName},
+		},
+	}
+
+	names := func(accessLogs []*xds_accesslog_filter.AccessLog) []string {
+		var names []string
+		for _, accessLog := range accessLogs {
+			names = append(names, accessLog.Name)
+		}
+		return names
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			var otel *xds_accesslog_filter.AccessLog
+			if tc.otelAccessLog {
+				otel = otelAccessLog
+			}
+			inbound, outbound := getAccessLogs(tc.spec, nil, otel)
+			assert.Equal(tc.expectedInbound, names(inbound))
+			assert.Equal(tc.expectedOutbound, names(outbound))
+		})
+	}
+}
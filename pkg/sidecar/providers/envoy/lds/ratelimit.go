@@ -0,0 +1,116 @@
+package lds
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_ratelimit_config "github.com/envoyproxy/go-control-plane/envoy/config/ratelimit/v3"
+	xds_common_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	xds_http_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	xds_network_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/ratelimit/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+const (
+	// rateLimitServiceStatPrefix is the stat prefix of the gRPC client used to query the rate limit service
+	rateLimitServiceStatPrefix = "rate_limit_service"
+)
+
+// buildTCPGlobalRateLimitFilter returns the network level global rate limit filter for the given config
+func buildTCPGlobalRateLimitFilter(config *policyv1alpha1.TCPGlobalRateLimitSpec, statPrefix string) (*xds_listener.Filter, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	if len(config.Descriptors) == 0 {
+		return nil, fmt.Errorf("no descriptors specified for TCP global rate limiting")
+	}
+
+	var descriptors []*xds_common_ratelimit.RateLimitDescriptor
+	for _, descriptor := range config.Descriptors {
+		rld := &xds_common_ratelimit.RateLimitDescriptor{}
+		for _, entry := range descriptor.Entries {
+			rld.Entries = append(rld.Entries, &xds_common_ratelimit.RateLimitDescriptor_Entry{
+				Key:   entry.Key,
+				Value: entry.Value,
+			})
+		}
+		descriptors = append(descriptors, rld)
+	}
+
+	rateLimit := &xds_network_ratelimit.RateLimit{
+		StatPrefix:       statPrefix,
+		Domain:           config.Domain,
+		Descriptors:      descriptors,
+		FailureModeDeny:  config.RateLimitService.FailureModeDeny,
+		RateLimitService: getRateLimitServiceConfig(config.RateLimitService),
+	}
+	if config.RateLimitService.Timeout != nil {
+		rateLimit.Timeout = durationpb.New(config.RateLimitService.Timeout.Duration)
+	}
+
+	marshalledConfig, err := protobuf.MarshalAny(rateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := &xds_listener.Filter{
+		Name:       envoy.L4GlobalRateLimitFilterName,
+		ConfigType: &xds_listener.Filter_TypedConfig{TypedConfig: marshalledConfig},
+	}
+
+	return filter, nil
+}
+
+// getHTTPGlobalRateLimitFilter returns the HTTP global rate limit filter for the given config.
+// The descriptors sent to the rate limit service are derived from the rate limit actions
+// configured on the VirtualHost and Route.
+func getHTTPGlobalRateLimitFilter(config *policyv1alpha1.HTTPGlobalRateLimitSpec) (*xds_hcm.HttpFilter, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	rateLimit := &xds_http_ratelimit.RateLimit{
+		Domain:           config.Domain,
+		FailureModeDeny:  config.RateLimitService.FailureModeDeny,
+		RateLimitService: getRateLimitServiceConfig(config.RateLimitService),
+	}
+	if config.RateLimitService.Timeout != nil {
+		rateLimit.Timeout = durationpb.New(config.RateLimitService.Timeout.Duration)
+	}
+
+	marshalledConfig, err := protobuf.MarshalAny(rateLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xds_hcm.HttpFilter{
+		Name: envoy.HTTPRateLimitFilterName,
+		ConfigType: &xds_hcm.HttpFilter_TypedConfig{
+			TypedConfig: marshalledConfig,
+		},
+	}, nil
+}
+
+// getRateLimitServiceConfig returns the config used by Envoy to reach the given rate limit service
+func getRateLimitServiceConfig(rls policyv1alpha1.RateLimitServiceSpec) *xds_ratelimit_config.RateLimitServiceConfig {
+	return &xds_ratelimit_config.RateLimitServiceConfig{
+		GrpcService: &xds_core.GrpcService{
+			TargetSpecifier: &xds_core.GrpcService_GoogleGrpc_{
+				GoogleGrpc: &xds_core.GrpcService_GoogleGrpc{
+					TargetUri:  net.JoinHostPort(rls.Host, strconv.Itoa(int(rls.Port))),
+					StatPrefix: rateLimitServiceStatPrefix,
+				},
+			},
+		},
+		TransportApiVersion: xds_core.ApiVersion_V3,
+	}
+}
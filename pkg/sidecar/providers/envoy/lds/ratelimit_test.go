@@ -0,0 +1,103 @@
+package lds
+
+import (
+	"testing"
+	"time"
+
+	xds_http_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
+	xds_network_ratelimit "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/ratelimit/v3"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+func TestBuildTCPGlobalRateLimitFilter(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      *policyv1alpha1.TCPGlobalRateLimitSpec
+		expectNil   bool
+		expectError bool
+	}{
+		{
+			name:      "no global rate limit config",
+			config:    nil,
+			expectNil: true,
+		},
+		{
+			name: "no descriptors",
+			config: &policyv1alpha1.TCPGlobalRateLimitSpec{
+				RateLimitService: policyv1alpha1.RateLimitServiceSpec{Host: "ratelimit.test", Port: 8081},
+				Domain:           "test",
+			},
+			expectError: true,
+		},
+		{
+			name: "valid global rate limit config",
+			config: &policyv1alpha1.TCPGlobalRateLimitSpec{
+				RateLimitService: policyv1alpha1.RateLimitServiceSpec{
+					Host:            "ratelimit.test",
+					Port:            8081,
+					Timeout:         &metav1.Duration{Duration: time.Second},
+					FailureModeDeny: true,
+				},
+				Domain: "test",
+				Descriptors: []policyv1alpha1.TCPRateLimitDescriptor{
+					{
+						Entries: []policyv1alpha1.TCPRateLimitDescriptorEntry{
+							{Key: "foo", Value: "bar"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			filter, err := buildTCPGlobalRateLimitFilter(tc.config, "stats")
+			a.Equal(tc.expectError, err != nil)
+			if tc.expectNil || tc.expectError {
+				a.Nil(filter)
+				return
+			}
+
+			a.Equal(envoy.L4GlobalRateLimitFilterName, filter.Name)
+			rl := &xds_network_ratelimit.RateLimit{}
+			a.NoError(filter.GetTypedConfig().UnmarshalTo(rl))
+			a.Equal("stats", rl.StatPrefix)
+			a.Equal(tc.config.Domain, rl.Domain)
+			a.True(rl.FailureModeDeny)
+			a.Equal(time.Second, rl.Timeout.AsDuration())
+			a.Equal("ratelimit.test:8081", rl.RateLimitService.GrpcService.GetGoogleGrpc().TargetUri)
+			a.Len(rl.Descriptors, 1)
+			a.Equal("foo", rl.Descriptors[0].Entries[0].Key)
+			a.Equal("bar", rl.Descriptors[0].Entries[0].Value)
+		})
+	}
+}
+
+func TestGetHTTPGlobalRateLimitFilter(t *testing.T) {
+	a := tassert.New(t)
+
+	filter, err := getHTTPGlobalRateLimitFilter(nil)
+	a.NoError(err)
+	a.Nil(filter)
+
+	filter, err = getHTTPGlobalRateLimitFilter(&policyv1alpha1.HTTPGlobalRateLimitSpec{
+		RateLimitService: policyv1alpha1.RateLimitServiceSpec{Host: "ratelimit.test", Port: 8081},
+		Domain:           "test",
+	})
+	a.NoError(err)
+	a.Equal(envoy.HTTPRateLimitFilterName, filter.Name)
+
+	rl := &xds_http_ratelimit.RateLimit{}
+	a.NoError(filter.GetTypedConfig().UnmarshalTo(rl))
+	a.Equal("test", rl.Domain)
+	a.False(rl.FailureModeDeny)
+	a.Nil(rl.Timeout)
+	a.Equal("ratelimit.test:8081", rl.RateLimitService.GrpcService.GetGoogleGrpc().TargetUri)
+}
@@ -88,7 +88,7 @@ func TestBuildRBACPolicyFromTrafficTarget(t *testing.T) {
 			assert := tassert.New(t)
 
 			// Test the RBAC policies
-			policy := buildRBACPolicyFromTrafficTarget(tc.trafficTarget, "cluster.local")
+			policy := buildRBACPolicyFromTrafficTarget(tc.trafficTarget, "cluster.local", false)
 
 			assert.Equal(tc.expectedPolicy, policy)
 		})
@@ -1,12 +1,15 @@
 package lds
 
 import (
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_discovery "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/cache/types"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/certificate"
 	"github.com/openservicemesh/osm/pkg/configurator"
+	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
@@ -22,12 +25,30 @@ import (
 func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_discovery.DiscoveryRequest, cfg configurator.Configurator, cm *certificate.Manager, proxyRegistry *registry.ProxyRegistry) ([]types.Resource, error) {
 	var ldsResources []types.Resource
 
+	// The settings which can be overridden per pod are resolved for the pod of the proxy
+	if proxy.PodMetadata != nil {
+		cfg = cfg.ForPod(proxy.PodMetadata.Namespace, proxy.PodMetadata.Labels)
+	}
+
 	var statsHeaders map[string]string
 	if featureflags := cfg.GetFeatureFlags(); featureflags.EnableWASMStats {
 		statsHeaders = proxy.StatsHeaders()
 	}
 
-	lb := newListenerBuilder(meshCatalog, proxy.Identity, cfg, statsHeaders, cm.GetTrustDomain())
+	lb := newListenerBuilder(meshCatalog, proxy.Identity, cfg, statsHeaders, cm.GetTrustDomain(), proxy.TelemetryAttributes())
+	lb.spiffeEnabled = cm.GetSpiffeEnabled()
+
+	pod, podErr := meshCatalog.GetKubeController().GetPodForProxy(proxy)
+	if podErr == nil {
+		lb.dualStack = k8s.HasIPv6PodIP(pod)
+	}
+
+	// Envoy exports the access logs to the OpenTelemetry collector over OTLP/gRPC only
+	var otelAccessLog *xds_accesslog_filter.AccessLog
+	if cfg.IsRemoteLoggingEnabled() && cfg.GetRemoteLoggingProtocol() == configv1alpha2.TelemetryProtocolOTLPGRPC {
+		otelAccessLog = envoy.GetOpenTelemetryAccessLog(constants.SidecarRemoteLoggingCluster, lb.telemetryAttributes)
+	}
+	lb.inboundAccessLogs, lb.outboundAccessLogs = getAccessLogs(cfg.GetMeshConfig().Spec.Observability.AccessLogging, lb.telemetryAttributes, otelAccessLog)
 
 	// --- OUTBOUND -------------------
 	outboundListener, err := lb.newOutboundListener()
@@ -44,7 +65,7 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 	}
 
 	// --- INBOUND -------------------
-	inboundListener := newInboundListener()
+	inboundListener := lb.newInboundListener()
 
 	svcList, err := proxyRegistry.ListProxyServices(proxy)
 	if err != nil {
@@ -71,7 +92,7 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 		ldsResources = append(ldsResources, inboundListener)
 	}
 
-	if pod, err := meshCatalog.GetKubeController().GetPodForProxy(proxy); err != nil {
+	if podErr != nil {
 		log.Warn().Str("proxy", proxy.String()).Msgf("Could not find pod for connecting proxy, no metadata was recorded")
 	} else if k8s.IsMetricsEnabled(pod) {
 		// Build Prometheus listener config
@@ -87,12 +108,13 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, _ *xds_d
 }
 
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
-func newListenerBuilder(meshCatalog catalog.MeshCataloger, svcIdentity identity.ServiceIdentity, cfg configurator.Configurator, statsHeaders map[string]string, trustDomain string) *listenerBuilder {
+func newListenerBuilder(meshCatalog catalog.MeshCataloger, svcIdentity identity.ServiceIdentity, cfg configurator.Configurator, statsHeaders map[string]string, trustDomain string, telemetryAttributes map[string]string) *listenerBuilder {
 	return &listenerBuilder{
-		meshCatalog:     meshCatalog,
-		serviceIdentity: svcIdentity,
-		cfg:             cfg,
-		statsHeaders:    statsHeaders,
-		trustDomain:     trustDomain,
+		meshCatalog:         meshCatalog,
+		serviceIdentity:     svcIdentity,
+		cfg:                 cfg,
+		statsHeaders:        statsHeaders,
+		trustDomain:         trustDomain,
+		telemetryAttributes: telemetryAttributes,
 	}
 }
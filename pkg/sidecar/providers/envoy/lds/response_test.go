@@ -74,8 +74,12 @@ func TestNewResponse(t *testing.T) {
 	meshCatalog := catalogFake.NewFakeMeshCatalog(kubeClient, configClient)
 
 	mockConfigurator.EXPECT().IsPermissiveTrafficPolicyMode().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().GetRevokedCertificates().Return(nil).AnyTimes()
 	mockConfigurator.EXPECT().IsTracingEnabled().Return(false).AnyTimes()
+	mockConfigurator.EXPECT().IsRemoteLoggingEnabled().Return(false).AnyTimes()
 	mockConfigurator.EXPECT().GetTracingEndpoint().Return("some-endpoint").AnyTimes()
+	mockConfigurator.EXPECT().GetTracingSampledFraction().Return(float32(1)).AnyTimes()
+	mockConfigurator.EXPECT().GetTracingProtocol().Return(configv1alpha2.TelemetryProtocolZipkin).AnyTimes()
 	mockConfigurator.EXPECT().IsEgressEnabled().Return(true).AnyTimes()
 	mockConfigurator.EXPECT().GetInboundExternalAuthConfig().Return(auth.ExtAuthConfig{
 		Enable: false,
@@ -93,7 +97,7 @@ func TestNewResponse(t *testing.T) {
 	assert.NotNil(pod)
 
 	mockController := meshCatalog.GetKubeController().(*k8s.MockController)
-	mockController.EXPECT().GetPodForProxy(proxy).Return(pod, nil)
+	mockController.EXPECT().GetPodForProxy(proxy).Return(pod, nil).Times(2)
 
 	// test scenario that listing proxy services returns an error
 	proxyRegistry := registry.NewProxyRegistry(registry.ExplicitProxyServiceMapper(func(*envoy.Proxy) ([]service.MeshService, error) {
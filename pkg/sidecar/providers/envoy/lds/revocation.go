@@ -0,0 +1,85 @@
+package lds
+
+import (
+	xds_listener "github.com/envoyproxy/go-control-plane/envoy/config/listener/v3"
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+const (
+	// revocationPolicyName is the name of the RBAC policy denying the revoked certificates
+	revocationPolicyName = "revoked-certificates"
+
+	// peerCertificateDigestAttribute is the Envoy attribute holding the hex encoded SHA-256 digest of the
+	// certificate presented by the downstream peer
+	peerCertificateDigestAttribute = "sha256_peer_certificate_digest"
+)
+
+// buildRevocationFilter builds a network RBAC filter denying the connections presenting one of the given revoked
+// certificates, identified by their SHA-256 fingerprint. It returns nil if there is no revoked certificate.
+func buildRevocationFilter(revoked []configv1alpha2.RevokedCertificate) (*xds_listener.Filter, error) {
+	if len(revoked) == 0 {
+		return nil, nil
+	}
+
+	var fingerprints []*expr.Expr
+	for _, rc := range revoked {
+		fingerprints = append(fingerprints, &expr.Expr{
+			ExprKind: &expr.Expr_ConstExpr{ConstExpr: &expr.Constant{ConstantKind: &expr.Constant_StringValue{StringValue: rc.Fingerprint}}},
+		})
+	}
+
+	// connection.sha256_peer_certificate_digest in [<fingerprint>, ...]
+	condition := &expr.Expr{
+		ExprKind: &expr.Expr_CallExpr{
+			CallExpr: &expr.Expr_Call{
+				Function: "@in",
+				Args: []*expr.Expr{
+					{
+						ExprKind: &expr.Expr_SelectExpr{
+							SelectExpr: &expr.Expr_Select{
+								Operand: &expr.Expr{ExprKind: &expr.Expr_IdentExpr{IdentExpr: &expr.Expr_Ident{Name: "connection"}}},
+								Field:   peerCertificateDigestAttribute,
+							},
+						},
+					},
+					{
+						ExprKind: &expr.Expr_ListExpr{ListExpr: &expr.Expr_CreateList{Elements: fingerprints}},
+					},
+				},
+			},
+		},
+	}
+
+	networkRBACPolicy := &xds_network_rbac.RBAC{
+		StatPrefix: "revocation-", // will be displayed as revocation-rbac.<path>
+		Rules: &xds_rbac.RBAC{
+			Action: xds_rbac.RBAC_DENY, // Denies the connection if the policy matches it
+			Policies: map[string]*xds_rbac.Policy{
+				revocationPolicyName: {
+					Permissions: []*xds_rbac.Permission{{Rule: &xds_rbac.Permission_Any{Any: true}}},
+					Principals:  []*xds_rbac.Principal{{Identifier: &xds_rbac.Principal_Any{Any: true}}},
+					Condition:   condition,
+				},
+			},
+		},
+	}
+
+	marshalledNetworkRBACPolicy, err := protobuf.MarshalAny(networkRBACPolicy)
+	if err != nil {
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
+			Msgf("Error marshalling revocation RBAC policy: %v", networkRBACPolicy)
+		return nil, err
+	}
+
+	return &xds_listener.Filter{
+		Name:       envoy.L4RevocationFilterName,
+		ConfigType: &xds_listener.Filter_TypedConfig{TypedConfig: marshalledNetworkRBACPolicy},
+	}, nil
+}
@@ -0,0 +1,47 @@
+package lds
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_network_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/rbac/v3"
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+func TestBuildRevocationFilter(t *testing.T) {
+	assert := tassert.New(t)
+	require := trequire.New(t)
+
+	filter, err := buildRevocationFilter(nil)
+	assert.NoError(err)
+	assert.Nil(filter)
+
+	filter, err = buildRevocationFilter([]configv1alpha2.RevokedCertificate{
+		{SerialNumber: "1", Fingerprint: "aa"},
+		{SerialNumber: "2", Fingerprint: "bb"},
+	})
+	require.NoError(err)
+	assert.Equal(envoy.L4RevocationFilterName, filter.Name)
+
+	networkRBAC := &xds_network_rbac.RBAC{}
+	require.NoError(filter.GetTypedConfig().UnmarshalTo(networkRBAC))
+	assert.Equal(xds_rbac.RBAC_DENY, networkRBAC.Rules.Action)
+	require.Contains(networkRBAC.Rules.Policies, revocationPolicyName)
+
+	call := networkRBAC.Rules.Policies[revocationPolicyName].Condition.GetCallExpr()
+	require.NotNil(call)
+	assert.Equal("@in", call.Function)
+	require.Len(call.Args, 2)
+	assert.Equal("connection", call.Args[0].GetSelectExpr().Operand.GetIdentExpr().Name)
+	assert.Equal(peerCertificateDigestAttribute, call.Args[0].GetSelectExpr().Field)
+
+	var fingerprints []string
+	for _, elem := range call.Args[1].GetListExpr().Elements {
+		fingerprints = append(fingerprints, elem.GetConstExpr().GetStringValue())
+	}
+	assert.Equal([]string{"aa", "bb"}, fingerprints)
+}
@@ -1,39 +1,101 @@
 package lds
 
 import (
+	"sort"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_tracing "github.com/envoyproxy/go-control-plane/envoy/config/trace/v3"
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
-	"google.golang.org/protobuf/types/known/anypb"
+	xds_tracing_type "github.com/envoyproxy/go-control-plane/envoy/type/tracing/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/proto"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+)
+
+const (
+	zipkinTracerName        = "envoy.tracers.zipkin"
+	openTelemetryTracerName = "envoy.tracers.opentelemetry"
 )
 
-// getHTTPTracingConfig returns an HTTP configuration tracing config for the HTTP connection manager to use
-func getHTTPTracingConfig(apiEndpoint string) (*xds_hcm.HttpConnectionManager_Tracing, error) {
-	zipkinTracingConf := &xds_tracing.ZipkinConfig{
-		CollectorCluster:         constants.SidecarTracingCluster,
-		CollectorEndpoint:        apiEndpoint,
-		CollectorEndpointVersion: xds_tracing.ZipkinConfig_HTTP_JSON,
+// getHTTPTracingConfig returns an HTTP configuration tracing config for the HTTP connection manager to use.
+// The spans are exported over OTLP/gRPC with the W3C trace context propagation for the 'otlp-grpc' protocol,
+// the given attributes identifying the proxy being added to them, or to a Zipkin collector otherwise.
+// Nil is returned for the 'otlp-http' protocol, not supported by Envoy.
+func getHTTPTracingConfig(protocol configv1alpha2.TelemetryProtocol, apiEndpoint string, sampledFraction float32, attributes map[string]string) (*xds_hcm.HttpConnectionManager_Tracing, error) {
+	var tracerName string
+	var tracerConf proto.Message
+	var customTags []*xds_tracing_type.CustomTag
+
+	switch protocol {
+	case configv1alpha2.TelemetryProtocolOTLPGRPC:
+		tracerName = openTelemetryTracerName
+		tracerConf = &xds_tracing.OpenTelemetryConfig{
+			GrpcService: &xds_core.GrpcService{
+				TargetSpecifier: &xds_core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &xds_core.GrpcService_EnvoyGrpc{
+						ClusterName: constants.SidecarTracingCluster,
+					},
+				},
+			},
+		}
+		customTags = getTracingCustomTags(attributes)
+
+	case configv1alpha2.TelemetryProtocolOTLPHTTP:
+		log.Error().Msgf("Tracing protocol %s is not supported by Envoy, use %s to export the spans over OTLP",
+			protocol, configv1alpha2.TelemetryProtocolOTLPGRPC)
+		return nil, nil
+
+	default:
+		tracerName = zipkinTracerName
+		tracerConf = &xds_tracing.ZipkinConfig{
+			CollectorCluster:         constants.SidecarTracingCluster,
+			CollectorEndpoint:        apiEndpoint,
+			CollectorEndpointVersion: xds_tracing.ZipkinConfig_HTTP_JSON,
+		}
 	}
 
-	zipkinConfMarshalled, err := anypb.New(zipkinTracingConf)
+	tracerConfMarshalled, err := protobuf.MarshalAny(tracerConf)
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
-			Msgf("Error marshalling Zipkin config")
+			Msgf("Error marshalling %s config", tracerName)
 		return nil, err
 	}
 
 	tracing := &xds_hcm.HttpConnectionManager_Tracing{
-		Verbose: true,
+		Verbose:        true,
+		RandomSampling: &xds_type.Percent{Value: float64(sampledFraction) * 100},
 		Provider: &xds_tracing.Tracing_Http{
 			// Name must refer to an instantiatable tracing driver
-			Name: "envoy.tracers.zipkin",
+			Name: tracerName,
 			ConfigType: &xds_tracing.Tracing_Http_TypedConfig{
-				TypedConfig: zipkinConfMarshalled,
+				TypedConfig: tracerConfMarshalled,
 			},
 		},
+		CustomTags: customTags,
 	}
 
 	return tracing, nil
 }
+
+// getTracingCustomTags returns the given attributes as literal tags added to every span, sorted by name
+func getTracingCustomTags(attributes map[string]string) []*xds_tracing_type.CustomTag {
+	var customTags []*xds_tracing_type.CustomTag
+	for tag, value := range attributes {
+		customTags = append(customTags, &xds_tracing_type.CustomTag{
+			Tag: tag,
+			Type: &xds_tracing_type.CustomTag_Literal_{
+				Literal: &xds_tracing_type.CustomTag_Literal{
+					Value: value,
+				},
+			},
+		})
+	}
+	sort.Slice(customTags, func(i, j int) bool {
+		return customTags[i].Tag < customTags[j].Tag
+	})
+	return customTags
+}
@@ -2,6 +2,8 @@
 package lds
 
 import (
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
+
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/identity"
@@ -19,4 +21,19 @@ type listenerBuilder struct {
 	cfg             configurator.Configurator
 	statsHeaders    map[string]string
 	trustDomain     string
+	// spiffeEnabled specifies whether the principals are SPIFFE IDs
+	spiffeEnabled bool
+
+	// dualStack specifies whether the pod of the proxy has an IPv6 address, so that the listeners
+	// intercepting the traffic accept both the IPv4 and the IPv6 connections
+	dualStack bool
+
+	// telemetryAttributes are the OpenTelemetry attributes identifying the proxy in the exported telemetry
+	telemetryAttributes map[string]string
+
+	// inboundAccessLogs are the access logs of the inbound traffic
+	inboundAccessLogs []*xds_accesslog_filter.AccessLog
+
+	// outboundAccessLogs are the access logs of the outbound traffic
+	outboundAccessLogs []*xds_accesslog_filter.AccessLog
 }
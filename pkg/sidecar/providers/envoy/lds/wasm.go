@@ -12,8 +12,7 @@ import (
 	xds_hcm "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	xds_wasm_ext "github.com/envoyproxy/go-control-plane/envoy/extensions/wasm/v3"
 
-	"google.golang.org/protobuf/types/known/anypb"
-
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 )
 
@@ -93,7 +92,7 @@ func getAddHeadersFilter(headers map[string]string) (*xds_hcm.HttpFilter, error)
 		InlineCode: addCallsReq.String(),
 	}
 
-	luaAny, err := anypb.New(lua)
+	luaAny, err := protobuf.MarshalAny(lua)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling Lua filter: %w", err)
 	}
@@ -131,7 +130,7 @@ func getStatsWASMFilter() (*xds_hcm.HttpFilter, error) {
 		},
 	}
 
-	wasmAny, err := anypb.New(wasmPlug)
+	wasmAny, err := protobuf.MarshalAny(wasmPlug)
 	if err != nil {
 		return nil, fmt.Errorf("Error marshalling Wasm config: %w", err)
 	}
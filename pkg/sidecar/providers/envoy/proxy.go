@@ -11,6 +11,7 @@ import (
 
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/models"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 // Proxy is a representation of an Envoy proxy connected to the xDS server.
@@ -36,6 +37,13 @@ type Proxy struct {
 	// Contains the last requested resource names (and therefore, subscribed) for a given TypeURI
 	subscribedResources map[TypeURI]mapset.Set
 
+	// Contains the versions of the resources last sent on a Delta xDS stream for a given TypeURI,
+	// keyed by resource name
+	resourceVersions map[TypeURI]map[string]string
+
+	// The outbound mesh traffic policy the clusters were last generated from
+	outboundMeshTrafficPolicy *trafficpolicy.OutboundMeshTrafficPolicy
+
 	// kind is the proxy's kind (ex. sidecar, gateway)
 	kind models.ProxyKind
 
@@ -62,6 +70,7 @@ type PodMetadata struct {
 	EnvoyNodeID    string
 	WorkloadKind   string
 	WorkloadName   string
+	Labels         map[string]string
 }
 
 // HasPodMetadata answers the question - has the Pod metadata been recorded for the given Envoy proxy
@@ -110,6 +119,24 @@ func (p *Proxy) StatsHeaders() map[string]string {
 	}
 }
 
+// TelemetryAttributes returns the OpenTelemetry attributes identifying the given proxy in the exported telemetry
+func (p *Proxy) TelemetryAttributes() map[string]string {
+	svcAccount := p.Identity.ToK8sServiceAccount()
+	attributes := map[string]string{
+		"service.name":      svcAccount.Name,
+		"service.namespace": svcAccount.Namespace,
+		"osm.identity":      p.Identity.String(),
+	}
+
+	if p.PodMetadata != nil {
+		attributes["k8s.namespace.name"] = p.PodMetadata.Namespace
+		attributes["k8s.pod.name"] = p.PodMetadata.Name
+		attributes["k8s.pod.uid"] = p.PodMetadata.UID
+	}
+
+	return attributes
+}
+
 // SetLastAppliedVersion records the version of the given Envoy proxy that was last acknowledged.
 func (p *Proxy) SetLastAppliedVersion(typeURI TypeURI, version uint64) {
 	p.lastAppliedVersion[typeURI] = version
@@ -200,6 +227,16 @@ func (p *Proxy) SetLastResourcesSent(typeURI TypeURI, resourcesSet mapset.Set) {
 	p.lastxDSResourcesSent[typeURI] = resourcesSet
 }
 
+// GetOutboundMeshTrafficPolicy returns the outbound mesh traffic policy the clusters of the proxy were last generated from
+func (p *Proxy) GetOutboundMeshTrafficPolicy() *trafficpolicy.OutboundMeshTrafficPolicy {
+	return p.outboundMeshTrafficPolicy
+}
+
+// SetOutboundMeshTrafficPolicy sets the outbound mesh traffic policy the clusters of the proxy were generated from
+func (p *Proxy) SetOutboundMeshTrafficPolicy(outboundMeshTrafficPolicy *trafficpolicy.OutboundMeshTrafficPolicy) {
+	p.outboundMeshTrafficPolicy = outboundMeshTrafficPolicy
+}
+
 // GetSubscribedResources returns a set of resources subscribed for a proxy given a TypeURL
 // If none were subscribed, empty set is returned
 func (p *Proxy) GetSubscribedResources(typeURI TypeURI) mapset.Set {
@@ -215,6 +252,23 @@ func (p *Proxy) SetSubscribedResources(typeURI TypeURI, resourcesSet mapset.Set)
 	p.subscribedResources[typeURI] = resourcesSet
 }
 
+// GetResourceVersions returns the versions of the resources last sent on a Delta xDS stream
+// for a proxy given a TypeURL, keyed by resource name.
+// If none were sent, an empty map is returned
+func (p *Proxy) GetResourceVersions(typeURI TypeURI) map[string]string {
+	versions, ok := p.resourceVersions[typeURI]
+	if !ok {
+		return make(map[string]string)
+	}
+	return versions
+}
+
+// SetResourceVersions sets the versions of the resources last sent on a Delta xDS stream
+// for a proxy given a TypeURL
+func (p *Proxy) SetResourceVersions(typeURI TypeURI, versions map[string]string) {
+	p.resourceVersions[typeURI] = versions
+}
+
 // Kind return the proxy's kind
 func (p *Proxy) Kind() models.ProxyKind {
 	return p.kind
@@ -236,6 +290,7 @@ func NewProxy(kind models.ProxyKind, uuid uuid.UUID, svcIdentity identity.Servic
 		lastAppliedVersion:   make(map[TypeURI]uint64),
 		lastxDSResourcesSent: make(map[TypeURI]mapset.Set),
 		subscribedResources:  make(map[TypeURI]mapset.Set),
+		resourceVersions:     make(map[TypeURI]map[string]string),
 
 		kind: kind,
 	}
@@ -225,6 +225,51 @@ func TestStatsHeaders(t *testing.T) {
 	}
 }
 
+func TestTelemetryAttributes(t *testing.T) {
+	tests := []struct {
+		name     string
+		proxy    Proxy
+		expected map[string]string
+	}{
+		{
+			name: "nil metadata",
+			proxy: Proxy{
+				Identity: identity.New("sa", "ns"),
+			},
+			expected: map[string]string{
+				"service.name":      "sa",
+				"service.namespace": "ns",
+				"osm.identity":      "sa.ns",
+			},
+		},
+		{
+			name: "with pod metadata",
+			proxy: Proxy{
+				Identity: identity.New("sa", "ns"),
+				PodMetadata: &PodMetadata{
+					UID:       "uid",
+					Name:      "pod",
+					Namespace: "ns",
+				},
+			},
+			expected: map[string]string{
+				"service.name":       "sa",
+				"service.namespace":  "ns",
+				"osm.identity":       "sa.ns",
+				"k8s.namespace.name": "ns",
+				"k8s.pod.name":       "pod",
+				"k8s.pod.uid":        "uid",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.proxy.TelemetryAttributes())
+		})
+	}
+}
+
 func TestPodMetadataString(t *testing.T) {
 	testCases := []struct {
 		name     string
@@ -280,3 +325,20 @@ func TestSubscribedResources(t *testing.T) {
 	assert.True(res.Contains("B"))
 	assert.True(res.Contains("C"))
 }
+
+func TestResourceVersions(t *testing.T) {
+	assert := tassert.New(t)
+
+	p := Proxy{
+		resourceVersions: make(map[TypeURI]map[string]string),
+	}
+
+	versions := p.GetResourceVersions(TypeEDS)
+	assert.Empty(versions)
+
+	p.SetResourceVersions(TypeEDS, map[string]string{"A": "1", "B": "2"})
+
+	versions = p.GetResourceVersions(TypeEDS)
+	assert.Equal(map[string]string{"A": "1", "B": "2"}, versions)
+	assert.Empty(p.GetResourceVersions(TypeCDS))
+}
@@ -0,0 +1,298 @@
+package rbac
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	methodHeaderKey = ":method"
+)
+
+// GetAuthorizationAction returns the RBAC action corresponding to the given AuthorizationPolicy action
+func GetAuthorizationAction(action policyv1alpha1.AuthorizationPolicyAction) xds_rbac.RBAC_Action {
+	switch action {
+	case policyv1alpha1.AuthorizationPolicyActionDeny:
+		return xds_rbac.RBAC_DENY
+	case policyv1alpha1.AuthorizationPolicyActionAudit:
+		return xds_rbac.RBAC_LOG
+	default:
+		return xds_rbac.RBAC_ALLOW
+	}
+}
+
+// BuildAuthorizationPolicies builds the RBAC policies for the given traffic target derived from an AuthorizationPolicy policy.
+// A policy is built per rule, and is keyed by the name of the traffic target and the index of the rule.
+// If forHTTP is false, the policies are built for TCP traffic whose HTTP attributes cannot be matched:
+// the operations with HTTP conditions are ignored by ALLOW and AUDIT rules, while DENY rules ignore the
+// HTTP conditions of their operations so that the traffic is denied conservatively.
+func BuildAuthorizationPolicies(trafficTarget trafficpolicy.TrafficTargetWithRoutes, trustDomain string, spiffeEnabled bool, forHTTP bool) (map[string]*xds_rbac.Policy, error) {
+	policies := make(map[string]*xds_rbac.Policy)
+
+	for idx, rule := range trafficTarget.AuthorizationRules {
+		var principals []*xds_rbac.Principal
+		for _, source := range rule.From {
+			principal, err := buildAuthorizationPrincipal(source, trustDomain, spiffeEnabled)
+			if err != nil {
+				return nil, fmt.Errorf("Error building principal for rule %d of AuthorizationPolicy %s: %w", idx, trafficTarget.Name, err)
+			}
+			principals = append(principals, principal)
+		}
+		if len(rule.From) == 0 {
+			principals = []*xds_rbac.Principal{getAnyPrincipal()}
+		}
+
+		var permissions []*xds_rbac.Permission
+		for _, operation := range rule.To {
+			if !forHTTP && hasHTTPConditions(operation) {
+				if trafficTarget.Action != policyv1alpha1.AuthorizationPolicyActionDeny {
+					continue
+				}
+				operation = policyv1alpha1.AuthorizationOperationSpec{Ports: operation.Ports, ServerNames: operation.ServerNames}
+			}
+			permissions = append(permissions, buildAuthorizationPermission(operation))
+		}
+		if len(rule.To) == 0 {
+			permissions = []*xds_rbac.Permission{getAnyPermission()}
+		}
+		if len(permissions) == 0 {
+			// None of the operations of this rule can be matched
+			continue
+		}
+
+		policies[fmt.Sprintf("%s/%d", trafficTarget.Name, idx)] = &xds_rbac.Policy{
+			Principals:  principals,
+			Permissions: permissions,
+		}
+	}
+
+	return policies, nil
+}
+
+func hasHTTPConditions(operation policyv1alpha1.AuthorizationOperationSpec) bool {
+	return len(operation.Methods) > 0 || len(operation.Paths) > 0 || len(operation.Headers) > 0
+}
+
+// buildAuthorizationPrincipal returns a principal matching the source if all of its specified fields match
+func buildAuthorizationPrincipal(source trafficpolicy.AuthorizationSource, trustDomain string, spiffeEnabled bool) (*xds_rbac.Principal, error) {
+	var ids []*xds_rbac.Principal
+
+	if len(source.Identities) > 0 {
+		var identities []*xds_rbac.Principal
+		for _, id := range source.Identities {
+			identities = append(identities, GetAuthenticatedPrincipal(id.AsPrincipal(trustDomain, spiffeEnabled)))
+		}
+		ids = append(ids, orPrincipal(identities))
+	}
+
+	if len(source.Namespaces) > 0 {
+		var namespaces []*xds_rbac.Principal
+		for _, namespace := range source.Namespaces {
+			// Principals are of the form <service-account>.<namespace>.<trust-domain>,
+			// or spiffe://<trust-domain>/ns/<namespace>/sa/<service-account> when SPIFFE is enabled
+			regex := `[^.]+\.` + regexp.QuoteMeta(fmt.Sprintf("%s.%s", namespace, trustDomain))
+			if spiffeEnabled {
+				regex = regexp.QuoteMeta(fmt.Sprintf("%s://%s/ns/%s/sa/", identity.SpiffeScheme, trustDomain, namespace)) + `[^/]+`
+			}
+			namespaces = append(namespaces, &xds_rbac.Principal{
+				Identifier: &xds_rbac.Principal_Authenticated_{
+					Authenticated: &xds_rbac.Principal_Authenticated{
+						PrincipalName: &xds_matcher.StringMatcher{
+							MatchPattern: &xds_matcher.StringMatcher_SafeRegex{
+								SafeRegex: &xds_matcher.RegexMatcher{
+									EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+									Regex:      regex,
+								},
+							},
+						},
+					},
+				},
+			})
+		}
+		ids = append(ids, orPrincipal(namespaces))
+	}
+
+	if len(source.IPBlocks) > 0 {
+		var ipBlocks []*xds_rbac.Principal
+		for _, ipBlock := range source.IPBlocks {
+			cidr, err := getCidrRange(ipBlock)
+			if err != nil {
+				return nil, err
+			}
+			ipBlocks = append(ipBlocks, &xds_rbac.Principal{
+				Identifier: &xds_rbac.Principal_DirectRemoteIp{DirectRemoteIp: cidr},
+			})
+		}
+		ids = append(ids, orPrincipal(ipBlocks))
+	}
+
+	if len(ids) == 0 {
+		return getAnyPrincipal(), nil
+	}
+	return andPrincipal(ids), nil
+}
+
+// buildAuthorizationPermission returns a permission matching the operation if all of its specified fields match
+func buildAuthorizationPermission(operation policyv1alpha1.AuthorizationOperationSpec) *xds_rbac.Permission {
+	var rules []*xds_rbac.Permission
+
+	if len(operation.Ports) > 0 {
+		var ports []*xds_rbac.Permission
+		for _, port := range operation.Ports {
+			ports = append(ports, GetDestinationPortPermission(uint32(port)))
+		}
+		rules = append(rules, orPermission(ports))
+	}
+
+	if len(operation.ServerNames) > 0 {
+		var serverNames []*xds_rbac.Permission
+		for _, serverName := range operation.ServerNames {
+			serverNames = append(serverNames, &xds_rbac.Permission{
+				Rule: &xds_rbac.Permission_RequestedServerName{RequestedServerName: getExactStringMatcher(serverName)},
+			})
+		}
+		rules = append(rules, orPermission(serverNames))
+	}
+
+	if len(operation.Methods) > 0 {
+		var methods []*xds_rbac.Permission
+		for _, method := range operation.Methods {
+			methods = append(methods, getHeaderPermission(methodHeaderKey, strings.ToUpper(method)))
+		}
+		rules = append(rules, orPermission(methods))
+	}
+
+	if len(operation.Paths) > 0 {
+		var paths []*xds_rbac.Permission
+		for _, path := range operation.Paths {
+			pathMatcher := getExactStringMatcher(path)
+			if strings.HasSuffix(path, "*") {
+				pathMatcher = &xds_matcher.StringMatcher{
+					MatchPattern: &xds_matcher.StringMatcher_Prefix{Prefix: strings.TrimSuffix(path, "*")},
+				}
+			}
+			paths = append(paths, &xds_rbac.Permission{
+				Rule: &xds_rbac.Permission_UrlPath{
+					UrlPath: &xds_matcher.PathMatcher{
+						Rule: &xds_matcher.PathMatcher_Path{Path: pathMatcher},
+					},
+				},
+			})
+		}
+		rules = append(rules, orPermission(paths))
+	}
+
+	for _, header := range operation.Headers {
+		if len(header.Values) == 0 {
+			rules = append(rules, &xds_rbac.Permission{
+				Rule: &xds_rbac.Permission_Header{
+					Header: &xds_route.HeaderMatcher{
+						Name:                 header.Name,
+						HeaderMatchSpecifier: &xds_route.HeaderMatcher_PresentMatch{PresentMatch: true},
+					},
+				},
+			})
+			continue
+		}
+		var values []*xds_rbac.Permission
+		for _, value := range header.Values {
+			values = append(values, getHeaderPermission(header.Name, value))
+		}
+		rules = append(rules, orPermission(values))
+	}
+
+	switch len(rules) {
+	case 0:
+		return getAnyPermission()
+	case 1:
+		return rules[0]
+	default:
+		return andPermission(rules)
+	}
+}
+
+func getHeaderPermission(name, value string) *xds_rbac.Permission {
+	return &xds_rbac.Permission{
+		Rule: &xds_rbac.Permission_Header{
+			Header: &xds_route.HeaderMatcher{
+				Name:                 name,
+				HeaderMatchSpecifier: &xds_route.HeaderMatcher_StringMatch{StringMatch: getExactStringMatcher(value)},
+			},
+		},
+	}
+}
+
+func getExactStringMatcher(value string) *xds_matcher.StringMatcher {
+	return &xds_matcher.StringMatcher{
+		MatchPattern: &xds_matcher.StringMatcher_Exact{Exact: value},
+	}
+}
+
+// getCidrRange returns the CIDR range for the given IP range, a single IP address is matched as a host range
+func getCidrRange(ipRange string) (*xds_core.CidrRange, error) {
+	if ip := net.ParseIP(ipRange); ip != nil {
+		prefixLen := net.IPv4len * 8
+		if ip.To4() == nil {
+			prefixLen = net.IPv6len * 8
+		}
+		return &xds_core.CidrRange{
+			AddressPrefix: ip.String(),
+			PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+		}, nil
+	}
+
+	ip, ipNet, err := net.ParseCIDR(ipRange)
+	if err != nil {
+		return nil, err
+	}
+	prefixLen, _ := ipNet.Mask.Size()
+	return &xds_core.CidrRange{
+		AddressPrefix: ip.String(),
+		PrefixLen:     wrapperspb.UInt32(uint32(prefixLen)),
+	}, nil
+}
+
+func orPrincipal(principals []*xds_rbac.Principal) *xds_rbac.Principal {
+	if len(principals) == 1 {
+		return principals[0]
+	}
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_OrIds{
+			OrIds: &xds_rbac.Principal_Set{Ids: principals},
+		},
+	}
+}
+
+func andPrincipal(principals []*xds_rbac.Principal) *xds_rbac.Principal {
+	if len(principals) == 1 {
+		return principals[0]
+	}
+	return &xds_rbac.Principal{
+		Identifier: &xds_rbac.Principal_AndIds{
+			AndIds: &xds_rbac.Principal_Set{Ids: principals},
+		},
+	}
+}
+
+func orPermission(permissions []*xds_rbac.Permission) *xds_rbac.Permission {
+	if len(permissions) == 1 {
+		return permissions[0]
+	}
+	return &xds_rbac.Permission{
+		Rule: &xds_rbac.Permission_OrRules{
+			OrRules: &xds_rbac.Permission_Set{Rules: permissions},
+		},
+	}
+}
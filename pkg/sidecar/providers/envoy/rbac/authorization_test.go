@@ -0,0 +1,186 @@
+package rbac
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestGetAuthorizationAction(t *testing.T) {
+	assert := tassert.New(t)
+
+	assert.Equal(xds_rbac.RBAC_ALLOW, GetAuthorizationAction(policyv1alpha1.AuthorizationPolicyActionAllow))
+	assert.Equal(xds_rbac.RBAC_DENY, GetAuthorizationAction(policyv1alpha1.AuthorizationPolicyActionDeny))
+	assert.Equal(xds_rbac.RBAC_LOG, GetAuthorizationAction(policyv1alpha1.AuthorizationPolicyActionAudit))
+}
+
+func TestBuildAuthorizationPolicies(t *testing.T) {
+	httpOperation := policyv1alpha1.AuthorizationOperationSpec{
+		Ports:   []uint16{8080},
+		Methods: []string{"get"},
+		Paths:   []string{"/api/*"},
+	}
+
+	testCases := []struct {
+		name          string
+		trafficTarget trafficpolicy.TrafficTargetWithRoutes
+		spiffeEnabled bool
+		forHTTP       bool
+		expectErr     bool
+		assertFunc    func(*tassert.Assertions, map[string]*xds_rbac.Policy)
+	}{
+		{
+			name: "empty rule matches any source and operation",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:               "ns/authz",
+				Action:             policyv1alpha1.AuthorizationPolicyActionAllow,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{{}},
+			},
+			forHTTP: true,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				a.Len(policies, 1)
+				policy := policies["ns/authz/0"]
+				a.True(policy.Principals[0].GetAny())
+				a.True(policy.Permissions[0].GetAny())
+			},
+		},
+		{
+			name: "sources with identities, namespaces and IP blocks",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{
+						From: []trafficpolicy.AuthorizationSource{
+							{Identities: []identity.ServiceIdentity{"sa-1.ns-1", "sa-2.ns-1"}},
+							{Namespaces: []string{"ns-2"}, IPBlocks: []string{"10.0.0.0/8", "192.168.1.1"}},
+						},
+					},
+				},
+			},
+			forHTTP: true,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				policy := policies["ns/authz/0"]
+				a.Len(policy.Principals, 2)
+
+				identities := policy.Principals[0].GetOrIds().Ids
+				a.Len(identities, 2)
+				a.Equal("sa-1.ns-1.cluster.local", identities[0].GetAuthenticated().PrincipalName.GetExact())
+
+				ids := policy.Principals[1].GetAndIds().Ids
+				a.Len(ids, 2)
+				a.Equal(`[^.]+\.ns-2\.cluster\.local`, ids[0].GetAuthenticated().PrincipalName.GetSafeRegex().Regex)
+				ipBlocks := ids[1].GetOrIds().Ids
+				a.Equal("10.0.0.0", ipBlocks[0].GetDirectRemoteIp().AddressPrefix)
+				a.Equal(uint32(8), ipBlocks[0].GetDirectRemoteIp().PrefixLen.Value)
+				a.Equal("192.168.1.1", ipBlocks[1].GetDirectRemoteIp().AddressPrefix)
+				a.Equal(uint32(32), ipBlocks[1].GetDirectRemoteIp().PrefixLen.Value)
+			},
+		},
+		{
+			name: "sources with identities and namespaces with SPIFFE enabled",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{
+						From: []trafficpolicy.AuthorizationSource{
+							{Identities: []identity.ServiceIdentity{"sa-1.ns-1"}},
+							{Namespaces: []string{"ns-2"}},
+						},
+					},
+				},
+			},
+			spiffeEnabled: true,
+			forHTTP:       true,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				policy := policies["ns/authz/0"]
+				a.Len(policy.Principals, 2)
+
+				a.Equal("spiffe://cluster.local/ns/ns-1/sa/sa-1", policy.Principals[0].GetAuthenticated().PrincipalName.GetExact())
+
+				a.Equal(`spiffe://cluster\.local/ns/ns-2/sa/[^/]+`, policy.Principals[1].GetAuthenticated().PrincipalName.GetSafeRegex().Regex)
+			},
+		},
+		{
+			name: "HTTP operation",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{To: []policyv1alpha1.AuthorizationOperationSpec{httpOperation}},
+				},
+			},
+			forHTTP: true,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				policy := policies["ns/authz/0"]
+				a.True(policy.Principals[0].GetAny())
+
+				rules := policy.Permissions[0].GetAndRules().Rules
+				a.Len(rules, 3)
+				a.Equal(uint32(8080), rules[0].GetDestinationPort())
+				a.Equal(methodHeaderKey, rules[1].GetHeader().Name)
+				a.Equal("GET", rules[1].GetHeader().GetStringMatch().GetExact())
+				a.Equal("/api/", rules[2].GetUrlPath().GetPath().GetPrefix())
+			},
+		},
+		{
+			name: "HTTP operation is not matched by TCP traffic for an ALLOW policy",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionAllow,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{To: []policyv1alpha1.AuthorizationOperationSpec{httpOperation}},
+				},
+			},
+			forHTTP: false,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				a.Empty(policies)
+			},
+		},
+		{
+			name: "HTTP conditions are ignored for TCP traffic for a DENY policy",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionDeny,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{To: []policyv1alpha1.AuthorizationOperationSpec{httpOperation}},
+				},
+			},
+			forHTTP: false,
+			assertFunc: func(a *tassert.Assertions, policies map[string]*xds_rbac.Policy) {
+				policy := policies["ns/authz/0"]
+				a.Equal(uint32(8080), policy.Permissions[0].GetDestinationPort())
+			},
+		},
+		{
+			name: "invalid IP block",
+			trafficTarget: trafficpolicy.TrafficTargetWithRoutes{
+				Name:   "ns/authz",
+				Action: policyv1alpha1.AuthorizationPolicyActionDeny,
+				AuthorizationRules: []trafficpolicy.AuthorizationRule{
+					{From: []trafficpolicy.AuthorizationSource{{IPBlocks: []string{"invalid"}}}},
+				},
+			},
+			forHTTP:   true,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			policies, err := BuildAuthorizationPolicies(tc.trafficTarget, "cluster.local", tc.spiffeEnabled, tc.forHTTP)
+			assert.Equal(tc.expectErr, err != nil)
+			if tc.assertFunc != nil {
+				tc.assertFunc(assert, policies)
+			}
+		})
+	}
+}
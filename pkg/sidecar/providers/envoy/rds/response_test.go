@@ -121,7 +121,7 @@ func TestNewResponse(t *testing.T) {
 								},
 								WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
 							},
-							AllowedPrincipals: mapset.NewSet(tests.BookstoreServiceAccount.AsPrincipal("cluster.local")),
+							AllowedPrincipals: mapset.NewSet(tests.BookstoreServiceAccount.AsPrincipal("cluster.local", false)),
 						},
 					},
 				},
@@ -138,7 +138,7 @@ func TestNewResponse(t *testing.T) {
 								},
 								WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
 							},
-							AllowedPrincipals: mapset.NewSet(tests.BookstoreServiceAccount.AsPrincipal("cluster.local")),
+							AllowedPrincipals: mapset.NewSet(tests.BookstoreServiceAccount.AsPrincipal("cluster.local", false)),
 						},
 					},
 				},
@@ -171,7 +171,7 @@ func TestNewResponse(t *testing.T) {
 								AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 									Name:      tests.BookbuyerServiceAccountName,
 									Namespace: tests.Namespace,
-								}.AsPrincipal("cluster.local")),
+								}.AsPrincipal("cluster.local", false)),
 							},
 							{
 								Route: trafficpolicy.RouteWeightedClusters{
@@ -184,7 +184,7 @@ func TestNewResponse(t *testing.T) {
 								AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 									Name:      tests.BookbuyerServiceAccountName,
 									Namespace: tests.Namespace,
-								}.AsPrincipal("cluster.local")),
+								}.AsPrincipal("cluster.local", false)),
 							},
 						},
 					},
@@ -214,7 +214,7 @@ func TestNewResponse(t *testing.T) {
 								AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 									Name:      tests.BookbuyerServiceAccountName,
 									Namespace: tests.Namespace,
-								}.AsPrincipal("cluster.local")),
+								}.AsPrincipal("cluster.local", false)),
 							},
 							{
 								Route: trafficpolicy.RouteWeightedClusters{
@@ -227,7 +227,7 @@ func TestNewResponse(t *testing.T) {
 								AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 									Name:      tests.BookbuyerServiceAccountName,
 									Namespace: tests.Namespace,
-								}.AsPrincipal("cluster.local")),
+								}.AsPrincipal("cluster.local", false)),
 							},
 						},
 					},
@@ -0,0 +1,153 @@
+package route
+
+import (
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_common_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/fault/v3"
+	xds_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/golang/protobuf/ptypes/any"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/protobuf"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// buildFaultInjectionRoutes returns the routes injecting faults into the requests matching
+// the HTTP request matches of the given fault injection policies. These routes must precede
+// the wildcard outbound route for the same weighted clusters. The delay or abort of the default
+// fault injection policy applies to the matching requests unless their own policy overrides it.
+func buildFaultInjectionRoutes(outRoute trafficpolicy.RouteWeightedClusters) []*xds_route.Route {
+	var routes []*xds_route.Route
+	defaultFault := getDefaultFault(outRoute.FaultInjections)
+	for _, fault := range outRoute.FaultInjections {
+		if len(fault.Matches) == 0 {
+			continue
+		}
+
+		faultConfig, err := getFaultFilterConfig(mergeDefaultFault(fault, defaultFault))
+		if err != nil {
+			log.Error().Err(err).Msgf("Error building fault injection config for destination %s, ignoring it", fault.Destination.Name)
+			continue
+		}
+
+		for _, match := range fault.Matches {
+			tempRoute := outRoute
+			tempRoute.HTTPRouteMatch = getFaultInjectionRouteMatch(match)
+			for _, method := range sanitizeHTTPMethods(tempRoute.HTTPRouteMatch.Methods) {
+				route := buildRoute(tempRoute, method)
+				route.TypedPerFilterConfig = map[string]*any.Any{
+					envoy.HTTPFaultFilterName: faultConfig,
+				}
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	return routes
+}
+
+// applyOutboundFaultInjection applies the default fault injection policy to the given route
+func applyOutboundFaultInjection(route *xds_route.Route, faults []*policyv1alpha1.FaultInjectionSpec) {
+	fault := getDefaultFault(faults)
+	if fault == nil {
+		return
+	}
+
+	faultConfig, err := getFaultFilterConfig(fault)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error building fault injection config for destination %s, ignoring it", fault.Destination.Name)
+		return
+	}
+	route.TypedPerFilterConfig = map[string]*any.Any{
+		envoy.HTTPFaultFilterName: faultConfig,
+	}
+}
+
+// getDefaultFault returns the first fault injection policy without HTTP request matches,
+// since such a policy applies to all requests to the destination
+func getDefaultFault(faults []*policyv1alpha1.FaultInjectionSpec) *policyv1alpha1.FaultInjectionSpec {
+	for _, fault := range faults {
+		if len(fault.Matches) == 0 {
+			return fault
+		}
+	}
+	return nil
+}
+
+// mergeDefaultFault returns the given fault injection policy with the delay and abort it does not
+// specify inherited from the default fault injection policy
+func mergeDefaultFault(fault, defaultFault *policyv1alpha1.FaultInjectionSpec) *policyv1alpha1.FaultInjectionSpec {
+	if defaultFault == nil || (fault.Delay != nil && fault.Abort != nil) {
+		return fault
+	}
+
+	merged := *fault
+	if merged.Delay == nil {
+		merged.Delay = defaultFault.Delay
+	}
+	if merged.Abort == nil {
+		merged.Abort = defaultFault.Abort
+	}
+	return &merged
+}
+
+// getFaultInjectionRouteMatch returns the HTTPRouteMatch corresponding to the given fault injection match
+func getFaultInjectionRouteMatch(match policyv1alpha1.FaultInjectionHTTPMatchSpec) trafficpolicy.HTTPRouteMatch {
+	routeMatch := trafficpolicy.HTTPRouteMatch{
+		Path:          match.Path,
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       match.Methods,
+		Headers:       match.Headers,
+	}
+
+	switch match.PathMatchType {
+	case policyv1alpha1.PathMatchTypeExact:
+		routeMatch.PathMatchType = trafficpolicy.PathMatchExact
+	case policyv1alpha1.PathMatchTypePrefix:
+		routeMatch.PathMatchType = trafficpolicy.PathMatchPrefix
+	}
+
+	if routeMatch.Path == "" {
+		routeMatch.Path = constants.RegexMatchAll
+		routeMatch.PathMatchType = trafficpolicy.PathMatchRegex
+	}
+	if len(routeMatch.Methods) == 0 {
+		routeMatch.Methods = []string{constants.WildcardHTTPMethod}
+	}
+
+	return routeMatch
+}
+
+// getFaultFilterConfig returns the marshalled HTTP fault filter config for the given fault injection policy
+func getFaultFilterConfig(fault *policyv1alpha1.FaultInjectionSpec) (*any.Any, error) {
+	httpFault := &xds_http_fault.HTTPFault{}
+
+	if fault.Delay != nil {
+		httpFault.Delay = &xds_common_fault.FaultDelay{
+			FaultDelaySecifier: &xds_common_fault.FaultDelay_FixedDelay{
+				FixedDelay: durationpb.New(fault.Delay.FixedDelay.Duration),
+			},
+			Percentage: &xds_type.FractionalPercent{
+				Numerator:   fault.Delay.Percentage,
+				Denominator: xds_type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+
+	if fault.Abort != nil {
+		httpFault.Abort = &xds_http_fault.FaultAbort{
+			ErrorType: &xds_http_fault.FaultAbort_HttpStatus{
+				HttpStatus: fault.Abort.HTTPStatus,
+			},
+			Percentage: &xds_type.FractionalPercent{
+				Numerator:   fault.Abort.Percentage,
+				Denominator: xds_type.FractionalPercent_HUNDRED,
+			},
+		}
+	}
+
+	return protobuf.MarshalAny(httpFault)
+}
@@ -0,0 +1,221 @@
+package route
+
+import (
+	"testing"
+	"time"
+
+	mapset "github.com/deckarep/golang-set"
+	xds_http_fault "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/fault/v3"
+	tassert "github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildOutboundRoutesWithFaultInjection(t *testing.T) {
+	delayFault := &policyv1alpha1.FaultInjectionSpec{
+		Matches: []policyv1alpha1.FaultInjectionHTTPMatchSpec{
+			{
+				Path:          "/books",
+				PathMatchType: policyv1alpha1.PathMatchTypePrefix,
+				Methods:       []string{"GET", "POST"},
+			},
+			{
+				Headers: map[string]string{"user": "tester"},
+			},
+		},
+		Delay: &policyv1alpha1.FaultDelaySpec{
+			Percentage: 50,
+			FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+		},
+	}
+	abortFault := &policyv1alpha1.FaultInjectionSpec{
+		Abort: &policyv1alpha1.FaultAbortSpec{
+			Percentage: 10,
+			HTTPStatus: 503,
+		},
+	}
+
+	testCases := []struct {
+		name                string
+		faults              []*policyv1alpha1.FaultInjectionSpec
+		expectedRoutes      int
+		expectedFaultRoutes int
+		expectWildcardFault bool
+	}{
+		{
+			name:                "no fault injection",
+			faults:              nil,
+			expectedRoutes:      1,
+			expectedFaultRoutes: 0,
+			expectWildcardFault: false,
+		},
+		{
+			name:                "fault injection with matches",
+			faults:              []*policyv1alpha1.FaultInjectionSpec{delayFault},
+			expectedRoutes:      4, // 2 methods for the first match + 1 for the second match + wildcard route
+			expectedFaultRoutes: 3,
+			expectWildcardFault: false,
+		},
+		{
+			name:                "fault injection without matches",
+			faults:              []*policyv1alpha1.FaultInjectionSpec{abortFault},
+			expectedRoutes:      1,
+			expectedFaultRoutes: 1,
+			expectWildcardFault: true,
+		},
+		{
+			name:                "fault injection with and without matches",
+			faults:              []*policyv1alpha1.FaultInjectionSpec{delayFault, abortFault},
+			expectedRoutes:      4,
+			expectedFaultRoutes: 4,
+			expectWildcardFault: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			routes := buildOutboundRoutes([]*trafficpolicy.RouteWeightedClusters{
+				{
+					HTTPRouteMatch: trafficpolicy.WildCardRouteMatch,
+					WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+						service.WeightedCluster{ClusterName: "default/bookstore-v1|80", Weight: 100},
+					}),
+					FaultInjections: tc.faults,
+				},
+			})
+			a.Len(routes, tc.expectedRoutes)
+
+			faultRoutes := 0
+			for _, route := range routes {
+				if _, ok := route.TypedPerFilterConfig[envoy.HTTPFaultFilterName]; ok {
+					faultRoutes++
+				}
+			}
+			a.Equal(tc.expectedFaultRoutes, faultRoutes)
+
+			// The wildcard route must be last
+			wildcardRoute := routes[len(routes)-1]
+			a.Equal(constants.RegexMatchAll, wildcardRoute.GetMatch().GetSafeRegex().Regex)
+			_, ok := wildcardRoute.TypedPerFilterConfig[envoy.HTTPFaultFilterName]
+			a.Equal(tc.expectWildcardFault, ok)
+		})
+	}
+}
+
+func TestMergeDefaultFault(t *testing.T) {
+	delay := &policyv1alpha1.FaultDelaySpec{
+		Percentage: 50,
+		FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+	}
+	abort := &policyv1alpha1.FaultAbortSpec{
+		Percentage: 10,
+		HTTPStatus: 503,
+	}
+	otherAbort := &policyv1alpha1.FaultAbortSpec{
+		Percentage: 100,
+		HTTPStatus: 500,
+	}
+	matches := []policyv1alpha1.FaultInjectionHTTPMatchSpec{{Path: "/books"}}
+
+	testCases := []struct {
+		name          string
+		fault         *policyv1alpha1.FaultInjectionSpec
+		defaultFault  *policyv1alpha1.FaultInjectionSpec
+		expectedDelay *policyv1alpha1.FaultDelaySpec
+		expectedAbort *policyv1alpha1.FaultAbortSpec
+	}{
+		{
+			name:          "no default fault",
+			fault:         &policyv1alpha1.FaultInjectionSpec{Matches: matches, Delay: delay},
+			defaultFault:  nil,
+			expectedDelay: delay,
+			expectedAbort: nil,
+		},
+		{
+			name:          "abort inherited from the default fault",
+			fault:         &policyv1alpha1.FaultInjectionSpec{Matches: matches, Delay: delay},
+			defaultFault:  &policyv1alpha1.FaultInjectionSpec{Abort: abort},
+			expectedDelay: delay,
+			expectedAbort: abort,
+		},
+		{
+			name:          "abort of the default fault overridden",
+			fault:         &policyv1alpha1.FaultInjectionSpec{Matches: matches, Abort: otherAbort},
+			defaultFault:  &policyv1alpha1.FaultInjectionSpec{Delay: delay, Abort: abort},
+			expectedDelay: delay,
+			expectedAbort: otherAbort,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			merged := mergeDefaultFault(tc.fault, tc.defaultFault)
+			a.Equal(tc.expectedDelay, merged.Delay)
+			a.Equal(tc.expectedAbort, merged.Abort)
+			a.Equal(tc.fault.Matches, merged.Matches)
+		})
+	}
+}
+
+func TestGetFaultInjectionRouteMatch(t *testing.T) {
+	a := tassert.New(t)
+
+	match := getFaultInjectionRouteMatch(policyv1alpha1.FaultInjectionHTTPMatchSpec{
+		Path:          "/books",
+		PathMatchType: policyv1alpha1.PathMatchTypeExact,
+	})
+	a.Equal(trafficpolicy.HTTPRouteMatch{
+		Path:          "/books",
+		PathMatchType: trafficpolicy.PathMatchExact,
+		Methods:       []string{constants.WildcardHTTPMethod},
+	}, match)
+
+	match = getFaultInjectionRouteMatch(policyv1alpha1.FaultInjectionHTTPMatchSpec{
+		PathMatchType: policyv1alpha1.PathMatchTypePrefix,
+		Methods:       []string{"GET"},
+	})
+	a.Equal(trafficpolicy.HTTPRouteMatch{
+		Path:          constants.RegexMatchAll,
+		PathMatchType: trafficpolicy.PathMatchRegex,
+		Methods:       []string{"GET"},
+	}, match)
+}
+
+func TestGetFaultFilterConfig(t *testing.T) {
+	a := tassert.New(t)
+
+	config, err := getFaultFilterConfig(&policyv1alpha1.FaultInjectionSpec{
+		Delay: &policyv1alpha1.FaultDelaySpec{
+			Percentage: 50,
+			FixedDelay: metav1.Duration{Duration: 2 * time.Second},
+		},
+		Abort: &policyv1alpha1.FaultAbortSpec{
+			Percentage: 10,
+			HTTPStatus: 503,
+		},
+	})
+	a.Nil(err)
+
+	httpFault := &xds_http_fault.HTTPFault{}
+	a.Nil(config.UnmarshalTo(httpFault))
+	a.Equal(uint32(50), httpFault.Delay.Percentage.Numerator)
+	a.Equal(int64(2), httpFault.Delay.GetFixedDelay().Seconds)
+	a.Equal(uint32(10), httpFault.Abort.Percentage.Numerator)
+	a.Equal(uint32(503), httpFault.Abort.GetHttpStatus())
+
+	config, err = getFaultFilterConfig(&policyv1alpha1.FaultInjectionSpec{})
+	a.Nil(err)
+	httpFault = &xds_http_fault.HTTPFault{}
+	a.Nil(config.UnmarshalTo(httpFault))
+	a.Nil(httpFault.Delay)
+	a.Nil(httpFault.Abort)
+}
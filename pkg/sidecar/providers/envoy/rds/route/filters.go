@@ -0,0 +1,165 @@
+package route
+
+import (
+	"net/http"
+	"sort"
+
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_matcher "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"github.com/golang/protobuf/ptypes/wrappers"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+const (
+	// fullPathRegex is the regex matching the whole request path, used to replace it
+	fullPathRegex = "^.*$"
+)
+
+// redirectResponseCodes maps the HTTP redirect status codes to the Envoy redirect response codes
+var redirectResponseCodes = map[int]xds_route.RedirectAction_RedirectResponseCode{
+	http.StatusMovedPermanently:  xds_route.RedirectAction_MOVED_PERMANENTLY,
+	http.StatusFound:             xds_route.RedirectAction_FOUND,
+	http.StatusSeeOther:          xds_route.RedirectAction_SEE_OTHER,
+	http.StatusTemporaryRedirect: xds_route.RedirectAction_TEMPORARY_REDIRECT,
+	http.StatusPermanentRedirect: xds_route.RedirectAction_PERMANENT_REDIRECT,
+}
+
+// applyRouteFilters applies the given filters to the given route. A route with a redirect filter
+// replies with a redirect response in place of forwarding the requests.
+func applyRouteFilters(route *xds_route.Route, filters *trafficpolicy.HTTPRouteFilters, pathMatchType trafficpolicy.PathMatchType) {
+	if route == nil || filters == nil {
+		return
+	}
+
+	if filters.RequestHeaders != nil {
+		route.RequestHeadersToAdd = buildHeaderValueOptions(filters.RequestHeaders)
+		route.RequestHeadersToRemove = filters.RequestHeaders.Remove
+	}
+	if filters.ResponseHeaders != nil {
+		route.ResponseHeadersToAdd = buildHeaderValueOptions(filters.ResponseHeaders)
+		route.ResponseHeadersToRemove = filters.ResponseHeaders.Remove
+	}
+
+	if filters.Redirect != nil {
+		route.Action = &xds_route.Route_Redirect{
+			Redirect: buildRedirectAction(filters.Redirect, pathMatchType),
+		}
+		return
+	}
+
+	routeAction := route.GetRoute()
+	if filters.URLRewrite == nil || routeAction == nil {
+		return
+	}
+	if filters.URLRewrite.Hostname != "" {
+		routeAction.HostRewriteSpecifier = &xds_route.RouteAction_HostRewriteLiteral{
+			HostRewriteLiteral: filters.URLRewrite.Hostname,
+		}
+	}
+	if path := filters.URLRewrite.Path; path != nil {
+		switch {
+		case path.ReplaceFullPath != nil:
+			routeAction.RegexRewrite = buildRegexRewrite(fullPathRegex, *path.ReplaceFullPath)
+
+		case path.ReplacePrefixMatch != nil:
+			if pathMatchType != trafficpolicy.PathMatchPrefix {
+				log.Error().Msg("Replacing the prefix of the request path requires a prefix path match, ignoring the path rewrite")
+				break
+			}
+			routeAction.PrefixRewrite = *path.ReplacePrefixMatch
+
+		case path.ReplaceRegex != nil:
+			routeAction.RegexRewrite = buildRegexRewrite(path.ReplaceRegex.Pattern, path.ReplaceRegex.Substitution)
+		}
+	}
+}
+
+// buildRegexRewrite returns the rewrite of the parts of the request path matching the given RE2 regex
+func buildRegexRewrite(regex string, substitution string) *xds_matcher.RegexMatchAndSubstitute {
+	return &xds_matcher.RegexMatchAndSubstitute{
+		Pattern: &xds_matcher.RegexMatcher{
+			EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+			Regex:      regex,
+		},
+		Substitution: substitution,
+	}
+}
+
+// buildHeaderValueOptions returns the header value options corresponding to the headers set and added
+// by the given modifier, sorted by header name
+func buildHeaderValueOptions(modifier *trafficpolicy.HTTPHeaderModifier) []*xds_core.HeaderValueOption {
+	var hvOptions []*xds_core.HeaderValueOption
+	for _, header := range sortedKeys(modifier.Set) {
+		hvOptions = append(hvOptions, &xds_core.HeaderValueOption{
+			Header: &xds_core.HeaderValue{
+				Key:   header,
+				Value: modifier.Set[header],
+			},
+			Append: &wrappers.BoolValue{Value: false},
+		})
+	}
+	for _, header := range sortedKeys(modifier.Add) {
+		hvOptions = append(hvOptions, &xds_core.HeaderValueOption{
+			Header: &xds_core.HeaderValue{
+				Key:   header,
+				Value: modifier.Add[header],
+			},
+			Append: &wrappers.BoolValue{Value: true},
+		})
+	}
+	return hvOptions
+}
+
+// buildRedirectAction returns the redirect action corresponding to the given redirect filter
+func buildRedirectAction(redirect *trafficpolicy.HTTPRequestRedirect, pathMatchType trafficpolicy.PathMatchType) *xds_route.RedirectAction {
+	redirectAction := &xds_route.RedirectAction{
+		HostRedirect: redirect.Hostname,
+		PortRedirect: uint32(redirect.Port),
+		ResponseCode: xds_route.RedirectAction_FOUND,
+	}
+
+	if redirect.StatusCode != 0 {
+		if responseCode, ok := redirectResponseCodes[redirect.StatusCode]; ok {
+			redirectAction.ResponseCode = responseCode
+		} else {
+			log.Error().Msgf("Unsupported redirect status code %d, using %d", redirect.StatusCode, http.StatusFound)
+		}
+	}
+
+	if redirect.Scheme != "" {
+		redirectAction.SchemeRewriteSpecifier = &xds_route.RedirectAction_SchemeRedirect{
+			SchemeRedirect: redirect.Scheme,
+		}
+	}
+
+	if path := redirect.Path; path != nil {
+		switch {
+		case path.ReplaceFullPath != nil:
+			redirectAction.PathRewriteSpecifier = &xds_route.RedirectAction_PathRedirect{
+				PathRedirect: *path.ReplaceFullPath,
+			}
+
+		case path.ReplacePrefixMatch != nil:
+			if pathMatchType != trafficpolicy.PathMatchPrefix {
+				log.Error().Msg("Replacing the prefix of the request path requires a prefix path match, ignoring the redirect path")
+				break
+			}
+			redirectAction.PathRewriteSpecifier = &xds_route.RedirectAction_PrefixRewrite{
+				PrefixRewrite: *path.ReplacePrefixMatch,
+			}
+		}
+	}
+
+	return redirectAction
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,226 @@
+package route
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildOutboundRoutesWithOutboundMatch(t *testing.T) {
+	assert := tassert.New(t)
+
+	outRoutes := []*trafficpolicy.RouteWeightedClusters{
+		{
+			HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/v2",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+				Methods:       []string{"GET", "POST", "GET"},
+				Headers:       map[string]string{"x-version": "^v2$"},
+			},
+			WeightedClusters: mapset.NewSet(service.WeightedCluster{
+				ClusterName: "default/bookstore-v2|8080",
+				Weight:      constants.ClusterWeightAcceptAll,
+			}),
+			OutboundMatch: true,
+		},
+		{
+			HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+				Path:          "/",
+				PathMatchType: trafficpolicy.PathMatchPrefix,
+				Methods:       []string{constants.WildcardHTTPMethod},
+			},
+			WeightedClusters: mapset.NewSet(service.WeightedCluster{
+				ClusterName: "default/bookstore-v1|8080",
+				Weight:      constants.ClusterWeightAcceptAll,
+			}),
+			OutboundMatch: true,
+		},
+	}
+
+	routes := buildOutboundRoutes(outRoutes)
+	assert.Len(routes, 3)
+
+	assert.Equal("/v2", routes[0].Match.GetPrefix())
+	assert.Len(routes[0].Match.Headers, 2)
+	assert.Equal(getRegexForMethod("GET"), routes[0].Match.Headers[0].GetSafeRegexMatch().Regex)
+	assert.Equal("x-version", routes[0].Match.Headers[1].Name)
+	assert.Equal("default/bookstore-v2|8080", routes[0].GetRoute().GetWeightedClusters().Clusters[0].Name)
+
+	assert.Equal("/v2", routes[1].Match.GetPrefix())
+	assert.Equal(getRegexForMethod("POST"), routes[1].Match.Headers[0].GetSafeRegexMatch().Regex)
+
+	assert.Equal("/", routes[2].Match.GetPrefix())
+	assert.Len(routes[2].Match.Headers, 1)
+	assert.Equal("default/bookstore-v1|8080", routes[2].GetRoute().GetWeightedClusters().Clusters[0].Name)
+}
+
+func TestApplyRouteFilters(t *testing.T) {
+	newPath := "/new"
+
+	testCases := []struct {
+		name          string
+		filters       *trafficpolicy.HTTPRouteFilters
+		pathMatchType trafficpolicy.PathMatchType
+		verify        func(*tassert.Assertions, *xds_route.Route)
+	}{
+		{
+			name:    "no filters",
+			filters: nil,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Nil(route.RequestHeadersToAdd)
+				a.Nil(route.ResponseHeadersToAdd)
+				a.NotNil(route.GetRoute().GetWeightedClusters())
+				a.Empty(route.GetRoute().PrefixRewrite)
+			},
+		},
+		{
+			name: "header modifiers",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				RequestHeaders: &trafficpolicy.HTTPHeaderModifier{
+					Set:    map[string]string{"x-b": "b", "x-a": "a"},
+					Add:    map[string]string{"x-c": "c"},
+					Remove: []string{"x-d"},
+				},
+				ResponseHeaders: &trafficpolicy.HTTPHeaderModifier{
+					Remove: []string{"server"},
+				},
+			},
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Len(route.RequestHeadersToAdd, 3)
+				a.Equal("x-a", route.RequestHeadersToAdd[0].Header.Key)
+				a.False(route.RequestHeadersToAdd[0].Append.Value)
+				a.Equal("x-b", route.RequestHeadersToAdd[1].Header.Key)
+				a.Equal("b", route.RequestHeadersToAdd[1].Header.Value)
+				a.Equal("x-c", route.RequestHeadersToAdd[2].Header.Key)
+				a.True(route.RequestHeadersToAdd[2].Append.Value)
+				a.Equal([]string{"x-d"}, route.RequestHeadersToRemove)
+				a.Empty(route.ResponseHeadersToAdd)
+				a.Equal([]string{"server"}, route.ResponseHeadersToRemove)
+			},
+		},
+		{
+			name: "URL rewrite with a prefix",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				URLRewrite: &trafficpolicy.HTTPURLRewrite{
+					Hostname: "bookstore.default",
+					Path:     &trafficpolicy.HTTPPathModifier{ReplacePrefixMatch: &newPath},
+				},
+			},
+			pathMatchType: trafficpolicy.PathMatchPrefix,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Equal("bookstore.default", route.GetRoute().GetHostRewriteLiteral())
+				a.Equal(newPath, route.GetRoute().PrefixRewrite)
+				a.Nil(route.GetRoute().RegexRewrite)
+			},
+		},
+		{
+			name: "URL rewrite with a prefix ignored without a prefix path match",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				URLRewrite: &trafficpolicy.HTTPURLRewrite{
+					Path: &trafficpolicy.HTTPPathModifier{ReplacePrefixMatch: &newPath},
+				},
+			},
+			pathMatchType: trafficpolicy.PathMatchExact,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Empty(route.GetRoute().PrefixRewrite)
+				a.Nil(route.GetRoute().HostRewriteSpecifier)
+			},
+		},
+		{
+			name: "URL rewrite with a full path",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				URLRewrite: &trafficpolicy.HTTPURLRewrite{
+					Path: &trafficpolicy.HTTPPathModifier{ReplaceFullPath: &newPath},
+				},
+			},
+			pathMatchType: trafficpolicy.PathMatchExact,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Equal(fullPathRegex, route.GetRoute().RegexRewrite.Pattern.Regex)
+				a.Equal(newPath, route.GetRoute().RegexRewrite.Substitution)
+			},
+		},
+		{
+			name: "URL rewrite with a regex",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				URLRewrite: &trafficpolicy.HTTPURLRewrite{
+					Path: &trafficpolicy.HTTPPathModifier{
+						ReplaceRegex: &trafficpolicy.HTTPRegexReplace{Pattern: "^/old/(.*)$", Substitution: "/new/\\1"},
+					},
+				},
+			},
+			pathMatchType: trafficpolicy.PathMatchRegex,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Equal("^/old/(.*)$", route.GetRoute().RegexRewrite.Pattern.Regex)
+				a.Equal("/new/\\1", route.GetRoute().RegexRewrite.Substitution)
+				a.Empty(route.GetRoute().PrefixRewrite)
+			},
+		},
+		{
+			name: "redirect",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				Redirect: &trafficpolicy.HTTPRequestRedirect{
+					Scheme:     "https",
+					Hostname:   "bookstore.example.com",
+					Port:       8443,
+					Path:       &trafficpolicy.HTTPPathModifier{ReplaceFullPath: &newPath},
+					StatusCode: 301,
+				},
+			},
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				a.Nil(route.GetRoute())
+				redirect := route.GetRedirect()
+				a.NotNil(redirect)
+				a.Equal("https", redirect.GetSchemeRedirect())
+				a.Equal("bookstore.example.com", redirect.HostRedirect)
+				a.Equal(uint32(8443), redirect.PortRedirect)
+				a.Equal(newPath, redirect.GetPathRedirect())
+				a.Equal(xds_route.RedirectAction_MOVED_PERMANENTLY, redirect.ResponseCode)
+			},
+		},
+		{
+			name: "redirect with a prefix and an unsupported status code",
+			filters: &trafficpolicy.HTTPRouteFilters{
+				Redirect: &trafficpolicy.HTTPRequestRedirect{
+					Path:       &trafficpolicy.HTTPPathModifier{ReplacePrefixMatch: &newPath},
+					StatusCode: 300,
+				},
+			},
+			pathMatchType: trafficpolicy.PathMatchPrefix,
+			verify: func(a *tassert.Assertions, route *xds_route.Route) {
+				redirect := route.GetRedirect()
+				a.NotNil(redirect)
+				a.Nil(redirect.SchemeRewriteSpecifier)
+				a.Equal(newPath, redirect.GetPrefixRewrite())
+				a.Equal(xds_route.RedirectAction_FOUND, redirect.ResponseCode)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			outRoute := trafficpolicy.RouteWeightedClusters{
+				HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+					Path:          "/old",
+					PathMatchType: tc.pathMatchType,
+				},
+				WeightedClusters: mapset.NewSet(service.WeightedCluster{
+					ClusterName: "default/bookstore|8080",
+					Weight:      constants.ClusterWeightAcceptAll,
+				}),
+				Filters: tc.filters,
+			}
+			if tc.filters != nil && tc.filters.Redirect != nil {
+				outRoute.WeightedClusters = mapset.NewSet()
+			}
+
+			route := buildRoute(outRoute, constants.WildcardHTTPMethod)
+			tc.verify(tassert.New(t), route)
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package route
+
+import (
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// buildHashPolicy returns the hash policies used by the consistent hashing load balancers
+// of the upstream clusters. Session affinity generates a cookie when the request does not
+// carry one, pinning the client to the selected upstream endpoint.
+func buildHashPolicy(loadBalancer *policyv1alpha1.LoadBalancerSpec) []*xds_route.RouteAction_HashPolicy {
+	if loadBalancer == nil {
+		return nil
+	}
+
+	lbType := loadBalancer.Type
+	if lbType == "" && loadBalancer.SessionAffinity != nil {
+		lbType = policyv1alpha1.RingHashLoadBalancer
+	}
+	if lbType != policyv1alpha1.RingHashLoadBalancer && lbType != policyv1alpha1.MaglevLoadBalancer {
+		return nil
+	}
+
+	if affinity := loadBalancer.SessionAffinity; affinity != nil {
+		cookieName := affinity.CookieName
+		if cookieName == "" {
+			cookieName = constants.SessionAffinityCookieName
+		}
+		ttl := constants.SessionAffinityCookieTTL
+		if affinity.TTL != nil {
+			ttl = affinity.TTL.Duration
+		}
+		return []*xds_route.RouteAction_HashPolicy{
+			{
+				PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+					Cookie: &xds_route.RouteAction_HashPolicy_Cookie{
+						Name: cookieName,
+						Ttl:  durationpb.New(ttl),
+						Path: "/",
+					},
+				},
+			},
+		}
+	}
+
+	hash := loadBalancer.ConsistentHash
+	switch {
+	case hash != nil && hash.Header != "":
+		return []*xds_route.RouteAction_HashPolicy{
+			{
+				PolicySpecifier: &xds_route.RouteAction_HashPolicy_Header_{
+					Header: &xds_route.RouteAction_HashPolicy_Header{
+						HeaderName: hash.Header,
+					},
+				},
+			},
+		}
+
+	case hash != nil && hash.Cookie != "":
+		return []*xds_route.RouteAction_HashPolicy{
+			{
+				PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+					Cookie: &xds_route.RouteAction_HashPolicy_Cookie{
+						Name: hash.Cookie,
+					},
+				},
+			},
+		}
+
+	default:
+		return []*xds_route.RouteAction_HashPolicy{
+			{
+				PolicySpecifier: &xds_route.RouteAction_HashPolicy_ConnectionProperties_{
+					ConnectionProperties: &xds_route.RouteAction_HashPolicy_ConnectionProperties{
+						SourceIp: true,
+					},
+				},
+			},
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package route
+
+import (
+	"testing"
+	"time"
+
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/durationpb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+func TestBuildHashPolicy(t *testing.T) {
+	testCases := []struct {
+		name         string
+		loadBalancer *policyv1alpha1.LoadBalancerSpec
+		expected     []*xds_route.RouteAction_HashPolicy
+	}{
+		{
+			name:         "no load balancer settings",
+			loadBalancer: nil,
+			expected:     nil,
+		},
+		{
+			name: "load balancer not based on consistent hashing",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.LeastRequestLoadBalancer,
+				ConsistentHash: &policyv1alpha1.ConsistentHashSpec{Header: "x-user"},
+			},
+			expected: nil,
+		},
+		{
+			name: "ring hash on a header",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.RingHashLoadBalancer,
+				ConsistentHash: &policyv1alpha1.ConsistentHashSpec{Header: "x-user"},
+			},
+			expected: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Header_{
+						Header: &xds_route.RouteAction_HashPolicy_Header{HeaderName: "x-user"},
+					},
+				},
+			},
+		},
+		{
+			name: "maglev on a cookie",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type:           policyv1alpha1.MaglevLoadBalancer,
+				ConsistentHash: &policyv1alpha1.ConsistentHashSpec{Cookie: "user"},
+			},
+			expected: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+						Cookie: &xds_route.RouteAction_HashPolicy_Cookie{Name: "user"},
+					},
+				},
+			},
+		},
+		{
+			name: "ring hash defaults to the source IP",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type: policyv1alpha1.RingHashLoadBalancer,
+			},
+			expected: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_ConnectionProperties_{
+						ConnectionProperties: &xds_route.RouteAction_HashPolicy_ConnectionProperties{SourceIp: true},
+					},
+				},
+			},
+		},
+		{
+			name: "session affinity with defaults",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				SessionAffinity: &policyv1alpha1.SessionAffinitySpec{},
+			},
+			expected: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+						Cookie: &xds_route.RouteAction_HashPolicy_Cookie{
+							Name: constants.SessionAffinityCookieName,
+							Ttl:  durationpb.New(constants.SessionAffinityCookieTTL),
+							Path: "/",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "session affinity with a custom cookie",
+			loadBalancer: &policyv1alpha1.LoadBalancerSpec{
+				Type: policyv1alpha1.MaglevLoadBalancer,
+				SessionAffinity: &policyv1alpha1.SessionAffinitySpec{
+					CookieName: "bookstore-session",
+					TTL:        &metav1.Duration{Duration: 10 * time.Minute},
+				},
+			},
+			expected: []*xds_route.RouteAction_HashPolicy{
+				{
+					PolicySpecifier: &xds_route.RouteAction_HashPolicy_Cookie_{
+						Cookie: &xds_route.RouteAction_HashPolicy_Cookie{
+							Name: "bookstore-session",
+							Ttl:  durationpb.New(10 * time.Minute),
+							Path: "/",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, buildHashPolicy(tc.loadBalancer))
+		})
+	}
+}
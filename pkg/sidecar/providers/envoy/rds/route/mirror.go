@@ -0,0 +1,31 @@
+package route
+
+import (
+	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// buildRequestMirrorPolicies returns the policies mirroring the requests to the given clusters.
+// Envoy appends the '-shadow' suffix to the host header of the mirrored requests and
+// discards their responses.
+func buildRequestMirrorPolicies(mirrorClusters []*trafficpolicy.MirrorCluster) []*xds_route.RouteAction_RequestMirrorPolicy {
+	var mirrorPolicies []*xds_route.RouteAction_RequestMirrorPolicy
+	for _, mirrorCluster := range mirrorClusters {
+		if mirrorCluster == nil || mirrorCluster.Percentage == 0 {
+			continue
+		}
+		mirrorPolicies = append(mirrorPolicies, &xds_route.RouteAction_RequestMirrorPolicy{
+			Cluster: mirrorCluster.ClusterName.String(),
+			RuntimeFraction: &xds_core.RuntimeFractionalPercent{
+				DefaultValue: &xds_type.FractionalPercent{
+					Numerator:   mirrorCluster.Percentage,
+					Denominator: xds_type.FractionalPercent_HUNDRED,
+				},
+			},
+		})
+	}
+	return mirrorPolicies
+}
@@ -0,0 +1,43 @@
+package route
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	"github.com/openservicemesh/osm/pkg/constants"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestBuildOutboundRoutesWithRequestMirroring(t *testing.T) {
+	assert := tassert.New(t)
+
+	outRoute := &trafficpolicy.RouteWeightedClusters{
+		HTTPRouteMatch: trafficpolicy.WildCardRouteMatch,
+		WeightedClusters: mapset.NewSet(service.WeightedCluster{
+			ClusterName: "default/bookstore|8080",
+			Weight:      constants.ClusterWeightAcceptAll,
+		}),
+		MirrorClusters: []*trafficpolicy.MirrorCluster{
+			{ClusterName: "default/bookstore-shadow|8080", Percentage: 25},
+			{ClusterName: "default/bookstore-disabled|8080", Percentage: 0},
+		},
+	}
+
+	routes := buildOutboundRoutes([]*trafficpolicy.RouteWeightedClusters{outRoute})
+	assert.Len(routes, 1)
+
+	mirrorPolicies := routes[0].GetRoute().RequestMirrorPolicies
+	assert.Len(mirrorPolicies, 1)
+	assert.Equal("default/bookstore-shadow|8080", mirrorPolicies[0].Cluster)
+	assert.Equal(uint32(25), mirrorPolicies[0].RuntimeFraction.DefaultValue.Numerator)
+	assert.Equal(xds_type.FractionalPercent_HUNDRED, mirrorPolicies[0].RuntimeFraction.DefaultValue.Denominator)
+
+	outRoute.MirrorClusters = nil
+	routes = buildOutboundRoutes([]*trafficpolicy.RouteWeightedClusters{outRoute})
+	assert.Len(routes, 1)
+	assert.Nil(routes[0].GetRoute().RequestMirrorPolicies)
+}
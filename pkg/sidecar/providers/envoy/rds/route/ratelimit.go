@@ -0,0 +1,174 @@
+package route
+
+import (
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	xds_route "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	xds_metadata "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rbac"
+)
+
+const (
+	// pathHeaderKey is the key of the pseudo header for the HTTP request path
+	pathHeaderKey = ":path"
+
+	// defaultGenericKeyDescriptorKey is the default key of a generic key descriptor entry
+	defaultGenericKeyDescriptorKey = "generic_key"
+
+	// defaultPathDescriptorKey is the default key of a request path descriptor entry
+	defaultPathDescriptorKey = "path"
+
+	// defaultSourceIdentityDescriptorKey is the default key of a source identity descriptor entry
+	defaultSourceIdentityDescriptorKey = "source_identity"
+
+	// rbacShadowEffectivePolicyIDKey is the dynamic metadata key set by the RBAC filter to the name
+	// of the shadow policy matching the request
+	rbacShadowEffectivePolicyIDKey = "shadow_effective_policy_id"
+)
+
+// buildRateLimits returns the list of Envoy rate limit configurations corresponding to the given descriptors
+func buildRateLimits(descriptors []policyv1alpha1.HTTPRateLimitDescriptor) []*xds_route.RateLimit {
+	var rateLimits []*xds_route.RateLimit
+
+	for _, descriptor := range descriptors {
+		rl := &xds_route.RateLimit{}
+		for _, entry := range descriptor.Entries {
+			if action := buildRateLimitAction(entry); action != nil {
+				rl.Actions = append(rl.Actions, action)
+			}
+		}
+		if len(rl.Actions) == 0 {
+			continue
+		}
+		rateLimits = append(rateLimits, rl)
+	}
+
+	return rateLimits
+}
+
+// buildRateLimitAction returns the Envoy rate limit action corresponding to the given descriptor entry
+func buildRateLimitAction(entry policyv1alpha1.HTTPRateLimitDescriptorEntry) *xds_route.RateLimit_Action {
+	switch {
+	case entry.GenericKey != nil:
+		key := entry.GenericKey.Key
+		if key == "" {
+			key = defaultGenericKeyDescriptorKey
+		}
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_GenericKey_{
+				GenericKey: &xds_route.RateLimit_Action_GenericKey{
+					DescriptorKey:   key,
+					DescriptorValue: entry.GenericKey.Value,
+				},
+			},
+		}
+
+	case entry.RequestHeader != nil:
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &xds_route.RateLimit_Action_RequestHeaders{
+					HeaderName:    entry.RequestHeader.Name,
+					DescriptorKey: entry.RequestHeader.Key,
+				},
+			},
+		}
+
+	case entry.RequestPath != nil:
+		key := entry.RequestPath.Key
+		if key == "" {
+			key = defaultPathDescriptorKey
+		}
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_RequestHeaders_{
+				RequestHeaders: &xds_route.RateLimit_Action_RequestHeaders{
+					HeaderName:    pathHeaderKey,
+					DescriptorKey: key,
+				},
+			},
+		}
+
+	case entry.SourceIdentity != nil:
+		// The source identity is not directly available to the rate limit filter. It is derived
+		// from the dynamic metadata set by the RBAC filter when a shadow policy named after the
+		// downstream identity matches the request, see buildSourceIdentityShadowRules.
+		key := entry.SourceIdentity.Key
+		if key == "" {
+			key = defaultSourceIdentityDescriptorKey
+		}
+		return &xds_route.RateLimit_Action{
+			ActionSpecifier: &xds_route.RateLimit_Action_Metadata{
+				Metadata: &xds_route.RateLimit_Action_MetaData{
+					DescriptorKey: key,
+					MetadataKey: &xds_metadata.MetadataKey{
+						Key: envoy.HTTPRBACFilterName,
+						Path: []*xds_metadata.MetadataKey_PathSegment{
+							{
+								Segment: &xds_metadata.MetadataKey_PathSegment_Key{
+									Key: rbacShadowEffectivePolicyIDKey,
+								},
+							},
+						},
+					},
+					Source: xds_route.RateLimit_Action_MetaData_DYNAMIC,
+				},
+			},
+		}
+	}
+
+	return nil
+}
+
+// hasSourceIdentityDescriptor returns true if any of the given descriptors derives an entry from the source identity
+func hasSourceIdentityDescriptor(descriptors []policyv1alpha1.HTTPRateLimitDescriptor) bool {
+	for _, descriptor := range descriptors {
+		for _, entry := range descriptor.Entries {
+			if entry.SourceIdentity != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getVirtualHostGlobalRateLimitDescriptors returns the global rate limit descriptors applied at the VirtualHost level
+func getVirtualHostGlobalRateLimitDescriptors(rateLimit *policyv1alpha1.RateLimitSpec) []policyv1alpha1.HTTPRateLimitDescriptor {
+	if rateLimit == nil || rateLimit.Global == nil || rateLimit.Global.HTTP == nil {
+		return nil
+	}
+	return rateLimit.Global.HTTP.Descriptors
+}
+
+// getRouteGlobalRateLimitDescriptors returns the global rate limit descriptors applied at the Route level
+func getRouteGlobalRateLimitDescriptors(rateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec) []policyv1alpha1.HTTPRateLimitDescriptor {
+	if rateLimit == nil || rateLimit.Global == nil {
+		return nil
+	}
+	return rateLimit.Global.Descriptors
+}
+
+// buildSourceIdentityShadowRules returns RBAC shadow rules with a policy per allowed downstream identity.
+// The RBAC filter sets the name of the matching shadow policy in its dynamic metadata, which is used
+// to derive the source identity of a request in rate limit descriptors.
+func buildSourceIdentityShadowRules(allowedPrincipals []string, trustDomain string) *xds_rbac.RBAC {
+	policies := make(map[string]*xds_rbac.Policy)
+	for _, principal := range allowedPrincipals {
+		if principal == identity.WildcardPrincipal {
+			continue
+		}
+		pb := &rbac.PolicyBuilder{}
+		pb.AddPrincipal(principal)
+		policies[identity.FromPrincipal(principal, trustDomain).String()] = pb.Build()
+	}
+
+	if len(policies) == 0 {
+		return nil
+	}
+
+	return &xds_rbac.RBAC{
+		Action:   xds_rbac.RBAC_ALLOW,
+		Policies: policies,
+	}
+}
@@ -0,0 +1,74 @@
+package route
+
+import (
+	"testing"
+
+	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
+	tassert "github.com/stretchr/testify/assert"
+
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
+)
+
+func TestBuildRateLimits(t *testing.T) {
+	a := tassert.New(t)
+
+	rateLimits := buildRateLimits([]policyv1alpha1.HTTPRateLimitDescriptor{
+		{
+			Entries: []policyv1alpha1.HTTPRateLimitDescriptorEntry{
+				{GenericKey: &policyv1alpha1.GenericKeyDescriptorEntry{Value: "foo"}},
+				{RequestHeader: &policyv1alpha1.RequestHeaderDescriptorEntry{Name: "x-user", Key: "user"}},
+				{RequestPath: &policyv1alpha1.RequestPathDescriptorEntry{}},
+				{SourceIdentity: &policyv1alpha1.SourceIdentityDescriptorEntry{Key: "client"}},
+			},
+		},
+		{
+			// Descriptors without entries are ignored
+		},
+	})
+	a.Len(rateLimits, 1)
+
+	actions := rateLimits[0].Actions
+	a.Len(actions, 4)
+
+	a.Equal(defaultGenericKeyDescriptorKey, actions[0].GetGenericKey().DescriptorKey)
+	a.Equal("foo", actions[0].GetGenericKey().DescriptorValue)
+
+	a.Equal("x-user", actions[1].GetRequestHeaders().HeaderName)
+	a.Equal("user", actions[1].GetRequestHeaders().DescriptorKey)
+
+	a.Equal(pathHeaderKey, actions[2].GetRequestHeaders().HeaderName)
+	a.Equal(defaultPathDescriptorKey, actions[2].GetRequestHeaders().DescriptorKey)
+
+	a.Equal("client", actions[3].GetMetadata().DescriptorKey)
+	a.Equal(envoy.HTTPRBACFilterName, actions[3].GetMetadata().MetadataKey.Key)
+	a.Equal(rbacShadowEffectivePolicyIDKey, actions[3].GetMetadata().MetadataKey.Path[0].GetKey())
+}
+
+func TestHasSourceIdentityDescriptor(t *testing.T) {
+	a := tassert.New(t)
+
+	a.False(hasSourceIdentityDescriptor(nil))
+	a.False(hasSourceIdentityDescriptor([]policyv1alpha1.HTTPRateLimitDescriptor{
+		{Entries: []policyv1alpha1.HTTPRateLimitDescriptorEntry{{RequestPath: &policyv1alpha1.RequestPathDescriptorEntry{}}}},
+	}))
+	a.True(hasSourceIdentityDescriptor([]policyv1alpha1.HTTPRateLimitDescriptor{
+		{Entries: []policyv1alpha1.HTTPRateLimitDescriptorEntry{{RequestPath: &policyv1alpha1.RequestPathDescriptorEntry{}}}},
+		{Entries: []policyv1alpha1.HTTPRateLimitDescriptorEntry{{SourceIdentity: &policyv1alpha1.SourceIdentityDescriptorEntry{}}}},
+	}))
+}
+
+func TestBuildSourceIdentityShadowRules(t *testing.T) {
+	a := tassert.New(t)
+
+	a.Nil(buildSourceIdentityShadowRules([]string{identity.WildcardPrincipal}, "cluster.local"))
+
+	shadowRules := buildSourceIdentityShadowRules([]string{
+		identity.K8sServiceAccount{Name: "foo", Namespace: "ns-1"}.AsPrincipal("cluster.local", false),
+		identity.WildcardPrincipal,
+	}, "cluster.local")
+	a.Equal(xds_rbac.RBAC_ALLOW, shadowRules.Action)
+	a.Len(shadowRules.Policies, 1)
+	a.Contains(shadowRules.Policies, "foo.ns-1")
+}
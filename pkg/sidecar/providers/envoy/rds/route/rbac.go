@@ -6,8 +6,8 @@ import (
 	xds_rbac "github.com/envoyproxy/go-control-plane/envoy/config/rbac/v3"
 	xds_http_rbac "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	"github.com/golang/protobuf/ptypes/any"
-	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/rbac"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
@@ -19,7 +19,8 @@ const (
 // buildInboundRBACFilterForRule builds an HTTP RBAC per route filter based on the given traffic policy rule.
 // The principals in the RBAC policy are derived from the allowed service accounts specified in the given rule.
 // The permissions in the RBAC policy are implicitly set to ANY (all permissions).
-func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule, trustDomain string) (*any.Any, error) {
+// If withSourceIdentities is set, shadow rules identifying the downstream service identity are added to the policy.
+func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule, trustDomain string, withSourceIdentities bool) (*any.Any, error) {
 	if rule.AllowedPrincipals == nil {
 		return nil, errors.New("traffipolicy.Rule.AllowedPrincipals not set")
 	}
@@ -27,8 +28,10 @@ func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule, trustDomain string)
 	pb := &rbac.PolicyBuilder{}
 
 	// Create the list of principals for this policy
+	var principals []string
 	for downstream := range rule.AllowedPrincipals.Iter() {
 		pb.AddPrincipal(downstream.(string))
+		principals = append(principals, downstream.(string))
 	}
 
 	// A single RBAC policy per route
@@ -41,11 +44,14 @@ func buildInboundRBACFilterForRule(rule *trafficpolicy.Rule, trustDomain string)
 			Policies: rbacPolicyMap,
 		},
 	}
+	if withSourceIdentities {
+		httpRBAC.ShadowRules = buildSourceIdentityShadowRules(principals, trustDomain)
+	}
 	httpRBACPerRoute := &xds_http_rbac.RBACPerRoute{
 		Rbac: httpRBAC,
 	}
 
-	marshalled, err := anypb.New(httpRBACPerRoute)
+	marshalled, err := protobuf.MarshalAny(httpRBACPerRoute)
 	if err != nil {
 		return nil, err
 	}
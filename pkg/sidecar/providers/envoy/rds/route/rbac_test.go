@@ -30,8 +30,8 @@ func TestBuildInboundRBACFilterForRule(t *testing.T) {
 					WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
 				},
 				AllowedPrincipals: mapset.NewSet(
-					identity.K8sServiceAccount{Name: "foo", Namespace: "ns-1"}.AsPrincipal("cluster.local"),
-					identity.K8sServiceAccount{Name: "bar", Namespace: "ns-2"}.AsPrincipal("cluster.local"),
+					identity.K8sServiceAccount{Name: "foo", Namespace: "ns-1"}.AsPrincipal("cluster.local", false),
+					identity.K8sServiceAccount{Name: "bar", Namespace: "ns-2"}.AsPrincipal("cluster.local", false),
 				),
 			},
 			expectedRBACPolicy: &xds_rbac.Policy{
@@ -89,7 +89,7 @@ func TestBuildInboundRBACFilterForRule(t *testing.T) {
 		t.Run(fmt.Sprintf("Test case %d: %s", i, tc.name), func(t *testing.T) {
 			assert := tassert.New(t)
 
-			rbacFilter, err := buildInboundRBACFilterForRule(tc.rule, "cluster.local")
+			rbacFilter, err := buildInboundRBACFilterForRule(tc.rule, "cluster.local", false)
 
 			assert.Equal(tc.expectError, err != nil)
 			if err != nil {
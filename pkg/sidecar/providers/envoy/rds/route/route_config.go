@@ -15,7 +15,6 @@ import (
 	"github.com/golang/protobuf/ptypes/any"
 	"github.com/golang/protobuf/ptypes/duration"
 	"github.com/golang/protobuf/ptypes/wrappers"
-	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -24,6 +23,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/configurator"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy"
 	"github.com/openservicemesh/osm/pkg/trafficpolicy"
@@ -77,7 +77,8 @@ func BuildInboundMeshRouteConfiguration(portSpecificRouteConfigs map[int][]*traf
 		routeConfig := NewRouteConfigurationStub(GetInboundMeshRouteConfigNameForPort(port))
 		for _, config := range configs {
 			virtualHost := buildVirtualHostStub(inboundVirtualHost, config.Name, config.Hostnames)
-			virtualHost.Routes = buildInboundRoutes(config.Rules, trustDomain)
+			shadowSourceIdentities := hasSourceIdentityDescriptor(getVirtualHostGlobalRateLimitDescriptors(config.RateLimit))
+			virtualHost.Routes = buildInboundRoutes(config.Rules, trustDomain, shadowSourceIdentities)
 			applyInboundVirtualHostConfig(virtualHost, config)
 			routeConfig.VirtualHosts = append(routeConfig.VirtualHosts, virtualHost)
 		}
@@ -116,6 +117,9 @@ func applyInboundVirtualHostConfig(vhost *xds_route.VirtualHost, policy *traffic
 	// Add other typed filter configs below when necessary
 
 	vhost.TypedPerFilterConfig = config
+
+	// Apply VirtualHost level global rate limiting descriptors
+	vhost.RateLimits = buildRateLimits(getVirtualHostGlobalRateLimitDescriptors(policy.RateLimit))
 }
 
 // getLocalRateLimitFilterConfig returns the marshalled HTTP local rate limiting config for the given policy
@@ -163,7 +167,7 @@ func getLocalRateLimitFilterConfig(config *policyv1alpha1.HTTPLocalRateLimitSpec
 		rl.Status = &xds_type.HttpStatus{Code: xds_type.StatusCode(config.ResponseStatusCode)}
 	}
 
-	marshalled, err := anypb.New(rl)
+	marshalled, err := protobuf.MarshalAny(rl)
 	if err != nil {
 		return nil, err
 	}
@@ -200,7 +204,7 @@ func BuildIngressConfiguration(ingress []*trafficpolicy.InboundTrafficPolicy, tr
 	ingressRouteConfig := NewRouteConfigurationStub(IngressRouteConfigName)
 	for _, in := range ingress {
 		virtualHost := buildVirtualHostStub(ingressVirtualHost, in.Name, in.Hostnames)
-		virtualHost.Routes = buildInboundRoutes(in.Rules, trustDomain)
+		virtualHost.Routes = buildInboundRoutes(in.Rules, trustDomain, false)
 		ingressRouteConfig.VirtualHosts = append(ingressRouteConfig.VirtualHosts, virtualHost)
 	}
 
@@ -269,8 +273,10 @@ func buildVirtualHostStub(namePrefix string, host string, domains []string) *xds
 	return &virtualHost
 }
 
-// buildInboundRoutes takes a route information from the given inbound traffic policy and returns a list of xds routes
-func buildInboundRoutes(rules []*trafficpolicy.Rule, trustDomain string) []*xds_route.Route {
+// buildInboundRoutes takes a route information from the given inbound traffic policy and returns a list of xds routes.
+// If shadowSourceIdentities is set, the RBAC policy of each route exposes the identity of the downstream client
+// so that it can be used in rate limit descriptors.
+func buildInboundRoutes(rules []*trafficpolicy.Rule, trustDomain string, shadowSourceIdentities bool) []*xds_route.Route {
 	var routes []*xds_route.Route
 	for _, rule := range rules {
 		// For a given route path, sanitize the methods in case there
@@ -279,7 +285,8 @@ func buildInboundRoutes(rules []*trafficpolicy.Rule, trustDomain string) []*xds_
 
 		// Create an RBAC policy derived from 'trafficpolicy.Rule'
 		// Each route is associated with an RBAC policy
-		rbacConfig, err := buildInboundRBACFilterForRule(rule, trustDomain)
+		withSourceIdentities := shadowSourceIdentities || hasSourceIdentityDescriptor(getRouteGlobalRateLimitDescriptors(rule.Route.RateLimit))
+		rbacConfig, err := buildInboundRBACFilterForRule(rule, trustDomain, withSourceIdentities)
 		if err != nil {
 			log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrBuildingRBACPolicyForRoute)).
 				Msgf("Error building RBAC policy for rule [%v], skipping route addition", rule)
@@ -316,6 +323,11 @@ func applyInboundRouteConfig(route *xds_route.Route, rbacConfig *any.Any, rateLi
 	}
 
 	route.TypedPerFilterConfig = perFilterConfig
+
+	// Apply global rate limiting descriptors
+	if routeAction := route.GetRoute(); routeAction != nil {
+		routeAction.RateLimits = buildRateLimits(getRouteGlobalRateLimitDescriptors(rateLimit))
+	}
 }
 
 func buildOutboundRoutes(outRoutes []*trafficpolicy.RouteWeightedClusters) []*xds_route.Route {
@@ -323,10 +335,30 @@ func buildOutboundRoutes(outRoutes []*trafficpolicy.RouteWeightedClusters) []*xd
 	for _, outRoute := range outRoutes {
 		// Create temp variable to avoid potentially overwriting the loop variable
 		tempOutbound := *outRoute
+
+		// Routes derived from Gateway API routes match the requests on the client side, such that
+		// only the fault injection policies without HTTP request matches apply to them.
+		// Each HTTP method corresponds to a separate route.
+		if tempOutbound.OutboundMatch {
+			for _, method := range sanitizeHTTPMethods(tempOutbound.HTTPRouteMatch.Methods) {
+				route := buildRoute(tempOutbound, method)
+				applyOutboundFaultInjection(route, tempOutbound.FaultInjections)
+				routes = append(routes, route)
+			}
+			continue
+		}
+
 		tempOutbound.HTTPRouteMatch.PathMatchType = trafficpolicy.PathMatchRegex
 		tempOutbound.HTTPRouteMatch.Path = constants.RegexMatchAll
 		tempOutbound.HTTPRouteMatch.Headers = map[string]string{}
-		routes = append(routes, buildRoute(tempOutbound, constants.WildcardHTTPMethod))
+		tempOutbound.HTTPRouteMatch.GRPC = false
+
+		// Routes injecting faults for specific HTTP request matches must precede the wildcard route
+		routes = append(routes, buildFaultInjectionRoutes(tempOutbound)...)
+
+		route := buildRoute(tempOutbound, constants.WildcardHTTPMethod)
+		applyOutboundFaultInjection(route, tempOutbound.FaultInjections)
+		routes = append(routes, route)
 	}
 
 	return routes
@@ -354,18 +386,26 @@ func buildRoute(weightedClusters trafficpolicy.RouteWeightedClusters, method str
 		Match: &xds_route.RouteMatch{
 			Headers: getHeadersForRoute(method, weightedClusters.HTTPRouteMatch.Headers),
 		},
-		Action: &xds_route.Route_Route{
+	}
+
+	// Routes redirecting the requests do not forward them to upstream clusters,
+	// their redirect action is set by the route filters
+	if weightedClusters.Filters == nil || weightedClusters.Filters.Redirect == nil {
+		route.Action = &xds_route.Route_Route{
 			Route: &xds_route.RouteAction{
 				ClusterSpecifier: &xds_route.RouteAction_WeightedClusters{
 					WeightedClusters: buildWeightedCluster(weightedClusters.WeightedClusters),
 				},
 				// Disable default 15s timeout. This otherwise results in requests that take
 				// longer than 15s to timeout, e.g. large file transfers.
-				Timeout:     &duration.Duration{Seconds: 0},
-				RetryPolicy: buildRetryPolicy(weightedClusters.RetryPolicy),
+				Timeout:               &duration.Duration{Seconds: 0},
+				RetryPolicy:           buildRetryPolicy(weightedClusters.RetryPolicy),
+				RequestMirrorPolicies: buildRequestMirrorPolicies(weightedClusters.MirrorClusters),
+				HashPolicy:            buildHashPolicy(weightedClusters.LoadBalancer),
 			},
-		},
+		}
 	}
+	applyRouteFilters(&route, weightedClusters.Filters, weightedClusters.HTTPRouteMatch.PathMatchType)
 
 	switch weightedClusters.HTTPRouteMatch.PathMatchType {
 	case trafficpolicy.PathMatchRegex:
@@ -387,6 +427,11 @@ func buildRoute(weightedClusters trafficpolicy.RouteWeightedClusters, method str
 		}
 	}
 
+	// gRPC route matches only match the requests with a gRPC content type
+	if weightedClusters.HTTPRouteMatch.GRPC {
+		route.Match.Grpc = &xds_route.RouteMatch_GrpcRouteMatchOptions{}
+	}
+
 	return &route
 }
 
@@ -276,14 +276,14 @@ func TestBuildInboundMeshRouteConfiguration(t *testing.T) {
 								HTTPRouteMatch:   tests.BookstoreBuyHTTPRoute,
 								WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
 							},
-							AllowedPrincipals: mapset.NewSet(tests.BookbuyerServiceAccount.ToServiceIdentity().AsPrincipal("cluster.local")),
+							AllowedPrincipals: mapset.NewSet(tests.BookbuyerServiceAccount.ToServiceIdentity().AsPrincipal("cluster.local", false)),
 						},
 						{
 							Route: trafficpolicy.RouteWeightedClusters{
 								HTTPRouteMatch:   tests.BookstoreSellHTTPRoute,
 								WeightedClusters: mapset.NewSet(tests.BookstoreV1DefaultWeightedCluster),
 							},
-							AllowedPrincipals: mapset.NewSet(tests.BookbuyerServiceAccount.ToServiceIdentity().AsPrincipal("cluster.local")),
+							AllowedPrincipals: mapset.NewSet(tests.BookbuyerServiceAccount.ToServiceIdentity().AsPrincipal("cluster.local", false)),
 						},
 					},
 				},
@@ -501,7 +501,7 @@ func TestBuildInboundRoutes(t *testing.T) {
 
 	for i, tc := range testCases {
 		t.Run(fmt.Sprintf("Testing test case %d: %s", i, tc.name), func(t *testing.T) {
-			actual := buildInboundRoutes(tc.inputRules, "cluster.local")
+			actual := buildInboundRoutes(tc.inputRules, "cluster.local", false)
 			tc.expectFunc(tassert.New(t), actual)
 		})
 	}
@@ -720,6 +720,54 @@ func TestBuildRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "outbound route for gRPC method match",
+			route: trafficpolicy.RouteWeightedClusters{
+				HTTPRouteMatch: trafficpolicy.HTTPRouteMatch{
+					PathMatchType: trafficpolicy.PathMatchExact,
+					Path:          "/helloworld.Greeter/SayHello",
+					GRPC:          true,
+				},
+				WeightedClusters: mapset.NewSetFromSlice([]interface{}{
+					service.WeightedCluster{ClusterName: service.ClusterName("osm/greeter|50051"), Weight: 100}}),
+			},
+			method: "POST",
+			expectedRoute: &xds_route.Route{
+				Match: &xds_route.RouteMatch{
+					PathSpecifier: &xds_route.RouteMatch_Path{
+						Path: "/helloworld.Greeter/SayHello",
+					},
+					Headers: []*xds_route.HeaderMatcher{
+						{
+							Name: ":method",
+							HeaderMatchSpecifier: &xds_route.HeaderMatcher_SafeRegexMatch{
+								SafeRegexMatch: &xds_matcher.RegexMatcher{
+									EngineType: &xds_matcher.RegexMatcher_GoogleRe2{GoogleRe2: &xds_matcher.RegexMatcher_GoogleRE2{}},
+									Regex:      "POST",
+								},
+							},
+						},
+					},
+					Grpc: &xds_route.RouteMatch_GrpcRouteMatchOptions{},
+				},
+				Action: &xds_route.Route_Route{
+					Route: &xds_route.RouteAction{
+						ClusterSpecifier: &xds_route.RouteAction_WeightedClusters{
+							WeightedClusters: &xds_route.WeightedCluster{
+								Clusters: []*xds_route.WeightedCluster_ClusterWeight{
+									{
+										Name:   "osm/greeter|50051",
+										Weight: &wrappers.UInt32Value{Value: 100},
+									},
+								},
+								TotalWeight: &wrappers.UInt32Value{Value: 100},
+							},
+						},
+						Timeout: &duration.Duration{Seconds: 0},
+					},
+				},
+			},
+		},
 		{
 			name: "inbound route for exact path match",
 			route: trafficpolicy.RouteWeightedClusters{
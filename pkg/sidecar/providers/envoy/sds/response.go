@@ -27,6 +27,7 @@ func NewResponse(meshCatalog catalog.MeshCataloger, proxy *envoy.Proxy, request
 		certManager:     certManager,
 		serviceIdentity: proxy.Identity,
 		TrustDomain:     certManager.GetTrustDomain(),
+		SpiffeEnabled:   certManager.GetSpiffeEnabled(),
 	}
 
 	var sdsResources []types.Resource
@@ -159,18 +160,20 @@ func (s *sdsImpl) getRootCert(cert *certificate.Certificate, sdscert secrets.SDS
 	}
 	svcIdentitiesInCertRequest := s.meshCatalog.ListServiceIdentitiesForService(*meshSvc)
 
-	secret.GetValidationContext().MatchSubjectAltNames = getSubjectAltNamesFromSvcIdentities(svcIdentitiesInCertRequest, s.TrustDomain)
+	secret.GetValidationContext().MatchSubjectAltNames = getSubjectAltNamesFromSvcIdentities(svcIdentitiesInCertRequest, s.TrustDomain, s.SpiffeEnabled)
 	return secret, nil
 }
 
 // Note: ServiceIdentity must be in the format "name.namespace" [https://github.com/openservicemesh/osm/issues/3188]
-func getSubjectAltNamesFromSvcIdentities(serviceIdentities []identity.ServiceIdentity, trustDomain string) []*xds_matcher.StringMatcher {
+// When SPIFFE is enabled, the SANs to match are the SPIFFE IDs of the service identities, so that the upstream
+// peers can also be workloads whose certificates are issued by another SPIFFE implementation, such as SPIRE.
+func getSubjectAltNamesFromSvcIdentities(serviceIdentities []identity.ServiceIdentity, trustDomain string, spiffeEnabled bool) []*xds_matcher.StringMatcher {
 	var matchSANs []*xds_matcher.StringMatcher
 
 	for _, si := range serviceIdentities {
 		match := xds_matcher.StringMatcher{
 			MatchPattern: &xds_matcher.StringMatcher_Exact{
-				Exact: si.AsPrincipal(trustDomain),
+				Exact: si.AsPrincipal(trustDomain, spiffeEnabled),
 			},
 		}
 		matchSANs = append(matchSANs, &match)
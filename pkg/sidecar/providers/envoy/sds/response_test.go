@@ -394,6 +394,7 @@ func TestGetSDSSecrets(t *testing.T) {
 func TestGetSubjectAltNamesFromSvcAccount(t *testing.T) {
 	type testCase struct {
 		serviceIdentities   []identity.ServiceIdentity
+		spiffeEnabled       bool
 		expectedSANMatchers []*xds_matcher.StringMatcher
 	}
 
@@ -416,13 +417,26 @@ func TestGetSubjectAltNamesFromSvcAccount(t *testing.T) {
 				},
 			},
 		},
+		{
+			serviceIdentities: []identity.ServiceIdentity{
+				identity.K8sServiceAccount{Name: "sa-1", Namespace: "ns-1"}.ToServiceIdentity(),
+			},
+			spiffeEnabled: true,
+			expectedSANMatchers: []*xds_matcher.StringMatcher{
+				{
+					MatchPattern: &xds_matcher.StringMatcher_Exact{
+						Exact: "spiffe://cluster.local/ns/ns-1/sa/sa-1",
+					},
+				},
+			},
+		},
 	}
 
 	for i, tc := range testCases {
 		t.Run(fmt.Sprintf("Testing test case %d", i), func(t *testing.T) {
 			assert := tassert.New(t)
 
-			actual := getSubjectAltNamesFromSvcIdentities(tc.serviceIdentities, "cluster.local")
+			actual := getSubjectAltNamesFromSvcIdentities(tc.serviceIdentities, "cluster.local", tc.spiffeEnabled)
 			assert.ElementsMatch(actual, tc.expectedSANMatchers)
 		})
 	}
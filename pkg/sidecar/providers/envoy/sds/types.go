@@ -18,4 +18,5 @@ type sdsImpl struct {
 	meshCatalog     catalog.MeshCataloger
 	certManager     *certificate.Manager
 	TrustDomain     string
+	SpiffeEnabled   bool
 }
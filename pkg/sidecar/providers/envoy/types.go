@@ -97,6 +97,11 @@ const (
 
 	HTTPExtAuthzFilterName    = "http_external_authz"
 	HTTPHealthCheckFilterName = "http_health_check"
+	HTTPJWTRBACFilterName     = "http_jwt_rbac"
+	HTTPJWTAuthnFilterName    = "envoy.filters.http.jwt_authn"
+	HTTPAuthzFilterNamePrefix = "http_authz"
+	HTTPGRPCWebFilterName     = "http_grpc_web"
+	HTTPGRPCStatsFilterName   = "http_grpc_stats"
 
 	// The HTTP typed filters referenced in the RDS configuration still need to
 	// use wellknown names. These filters are configured as a map where the key is
@@ -104,11 +109,16 @@ const (
 	// See https://github.com/envoyproxy/envoy/issues/21759#issuecomment-1163570994
 	HTTPRBACFilterName           = "envoy.filters.http.rbac"
 	HTTPLocalRateLimitFilterName = "envoy.filters.http.local_ratelimit"
+	HTTPRateLimitFilterName      = "envoy.filters.http.ratelimit"
+	HTTPFaultFilterName          = "envoy.filters.http.fault"
 
 	// Network (L4) filters
-	TCPProxyFilterName         = "tcp_proxy"
-	L4LocalRateLimitFilterName = "l4_local_rate_limit"
-	L4RBACFilterName           = "l4_rbac"
+	TCPProxyFilterName          = "tcp_proxy"
+	L4LocalRateLimitFilterName  = "l4_local_rate_limit"
+	L4GlobalRateLimitFilterName = "l4_global_rate_limit"
+	L4RBACFilterName            = "l4_rbac"
+	L4AuthzFilterNamePrefix     = "l4_authz"
+	L4RevocationFilterName      = "l4_revocation"
 
 	// Listener filters
 	OriginalDstFilterName   = "original_dst"
@@ -120,6 +130,7 @@ const (
 const (
 	HTTPRouterFilterTypeURL    = "type.googleapis.com/envoy.extensions.filters.http.router.v3.Router"
 	HTTPRBACFilterTypeURL      = "type.googleapis.com/envoy.extensions.filters.http.rbac.v3.RBAC"
+	HTTPFaultFilterTypeURL     = "type.googleapis.com/envoy.extensions.filters.http.fault.v3.HTTPFault"
 	OriginalDstFilterTypeURL   = "type.googleapis.com/envoy.extensions.filters.listener.original_dst.v3.OriginalDst"
 	TLSInspectorFilterTypeURL  = "type.googleapis.com/envoy.extensions.filters.listener.tls_inspector.v3.TlsInspector"
 	HTTPInspectorFilterTypeURL = "type.googleapis.com/envoy.extensions.filters.listener.http_inspector.v3.HttpInspector"
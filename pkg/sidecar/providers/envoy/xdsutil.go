@@ -2,15 +2,23 @@ package envoy
 
 import (
 	"fmt"
+	"math"
 	"net"
+	"sort"
+	"strconv"
 	"strings"
 
 	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_accesslog_grpc "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/grpc/v3"
+	xds_accesslog_otel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	xds_accesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
 	xds_auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
+	otlp_common "go.opentelemetry.io/proto/otlp/common/v1"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -18,6 +26,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/errcode"
 	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/protobuf"
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/envoy/secrets"
 )
@@ -31,8 +40,48 @@ const (
 
 	// AccessLoggerName is name used for the envoy access loggers.
 	AccessLoggerName = "envoy.access_loggers.stream"
+
+	// OpenTelemetryAccessLoggerName is the name used for the envoy OpenTelemetry access loggers.
+	OpenTelemetryAccessLoggerName = "envoy.access_loggers.open_telemetry"
+
+	// OpenTelemetryAccessLogName is the name of the access logs exported by the envoy OpenTelemetry access loggers.
+	OpenTelemetryAccessLogName = "osm-access-log"
+
+	// DefaultTextAccessLogFormat is the format of the access logs in the text format when not specified, Envoy's default one.
+	DefaultTextAccessLogFormat = "[%START_TIME%] \"%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL%\" " +
+		"%RESPONSE_CODE% %RESPONSE_FLAGS% %BYTES_RECEIVED% %BYTES_SENT% %DURATION% %RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)% " +
+		"\"%REQ(X-FORWARDED-FOR)%\" \"%REQ(USER-AGENT)%\" \"%REQ(X-REQUEST-ID)%\" \"%REQ(:AUTHORITY)%\" \"%UPSTREAM_HOST%\"\n"
+
+	// accessLogMinStatusCodeRuntimeKey is the runtime key of the minimum status code of the logged requests
+	accessLogMinStatusCodeRuntimeKey = "osm.access_log.min_status_code"
+
+	// accessLogSampledFractionRuntimeKey is the runtime key of the fraction of the logged requests
+	accessLogSampledFractionRuntimeKey = "osm.access_log.sampled_fraction"
 )
 
+// accessLogFormat is the format of the fields of the access logs
+var accessLogFormat = map[string]string{
+	"start_time":            `%START_TIME%`,
+	"method":                `%REQ(:METHOD)%`,
+	"path":                  `%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%`,
+	"protocol":              `%PROTOCOL%`,
+	"response_code":         `%RESPONSE_CODE%`,
+	"response_code_details": `%RESPONSE_CODE_DETAILS%`,
+	"time_to_first_byte":    `%RESPONSE_DURATION%`,
+	"upstream_cluster":      `%UPSTREAM_CLUSTER%`,
+	"response_flags":        `%RESPONSE_FLAGS%`,
+	"bytes_received":        `%BYTES_RECEIVED%`,
+	"bytes_sent":            `%BYTES_SENT%`,
+	"duration":              `%DURATION%`,
+	"upstream_service_time": `%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%`,
+	"x_forwarded_for":       `%REQ(X-FORWARDED-FOR)%`,
+	"user_agent":            `%REQ(USER-AGENT)%`,
+	"request_id":            `%REQ(X-REQUEST-ID)%`,
+	"requested_server_name": `%REQUESTED_SERVER_NAME%`,
+	"authority":             `%REQ(:AUTHORITY)%`,
+	"upstream_host":         `%UPSTREAM_HOST%`,
+}
+
 // ALPNInMesh indicates that the proxy is connecting to an in-mesh destination.
 // It is set as a part of configuring the UpstreamTLSContext.
 var ALPNInMesh = []string{"osm"}
@@ -52,6 +101,14 @@ func GetAddress(address string, port uint32) *xds_core.Address {
 	}
 }
 
+// GetDualStackAddress creates an Envoy Address instance listening on all the IPv6 addresses of the given port,
+// which also accepts the IPv4 connections as IPv4-mapped IPv6 addresses.
+func GetDualStackAddress(port uint32) *xds_core.Address {
+	address := GetAddress(constants.WildcardIPv6Addr, port)
+	address.GetSocketAddress().Ipv4Compat = true
+	return address
+}
+
 // GetTLSParams creates Envoy TlsParameters struct.
 func GetTLSParams(sidecarSpec configv1alpha2.SidecarSpec) *xds_auth.TlsParameters {
 	minVersionInt := xds_auth.TlsParameters_TlsProtocol_value[sidecarSpec.TLSMinProtocolVersion]
@@ -75,53 +132,195 @@ func GetTLSParams(sidecarSpec configv1alpha2.SidecarSpec) *xds_auth.TlsParameter
 
 // GetAccessLog creates an Envoy AccessLog struct.
 func GetAccessLog() []*xds_accesslog_filter.AccessLog {
-	accessLog, err := anypb.New(getStdoutAccessLog())
+	accessLog := GetStdoutAccessLog(configv1alpha2.AccessLoggingSpec{}, nil)
+	if accessLog == nil {
+		return nil
+	}
+	return []*xds_accesslog_filter.AccessLog{accessLog}
+}
+
+// GetStdoutAccessLog creates an Envoy AccessLog writing the access logs to stdout as configured by the given spec.
+// The given attributes identifying the proxy are added as literal fields to the access logs in the JSON format.
+func GetStdoutAccessLog(spec configv1alpha2.AccessLoggingSpec, attributes map[string]string) *xds_accesslog_filter.AccessLog {
+	// The JSON log format being a map, the access log is marshalled deterministically so that the
+	// resources embedding it are identical when unchanged, as expected by Delta xDS
+	accessLog := new(anypb.Any)
+	err := anypb.MarshalFrom(accessLog, getStdoutAccessLog(spec, attributes), proto.MarshalOptions{Deterministic: true})
 	if err != nil {
 		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
 			Msgf("Error marshalling AccessLog object")
 		return nil
 	}
-	return []*xds_accesslog_filter.AccessLog{{
-		Name: AccessLoggerName,
+	return &xds_accesslog_filter.AccessLog{
+		Name:   AccessLoggerName,
+		Filter: getAccessLogFilter(spec.Filter),
 		ConfigType: &xds_accesslog_filter.AccessLog_TypedConfig{
 			TypedConfig: accessLog,
-		}},
+		},
 	}
 }
 
-func getStdoutAccessLog() *xds_accesslog.StdoutAccessLog {
+func getStdoutAccessLog(spec configv1alpha2.AccessLoggingSpec, attributes map[string]string) *xds_accesslog.StdoutAccessLog {
+	if spec.Format == configv1alpha2.AccessLogFormatText {
+		textFormat := spec.TextFormat
+		if textFormat == "" {
+			textFormat = DefaultTextAccessLogFormat
+		}
+		return &xds_accesslog.StdoutAccessLog{
+			AccessLogFormat: &xds_accesslog.StdoutAccessLog_LogFormat{
+				LogFormat: &xds_core.SubstitutionFormatString{
+					Format: &xds_core.SubstitutionFormatString_TextFormatSource{
+						TextFormatSource: &xds_core.DataSource{
+							Specifier: &xds_core.DataSource_InlineString{
+								InlineString: textFormat,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	jsonFormat := &structpb.Struct{
+		Fields: make(map[string]*structpb.Value, len(accessLogFormat)+len(attributes)+len(spec.Fields)),
+	}
+	for field, value := range attributes {
+		jsonFormat.Fields[field] = pbStringValue(value)
+	}
+	for field, format := range accessLogFormat {
+		jsonFormat.Fields[field] = pbStringValue(format)
+	}
+	for field, format := range spec.Fields {
+		jsonFormat.Fields[field] = pbStringValue(format)
+	}
+
 	accessLogger := &xds_accesslog.StdoutAccessLog{
 		AccessLogFormat: &xds_accesslog.StdoutAccessLog_LogFormat{
 			LogFormat: &xds_core.SubstitutionFormatString{
 				Format: &xds_core.SubstitutionFormatString_JsonFormat{
-					JsonFormat: &structpb.Struct{
-						Fields: map[string]*structpb.Value{
-							"start_time":            pbStringValue(`%START_TIME%`),
-							"method":                pbStringValue(`%REQ(:METHOD)%`),
-							"path":                  pbStringValue(`%REQ(X-ENVOY-ORIGINAL-PATH?:PATH)%`),
-							"protocol":              pbStringValue(`%PROTOCOL%`),
-							"response_code":         pbStringValue(`%RESPONSE_CODE%`),
-							"response_code_details": pbStringValue(`%RESPONSE_CODE_DETAILS%`),
-							"time_to_first_byte":    pbStringValue(`%RESPONSE_DURATION%`),
-							"upstream_cluster":      pbStringValue(`%UPSTREAM_CLUSTER%`),
-							"response_flags":        pbStringValue(`%RESPONSE_FLAGS%`),
-							"bytes_received":        pbStringValue(`%BYTES_RECEIVED%`),
-							"bytes_sent":            pbStringValue(`%BYTES_SENT%`),
-							"duration":              pbStringValue(`%DURATION%`),
-							"upstream_service_time": pbStringValue(`%RESP(X-ENVOY-UPSTREAM-SERVICE-TIME)%`),
-							"x_forwarded_for":       pbStringValue(`%REQ(X-FORWARDED-FOR)%`),
-							"user_agent":            pbStringValue(`%REQ(USER-AGENT)%`),
-							"request_id":            pbStringValue(`%REQ(X-REQUEST-ID)%`),
-							"requested_server_name": pbStringValue("%REQUESTED_SERVER_NAME%"),
-							"authority":             pbStringValue(`%REQ(:AUTHORITY)%`),
-							"upstream_host":         pbStringValue(`%UPSTREAM_HOST%`),
+					JsonFormat: jsonFormat,
+				},
+			},
+		},
+	}
+	return accessLogger
+}
+
+// getAccessLogFilter returns the filter of the access logs matching the given spec, nil when all the requests are logged
+func getAccessLogFilter(spec configv1alpha2.AccessLogFilterSpec) *xds_accesslog_filter.AccessLogFilter {
+	var filters []*xds_accesslog_filter.AccessLogFilter
+
+	if spec.MinStatusCode != nil {
+		filters = append(filters, &xds_accesslog_filter.AccessLogFilter{
+			FilterSpecifier: &xds_accesslog_filter.AccessLogFilter_StatusCodeFilter{
+				StatusCodeFilter: &xds_accesslog_filter.StatusCodeFilter{
+					Comparison: &xds_accesslog_filter.ComparisonFilter{
+						Op: xds_accesslog_filter.ComparisonFilter_GE,
+						Value: &xds_core.RuntimeUInt32{
+							DefaultValue: *spec.MinStatusCode,
+							RuntimeKey:   accessLogMinStatusCodeRuntimeKey,
 						},
 					},
 				},
 			},
+		})
+	}
+
+	if spec.SampledFraction != nil && len(*spec.SampledFraction) > 0 {
+		sampledFraction, err := strconv.ParseFloat(*spec.SampledFraction, 32)
+		if err != nil || sampledFraction < 0 || sampledFraction > 1 {
+			log.Error().Err(err).Msgf("Invalid access log sampled fraction %s, logging all the requests", *spec.SampledFraction)
+		} else {
+			filters = append(filters, &xds_accesslog_filter.AccessLogFilter{
+				FilterSpecifier: &xds_accesslog_filter.AccessLogFilter_RuntimeFilter{
+					RuntimeFilter: &xds_accesslog_filter.RuntimeFilter{
+						RuntimeKey: accessLogSampledFractionRuntimeKey,
+						PercentSampled: &xds_type.FractionalPercent{
+							Numerator:   uint32(math.Round(sampledFraction * 1000000)),
+							Denominator: xds_type.FractionalPercent_MILLION,
+						},
+						UseIndependentRandomness: true,
+					},
+				},
+			})
+		}
+	}
+
+	switch len(filters) {
+	case 0:
+		return nil
+	case 1:
+		return filters[0]
+	default:
+		return &xds_accesslog_filter.AccessLogFilter{
+			FilterSpecifier: &xds_accesslog_filter.AccessLogFilter_AndFilter{
+				AndFilter: &xds_accesslog_filter.AndFilter{
+					Filters: filters,
+				},
+			},
+		}
+	}
+}
+
+// GetOpenTelemetryAccessLog creates an Envoy AccessLog exporting the access logs over OTLP/gRPC to the given cluster.
+// The given attributes identifying the proxy are added to the attributes of every log record.
+func GetOpenTelemetryAccessLog(clusterName string, attributes map[string]string) *xds_accesslog_filter.AccessLog {
+	var logAttributes []*otlp_common.KeyValue
+	for key, value := range attributes {
+		logAttributes = append(logAttributes, otlpStringKeyValue(key, value))
+	}
+	for field, format := range accessLogFormat {
+		logAttributes = append(logAttributes, otlpStringKeyValue(field, format))
+	}
+	sort.Slice(logAttributes, func(i, j int) bool {
+		return logAttributes[i].Key < logAttributes[j].Key
+	})
+
+	accessLogger := &xds_accesslog_otel.OpenTelemetryAccessLogConfig{
+		CommonConfig: &xds_accesslog_grpc.CommonGrpcAccessLogConfig{
+			LogName: OpenTelemetryAccessLogName,
+			GrpcService: &xds_core.GrpcService{
+				TargetSpecifier: &xds_core.GrpcService_EnvoyGrpc_{
+					EnvoyGrpc: &xds_core.GrpcService_EnvoyGrpc{
+						ClusterName: clusterName,
+					},
+				},
+			},
+			TransportApiVersion: xds_core.ApiVersion_V3,
+		},
+		Body: &otlp_common.AnyValue{
+			Value: &otlp_common.AnyValue_StringValue{
+				StringValue: `%REQ(:METHOD)% %REQ(X-ENVOY-ORIGINAL-PATH?:PATH)% %PROTOCOL% %RESPONSE_CODE%`,
+			},
+		},
+		Attributes: &otlp_common.KeyValueList{
+			Values: logAttributes,
+		},
+	}
+
+	accessLog, err := protobuf.MarshalAny(accessLogger)
+	if err != nil {
+		log.Error().Err(err).Str(errcode.Kind, errcode.GetErrCodeWithMetric(errcode.ErrMarshallingXDSResource)).
+			Msgf("Error marshalling OpenTelemetry AccessLog object")
+		return nil
+	}
+	return &xds_accesslog_filter.AccessLog{
+		Name: OpenTelemetryAccessLoggerName,
+		ConfigType: &xds_accesslog_filter.AccessLog_TypedConfig{
+			TypedConfig: accessLog,
+		},
+	}
+}
+
+func otlpStringKeyValue(key, value string) *otlp_common.KeyValue {
+	return &otlp_common.KeyValue{
+		Key: key,
+		Value: &otlp_common.AnyValue{
+			Value: &otlp_common.AnyValue_StringValue{
+				StringValue: value,
+			},
 		},
 	}
-	return accessLogger
 }
 
 func pbStringValue(v string) *structpb.Value {
@@ -3,10 +3,13 @@ package envoy
 import (
 	"testing"
 
+	xds_accesslog_filter "github.com/envoyproxy/go-control-plane/envoy/config/accesslog/v3"
 	core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	xds_core "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	xds_accesslog_otel "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/open_telemetry/v3"
 	xds_accesslog "github.com/envoyproxy/go-control-plane/envoy/extensions/access_loggers/stream/v3"
 	auth "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	xds_type "github.com/envoyproxy/go-control-plane/envoy/type/v3"
 	structpb "github.com/golang/protobuf/ptypes/struct"
 	"github.com/golang/protobuf/ptypes/wrappers"
 	tassert "github.com/stretchr/testify/assert"
@@ -63,11 +66,142 @@ func TestGetStdoutAccessLog(t *testing.T) {
 			},
 		},
 	}
-	resAccessLogger := getStdoutAccessLog()
+	resAccessLogger := getStdoutAccessLog(configv1alpha2.AccessLoggingSpec{}, nil)
 
 	assert.Equal(resAccessLogger, expAccessLogger)
 }
 
+func TestGetStdoutAccessLogFormats(t *testing.T) {
+	testCases := []struct {
+		name       string
+		spec       configv1alpha2.AccessLoggingSpec
+		attributes map[string]string
+		assertFunc func(*tassert.Assertions, *xds_core.SubstitutionFormatString)
+	}{
+		{
+			name:       "JSON format with attributes and custom fields",
+			spec:       configv1alpha2.AccessLoggingSpec{Fields: map[string]string{"peer": "%DOWNSTREAM_PEER_SUBJECT%", "method": "%REQ(:METHOD)%-custom"}},
+			attributes: map[string]string{"osm.identity": "sa.ns"},
+			assertFunc: func(a *tassert.Assertions, format *xds_core.SubstitutionFormatString) {
+				fields := format.GetJsonFormat().Fields
+				a.Len(fields, len(accessLogFormat)+2)
+				a.Equal("sa.ns", fields["osm.identity"].GetStringValue())
+				a.Equal("%DOWNSTREAM_PEER_SUBJECT%", fields["peer"].GetStringValue())
+				a.Equal("%REQ(:METHOD)%-custom", fields["method"].GetStringValue())
+			},
+		},
+		{
+			name: "text format with the default format string",
+			spec: configv1alpha2.AccessLoggingSpec{Format: configv1alpha2.AccessLogFormatText},
+			assertFunc: func(a *tassert.Assertions, format *xds_core.SubstitutionFormatString) {
+				a.Nil(format.GetJsonFormat())
+				a.Equal(DefaultTextAccessLogFormat, format.GetTextFormatSource().GetInlineString())
+			},
+		},
+		{
+			name: "text format with a custom format string",
+			spec: configv1alpha2.AccessLoggingSpec{Format: configv1alpha2.AccessLogFormatText, TextFormat: "%RESPONSE_CODE%\n"},
+			assertFunc: func(a *tassert.Assertions, format *xds_core.SubstitutionFormatString) {
+				a.Equal("%RESPONSE_CODE%\n", format.GetTextFormatSource().GetInlineString())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := tassert.New(t)
+
+			accessLog := GetStdoutAccessLog(tc.spec, tc.attributes)
+			a.NotNil(accessLog)
+			a.Equal(AccessLoggerName, accessLog.Name)
+
+			accessLogger := &xds_accesslog.StdoutAccessLog{}
+			a.Nil(accessLog.GetTypedConfig().UnmarshalTo(accessLogger))
+			tc.assertFunc(a, accessLogger.GetLogFormat())
+		})
+	}
+}
+
+func TestGetAccessLogFilter(t *testing.T) {
+	minStatusCode := uint32(500)
+	sampledFraction := "0.25"
+	invalidSampledFraction := "2"
+
+	testCases := []struct {
+		name       string
+		spec       configv1alpha2.AccessLogFilterSpec
+		assertFunc func(*tassert.Assertions, *xds_accesslog_filter.AccessLogFilter)
+	}{
+		{
+			name: "no filter",
+			spec: configv1alpha2.AccessLogFilterSpec{},
+			assertFunc: func(a *tassert.Assertions, filter *xds_accesslog_filter.AccessLogFilter) {
+				a.Nil(filter)
+			},
+		},
+		{
+			name: "status code filter",
+			spec: configv1alpha2.AccessLogFilterSpec{MinStatusCode: &minStatusCode},
+			assertFunc: func(a *tassert.Assertions, filter *xds_accesslog_filter.AccessLogFilter) {
+				comparison := filter.GetStatusCodeFilter().GetComparison()
+				a.Equal(xds_accesslog_filter.ComparisonFilter_GE, comparison.Op)
+				a.Equal(minStatusCode, comparison.Value.DefaultValue)
+			},
+		},
+		{
+			name: "sampled fraction filter",
+			spec: configv1alpha2.AccessLogFilterSpec{SampledFraction: &sampledFraction},
+			assertFunc: func(a *tassert.Assertions, filter *xds_accesslog_filter.AccessLogFilter) {
+				a.Equal(uint32(250000), filter.GetRuntimeFilter().PercentSampled.Numerator)
+				a.Equal(xds_type.FractionalPercent_MILLION, filter.GetRuntimeFilter().PercentSampled.Denominator)
+			},
+		},
+		{
+			name: "invalid sampled fraction filter",
+			spec: configv1alpha2.AccessLogFilterSpec{SampledFraction: &invalidSampledFraction},
+			assertFunc: func(a *tassert.Assertions, filter *xds_accesslog_filter.AccessLogFilter) {
+				a.Nil(filter)
+			},
+		},
+		{
+			name: "status code and sampled fraction filters",
+			spec: configv1alpha2.AccessLogFilterSpec{MinStatusCode: &minStatusCode, SampledFraction: &sampledFraction},
+			assertFunc: func(a *tassert.Assertions, filter *xds_accesslog_filter.AccessLogFilter) {
+				filters := filter.GetAndFilter().GetFilters()
+				a.Len(filters, 2)
+				a.NotNil(filters[0].GetStatusCodeFilter())
+				a.NotNil(filters[1].GetRuntimeFilter())
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.assertFunc(tassert.New(t), getAccessLogFilter(tc.spec))
+		})
+	}
+}
+
+func TestGetOpenTelemetryAccessLog(t *testing.T) {
+	assert := tassert.New(t)
+
+	res := GetOpenTelemetryAccessLog("cluster", map[string]string{"service.name": "sa"})
+	assert.NotNil(res)
+	assert.Equal(OpenTelemetryAccessLoggerName, res.Name)
+
+	accessLogger := &xds_accesslog_otel.OpenTelemetryAccessLogConfig{}
+	assert.Nil(res.GetTypedConfig().UnmarshalTo(accessLogger))
+	assert.Equal("cluster", accessLogger.CommonConfig.GrpcService.GetEnvoyGrpc().ClusterName)
+	assert.Equal(OpenTelemetryAccessLogName, accessLogger.CommonConfig.LogName)
+
+	attributes := accessLogger.Attributes.Values
+	assert.Len(attributes, len(accessLogFormat)+1)
+	for i := 1; i < len(attributes); i++ {
+		assert.Less(attributes[i-1].Key, attributes[i].Key)
+	}
+	assert.Contains(attributes, otlpStringKeyValue("service.name", "sa"))
+}
+
 var sidecarSpec = configv1alpha2.SidecarSpec{
 	TLSMinProtocolVersion: "TLSv1_2",
 	TLSMaxProtocolVersion: "TLSv1_3",
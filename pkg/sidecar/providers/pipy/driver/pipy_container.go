@@ -168,6 +168,10 @@ func getPipySidecarContainerSpec(injCtx *driver.InjectorContext, pod *corev1.Pod
 				Value: injCtx.Configurator.GetTracingEndpoint(),
 			})
 		}
+		sidecarContainer.Env = append(sidecarContainer.Env, corev1.EnvVar{
+			Name:  "TRACING_PROTOCOL",
+			Value: string(injCtx.Configurator.GetTracingProtocol()),
+		})
 		sidecarContainer.Env = append(sidecarContainer.Env, corev1.EnvVar{
 			Name:  "TRACING_SAMPLED_FRACTION",
 			Value: fmt.Sprintf("%0.2f", injCtx.Configurator.GetTracingSampledFraction()),
@@ -197,6 +201,10 @@ func getPipySidecarContainerSpec(injCtx *driver.InjectorContext, pod *corev1.Pod
 				Value: injCtx.Configurator.GetRemoteLoggingAuthorization(),
 			})
 		}
+		sidecarContainer.Env = append(sidecarContainer.Env, corev1.EnvVar{
+			Name:  "REMOTE_LOGGING_PROTOCOL",
+			Value: string(injCtx.Configurator.GetRemoteLoggingProtocol()),
+		})
 		sidecarContainer.Env = append(sidecarContainer.Env, corev1.EnvVar{
 			Name:  "REMOTE_LOGGING_SAMPLED_FRACTION",
 			Value: fmt.Sprintf("%0.2f", injCtx.Configurator.GetRemoteLoggingSampledFraction()),
@@ -69,6 +69,7 @@ type PodMetadata struct {
 	SidecarNodeID   string
 	WorkloadKind    string
 	WorkloadName    string
+	Labels          map[string]string
 	ReadinessProbes []*v1.Probe
 	LivenessProbes  []*v1.Probe
 	StartupProbes   []*v1.Probe
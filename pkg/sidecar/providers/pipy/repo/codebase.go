@@ -30,15 +30,27 @@ var codebaseMainJs []byte
 //go:embed codebase/metrics.js
 var codebaseMetricsJs []byte
 
+//go:embed codebase/modules/inbound-grpc-web.js
+var codebaseModulesInboundGRPCWebJs []byte
+
+//go:embed codebase/modules/inbound-http-authz.js
+var codebaseModulesInboundHTTPAuthzJs []byte
+
 //go:embed codebase/modules/inbound-http-default.js
 var codebaseModulesInboundHTTPDefaultJs []byte
 
+//go:embed codebase/modules/inbound-http-filters.js
+var codebaseModulesInboundHTTPFiltersJs []byte
+
 //go:embed codebase/modules/inbound-http-load-balancing.js
 var codebaseModulesInboundHTTPLoadBalancingJs []byte
 
 //go:embed codebase/modules/inbound-http-routing.js
 var codebaseModulesInboundHTTPRoutingJs []byte
 
+//go:embed codebase/modules/inbound-jwt-authn.js
+var codebaseModulesInboundJWTAuthnJs []byte
+
 //go:embed codebase/modules/inbound-logging-http.js
 var codebaseModulesInboundLoggingHTTPJs []byte
 
@@ -57,6 +69,12 @@ var codebaseModulesInboundTCPLoadBalancingJs []byte
 //go:embed codebase/modules/inbound-tcp-routing.js
 var codebaseModulesInboundTCPRoutingJs []byte
 
+//go:embed codebase/modules/inbound-tcp-throttle-global.js
+var codebaseModulesInboundTCPThrottleGlobalJs []byte
+
+//go:embed codebase/modules/inbound-throttle-global.js
+var codebaseModulesInboundThrottleGlobalJs []byte
+
 //go:embed codebase/modules/inbound-throttle-route.js
 var codebaseModulesInboundThrottleRouteJs []byte
 
@@ -72,9 +90,15 @@ var codebaseModulesInboundTracingHTTPJs []byte
 //go:embed codebase/modules/outbound-circuit-breaker.js
 var codebaseModulesOutboundCircuitBreakerJs []byte
 
+//go:embed codebase/modules/outbound-fault-injection.js
+var codebaseModulesOutboundFaultInjectionJs []byte
+
 //go:embed codebase/modules/outbound-http-default.js
 var codebaseModulesOutboundHTTPDefaultJs []byte
 
+//go:embed codebase/modules/outbound-http-filters.js
+var codebaseModulesOutboundHTTPFiltersJs []byte
+
 //go:embed codebase/modules/outbound-http-load-balancing.js
 var codebaseModulesOutboundHTTPLoadBalancingJs []byte
 
@@ -102,6 +126,9 @@ var codebaseModulesOutboundTCPRoutingJs []byte
 //go:embed codebase/modules/outbound-tracing-http.js
 var codebaseModulesOutboundTracingHTTPJs []byte
 
+//go:embed codebase/modules/outbound-udp-main.js
+var codebaseModulesOutboundUDPMainJs []byte
+
 //go:embed codebase/probes.js
 var codebaseProbesJs []byte
 
@@ -126,21 +153,29 @@ var osmCodebaseItems = []client.BatchItem{
 	{Filename: "logging.js", Content: codebaseLoggingJs},
 	{Filename: "main.js", Content: codebaseMainJs},
 	{Filename: "metrics.js", Content: codebaseMetricsJs},
+	{Filename: "modules/inbound-grpc-web.js", Content: codebaseModulesInboundGRPCWebJs},
+	{Filename: "modules/inbound-http-authz.js", Content: codebaseModulesInboundHTTPAuthzJs},
 	{Filename: "modules/inbound-http-default.js", Content: codebaseModulesInboundHTTPDefaultJs},
+	{Filename: "modules/inbound-http-filters.js", Content: codebaseModulesInboundHTTPFiltersJs},
 	{Filename: "modules/inbound-http-load-balancing.js", Content: codebaseModulesInboundHTTPLoadBalancingJs},
 	{Filename: "modules/inbound-http-routing.js", Content: codebaseModulesInboundHTTPRoutingJs},
+	{Filename: "modules/inbound-jwt-authn.js", Content: codebaseModulesInboundJWTAuthnJs},
 	{Filename: "modules/inbound-logging-http.js", Content: codebaseModulesInboundLoggingHTTPJs},
 	{Filename: "modules/inbound-main.js", Content: codebaseModulesInboundMainJs},
 	{Filename: "modules/inbound-metrics-http.js", Content: codebaseModulesInboundMetricsHTTPJs},
 	{Filename: "modules/inbound-tcp-default.js", Content: codebaseModulesInboundTCPDefaultJs},
 	{Filename: "modules/inbound-tcp-load-balancing.js", Content: codebaseModulesInboundTCPLoadBalancingJs},
 	{Filename: "modules/inbound-tcp-routing.js", Content: codebaseModulesInboundTCPRoutingJs},
+	{Filename: "modules/inbound-tcp-throttle-global.js", Content: codebaseModulesInboundTCPThrottleGlobalJs},
+	{Filename: "modules/inbound-throttle-global.js", Content: codebaseModulesInboundThrottleGlobalJs},
 	{Filename: "modules/inbound-throttle-route.js", Content: codebaseModulesInboundThrottleRouteJs},
 	{Filename: "modules/inbound-throttle-service.js", Content: codebaseModulesInboundThrottleServiceJs},
 	{Filename: "modules/inbound-tls-termination.js", Content: codebaseModulesInboundTLSTerminationJs},
 	{Filename: "modules/inbound-tracing-http.js", Content: codebaseModulesInboundTracingHTTPJs},
 	{Filename: "modules/outbound-circuit-breaker.js", Content: codebaseModulesOutboundCircuitBreakerJs},
+	{Filename: "modules/outbound-fault-injection.js", Content: codebaseModulesOutboundFaultInjectionJs},
 	{Filename: "modules/outbound-http-default.js", Content: codebaseModulesOutboundHTTPDefaultJs},
+	{Filename: "modules/outbound-http-filters.js", Content: codebaseModulesOutboundHTTPFiltersJs},
 	{Filename: "modules/outbound-http-load-balancing.js", Content: codebaseModulesOutboundHTTPLoadBalancingJs},
 	{Filename: "modules/outbound-http-routing.js", Content: codebaseModulesOutboundHTTPRoutingJs},
 	{Filename: "modules/outbound-logging-http.js", Content: codebaseModulesOutboundLoggingHTTPJs},
@@ -150,6 +185,7 @@ var osmCodebaseItems = []client.BatchItem{
 	{Filename: "modules/outbound-tcp-load-balancing.js", Content: codebaseModulesOutboundTCPLoadBalancingJs},
 	{Filename: "modules/outbound-tcp-routing.js", Content: codebaseModulesOutboundTCPRoutingJs},
 	{Filename: "modules/outbound-tracing-http.js", Content: codebaseModulesOutboundTracingHTTPJs},
+	{Filename: "modules/outbound-udp-main.js", Content: codebaseModulesOutboundUDPMainJs},
 	{Filename: "probes.js", Content: codebaseProbesJs},
 	{Filename: "stats.js", Content: codebaseStatsJs},
 	{Filename: "tracing.js", Content: codebaseTracingJs},
@@ -26,6 +26,61 @@
     )
   )(),
 
+  // The distinct CA bundles the certificates of the external clusters are validated against
+  egressTrustedCAs = Object.keys(
+    Object.values(config?.Outbound?.ClustersConfigs || {}).reduce(
+      (cas, c) => (c?.TLS?.TrustedCA && (cas[c.TLS.TrustedCA] = true), cas),
+      {}
+    )
+  ),
+
+  // Names of the minimum TLS protocol versions of the Egress policies,
+  // TLS_AUTO leaving the protocol version to be negotiated
+  tlsProtocolVersions = {
+    'TLSv1_0': 'TLSv1',
+    'TLSv1_1': 'TLSv1.1',
+    'TLSv1_2': 'TLSv1.2',
+    'TLSv1_3': 'TLSv1.3',
+  },
+
+  // Each external cluster is only trusted with its own CA bundle, hence a TLS connection per distinct CA bundle,
+  // the connections of the external clusters without a CA bundle being the last ones
+  connectEgressTLS = ($, cas) => (
+    cas.length > 0 ? (
+      $.branch(
+        () => __tls.TrustedCA === cas[0], (
+          $=>$.connectTLS({
+            certificate: () => _cert ? ({
+              cert: _cert,
+              key: _key,
+            }) : undefined,
+            trusted: [new crypto.Certificate(cas[0])],
+            sni: () => __tls.SNI,
+            minVersion: () => tlsProtocolVersions[__tls.MinProtocolVersion],
+            verify: (ok, cert) => (
+              ok && Boolean(
+                __tls.SubjectAltNames?.includes?.(cert?.subject?.commonName) ||
+                cert?.subjectAltNames?.find?.(o => __tls.SubjectAltNames?.includes?.(o))
+              )
+            ),
+          }).to($=>$.use('connect-tcp.js'))
+        ),
+        $=>connectEgressTLS($, cas.slice(1))
+      )
+    ) : (
+      // The certificate of the external host is only validated when a trusted CA is specified
+      $.connectTLS({
+        certificate: () => _cert ? ({
+          cert: _cert,
+          key: _key,
+        }) : undefined,
+        sni: () => __tls.SNI,
+        minVersion: () => tlsProtocolVersions[__tls.MinProtocolVersion],
+        verify: () => true,
+      }).to($=>$.use('connect-tcp.js'))
+    )
+  ),
+
   certCache = new algo.Cache(certString => new crypto.Certificate(certString)),
   keyCache = new algo.Cache(keyString => new crypto.PrivateKey(keyString)),
 ) => (
@@ -37,27 +92,45 @@ pipy({
 
 .import({
   __cert: 'outbound',
+  __tls: 'outbound',
 })
 
 .pipeline()
-.handleStreamStart(
-  () => (
-    __cert && (
-      _cert = certCache.get(__cert.CertChain),
-      _key = keyCache.get(__cert.PrivateKey),
-      true
-    ) || (
-      _cert = certCache.get(certChain),
-      _key = keyCache.get(privateKey)
+.branch(
+  () => __tls, (
+    $=>connectEgressTLS(
+      $.handleStreamStart(
+        () => (
+          __tls.CertChain && (
+            _cert = certCache.get(__tls.CertChain),
+            _key = keyCache.get(__tls.PrivateKey)
+          )
+        )
+      ),
+      egressTrustedCAs
+    )
+  ), (
+    $=>$
+    .handleStreamStart(
+      () => (
+        __cert && (
+          _cert = certCache.get(__cert.CertChain),
+          _key = keyCache.get(__cert.PrivateKey),
+          true
+        ) || (
+          _cert = certCache.get(certChain),
+          _key = keyCache.get(privateKey)
+        )
+      )
     )
+    .connectTLS({
+      certificate: () => ({
+        cert: _cert,
+        key: _key,
+      }),
+      trusted: listIssuingCA,
+    }).to($=>$.use('connect-tcp.js'))
   )
 )
-.connectTLS({
-  certificate: () => ({
-    cert: _cert,
-    key: _key,
-  }),
-  trusted: listIssuingCA,
-}).to($=>$.use('connect-tcp.js'))
 
 ))()
\ No newline at end of file
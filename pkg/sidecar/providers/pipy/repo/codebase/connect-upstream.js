@@ -33,6 +33,7 @@ pipy({
 .import({
   __port: 'outbound',
   __cert: 'outbound',
+  __tls: 'outbound',
   __isEgress: 'outbound',
   __target: 'connect-tcp',
 })
@@ -53,7 +54,7 @@ pipy({
   )
 )
 .branch(
-  () => __cert || (certChain && !__isEgress), (
+  () => __tls || __cert || (certChain && !__isEgress), (
     $=>$.use('connect-tls.js')
   ),
   () => __isEgress && _egressEndpoint, (
@@ -9,7 +9,8 @@
       ([k, v]) => (
         ((rr) => (
           rr = [],
-          v.map(
+          // Only the IPv4 addresses are served in the A records
+          v.filter(ip => ip.indexOf(':') < 0).map(
             ip => (
               rr.push({
                 'name': k,
@@ -8,8 +8,16 @@
       pod,
     } = pipy.solve('utils.js'),
     address = os.env.REMOTE_LOGGING_ADDRESS,
+    protocol = (os.env.REMOTE_LOGGING_PROTOCOL || 'http'),
+    isOTLP = (protocol === 'otlp-http'),
     tracingLimitedID = os.env.REMOTE_LOGGING_SAMPLED_FRACTION && (os.env.REMOTE_LOGGING_SAMPLED_FRACTION * Math.pow(2, 63)),
-    logLogging = address && new logging.JSONLogger('access-logger').toHTTP('http://' + address +
+    {
+      toInt63,
+      toTraceId128,
+      toOTLPAttributes,
+      makeOTLPExporter,
+    } = pipy.solve('utils.js'),
+    logLogging = address && !isOTLP && protocol !== 'otlp-grpc' && new logging.JSONLogger('access-logger').toHTTP('http://' + address +
       (os.env.REMOTE_LOGGING_ENDPOINT || '/?query=insert%20into%20log(message)%20format%20JSONAsString'), {
       batch: {
         timeout: 1,
@@ -23,9 +31,26 @@
         'Authorization': os.env.REMOTE_LOGGING_AUTHORIZATION || ''
       }
     }).log,
-    {
-      toInt63,
-    } = pipy.solve('utils.js'),
+    logOTLP = address && isOTLP && makeOTLPExporter('otlp-logs', 'http://' + address + (os.env.REMOTE_LOGGING_ENDPOINT || '/v1/logs'), 'Logs', {
+      'Authorization': os.env.REMOTE_LOGGING_AUTHORIZATION || ''
+    }),
+
+    // Converts the access log data to a log record in the OTLP/JSON format
+    toOTLPLogRecord = data => ({
+      timeUnixNano: data.reqTime + '000000',
+      observedTimeUnixNano: data.endTime + '000000',
+      severityNumber: 9,
+      severityText: 'INFO',
+      body: { stringValue: JSON.stringify(data) },
+      attributes: toOTLPAttributes({
+        'http.method': data.req?.method,
+        'http.target': data.req?.path,
+        'http.status_code': data.res?.status,
+        'osm.direction': data.type,
+      }),
+      traceId: data.trace.id ? toTraceId128(data.trace.id) : '',
+      spanId: data.trace.span,
+    }),
     initTracingHeaders = (headers) => (
       (
         uuid = algo.uuid(),
@@ -48,8 +73,11 @@
       )
     )(),
   ) => (
+    (
+      protocol === 'otlp-grpc' && console.log('Remote logging protocol otlp-grpc is not supported by the Pipy sidecar, use otlp-http to export the logs over OTLP')
+    ),
     {
-      loggingEnabled: Boolean(logLogging),
+      loggingEnabled: Boolean(logLogging || logOTLP),
 
       makeLoggingData: (msg, remoteAddr, remotePort, localAddr, localPort, isOutbound) => (
         (
@@ -104,7 +132,7 @@
         loggingData['resTime'] = Date.now(),
         loggingData['endTime'] = Date.now(),
         loggingData['type'] = type,
-        logLogging(loggingData)
+        logOTLP ? logOTLP(toOTLPLogRecord(loggingData)) : logLogging(loggingData)
         // , console.log('loggingData : ', loggingData)
       ),
     }
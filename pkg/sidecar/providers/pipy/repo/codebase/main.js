@@ -1,6 +1,9 @@
 ((
   config = pipy.solve('config.js'),
 
+  // Listen on all the IPv6 addresses to also accept the IPv6 traffic when the pod is dual-stack
+  listenAddress = port => config?.Spec?.DualStack ? `:::${port}` : port,
+
   connectOptions = (config?.Spec?.SidecarTimeout > 0) ? (
     {
       connectTimeout: config.Spec.SidecarTimeout,
@@ -15,7 +18,7 @@
 .branch(
   Boolean(config?.Inbound?.TrafficMatches), (
     $=>$
-    .listen(15003, { transparent: true, ...connectOptions })
+    .listen(listenAddress(15003), { transparent: true, ...connectOptions })
     .onStart(() => new Data)
     .use('modules/inbound-main.js')
   )
@@ -24,12 +27,23 @@
 .branch(
   Boolean(config?.Outbound || config?.Spec?.Traffic?.EnableEgress), (
     $=>$
-    .listen(15001, { transparent: true, ...connectOptions })
+    .listen(listenAddress(15001), { transparent: true, ...connectOptions })
     .onStart(() => new Data)
     .use('modules/outbound-main.js')
   )
 )
 
+//
+// Outbound UDP traffic intercepted with TPROXY
+//
+.branch(
+  Boolean(config?.Spec?.Traffic?.UDPPortPolicies), (
+    $=>$
+    .listen(listenAddress(15001), { protocol: 'udp', transparent: true })
+    .use('modules/outbound-udp-main.js')
+  )
+)
+
 .branch(
   config?.Spec?.Probes?.LivenessProbes?.[0]?.httpGet?.port === 15901,
   $=>$.listen(15901).use('probes.js', 'liveness')
@@ -55,6 +55,12 @@
       'sidecar_cluster_name',
       'sidecar_response_code_class'
     ]),
+    upstreamGRPCStatusCount = new stats.Counter('sidecar_cluster_upstream_rq_grpc', [
+      'sidecar_cluster_name',
+      'grpc_service',
+      'grpc_method',
+      'grpc_status'
+    ]),
     upstreamResponseTotal = new stats.Counter('sidecar_cluster_upstream_rq_total', [
       'source_namespace',
       'source_workload_kind',
@@ -100,6 +106,7 @@
         requestSendResetCounter: requestSendResetCounter.withLabels(clusterName),
         upstreamCodeCount: upstreamCodeCount.withLabels(clusterName),
         upstreamCodeXCount: upstreamCodeXCount.withLabels(clusterName),
+        upstreamGRPCStatusCount: upstreamGRPCStatusCount.withLabels(clusterName),
         upstreamResponseTotal: upstreamResponseTotal.withLabels(namespace, kind, name, pod, clusterName),
         upstreamResponseCode: upstreamResponseCode.withLabels(namespace, kind, name, pod, clusterName),
       }
@@ -114,6 +121,15 @@
     )()),
 
     serverLiveGauge = new stats.Gauge('sidecar_server_live'),
+
+    // A gRPC request is a POST to /<service>/<method> with an application/grpc content type
+    getGRPCMethod = head => (
+      (
+        items = head?.headers?.['content-type']?.startsWith?.('application/grpc') && head?.path?.split?.('?')[0].split('/'),
+      ) => (
+        items?.length === 3 && items[1] && items[2] ? { service: items[1], method: items[2] } : null
+      )
+    )(),
   ) => (
 
     Object.keys(config?.Inbound?.ClustersConfigs || {}).concat(Object.keys(config?.Outbound?.ClustersConfigs || {})).forEach(
@@ -145,6 +161,7 @@
       identity,
       metricsCache,
       identityCache,
+      getGRPCMethod,
       rateLimitCounter: new stats.Counter('http_local_rate_limiter', [
         'http_local_rate_limit'
       ]),
@@ -0,0 +1,67 @@
+((
+  grpcWebContentType = 'application/grpc-web',
+
+  // Only binary gRPC-Web requests are bridged, base64 encoded ones (application/grpc-web-text) are forwarded as is
+  isGRPCWeb = head => (
+    (
+      contentType = head?.headers?.['content-type'],
+    ) => (
+      contentType?.startsWith?.(grpcWebContentType) && !contentType.startsWith(grpcWebContentType + '-text')
+    )
+  )(),
+
+  // The trailers of the gRPC response are sent in the last frame of the gRPC-Web response body,
+  // flagged with the most significant bit of its first byte
+  makeTrailersFrame = trailers => (
+    (
+      text = new Data(Object.entries(trailers).map(([k, v]) => k + ': ' + v + '\r\n').join('')),
+      size = text.size,
+      frame = new Data([0x80, (size >> 24) & 0xff, (size >> 16) & 0xff, (size >> 8) & 0xff, size & 0xff]),
+    ) => (
+      frame.push(text),
+      frame
+    )
+  )(),
+) => pipy({
+  _contentType: null,
+})
+
+.import({
+  __port: 'inbound',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _contentType = null,
+    __port?.Protocol === 'grpc' && isGRPCWeb(msg?.head) && (
+      _contentType = msg.head.headers['content-type'],
+      msg.head.headers['content-type'] = 'application/grpc' + _contentType.substring(grpcWebContentType.length),
+      msg.head.headers['te'] = 'trailers',
+      delete msg.head.headers['content-length']
+    )
+  )
+)
+.chain()
+.branch(
+  () => _contentType, (
+    // The response is streamed, its trailers being sent in a last frame appended to its body
+    $=>$
+    .handleMessageStart(
+      msg => msg?.head?.headers && (
+        msg.head.headers['content-type'] = _contentType,
+        delete msg.head.headers['content-length']
+      )
+    )
+    .replaceMessageEnd(
+      msgEnd => (
+        msgEnd?.tail?.headers && Object.keys(msgEnd.tail.headers).length > 0 ? [
+          makeTrailersFrame(msgEnd.tail.headers),
+          new MessageEnd,
+        ] : msgEnd
+      )
+    )
+  )
+)
+
+)()
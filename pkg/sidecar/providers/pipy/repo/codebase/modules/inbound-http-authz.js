@@ -0,0 +1,124 @@
+((
+  toNetmask = block => (
+    new Netmask(block.indexOf('/') >= 0 ? block : (block.indexOf(':') >= 0 ? block + '/128' : block + '/32'))
+  ),
+
+  matchPath = (paths, path) => (
+    paths.some(
+      p => p.endsWith('*') ? path.startsWith(p.substring(0, p.length - 1)) : path === p
+    )
+  ),
+
+  // Identities are of the form <service-account>.<namespace>
+  namespaceOf = identity => (
+    (dot = identity.indexOf('.')) => dot < 0 ? '' : identity.substring(dot + 1)
+  )(),
+
+  makeSource = source => (
+    (
+      identities = source.Identities?.length > 0 ? new Set(source.Identities) : null,
+      namespaces = source.Namespaces?.length > 0 ? new Set(source.Namespaces) : null,
+      ipBlocks = source.IPBlocks?.length > 0 ? source.IPBlocks.map(toNetmask) : null,
+    ) => (
+      (identity, ip) => (
+        (!identities || identities.has(identity)) &&
+        (!namespaces || namespaces.has(namespaceOf(identity))) &&
+        (!ipBlocks || ipBlocks.some(m => m.contains(ip)))
+      )
+    )
+  )(),
+
+  makeOperation = operation => (
+    (
+      ports = operation.Ports?.length > 0 ? new Set(operation.Ports) : null,
+      methods = operation.Methods?.length > 0 ? new Set(operation.Methods.map(m => m.toUpperCase())) : null,
+      paths = operation.Paths?.length > 0 ? operation.Paths : null,
+      headers = Object.entries(operation.Headers || {}),
+    ) => (
+      (port, head) => (
+        (!ports || ports.has(port)) &&
+        (!methods || methods.has(head.method)) &&
+        (!paths || matchPath(paths, head.path.split('?')[0])) &&
+        headers.every(
+          ([name, values]) => (
+            (value = head.headers[name]) => value !== undefined && (values.length === 0 || values.includes(value))
+          )()
+        )
+      )
+    )
+  )(),
+
+  makeRule = rule => (
+    (
+      sources = (rule.From || []).map(makeSource),
+      operations = (rule.To || []).map(makeOperation),
+    ) => (
+      (identity, ip, port, head) => (
+        (sources.length === 0 || sources.some(s => s(identity, ip))) &&
+        (operations.length === 0 || operations.some(o => o(port, head)))
+      )
+    )
+  )(),
+
+  makeAuthorizer = policies => (
+    (
+      policiesOf = action => (
+        policies.filter(p => p.Action === action).map(
+          p => ({ name: p.Name, rules: (p.Rules || []).map(makeRule) })
+        )
+      ),
+      denyPolicies = policiesOf('DENY'),
+      auditPolicies = policiesOf('AUDIT'),
+      allowPolicies = policiesOf('ALLOW'),
+
+      findPolicy = (list, identity, ip, port, head) => (
+        list.find(p => p.rules.some(rule => rule(identity, ip, port, head)))
+      ),
+    ) => (
+      head => (
+        (
+          identity = head.headers.serviceidentity || '',
+          ip = __inbound.remoteAddress || '127.0.0.1',
+          port = __inbound.destinationPort,
+          audit = findPolicy(auditPolicies, identity, ip, port, head),
+        ) => (
+          audit && console.log('inbound-http-authz # audit policy/identity/ip/method/path :', audit.name, identity, ip, head.method, head.path),
+          findPolicy(denyPolicies, identity, ip, port, head) ? 403 : (
+            allowPolicies.length > 0 && !findPolicy(allowPolicies, identity, ip, port, head) ? 403 : 0
+          )
+        )
+      )()
+    )
+  )(),
+
+  authorizerCache = new algo.Cache(service => makeAuthorizer(service.AuthorizationPolicies)),
+) => (
+
+pipy({
+  _status: 0,
+})
+
+.import({
+  __service: 'inbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _status = __service?.AuthorizationPolicies ? authorizerCache.get(__service)(msg.head) : 0
+  )
+)
+.branch(
+  () => _status > 0, (
+    $=>$.replaceMessage(
+      () => [
+        new Message({ status: _status }, 'RBAC: access denied'),
+        new StreamEnd
+      ]
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+))()
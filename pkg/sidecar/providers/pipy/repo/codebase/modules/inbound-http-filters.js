@@ -0,0 +1,44 @@
+((
+  { makeRouteFilters } = pipy.solve('utils.js'),
+  filtersCache = new algo.Cache(makeRouteFilters),
+) => (
+
+pipy({
+  _filters: null,
+  _location: null,
+})
+
+.import({
+  __route: 'inbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _filters = filtersCache.get(__route),
+    _filters && (
+      _filters.redirect ? (
+        _location = _filters.redirect(msg.head)
+      ) : (
+        _filters.request(msg.head)
+      )
+    )
+  )
+)
+.branch(
+  () => _location, (
+    $=>$.replaceMessage(
+      () => [new Message({ status: _filters.redirectStatus, headers: { location: _location } }), new StreamEnd]
+    )
+  ), (
+    $=>$
+    .chain()
+    .handleMessageStart(
+      msg => _filters?.response && msg?.head && (
+        msg.head.headers = _filters.response(msg.head.headers || {})
+      )
+    )
+  )
+)
+
+))()
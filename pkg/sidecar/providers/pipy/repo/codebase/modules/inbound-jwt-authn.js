@@ -0,0 +1,178 @@
+((
+  config = pipy.solve('config.js'),
+
+  parseJWKS = jwks => (
+    (jwks?.keys || []).map(
+      k => ({ kid: k.kid, key: new crypto.JWK(k) })
+    )
+  ),
+
+  parseURI = uri => (
+    (
+      tls = uri.startsWith('https://'),
+      rest = uri.substring(tls ? 8 : 7),
+      slash = rest.indexOf('/'),
+      host = slash < 0 ? rest : rest.substring(0, slash),
+      colon = host.lastIndexOf(':'),
+    ) => ({
+      uri,
+      tls,
+      host,
+      hostname: colon < 0 ? host : host.substring(0, colon),
+      address: colon < 0 ? host + (tls ? ':443' : ':80') : host,
+      path: slash < 0 ? '/' : rest.substring(slash),
+    })
+  )(),
+
+  // Key sets fetched from the JWKS URIs, refreshed periodically
+  remoteKeys = {},
+
+  jwksTargets = (
+    (uris = {}) => (
+      Object.values(config?.Inbound?.TrafficMatches || {}).forEach(
+        trafficMatch => Object.values(trafficMatch?.HttpServiceRouteRules || {}).forEach(
+          rules => (rules?.JWTAuthentication?.Providers || []).forEach(
+            provider => provider.JWKSURI && (uris[provider.JWKSURI] = true)
+          )
+        )
+      ),
+      Object.keys(uris).map(parseURI)
+    )
+  )(),
+
+  matchAudience = (audiences, aud) => (
+    !audiences || (
+      Array.isArray(aud) ? aud.some(a => audiences.has(a)) : audiences.has(aud)
+    )
+  ),
+
+  matchClaim = (claim, values) => (
+    Array.isArray(claim) ? claim.some(c => values.has(String(c))) : (claim !== undefined && values.has(String(claim)))
+  ),
+
+  makeVerifier = jwtAuthn => (
+    (
+      providers = (jwtAuthn?.Providers || []).map(
+        p => ({
+          issuer: p.Issuer,
+          audiences: p.Audiences?.length > 0 ? new Set(p.Audiences) : null,
+          localKeys: p.JWKS ? parseJWKS(JSON.parse(p.JWKS)) : null,
+          uri: p.JWKSURI,
+          forward: p.ForwardOriginalToken,
+          claimToHeaders: Object.entries(p.ClaimToHeaders || {}),
+        })
+      ),
+      rules = (jwtAuthn?.Rules || []).map(
+        rule => Object.entries(rule.Claims || {}).map(([claim, values]) => [claim, new Set(values)])
+      ),
+      // The headers set by the downstream are removed so that they cannot be spoofed
+      claimHeaders = providers.reduce(
+        (headers, p) => headers.concat(p.claimToHeaders.map(([claim, header]) => header.toLowerCase())), []
+      ),
+
+      verifySignature = (provider, jwt) => (
+        (
+          kid = jwt.header?.kid,
+          keys = provider.localKeys || remoteKeys[provider.uri] || [],
+        ) => (
+          keys.filter(k => !kid || !k.kid || k.kid === kid).some(k => jwt.verify(k.key))
+        )
+      )(),
+
+      verifyTime = (payload, now) => (
+        (payload.exp === undefined || now < payload.exp) &&
+        (payload.nbf === undefined || payload.nbf <= now)
+      ),
+    ) => (
+      head => (
+        (
+          headers = head.headers,
+          token = (headers.authorization || '').replace(/^bearer\s+/i, ''),
+          jwt = token && token !== headers.authorization && new crypto.JWT(token),
+          payload = jwt?.isValid && jwt.payload,
+          provider = payload && verifyTime(payload, Date.now() / 1000) && providers.find(
+            p => p.issuer === payload.iss && matchAudience(p.audiences, payload.aud) && verifySignature(p, jwt)
+          ),
+        ) => (
+          claimHeaders.forEach(h => delete headers[h]),
+          !provider ? 401 : (
+            !(rules.length === 0 || rules.some(rule => rule.every(([claim, values]) => matchClaim(payload[claim], values)))) ? 403 : (
+              provider.claimToHeaders.forEach(
+                ([claim, header]) => (
+                  payload[claim] !== undefined && typeof payload[claim] !== 'object' && (
+                    headers[header.toLowerCase()] = String(payload[claim])
+                  )
+                )
+              ),
+              provider.forward || delete headers.authorization,
+              0
+            )
+          )
+        )
+      )()
+    )
+  )(),
+
+  verifierCache = new algo.Cache(service => makeVerifier(service.JWTAuthentication)),
+) => (
+
+pipy({
+  _status: 0,
+  _jwksTarget: null,
+})
+
+.import({
+  __service: 'inbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _status = __service?.JWTAuthentication ? verifierCache.get(__service)(msg.head) : 0
+  )
+)
+.branch(
+  () => _status > 0, (
+    $=>$.replaceMessage(
+      () => [
+        new Message({ status: _status }, _status === 401 ? 'Jwt verification fails' : 'RBAC: access denied'),
+        new StreamEnd
+      ]
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+.task('60s')
+.onStart(
+  () => new Message
+)
+.fork(() => jwksTargets).to(
+  $=>$
+  .onStart(target => void (_jwksTarget = target))
+  .replaceMessage(
+    () => new Message({ method: 'GET', path: _jwksTarget.path, headers: { host: _jwksTarget.host } })
+  )
+  .muxHTTP(() => _jwksTarget.uri).to(
+    $=>$.branch(
+      () => _jwksTarget.tls, (
+        $=>$.connectTLS({ sni: () => _jwksTarget.hostname }).to(
+          $=>$.connect(() => _jwksTarget.address)
+        )
+      ), (
+        $=>$.connect(() => _jwksTarget.address)
+      )
+    )
+  )
+  .handleMessage(
+    msg => msg.head.status === 200 && (
+      remoteKeys[_jwksTarget.uri] = parseJWKS(JSON.decode(msg.body))
+    )
+  )
+)
+.replaceMessage(
+  () => new StreamEnd
+)
+
+))()
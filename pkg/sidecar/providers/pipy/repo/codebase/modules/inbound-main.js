@@ -20,8 +20,7 @@
           ipSet = ips.length > 0 && new Set(ips),
           masks = allowedEndpoints.filter(k => k.indexOf('/') > 0),
           maskArray = masks.length > 0 && masks.map(e => new Netmask(e)),
-        ) => (
-          ipSet && maskArray ? (
+          allowIP = ipSet && maskArray ? (
             ip => (ipSet.has(ip) || maskArray.find(e => e.contains(ip)))
           ) : (
             ipSet ? (
@@ -31,7 +30,10 @@
                 ip => maskArray.find(e => e.contains(ip))
               ) : () => false
             )
-          )
+          ),
+        ) => (
+          // IPv4-mapped IPv6 addresses are allowed as IPv4 addresses
+          ip => allowIP(ip.startsWith('::ffff:') && ip.indexOf('.') > 0 ? ip.substring(7) : ip)
         )
       )(),
       connectionQuota = portConfig?.RateLimit?.Local && (
@@ -101,11 +103,16 @@
     /*[
       'modules/inbound-tls-termination.js',
       'modules/inbound-http-routing.js',
+      'modules/inbound-grpc-web.js',
       'modules/inbound-metrics-http.js',
       'modules/inbound-tracing-http.js',
       'modules/inbound-logging-http.js',
+      'modules/inbound-jwt-authn.js',
+      'modules/inbound-http-authz.js',
       'modules/inbound-throttle-service.js',
       'modules/inbound-throttle-route.js',
+      'modules/inbound-throttle-global.js',
+      'modules/inbound-http-filters.js',
       'modules/inbound-http-load-balancing.js',
       'modules/inbound-http-default.js',
     ]*/
@@ -116,6 +123,7 @@
     /*[
       'modules/inbound-tls-termination.js',
       'modules/inbound-tcp-routing.js',
+      'modules/inbound-tcp-throttle-global.js',
       'modules/inbound-tcp-load-balancing.js',
       'modules/inbound-tcp-default.js',
     ]*/
@@ -2,16 +2,25 @@
   {
     identity,
     metricsCache,
+    getGRPCMethod,
   } = pipy.solve('metrics.js'),
 ) => (
 
-pipy()
+pipy({
+  _grpcMethod: null,
+  _grpcStatus: null,
+})
 
 .import({
   __cluster: 'inbound-http-routing',
 })
 
 .pipeline()
+.handleMessageStart(
+  (msg) => (
+    _grpcMethod = getGRPCMethod(msg?.head)
+  )
+)
 .chain()
 .handleMessageStart(
   (msg) => (
@@ -27,5 +36,26 @@ pipy()
     )
   )()
 )
+.branch(
+  () => _grpcMethod, (
+    // The gRPC status is sent in the response trailers, or in the response headers of a trailers-only response
+    $=>$.handleMessageStart(
+      (msg) => (
+        _grpcStatus = msg?.head?.headers?.['grpc-status']
+      )
+    )
+    .handleMessageEnd(
+      (msgEnd) => (
+        (
+          status = _grpcStatus || msgEnd?.tail?.headers?.['grpc-status'],
+        ) => (
+          status && metricsCache.get(__cluster?.name).upstreamGRPCStatusCount.withLabels(
+            _grpcMethod.service, _grpcMethod.method, status
+          ).increase()
+        )
+      )()
+    )
+  )
+)
 
-))()
\ No newline at end of file
+))()
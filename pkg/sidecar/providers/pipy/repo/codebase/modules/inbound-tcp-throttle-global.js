@@ -0,0 +1,71 @@
+((
+  { initGlobalRateLimit, makeGlobalRateLimitRequest } = pipy.solve('utils.js'),
+  connectionLimitCounter = new stats.Counter('sidecar_global_rate_limit_inbound', ['label']),
+  rateLimitCache = new algo.Cache(initGlobalRateLimit),
+) => (
+
+pipy({
+  _rateLimit: null,
+  _request: null,
+  _checked: false,
+  _overLimit: false,
+})
+
+.import({
+  __port: 'inbound',
+})
+
+.pipeline()
+.onStart(
+  () => void (
+    (_rateLimit = rateLimitCache.get(__port?.RateLimit)) && (
+      _request = makeGlobalRateLimitRequest(_rateLimit)
+    ),
+    _checked = !_request
+  )
+)
+.branch(
+  () => _request, (
+    $=>$
+    .fork().to(
+      $=>$
+      .replaceStreamStart(() => [_request, new StreamEnd])
+      .muxHTTP(() => _rateLimit).to(
+        $=>$.connect(() => _rateLimit.target, {
+          connectTimeout: () => _rateLimit.timeout,
+          readTimeout: () => _rateLimit.timeout,
+        })
+      )
+      .handleMessage(
+        msg => (
+          (_overLimit = (JSON.decode(msg.body)?.overallCode === 'OVER_LIMIT')),
+          _checked = true
+        )
+      )
+      .handleStreamEnd(
+        () => !_checked && (
+          (_overLimit = _rateLimit.failureModeDeny),
+          _checked = true
+        )
+      )
+      .replaceStreamStart(new StreamEnd)
+    )
+    .wait(() => _checked)
+    .branch(
+      () => _overLimit, (
+        $=>$.replaceStreamStart(
+          () => (
+            connectionLimitCounter.withLabels(__port?.Port + '_' + __port?.Protocol).increase(),
+            new StreamEnd('ConnectionReset')
+          )
+        )
+      ), (
+        $=>$.chain()
+      )
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+))()
@@ -0,0 +1,74 @@
+((
+  { initGlobalRateLimit, makeGlobalRateLimitRequest } = pipy.solve('utils.js'),
+  { rateLimitCounter } = pipy.solve('metrics.js'),
+  rateLimitedCounter = rateLimitCounter.withLabels('throttle-global'),
+  rateLimitCache = new algo.Cache(initGlobalRateLimit),
+) => (
+
+pipy({
+  _rateLimit: null,
+  _request: null,
+  _checked: false,
+  _overLimit: false,
+})
+
+.import({
+  __service: 'inbound-http-routing',
+  __route: 'inbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    // Route level descriptors override the service level descriptors
+    _rateLimit = rateLimitCache.get(__route?.RateLimit) || rateLimitCache.get(__service?.RateLimit),
+    _request = _rateLimit && makeGlobalRateLimitRequest(_rateLimit, msg.head.path, msg.head.headers),
+    _checked = !_request,
+    _overLimit = false
+  )
+)
+.branch(
+  () => _request, (
+    $=>$
+    .fork().to(
+      $=>$
+      .replaceMessage(() => _request)
+      .muxHTTP(() => _rateLimit).to(
+        $=>$.connect(() => _rateLimit.target, {
+          connectTimeout: () => _rateLimit.timeout,
+          readTimeout: () => _rateLimit.timeout,
+        })
+      )
+      .handleMessage(
+        msg => (
+          (_overLimit = (JSON.decode(msg.body)?.overallCode === 'OVER_LIMIT')),
+          _checked = true
+        )
+      )
+      .handleStreamEnd(
+        () => !_checked && (
+          (_overLimit = _rateLimit.failureModeDeny),
+          _checked = true
+        )
+      )
+      .replaceMessage(new StreamEnd)
+    )
+    .wait(() => _checked)
+    .branch(
+      () => _overLimit, (
+        $=>$.replaceMessage(
+          () => (
+            rateLimitedCounter.increase(),
+            [new Message({ status: 429 }), new StreamEnd]
+          )
+        )
+      ), (
+        $=>$.chain()
+      )
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+))()
@@ -5,6 +5,20 @@
   privateKey = config?.Certificate?.PrivateKey,
   issuingCA = config?.Certificate?.IssuingCA,
 
+  // The certificates of a common name revoked more than once are denied up to the latest revoked one
+  revokedCertificates = config?.RevokedCertificates && config.RevokedCertificates.reduce(
+    (revoked, c) => (
+      !(revoked[c.CommonName] >= c.NotBefore) && (revoked[c.CommonName] = c.NotBefore),
+      revoked
+    ),
+    {}
+  ),
+
+  isRevoked = cert => (
+    revokedCertificates?.[cert?.subject?.commonName] !== undefined &&
+    cert.notBefore <= revokedCertificates[cert.subject.commonName]
+  ),
+
   sourceIPRangesCache = new algo.Cache((sourceIPRanges) => (
     sourceIPRanges ? (
       Object.entries(sourceIPRanges).map(
@@ -44,6 +58,9 @@
       }),
       trusted: issuingCA ? [new crypto.Certificate(issuingCA)] : [],
       verify: (ok, cert) => (
+        isRevoked(cert) && (
+          ok = false
+        ),
         _tlsConfig?.mTLS && !_tlsConfig?.skipClientCertValidation && (
           _tlsConfig?.authenticatedPrincipals && (_forbiddenTLS = true),
           (_tlsConfig?.authenticatedPrincipals?.[cert?.subject?.commonName] || (
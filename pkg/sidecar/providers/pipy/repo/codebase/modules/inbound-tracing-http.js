@@ -1,6 +1,7 @@
 ((
   {
     tracingEnabled,
+    isSampled,
     makeZipKinData,
     saveTracing,
   } = pipy.solve('tracing.js'),
@@ -23,7 +24,7 @@ pipy({
 .handleMessage(
   (msg) => (
     tracingEnabled && (
-      (_sampled = (msg?.head?.headers?.['x-b3-sampled'] === '1')) && (
+      (_sampled = isSampled(msg?.head?.headers)) && (
         _httpBytesStruct = {},
         _httpBytesStruct.requestSize = msg?.body?.size,
         _zipkinData = makeZipKinData(msg, msg.head.headers, __cluster?.name, 'SERVER', true)
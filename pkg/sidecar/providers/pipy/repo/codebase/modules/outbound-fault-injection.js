@@ -0,0 +1,92 @@
+((
+  allMethods = ['GET', 'HEAD', 'POST', 'PUT', 'DELETE', 'PATCH', 'OPTIONS'],
+
+  makeMatch = config => (
+    (
+      matchPath = (
+        (config.Type === 'Exact') && (
+          (path) => path === config.Path
+        ) || (config.Type === 'Prefix') && (
+          (path) => path.startsWith(config.Path)
+        ) || (
+          ((match = new RegExp(config.Path)) => (
+            (path) => match.test(path)
+          ))()
+        )
+      ),
+      headerRules = config.Headers ? Object.entries(config.Headers).map(([k, v]) => [k, new RegExp(v)]) : [],
+      allowedMethods = config.Methods || allMethods,
+    ) => (
+      (head) => (
+        allowedMethods.includes(head.method) &&
+        matchPath(head.path) &&
+        headerRules.every(([k, v]) => v.test(head.headers[k] || ''))
+      )
+    )
+  )(),
+
+  // Faults with request matches take precedence over the faults applied to the whole route,
+  // and inherit the delay or abort they do not specify from the first fault applied to the whole route
+  faultsCache = new algo.Cache(
+    route => (
+      (
+        faults = (route?.FaultInjections || []).map(
+          fault => ({
+            matches: (fault.Matches || []).map(makeMatch),
+            delay: fault.Delay,
+            abort: fault.Abort,
+          })
+        ),
+        defaultFault = faults.find(fault => fault.matches.length === 0),
+      ) => (
+        faults.filter(fault => fault.matches.length > 0).map(
+          fault => ({
+            matches: fault.matches,
+            delay: fault.delay || defaultFault?.delay,
+            abort: fault.abort || defaultFault?.abort,
+          })
+        ).concat(
+          faults.filter(fault => fault.matches.length === 0)
+        )
+      )
+    )()
+  ),
+
+  hit = percentage => Math.random() * 100 < percentage,
+) => (
+
+pipy({
+  _fault: null,
+  _delayed: false,
+  _abortStatus: 0,
+})
+
+.import({
+  __route: 'outbound-http-routing',
+})
+
+.pipeline()
+.handleMessageStart(
+  msg => (
+    _fault = faultsCache.get(__route)?.find?.(
+      fault => fault.matches.length === 0 || fault.matches.some(match => match(msg.head))
+    ),
+    _abortStatus = (_fault?.abort && hit(_fault.abort.Percentage)) ? _fault.abort.Status : 0,
+    _delayed = !(_fault?.delay && hit(_fault.delay.Percentage)) || (
+      new Timeout(_fault.delay.FixedDelay).wait().then(() => _delayed = true),
+      false
+    )
+  )
+)
+.wait(() => _delayed)
+.branch(
+  () => _abortStatus > 0, (
+    $=>$.replaceMessage(
+      () => [new Message({ status: _abortStatus }, 'fault filter abort'), new StreamEnd]
+    )
+  ), (
+    $=>$.chain()
+  )
+)
+
+))()
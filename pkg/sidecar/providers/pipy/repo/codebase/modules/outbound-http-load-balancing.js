@@ -8,6 +8,10 @@
   {
     shuffle,
     failover,
+    makeOutlierDetection,
+    makeLoadBalancer,
+    makeHashKey,
+    isHashLoadBalancer,
   } = pipy.solve('utils.js'),
 
   retryCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry', ['sidecar_cluster_name']),
@@ -17,29 +21,51 @@
   retryBackoffCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_backoff_exponential', ['sidecar_cluster_name']),
   retryBackoffLimitCounter = new stats.Counter('sidecar_cluster_upstream_rq_retry_backoff_ratelimited', ['sidecar_cluster_name']),
 
+  // gRPC status codes of the gRPC statuses a gRPC call can be retried on
+  grpcRetryStatuses = {
+    'cancelled': 1,
+    'deadline-exceeded': 4,
+    'resource-exhausted': 8,
+    'internal': 13,
+    'unavailable': 14,
+  },
+
   makeClusterConfig = (clusterConfig) => (
     clusterConfig && (
       (
         endpointAttributes = {},
         obj = {
-          targetBalancer: clusterConfig.Endpoints && new algo.RoundRobinLoadBalancer(
+          targetBalancer: clusterConfig.Endpoints && makeLoadBalancer(
+            clusterConfig,
             shuffle(Object.fromEntries(Object.entries(clusterConfig.Endpoints).map(([k, v]) => (endpointAttributes[k] = v, [k, v.Weight]))))
           ),
+          isHashBalancer: isHashLoadBalancer(clusterConfig),
+          hashKey: makeHashKey(clusterConfig),
+          sessionAffinity: clusterConfig.LoadBalancer?.SessionAffinity,
           endpointAttributes,
           failoverBalancer: clusterConfig.Endpoints && failover(Object.fromEntries(Object.entries(clusterConfig.Endpoints).map(([k, v]) => [k, v.Weight]))),
+          outlierDetection: makeOutlierDetection(clusterConfig),
           needRetry: Boolean(clusterConfig.RetryPolicy?.NumRetries),
           numRetries: clusterConfig.RetryPolicy?.NumRetries,
-          retryStatusCodes: (clusterConfig.RetryPolicy?.RetryOn || '5xx').split(',').reduce(
+          retryStatusCodes: (clusterConfig.RetryPolicy?.RetryOn || '5xx').split(',').map(code => code.trim()).reduce(
             (lut, code) => (
               code.endsWith('xx') ? (
                 new Array(100).fill(0).forEach((_, i) => lut[(code.charAt(0)|0)*100+i] = true)
               ) : (
-                lut[code|0] = true
+                !(code in grpcRetryStatuses) && (lut[code|0] = true)
               ),
               lut
             ),
             []
           ),
+          // gRPC statuses are returned in the headers of the trailers-only responses of the failed calls
+          retryGRPCStatuses: (clusterConfig.RetryPolicy?.RetryOn || '').split(',').map(code => code.trim()).reduce(
+            (lut, code) => (
+              (code in grpcRetryStatuses) && (lut[grpcRetryStatuses[code]] = true),
+              lut
+            ),
+            []
+          ),
           retryBackoffBaseInterval: clusterConfig.RetryPolicy?.RetryBackoffBaseInterval > 1 ? 1 : clusterConfig.RetryPolicy?.RetryBackoffBaseInterval,
           retryCounter: retryCounter.withLabels(clusterConfig.name),
           retrySuccessCounter: retrySuccessCounter.withLabels(clusterConfig.name),
@@ -66,8 +92,11 @@
 
   clusterConfigs = new algo.Cache(makeClusterConfig),
 
-  shouldRetry = (statusCode) => (
-    _clusterConfig.retryStatusCodes[statusCode] ? (
+  shouldRetry = (head) => (
+    (
+      _clusterConfig.retryStatusCodes[head?.status] ||
+      (head?.headers?.['grpc-status'] && _clusterConfig.retryGRPCStatuses[head.headers['grpc-status']|0])
+    ) ? (
       (_retryCount < _clusterConfig.numRetries) ? (
         _clusterConfig.retryCounter.increase(),
         _clusterConfig.retryBackoffCounter.increase(),
@@ -89,11 +118,13 @@
   _targetObject: null,
   _muxHttpOptions: null,
   _session: null,
+  _affinityCookie: null,
 })
 
 .import({
   __port: 'outbound',
   __cert: 'outbound',
+  __tls: 'outbound',
   __isHTTP2: 'outbound',
   __isEgress: 'outbound',
   __route: 'outbound-http-routing',
@@ -115,11 +146,23 @@
     )
   )
 )
-.onEnd(() => void ( _session = null))
+.onEnd(() => void (
+  _targetObject?.release?.(),
+  _session = null
+))
 .handleMessageStart(
   msg => (
     _clusterConfig && (
-      __isHTTP2 ? (
+      _clusterConfig.isHashBalancer ? (
+        (
+          key = _clusterConfig.hashKey ? _clusterConfig.hashKey(msg.head) : __inbound.remoteAddress,
+        ) => (
+          !key && _clusterConfig.sessionAffinity && (
+            key = _affinityCookie = algo.uuid()
+          ),
+          _targetObject = _clusterConfig.targetBalancer?.next?.(key)
+        )
+      )() : __isHTTP2 ? (
         http2PerRequestLoadBalancing ? (
           _targetObject = _clusterConfig.targetBalancer?.next?.({})
         ) : (
@@ -132,6 +175,12 @@
           _targetObject = _clusterConfig.targetBalancer?.next?.()
         )
       ),
+      _clusterConfig.outlierDetection && (
+        _targetObject = _clusterConfig.outlierDetection.select(
+          _targetObject,
+          () => (_targetObject?.release?.(), _targetObject = _clusterConfig.targetBalancer.next())
+        )
+      ),
       __target = _targetObject?.id
     ) && (
       (
@@ -156,7 +205,7 @@
       .link('upstream')
       .replaceMessageStart(
         msg => (
-          shouldRetry(msg.head.status) ? new StreamEnd('Replay') : msg
+          shouldRetry(msg.head) ? new StreamEnd('Replay') : msg
         )
       )
     )
@@ -173,6 +222,7 @@
             status = msg?.head?.status
           ) => (
             _failoverObject && (!status || status > '499') ? (
+              _targetObject?.release?.(),
               _targetObject = _failoverObject,
               __target = _targetObject.id,
               _failoverObject = null,
@@ -188,6 +238,19 @@
     $=>$.link('upstream')
   )
 )
+.handleMessageStart(
+  msg => _affinityCookie && msg.head && (
+    (
+      cookie = _clusterConfig.sessionAffinity.CookieName + '=' + _affinityCookie +
+        '; Max-Age=' + Math.floor(_clusterConfig.sessionAffinity.TTL) + '; Path=/; HttpOnly',
+      setCookie = msg.head.headers?.['set-cookie'],
+    ) => (
+      msg.head.headers = msg.head.headers || {},
+      msg.head.headers['set-cookie'] = setCookie ? [].concat(setCookie, cookie) : cookie,
+      _affinityCookie = null
+    )
+  )()
+)
 
 .pipeline('upstream')
 .handleStreamStart(
@@ -199,6 +262,12 @@
         __cert = __cluster.SourceCert
       )
     ),
+    __cluster?.External && (
+      __isEgress = true
+    ),
+    __isEgress && __cluster?.TLS && (
+      __tls = __cluster.TLS
+    ),
     __metricLabel = __cluster?.name
   )
 )
@@ -206,8 +275,8 @@
   isDebugEnabled, (
     $=>$.handleStreamStart(
       () => (
-        console.log('outbound-http # port/service/route/cluster/egress/cert :',
-          __port?.Port, __service?.name, __route?.Path, __cluster?.name, __isEgress, Boolean(__cert))
+        console.log('outbound-http # port/service/route/cluster/egress/cert/tls :',
+          __port?.Port, __service?.name, __route?.Path, __cluster?.name, __isEgress, Boolean(__cert), Boolean(__tls))
       )
     )
   )
@@ -217,7 +286,14 @@
     $=>$.chain()
   ),
   (
-    $=>$.muxHTTP(() => _targetObject, () => _muxHttpOptions).to($=>$.use('connect-upstream.js'))
+    $=>$
+    .muxHTTP(() => _targetObject, () => _muxHttpOptions).to($=>$.use('connect-upstream.js'))
+    .handleMessageStart(
+      msg => _clusterConfig?.outlierDetection?.report?.(__target, msg?.head?.status >= 500)
+    )
+    .handleStreamEnd(
+      evt => evt.error && _clusterConfig?.outlierDetection?.report?.(__target, true)
+    )
   )
 )
 
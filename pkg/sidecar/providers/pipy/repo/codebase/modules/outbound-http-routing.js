@@ -1,5 +1,7 @@
 ((
   config = pipy.solve('config.js'),
+  certChain = config?.Certificate?.CertChain,
+  privateKey = config?.Certificate?.PrivateKey,
   {
     shuffle,
     failover,
@@ -15,6 +17,24 @@
     )())
   ),
 
+  mirrorBalancerCache = new algo.Cache(
+    (cluster => (
+      cluster?.Endpoints && new algo.RoundRobinLoadBalancer(
+        shuffle(Object.fromEntries(Object.entries(cluster.Endpoints).map(([k, v]) => [k, v.Weight])))
+      )
+    ))
+  ),
+
+  selectMirrors = (mirrors) => (
+    mirrors && (
+      (
+        selected = mirrors.filter(([, percentage]) => Math.random() * 100 < percentage).map(([name]) => clusterCache.get(name)).filter(c => c),
+      ) => (
+        selected.length > 0 ? selected : null
+      )
+    )()
+  ),
+
   makeServiceHandler = (portConfig, serviceName) => (
     (
       rules = portConfig?.HttpServiceRouteRules?.[serviceName]?.RouteRules || [],
@@ -38,12 +58,14 @@
             headerRules = config.Headers ? Object.entries(config.Headers).map(([k, v]) => [k, new RegExp(v)]) : null,
             balancer = new algo.RoundRobinLoadBalancer(shuffle(config.TargetClusters || {})),
             failoverBalancer = failover(config.TargetClusters),
+            mirrors = config.MirrorClusters && Object.entries(config.MirrorClusters),
             service = Object.assign({ name: serviceName }, portConfig?.HttpServiceRouteRules?.[serviceName]),
             rule = headerRules ? (
               (path, headers) => matchPath(path) && headerRules.every(([k, v]) => v.test(headers[k] || '')) && (
                 __route = config,
                 __service = service,
                 __cluster = clusterCache.get(balancer.next()?.id),
+                _mirrors = selectMirrors(mirrors),
                 failoverBalancer && (
                   _failoverCluster = clusterCache.get(failoverBalancer.next()?.id)
                 ),
@@ -54,6 +76,7 @@
                 __route = config,
                 __service = service,
                 __cluster = clusterCache.get(balancer.next()?.id),
+                _mirrors = selectMirrors(mirrors),
                 failoverBalancer && (
                   _failoverCluster = clusterCache.get(failoverBalancer.next()?.id)
                 ),
@@ -104,12 +127,15 @@
   _origPath: null,
   _failoverCluster: null,
   _useHttp2: false,
+  _mirrors: null,
 })
 
 .import({
   __port: 'outbound',
   __protocol: 'outbound',
   __isHTTP2: 'outbound',
+  __cert: 'outbound',
+  __target: 'connect-tcp',
 })
 
 .export('outbound-http-routing', {
@@ -141,12 +167,16 @@
         _failoverCluster && (
           __cluster = _failoverCluster,
           _failoverCluster = null,
+          _mirrors = null,
           true
         ) || (
           portHandlers.get(__port)(msg)
         )
       )
     )
+    .fork(() => _mirrors || []).to(
+      $=>$.link('mirror')
+    )
     .chain()
     .replaceMessage(
       msg => (
@@ -160,4 +190,42 @@
   )
 )
 
+.pipeline('mirror')
+.onStart(
+  cluster => void (
+    __cluster = cluster,
+    __target = mirrorBalancerCache.get(cluster)?.next?.()?.id,
+    cluster?.SourceCert && (
+      __cert = cluster.SourceCert.OsmIssued ? { CertChain: certChain, PrivateKey: privateKey } : cluster.SourceCert
+    )
+  )
+)
+.replaceMessageStart(
+  msg => new MessageStart(
+    Object.assign({}, msg.head, {
+      headers: Object.assign({}, msg.head.headers, {
+        host: (
+          (
+            host = msg.head.headers.host || '',
+            i = host.lastIndexOf(':'),
+          ) => (
+            i > 0 ? host.substring(0, i) + '-shadow' + host.substring(i) : host + '-shadow'
+          )
+        )()
+      })
+    })
+  )
+)
+.branch(
+  () => __target, (
+    $=>$
+    .muxHTTP(() => __target, { version: () => __isHTTP2 ? 2 : 1 }).to(
+      $=>$.use('connect-upstream.js')
+    )
+  ), (
+    $=>$
+  )
+)
+.replaceMessage(new StreamEnd)
+
 )()
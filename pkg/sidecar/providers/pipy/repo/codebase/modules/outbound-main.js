@@ -10,7 +10,10 @@
 
   makePortHandler = (port) => (
     (
-      destinations = (config?.Outbound?.TrafficMatches?.[port] || []).map(
+      // The UDP traffic matches are served by the UDP listener
+      destinations = (config?.Outbound?.TrafficMatches?.[port] || []).filter(
+        config => config?.Protocol !== 'udp'
+      ).map(
         config => ({
           ranges: config.DestinationIPRanges && Object.entries(config.DestinationIPRanges).map(
             ([k, config]) => ({
@@ -65,6 +68,7 @@
   __isHTTP2: false,
   __isEgress: false,
   __cert: null,
+  __tls: null,
 })
 
 .pipeline()
@@ -81,6 +85,8 @@
       'modules/outbound-metrics-http.js',
       'modules/outbound-tracing-http.js',
       'modules/outbound-logging-http.js',
+      'modules/outbound-fault-injection.js',
+      'modules/outbound-http-filters.js',
       'modules/outbound-circuit-breaker.js',
       'modules/outbound-http-load-balancing.js',
       'modules/outbound-http-default.js',
@@ -2,11 +2,14 @@
   {
     metricsCache,
     identityCache,
+    getGRPCMethod,
   } = pipy.solve('metrics.js'),
 ) => (
 
 pipy({
-  _requestTime: null
+  _requestTime: null,
+  _grpcMethod: null,
+  _grpcStatus: null,
 })
 
 .import({
@@ -15,8 +18,9 @@ pipy({
 
 .pipeline()
 .handleMessageStart(
-  () => (
-    _requestTime = Date.now()
+  (msg) => (
+    _requestTime = Date.now(),
+    _grpcMethod = getGRPCMethod(msg?.head)
   )
 )
 .chain()
@@ -43,5 +47,26 @@ pipy({
     )
   )()
 )
+.branch(
+  () => _grpcMethod, (
+    // The gRPC status is sent in the response trailers, or in the response headers of a trailers-only response
+    $=>$.handleMessageStart(
+      (msg) => (
+        _grpcStatus = msg?.head?.headers?.['grpc-status']
+      )
+    )
+    .handleMessageEnd(
+      (msgEnd) => (
+        (
+          status = _grpcStatus || msgEnd?.tail?.headers?.['grpc-status'],
+        ) => (
+          status && metricsCache.get(__cluster?.name).upstreamGRPCStatusCount.withLabels(
+            _grpcMethod.service, _grpcMethod.method, status
+          ).increase()
+        )
+      )()
+    )
+  )
+)
 
-))()
\ No newline at end of file
+))()
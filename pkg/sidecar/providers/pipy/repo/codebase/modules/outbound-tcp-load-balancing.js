@@ -2,11 +2,21 @@
   config = pipy.solve('config.js'),
   specEnableEgress = config?.Spec?.Traffic?.EnableEgress,
   isDebugEnabled = config?.Spec?.SidecarLogLevel === 'debug',
+  {
+    makeOutlierDetection,
+    makeLoadBalancer,
+    isHashLoadBalancer,
+  } = pipy.solve('utils.js'),
 
-  targetBalancers = new algo.Cache(target => new algo.RoundRobinLoadBalancer(
+  outlierDetections = new algo.Cache(makeOutlierDetection),
+
+  targetBalancers = new algo.Cache(target => makeLoadBalancer(
+    target,
     Object.fromEntries(Object.entries(target?.Endpoints || {}).map(([k, v]) => [k, v.Weight || 100]))
   )),
-) => pipy()
+) => pipy({
+  _targetObject: null,
+})
 
 .import({
   __port: 'outbound',
@@ -20,12 +30,32 @@
 .pipeline()
 .handleStreamStart(
   () => (
-    __target = __cluster && targetBalancers.get(__cluster)?.next?.()?.id,
+    __target = __cluster && (
+      (
+        balancer = targetBalancers.get(__cluster),
+        key = isHashLoadBalancer(__cluster) ? __inbound.remoteAddress : undefined,
+        outlierDetection = __cluster.ConnectionSettings?.OutlierDetection && outlierDetections.get(__cluster),
+      ) => (
+        _targetObject = balancer?.next?.(key),
+        outlierDetection && (
+          _targetObject = outlierDetection.select(
+            _targetObject,
+            () => (_targetObject?.release?.(), _targetObject = balancer.next())
+          )
+        ),
+        _targetObject?.id
+      )
+    )(),
     !__target && (specEnableEgress || __port?.TcpServiceRouteRules?.AllowedEgressTraffic) && (
-      __target = __inbound.destinationAddress + ':' + __inbound.destinationPort,
+      __target = (
+        __inbound.destinationAddress.indexOf(':') >= 0 ? '[' + __inbound.destinationAddress + ']' : __inbound.destinationAddress
+      ) + ':' + __inbound.destinationPort,
       __cluster = {name: __target},
       __isEgress = true
     ),
+    __cluster?.External && (
+      __isEgress = true
+    ),
     !__cert && __cluster?.SourceCert && (
       __cluster.SourceCert.OsmIssued && (
         __cert = {CertChain: certChain, PrivateKey: privateKey}
@@ -50,7 +80,16 @@
     $=>$.chain()
   ),
   (
-    $=>$.use('connect-upstream.js')
+    $=>$
+    .use('connect-upstream.js')
+    .handleStreamEnd(
+      evt => (
+        _targetObject?.release?.(),
+        __cluster?.ConnectionSettings?.OutlierDetection && (
+          outlierDetections.get(__cluster)?.report?.(__target, Boolean(evt.error))
+        )
+      )
+    )
   )
 )
 
@@ -0,0 +1,128 @@
+((
+  config = pipy.solve('config.js'),
+  isDebugEnabled = config?.Spec?.SidecarLogLevel === 'debug',
+
+  {
+    makeLoadBalancer,
+  } = pipy.solve('utils.js'),
+
+  portPolicies = Object.fromEntries(
+    (config?.Spec?.Traffic?.UDPPortPolicies || []).map(
+      policy => [policy.Port, policy]
+    )
+  ),
+
+  sendPacketsCounter = new stats.Counter('sidecar_udp_outbound_tx_packets_total', [
+    'sidecar_udp_port', 'sidecar_udp_action'
+  ]),
+  sendBytesCounter = new stats.Counter('sidecar_udp_outbound_tx_bytes_total', [
+    'sidecar_udp_port', 'sidecar_udp_action'
+  ]),
+  receiveBytesCounter = new stats.Counter('sidecar_udp_outbound_rx_bytes_total', [
+    'sidecar_udp_port'
+  ]),
+
+  clusterCache = new algo.Cache(
+    (clusterName => (
+      (cluster = config?.Outbound?.ClustersConfigs?.[clusterName]) => (
+        cluster ? Object.assign({ name: clusterName }, cluster) : null
+      )
+    )())
+  ),
+
+  clusterBalancers = new algo.Cache(clusters => new algo.RoundRobinLoadBalancer(clusters || {})),
+
+  targetBalancers = new algo.Cache(cluster => makeLoadBalancer(
+    cluster,
+    Object.fromEntries(Object.entries(cluster?.Endpoints || {}).map(([k, v]) => [k, v.Weight || 100]))
+  )),
+
+  // Only the traffic matches of the UDP services are load balanced by the sidecar,
+  // the UDP traffic to any other destination is forwarded to its original destination.
+  makePortHandler = (port) => (
+    (
+      destinations = (config?.Outbound?.TrafficMatches?.[port] || []).filter(
+        config => config?.Protocol === 'udp' && config?.DestinationIPRanges
+      ).map(
+        config => ({
+          ranges: Object.keys(config.DestinationIPRanges).map(k => new Netmask(k)),
+          config,
+        })
+      ),
+    ) => (
+      (address) => destinations.find(dst => dst.ranges.find(r => r.contains(address)))?.config
+    )
+  )(),
+
+  portHandlers = new algo.Cache(makePortHandler),
+
+  formatAddress = (address, port) => (
+    (address.indexOf(':') >= 0 ? '[' + address + ']' : address) + ':' + port
+  ),
+) => pipy({
+  _policy: null,
+  _action: null,
+  _cluster: null,
+  _target: null,
+  _targetObject: null,
+})
+
+.pipeline()
+.onStart(
+  () => void (
+    _policy = portPolicies[__inbound.destinationPort],
+    _action = _policy?.Action === 'Deny' ? 'deny' : 'allow',
+    _action === 'allow' && (
+      (
+        port = portHandlers.get(__inbound.destinationPort)(__inbound.destinationAddress || '127.0.0.1'),
+        clusterName = port && clusterBalancers.get(port?.TcpServiceRouteRules?.TargetClusters)?.next?.()?.id,
+      ) => (
+        _cluster = clusterName && clusterCache.get(clusterName),
+        _targetObject = _cluster && targetBalancers.get(_cluster)?.next?.(),
+        _target = _targetObject?.id || formatAddress(__inbound.destinationAddress, __inbound.destinationPort)
+      )
+    )()
+  )
+)
+.branch(
+  isDebugEnabled, (
+    $=>$.handleStreamStart(
+      () => (
+        console.log('outbound-udp # port/action/cluster/target :', __inbound.destinationPort, _action, _cluster?.name, _target)
+      )
+    )
+  )
+)
+.branch(
+  () => _policy?.EnableMetrics, (
+    $=>$.handleMessage(
+      msg => (
+        sendPacketsCounter.withLabels(__inbound.destinationPort, _action).increase(),
+        sendBytesCounter.withLabels(__inbound.destinationPort, _action).increase(msg?.body?.size || 0)
+      )
+    )
+  )
+)
+.branch(
+  () => _action === 'deny', (
+    $=>$.replaceStreamStart(
+      new StreamEnd()
+    )
+  ),
+  (
+    $=>$
+    .connect(() => _target, { protocol: 'udp' })
+    .branch(
+      () => _policy?.EnableMetrics, (
+        $=>$.handleMessage(
+          msg => receiveBytesCounter.withLabels(__inbound.destinationPort).increase(msg?.body?.size || 0)
+        )
+      )
+    )
+    .handleStreamEnd(
+      () => _targetObject?.release?.()
+    )
+  )
+)
+
+)()
@@ -7,9 +7,17 @@
       pod,
     } = pipy.solve('utils.js'),
     tracingAddress = os.env.TRACING_ADDRESS,
-    tracingEndpoint = (os.env.TRACING_ENDPOINT || '/api/v2/spans'),
+    tracingProtocol = (os.env.TRACING_PROTOCOL || 'zipkin'),
+    isOTLP = (tracingProtocol === 'otlp-http'),
+    tracingEndpoint = (os.env.TRACING_ENDPOINT || (isOTLP ? '/v1/traces' : '/api/v2/spans')),
     tracingLimitedID = os.env.TRACING_SAMPLED_FRACTION && (os.env.TRACING_SAMPLED_FRACTION * Math.pow(2, 63)),
-    logZipkin = tracingAddress && new logging.JSONLogger('zipkin').toHTTP('http://' + tracingAddress + tracingEndpoint, {
+    {
+      toInt63,
+      toTraceId128,
+      toOTLPAttributes,
+      makeOTLPExporter,
+    } = pipy.solve('utils.js'),
+    logZipkin = tracingAddress && !isOTLP && tracingProtocol !== 'otlp-grpc' && new logging.JSONLogger('zipkin').toHTTP('http://' + tracingAddress + tracingEndpoint, {
       batch: {
         timeout: 1,
         interval: 1,
@@ -22,12 +30,46 @@
         'Content-Type': 'application/json',
       }
     }).log,
-    {
-      toInt63,
-    } = pipy.solve('utils.js'),
+    logOTLP = tracingAddress && isOTLP && makeOTLPExporter('otlp-traces', 'http://' + tracingAddress + tracingEndpoint, 'Spans', {
+      'Host': tracingAddress,
+    }),
+
+    // Converts a span in the Zipkin format to a span in the OTLP/JSON format
+    toOTLPSpan = zipkinData => ({
+      traceId: toTraceId128(zipkinData.traceId),
+      spanId: zipkinData.id,
+      parentSpanId: zipkinData.parentId || '',
+      name: zipkinData.name || '',
+      kind: zipkinData.kind === 'SERVER' ? 2 : 3,
+      startTimeUnixNano: zipkinData.timestamp + '000',
+      endTimeUnixNano: (zipkinData.timestamp + zipkinData.duration) + '000',
+      attributes: toOTLPAttributes(zipkinData.tags),
+      status: { code: Number(zipkinData.tags['http.status_code']) >= 500 ? 2 : 0 },
+    }),
+
+    // W3C trace context 'traceparent' header of the given trace, as [version, trace-id, parent-id, trace-flags]
+    parseTraceparent = headers => (
+      (
+        traceparent = isOTLP && headers?.['traceparent']?.toString?.().split('-'),
+      ) => (traceparent?.length === 4) ? traceparent : null
+    )(),
+
+    // The W3C trace context takes precedence over the B3 headers, which are then set from it
+    extractTraceparent = headers => (
+      (
+        traceparent = parseTraceparent(headers),
+      ) => traceparent && (
+        headers['x-b3-traceid'] = traceparent[1],
+        headers['x-b3-spanid'] = traceparent[2],
+        headers['x-b3-sampled'] = (parseInt(traceparent[3], 16) & 1) ? '1' : '0'
+      )
+    )(),
   ) => (
+    (
+      tracingProtocol === 'otlp-grpc' && console.log('Tracing protocol otlp-grpc is not supported by the Pipy sidecar, use otlp-http to export the spans over OTLP')
+    ),
     {
-      tracingEnabled: Boolean(logZipkin),
+      tracingEnabled: Boolean(logZipkin || logOTLP),
 
       initTracingHeaders: (headers, proto) => (
         (
@@ -36,16 +78,20 @@
           id = uuid.substring(0, 18).replaceAll('-', ''),
         ) => (
           proto && (headers['x-forwarded-proto'] = proto),
+          extractTraceparent(headers),
           headers['x-b3-spanid'] && (
             (headers['x-b3-parentspanid'] = headers['x-b3-spanid']) && (headers['x-b3-spanid'] = id)
           ),
           !headers['x-b3-traceid'] && (
-            (headers['x-b3-traceid'] = id) && (headers['x-b3-spanid'] = id)
+            (headers['x-b3-traceid'] = (isOTLP ? uuid.replaceAll('-', '') : id)) && (headers['x-b3-spanid'] = id)
           ),
           headers['x-b3-sampled'] && (
             sampled = (headers['x-b3-sampled'] === '1'), true
           ) || (
-            (sampled = (!tracingLimitedID || toInt63(headers['x-b3-traceid']) < tracingLimitedID)) ? (headers['x-b3-sampled'] = '1') : (headers['x-b3-sampled'] = '0')
+            (sampled = (!tracingLimitedID || toInt63(headers['x-b3-traceid'].slice(-16)) < tracingLimitedID)) ? (headers['x-b3-sampled'] = '1') : (headers['x-b3-sampled'] = '0')
+          ),
+          isOTLP && (
+            headers['traceparent'] = '00-' + toTraceId128(headers['x-b3-traceid']) + '-' + headers['x-b3-spanid'] + '-' + (sampled ? '01' : '00')
           ),
           !headers['x-request-id'] && (
             headers['x-request-id'] = uuid
@@ -58,6 +104,12 @@
         )
       )(),
 
+      // Returns whether the inbound request is sampled, as decided by the upstream proxy
+      isSampled: headers => (
+        headers && extractTraceparent(headers),
+        headers?.['x-b3-sampled'] === '1'
+      ),
+
       makeZipKinData: (msg, headers, clusterName, kind, shared) => (
         (data) => (
           data = {
@@ -101,10 +153,10 @@
           zipkinData.tags['request_size'] = bytesStruct.requestSize.toString(),
           zipkinData.tags['response_size'] = bytesStruct.responseSize.toString(),
           zipkinData['duration'] = Date.now() * 1000 - zipkinData['timestamp'],
-          logZipkin(zipkinData)
+          logOTLP ? logOTLP(toOTLPSpan(zipkinData)) : logZipkin(zipkinData)
           // , console.log('zipkinData : ', zipkinData)
         )
       ),
     }
   )
-)()
\ No newline at end of file
+)()
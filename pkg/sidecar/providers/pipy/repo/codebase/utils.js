@@ -10,6 +10,172 @@
     ) => value / 2
   )(),
   traceId = () => algo.uuid().substring(0, 18).replaceAll('-', ''),
+
+  // Pads a 64-bit B3 trace ID to the 128-bit trace ID of the W3C trace context and OTLP
+  toTraceId128 = id => (id && id.length === 16) ? '0000000000000000' + id : id,
+
+  // OpenTelemetry attributes (OTLP/JSON encoding) of the given key/value pairs
+  toOTLPAttributes = obj => Object.entries(obj).map(
+    ([key, value]) => ({ key, value: { stringValue: (value === undefined || value === null) ? '' : String(value) } })
+  ),
+
+  // OpenTelemetry resource identifying the sidecar in the exported telemetry
+  otlpResource = {
+    attributes: toOTLPAttributes({
+      'service.name': name,
+      'service.namespace': namespace,
+      'osm.identity': name + '.' + namespace,
+      'k8s.namespace.name': namespace,
+      'k8s.pod.name': pod,
+      'k8s.pod.uid': os.env.POD_UID || '',
+    }),
+  },
+
+  // Logs the given spans ('Spans') or log records ('Logs') to an OpenTelemetry collector over OTLP/HTTP,
+  // the batched records being wrapped in a single export request of the sidecar resource.
+  makeOTLPExporter = (name, url, signal, headers) => new logging.JSONLogger(name).toHTTP(url, {
+    batch: {
+      timeout: 1,
+      interval: 1,
+      prefix: '{"resource' + signal + '":[{"resource":' + JSON.stringify(otlpResource) +
+        ',"scope' + signal + '":[{"scope":{"name":"osm-edge"},"' + (signal === 'Spans' ? 'spans' : 'logRecords') + '":[',
+      postfix: ']}]}]}',
+      separator: ','
+    },
+    headers: Object.assign({ 'Content-Type': 'application/json' }, headers),
+  }).log,
+
+  // 32-bit FNV-1a hash, multiplied with Math.imul since the product of two 32-bit integers exceeds the 53-bit
+  // precision of the doubles
+  hashString = str => (
+    str.split('').reduce((hash, char) => Math.imul(hash ^ char.charCodeAt(0), 16777619) >>> 0, 2166136261)
+  ),
+
+  getCookie = (cookies, name) => (
+    cookies && (
+      (cookies.split(';').map(c => c.trim()).find(c => c.startsWith(name + '=')) || '').substring(name.length + 1)
+    ) || undefined
+  ),
+
+  // Consistent hashing on a ring of virtual nodes, the number of which is proportional to the endpoint weight.
+  // Requests without a key are spread randomly.
+  makeHashRing = targets => (
+    (
+      ring = Object.entries(targets).reduce(
+        (nodes, [id, weight]) => nodes.concat(
+          new Array(Math.max(1, Math.round((weight || 100) / 10)) * 8).fill(0).map((_, i) => ({ id, hash: hashString(id + '#' + i) }))
+        ),
+        []
+      ).sort((a, b) => a.hash - b.hash),
+      find = (hash, lo, hi) => (
+        lo >= hi ? ring[lo % ring.length] : (
+          (mid = (lo + hi) >> 1) => ring[mid].hash < hash ? find(hash, mid + 1, hi) : find(hash, lo, mid)
+        )()
+      ),
+    ) => ({
+      next: key => ring.length > 0 ? find(key ? hashString(String(key)) : Math.random() * 4294967296, 0, ring.length) : undefined,
+    })
+  )(),
+
+  // Selects the endpoint with the fewest active requests relative to its weight.
+  // The active request count is decremented by release() on the selected target.
+  makeLeastRequest = targets => (
+    (
+      ids = Object.keys(targets),
+      active = {},
+      load = id => (active[id] || 0) / (targets[id] || 1),
+    ) => ({
+      next: () => ids.length > 0 ? (
+        (
+          id = ids.reduce((best, id) => load(id) < load(best) ? id : best, ids[(Math.random() * ids.length) | 0]),
+        ) => (
+          active[id] = (active[id] || 0) + 1,
+          { id, release: () => void (active[id] > 0 && active[id]--) }
+        )
+      )() : undefined,
+    })
+  )(),
+
+  makeRandom = targets => (
+    (
+      entries = Object.entries(targets),
+      total = entries.reduce((sum, [, weight]) => sum + weight, 0),
+      pick = (r, i) => (i >= entries.length - 1 || r < entries[i][1]) ? entries[i] : pick(r - entries[i][1], i + 1),
+    ) => ({
+      next: () => entries.length > 0 ? { id: pick(Math.random() * total, 0)[0] } : undefined,
+    })
+  )(),
+
+  makeHeaderModifier = modifier => modifier && (
+    headers => (
+      modifier.Set && Object.entries(modifier.Set).forEach(
+        ([k, v]) => headers[k] = v
+      ),
+      modifier.Add && Object.entries(modifier.Add).forEach(
+        ([k, v]) => headers[k] = headers[k] ? headers[k] + ',' + v : v
+      ),
+      modifier.Remove && modifier.Remove.forEach(
+        k => delete headers[k]
+      ),
+      headers
+    )
+  ),
+
+  // Applies the given path modifier to the path without its query string
+  modifyPathOnly = modify => (
+    path => (
+      (
+        i = path.indexOf('?'),
+      ) => (
+        i >= 0 ? modify(path.substring(0, i)) + path.substring(i) : modify(path)
+      )
+    )()
+  ),
+
+  // The prefix of the path can only be replaced for the route rules matching the path prefix.
+  // Regex substitutions refer to the capture groups as \1, \2, etc.
+  makePathModifier = (route, modifier) => modifier && (
+    (modifier.ReplaceFullPath !== undefined) && (
+      modifyPathOnly(() => modifier.ReplaceFullPath)
+    ) || (modifier.ReplacePrefixMatch !== undefined) && (route.Type === 'Prefix') && (
+      path => (
+        (
+          rest = path.substring(route.Path.length),
+        ) => (
+          (modifier.ReplacePrefixMatch.endsWith('/') && rest.startsWith('/')) ? (
+            modifier.ReplacePrefixMatch + rest.substring(1)
+          ) : (
+            modifier.ReplacePrefixMatch + rest
+          )
+        )
+      )()
+    ) || modifier.ReplaceRegex && (
+      (
+        regex = new RegExp(modifier.ReplaceRegex.Pattern, 'g'),
+        substitution = (modifier.ReplaceRegex.Substitution || '').replace(/\$/g, '$$$$').replace(/\\(\d)/g, '$$$1'),
+      ) => (
+        modifyPathOnly(path => path.replace(regex, substitution))
+      )
+    )()
+  ) || null,
+
+  makeRedirect = (route, redirect) => redirect && (
+    (
+      modifyPath = makePathModifier(route, redirect.Path),
+    ) => (
+      head => (
+        (
+          host = head.headers.host || '',
+          i = host.lastIndexOf(':'),
+          hostname = redirect.Hostname || (i > 0 ? host.substring(0, i) : host),
+          port = redirect.Port ? ':' + redirect.Port : (!redirect.Hostname && i > 0 ? host.substring(i) : ''),
+          path = modifyPath ? modifyPath(head.path) : head.path,
+        ) => (
+          (redirect.Scheme || 'http') + '://' + hostname + port + path
+        )
+      )()
+    )
+  )(),
 ) => (
   {
     namespace,
@@ -36,6 +202,56 @@
       ) : null
     ),
 
+    initGlobalRateLimit: rateLimit => (
+      rateLimit?.Global?.RateLimitService && rateLimit.Global.Descriptors?.length > 0 ? (
+        {
+          target: rateLimit.Global.RateLimitService.Host + ':' + rateLimit.Global.RateLimitService.Port,
+          timeout: rateLimit.Global.RateLimitService.Timeout || 0.02,
+          failureModeDeny: Boolean(rateLimit.Global.RateLimitService.FailureModeDeny),
+          domain: rateLimit.Global.Domain,
+          descriptors: rateLimit.Global.Descriptors,
+        }
+      ) : null
+    ),
+
+    makeGlobalRateLimitRequest: (globalRateLimit, path, headers) => (
+      (
+        descriptors = globalRateLimit.descriptors.map(
+          descriptor => (
+            (
+              entries = descriptor.Entries.map(
+                entry => (
+                  (
+                    value = (
+                      (entry.Type === 'GenericKey' && entry.Value) ||
+                      (entry.Type === 'RequestHeader' && headers?.[entry.Header]) ||
+                      (entry.Type === 'RequestPath' && path) ||
+                      (entry.Type === 'SourceIdentity' && headers?.serviceidentity) ||
+                      null
+                    ),
+                  ) => value ? { key: entry.Key, value } : null
+                )()
+              ),
+            ) => entries.every(e => e) ? { entries } : null
+          )()
+        ).filter(e => e),
+      ) => (
+        descriptors.length > 0 ? (
+          new Message(
+            {
+              method: 'POST',
+              path: '/json',
+              headers: {
+                'host': globalRateLimit.target,
+                'content-type': 'application/json',
+              },
+            },
+            JSON.encode({ domain: globalRateLimit.domain, descriptors })
+          )
+        ) : null
+      )
+    )(),
+
     shuffle: arg => (
       (
         sort = a => (a.map(e => e).map(() => a.splice(Math.random() * a.length | 0, 1)[0])),
@@ -57,7 +273,115 @@
       ))() : null
     ),
 
+    // Passively ejects the upstream hosts of a cluster after consecutive errors.
+    // An ejected host is skipped for BaseEjectionTime multiplied by the number of
+    // times it has been ejected, which decays for every Interval it stays healthy.
+    makeOutlierDetection: clusterConfig => (
+      clusterConfig?.ConnectionSettings?.OutlierDetection ? (
+        (
+          clusterName = clusterConfig.name || '',
+          settings = clusterConfig.ConnectionSettings.OutlierDetection,
+          consecutiveErrors = settings.ConsecutiveErrors || 5,
+          interval = (settings.Interval || 10) * 1000,
+          baseEjectionTime = (settings.BaseEjectionTime || 30) * 1000,
+          maxEjectionPercent = (settings.MaxEjectionPercent === undefined) ? 10 : settings.MaxEjectionPercent,
+          totalHosts = Object.keys(clusterConfig.Endpoints || {}).length,
+          hosts = {},
+          getHost = target => hosts[target] || (hosts[target] = { errors: 0, ejections: 0, ejectedUntil: 0 }),
+          isEjected = target => (hosts[target]?.ejectedUntil || 0) > Date.now(),
+          canEject = () => Object.keys(hosts).filter(isEjected).length * 100 < totalHosts * maxEjectionPercent,
+          select = (target, next, retries = totalHosts) => (
+            (target && retries > 0 && isEjected(target.id)) ? select(next(), next, retries - 1) : target
+          ),
+        ) => ({
+          isEjected,
+          select,
+          report: (target, failed) => (
+            target && (
+              (
+                host = getHost(target),
+                now = Date.now(),
+              ) => (
+                failed ? (
+                  (++host.errors >= consecutiveErrors) && !(host.ejectedUntil > now) && canEject() && (
+                    host.errors = 0,
+                    host.ejections++,
+                    host.ejectedUntil = now + baseEjectionTime * host.ejections,
+                    console.log('[outlier_detection] ejected host', clusterName, target, host.ejections)
+                  )
+                ) : (
+                  host.errors = 0,
+                  (host.ejections > 0) && (now - host.ejectedUntil > interval) && (
+                    host.ejections--,
+                    host.ejectedUntil = now
+                  )
+                )
+              )
+            )()
+          ),
+        })
+      )() : null
+    ),
+
+    // makeLoadBalancer returns the endpoint balancer for the LoadBalancer settings of the cluster.
+    // Maglev is served by the hash ring as well.
+    makeLoadBalancer: (clusterConfig, targets) => (
+      (
+        type = clusterConfig?.LoadBalancer?.Type,
+      ) => (
+        (type === 'RingHash' || type === 'Maglev') ? makeHashRing(targets) : (
+          (type === 'LeastRequest') ? makeLeastRequest(targets) : (
+            (type === 'Random') ? makeRandom(targets) : new algo.RoundRobinLoadBalancer(targets)
+          )
+        )
+      )
+    )(),
+
+    // makeHashKey returns the function extracting the key an HTTP request is hashed on,
+    // or null if the requests are hashed on the source IP.
+    makeHashKey: clusterConfig => (
+      (
+        affinity = clusterConfig?.LoadBalancer?.SessionAffinity,
+        hash = clusterConfig?.LoadBalancer?.ConsistentHash,
+      ) => (
+        affinity ? (
+          head => getCookie(head.headers?.cookie, affinity.CookieName)
+        ) : hash?.Header ? (
+          head => head.headers?.[hash.Header.toLowerCase()]
+        ) : hash?.Cookie ? (
+          head => getCookie(head.headers?.cookie, hash.Cookie)
+        ) : null
+      )
+    )(),
+
+    // makeRouteFilters returns the request, response and redirect handlers
+    // corresponding to the filters of the given route rule, or null if it has none.
+    makeRouteFilters: route => route?.Filters && (
+      (
+        filters = route.Filters,
+        modifyRequestHeaders = makeHeaderModifier(filters.RequestHeaders),
+        modifyPath = makePathModifier(route, filters.URLRewrite?.Path),
+        hostname = filters.URLRewrite?.Hostname,
+      ) => ({
+        request: head => (
+          modifyRequestHeaders && modifyRequestHeaders(head.headers),
+          modifyPath && (head.path = modifyPath(head.path)),
+          hostname && (head.headers.host = hostname)
+        ),
+        response: makeHeaderModifier(filters.ResponseHeaders),
+        redirect: makeRedirect(route, filters.Redirect),
+        redirectStatus: filters.Redirect?.StatusCode || 302,
+      })
+    )(),
+
+    isHashLoadBalancer: clusterConfig => (
+      clusterConfig?.LoadBalancer?.Type === 'RingHash' || clusterConfig?.LoadBalancer?.Type === 'Maglev'
+    ),
+
     toInt63,
     traceId,
+    toTraceId128,
+    toOTLPAttributes,
+    makeOTLPExporter,
   }
 ))()
\ No newline at end of file
@@ -0,0 +1,93 @@
+package repo
+
+import (
+	"github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+	"github.com/openservicemesh/osm/pkg/constants"
+)
+
+// FaultInjection defines the faults injected into the outbound HTTP requests
+// matching the route rule.
+type FaultInjection struct {
+	// Matches defines the HTTP requests the faults are injected into.
+	// If empty, the faults are injected into all the requests matching the route rule.
+	// +optional
+	Matches []*HTTPMatchRule `json:"Matches,omitempty"`
+
+	// Delay defines the delay injected before forwarding the requests.
+	// +optional
+	Delay *FaultDelay `json:"Delay,omitempty"`
+
+	// Abort defines the abort injected in place of forwarding the requests.
+	// +optional
+	Abort *FaultAbort `json:"Abort,omitempty"`
+}
+
+// FaultDelay defines the delay injected into the HTTP requests.
+type FaultDelay struct {
+	// Percentage defines the percentage of requests to delay.
+	Percentage uint32 `json:"Percentage"`
+
+	// FixedDelay defines the duration in seconds the requests are delayed by.
+	FixedDelay float64 `json:"FixedDelay"`
+}
+
+// FaultAbort defines the abort injected into the HTTP requests.
+type FaultAbort struct {
+	// Percentage defines the percentage of requests to abort.
+	Percentage uint32 `json:"Percentage"`
+
+	// Status defines the HTTP status code returned for the aborted requests.
+	Status uint32 `json:"Status"`
+}
+
+func newFaultInjection(spec *v1alpha1.FaultInjectionSpec) *FaultInjection {
+	if spec == nil || (spec.Delay == nil && spec.Abort == nil) {
+		return nil
+	}
+
+	fault := new(FaultInjection)
+	for _, match := range spec.Matches {
+		fault.Matches = append(fault.Matches, newFaultInjectionMatch(match))
+	}
+	if spec.Delay != nil {
+		fault.Delay = &FaultDelay{
+			Percentage: spec.Delay.Percentage,
+			FixedDelay: spec.Delay.FixedDelay.Seconds(),
+		}
+	}
+	if spec.Abort != nil {
+		fault.Abort = &FaultAbort{
+			Percentage: spec.Abort.Percentage,
+			Status:     spec.Abort.HTTPStatus,
+		}
+	}
+	return fault
+}
+
+func newFaultInjectionMatch(match v1alpha1.FaultInjectionHTTPMatchSpec) *HTTPMatchRule {
+	httpMatch := new(HTTPMatchRule)
+	httpMatch.Path = URIPathValue(match.Path)
+	switch match.PathMatchType {
+	case v1alpha1.PathMatchTypeExact:
+		httpMatch.Type = PathMatchExact
+	case v1alpha1.PathMatchTypePrefix:
+		httpMatch.Type = PathMatchPrefix
+	default:
+		httpMatch.Type = PathMatchRegex
+	}
+	if len(httpMatch.Path) == 0 {
+		httpMatch.Path = constants.RegexMatchAll
+		httpMatch.Type = PathMatchRegex
+	}
+	for k, v := range match.Headers {
+		httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
+	}
+	if len(match.Methods) == 0 {
+		httpMatch.addMethodMatch("*")
+	} else {
+		for _, method := range match.Methods {
+			httpMatch.addMethodMatch(Method(method))
+		}
+	}
+	return httpMatch
+}
@@ -0,0 +1,170 @@
+package repo
+
+import (
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+// HTTPRouteFilters defines the modifications applied to the HTTP requests
+// matching the route rule and to their responses.
+type HTTPRouteFilters struct {
+	// RequestHeaders defines the modifications of the request headers.
+	// +optional
+	RequestHeaders *HTTPHeaderModifier `json:"RequestHeaders,omitempty"`
+
+	// ResponseHeaders defines the modifications of the response headers.
+	// +optional
+	ResponseHeaders *HTTPHeaderModifier `json:"ResponseHeaders,omitempty"`
+
+	// URLRewrite defines the rewrite of the request URL.
+	// +optional
+	URLRewrite *HTTPURLRewrite `json:"URLRewrite,omitempty"`
+
+	// Redirect defines the redirect response returned in place of forwarding the requests.
+	// +optional
+	Redirect *HTTPRequestRedirect `json:"Redirect,omitempty"`
+}
+
+// HTTPHeaderModifier defines the modifications of HTTP headers.
+type HTTPHeaderModifier struct {
+	// Set defines the headers to overwrite.
+	// +optional
+	Set map[Header]string `json:"Set,omitempty"`
+
+	// Add defines the headers to append the values to.
+	// +optional
+	Add map[Header]string `json:"Add,omitempty"`
+
+	// Remove defines the headers to remove.
+	// +optional
+	Remove []Header `json:"Remove,omitempty"`
+}
+
+// HTTPPathModifier defines the modification of an HTTP request path.
+type HTTPPathModifier struct {
+	// ReplaceFullPath defines the path replacing the whole path.
+	// +optional
+	ReplaceFullPath *string `json:"ReplaceFullPath,omitempty"`
+
+	// ReplacePrefixMatch defines the prefix replacing the prefix matched by the route rule.
+	// +optional
+	ReplacePrefixMatch *string `json:"ReplacePrefixMatch,omitempty"`
+
+	// ReplaceRegex defines the replacement of the parts of the path matching a regular expression.
+	// +optional
+	ReplaceRegex *HTTPRegexReplace `json:"ReplaceRegex,omitempty"`
+}
+
+// HTTPRegexReplace defines the replacement of the parts of a string matching a regular expression.
+type HTTPRegexReplace struct {
+	// Pattern defines the regular expression.
+	Pattern string `json:"Pattern"`
+
+	// Substitution defines the value replacing the matching parts, referring to
+	// the capture groups as \1, \2, etc.
+	Substitution string `json:"Substitution"`
+}
+
+// HTTPURLRewrite defines the rewrite of an HTTP request URL.
+type HTTPURLRewrite struct {
+	// Hostname defines the value of the rewritten Host header.
+	// +optional
+	Hostname string `json:"Hostname,omitempty"`
+
+	// Path defines the modification of the request path.
+	// +optional
+	Path *HTTPPathModifier `json:"Path,omitempty"`
+}
+
+// HTTPRequestRedirect defines the redirect response returned for the HTTP requests.
+type HTTPRequestRedirect struct {
+	// Scheme defines the scheme of the Location header.
+	// +optional
+	Scheme string `json:"Scheme,omitempty"`
+
+	// Hostname defines the hostname of the Location header.
+	// +optional
+	Hostname string `json:"Hostname,omitempty"`
+
+	// Path defines the modification of the request path in the Location header.
+	// +optional
+	Path *HTTPPathModifier `json:"Path,omitempty"`
+
+	// Port defines the port of the Location header.
+	// +optional
+	Port int `json:"Port,omitempty"`
+
+	// StatusCode defines the status code of the redirect response.
+	StatusCode int `json:"StatusCode"`
+}
+
+func newHTTPRouteFilters(filters *trafficpolicy.HTTPRouteFilters) *HTTPRouteFilters {
+	if filters == nil {
+		return nil
+	}
+
+	routeFilters := &HTTPRouteFilters{
+		RequestHeaders:  newHTTPHeaderModifier(filters.RequestHeaders),
+		ResponseHeaders: newHTTPHeaderModifier(filters.ResponseHeaders),
+	}
+	if filters.URLRewrite != nil {
+		routeFilters.URLRewrite = &HTTPURLRewrite{
+			Hostname: filters.URLRewrite.Hostname,
+			Path:     newHTTPPathModifier(filters.URLRewrite.Path),
+		}
+	}
+	if filters.Redirect != nil {
+		routeFilters.Redirect = &HTTPRequestRedirect{
+			Scheme:     filters.Redirect.Scheme,
+			Hostname:   filters.Redirect.Hostname,
+			Path:       newHTTPPathModifier(filters.Redirect.Path),
+			Port:       filters.Redirect.Port,
+			StatusCode: filters.Redirect.StatusCode,
+		}
+		// Redirect with a 302 status code if unset
+		if routeFilters.Redirect.StatusCode == 0 {
+			routeFilters.Redirect.StatusCode = 302
+		}
+	}
+	return routeFilters
+}
+
+func newHTTPHeaderModifier(modifier *trafficpolicy.HTTPHeaderModifier) *HTTPHeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	headerModifier := new(HTTPHeaderModifier)
+	for k, v := range modifier.Set {
+		if headerModifier.Set == nil {
+			headerModifier.Set = make(map[Header]string)
+		}
+		headerModifier.Set[Header(k)] = v
+	}
+	for k, v := range modifier.Add {
+		if headerModifier.Add == nil {
+			headerModifier.Add = make(map[Header]string)
+		}
+		headerModifier.Add[Header(k)] = v
+	}
+	for _, k := range modifier.Remove {
+		headerModifier.Remove = append(headerModifier.Remove, Header(k))
+	}
+	return headerModifier
+}
+
+func newHTTPPathModifier(modifier *trafficpolicy.HTTPPathModifier) *HTTPPathModifier {
+	if modifier == nil {
+		return nil
+	}
+	pathModifier := &HTTPPathModifier{
+		ReplaceFullPath:    modifier.ReplaceFullPath,
+		ReplacePrefixMatch: modifier.ReplacePrefixMatch,
+	}
+	if modifier.ReplaceRegex != nil {
+		pathModifier.ReplaceRegex = &HTTPRegexReplace{
+			Pattern:      modifier.ReplaceRegex.Pattern,
+			Substitution: modifier.ReplaceRegex.Substitution,
+		}
+	}
+	return pathModifier
+}
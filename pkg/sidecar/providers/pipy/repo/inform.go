@@ -40,6 +40,10 @@ func (s *Server) informTrafficPolicies(proxyPtr **pipy.Proxy, wg *sync.WaitGroup
 	proxyUpdateChan := proxyUpdatePubSub.Sub(announcements.ProxyUpdate.String(), messaging.GetPubSubTopicForProxyUUID(proxy.UUID.String()))
 	defer s.msgBroker.Unsub(proxyUpdatePubSub, proxyUpdateChan)
 
+	// Until its dependencies are recorded, the proxy is updated on every targeted update
+	s.msgBroker.AddProxy(proxy.UUID.String())
+	defer s.msgBroker.RemoveProxy(proxy.UUID.String())
+
 	// Register for certificate rotation updates
 	certPubSub := s.msgBroker.GetCertPubSub()
 	certRotateChan := certPubSub.Sub(announcements.CertificateRotated.String())
@@ -127,6 +131,7 @@ func (s *Server) recordPodMetadata(p *pipy.Proxy) error {
 			CreationTime: pod.GetCreationTimestamp().Time,
 			WorkloadKind: workloadKind,
 			WorkloadName: workloadName,
+			Labels:       pod.Labels,
 		}
 
 		for idx := range pod.Spec.Containers {
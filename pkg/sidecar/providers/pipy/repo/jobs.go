@@ -13,6 +13,7 @@ import (
 	"github.com/openservicemesh/osm/pkg/service"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/pipy"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/pipy/client"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 )
 
 // PipyConfGeneratorJob is the job to generate pipy policy json
@@ -57,15 +58,27 @@ func (job *PipyConfGeneratorJob) Run() {
 	certs(s, proxy, pipyConf, proxyServices)
 	pluginSetV := plugin(cataloger, s, pipyConf, proxy)
 	inbound(cataloger, proxy.Identity, s, pipyConf, proxyServices)
-	outbound(cataloger, proxy.Identity, s, pipyConf, proxy)
+	outboundTrafficPolicy := cataloger.GetOutboundMeshTrafficPolicy(proxy.Identity)
+	outbound(cataloger, proxy.Identity, s, pipyConf, proxy, outboundTrafficPolicy)
 	egress(cataloger, proxy.Identity, s, pipyConf, proxy)
 	forward(cataloger, proxy.Identity, s, pipyConf, proxy)
 	balance(pipyConf)
 	reorder(pipyConf)
 	endpoints(pipyConf, s)
+	dependencies(s, proxy, proxyServices, outboundTrafficPolicy)
 	job.publishSidecarConf(s.repoClient, proxy, pipyConf, pluginSetV)
 }
 
+// dependencies records the resources the configuration of the proxy depends on with the message broker,
+// so that the proxy is only updated when one of these resources changes. The proxy depends on the services
+// whose endpoints it is configured with, i.e. its upstream services and the services of the allowed sources.
+func dependencies(s *Server, proxy *pipy.Proxy, proxyServices []service.MeshService, outboundTrafficPolicy *trafficpolicy.OutboundMeshTrafficPolicy) {
+	if s.msgBroker != nil {
+		s.msgBroker.SetProxyDependencies(proxy.UUID.String(),
+			s.catalog.ListProxyDependencies(proxy.Identity, proxyServices, outboundTrafficPolicy))
+	}
+}
+
 func endpoints(pipyConf *PipyConf, s *Server) {
 	ready := pipyConf.copyAllowedEndpoints(s.kubeController, s.proxyRegistry)
 	if !ready {
@@ -85,7 +98,9 @@ func reorder(pipyConf *PipyConf) {
 		for _, trafficMatches := range pipyConf.Outbound.TrafficMatches {
 			for _, trafficMatch := range trafficMatches {
 				for _, routeRules := range trafficMatch.HTTPServiceRouteRules {
-					routeRules.RouteRules.sort()
+					if !routeRules.ordered {
+						routeRules.RouteRules.sort()
+					}
 				}
 			}
 		}
@@ -146,8 +161,8 @@ func forward(cataloger catalog.MeshCataloger, serviceIdentity identity.ServiceId
 	return true
 }
 
-func outbound(cataloger catalog.MeshCataloger, serviceIdentity identity.ServiceIdentity, s *Server, pipyConf *PipyConf, proxy *pipy.Proxy) bool {
-	outboundTrafficPolicy := cataloger.GetOutboundMeshTrafficPolicy(serviceIdentity)
+func outbound(cataloger catalog.MeshCataloger, serviceIdentity identity.ServiceIdentity, s *Server, pipyConf *PipyConf, proxy *pipy.Proxy,
+	outboundTrafficPolicy *trafficpolicy.OutboundMeshTrafficPolicy) bool {
 	if len(outboundTrafficPolicy.ServicesResolvableSet) > 0 {
 		pipyConf.DNSResolveDB = outboundTrafficPolicy.ServicesResolvableSet
 	}
@@ -233,70 +248,77 @@ func plugin(cataloger catalog.MeshCataloger, s *Server, pipyConf *PipyConf, prox
 }
 
 func certs(s *Server, proxy *pipy.Proxy, pipyConf *PipyConf, proxyServices []service.MeshService) {
-	if mc, ok := s.catalog.(*catalog.MeshCatalog); ok {
-		meshConf := mc.GetConfigurator()
-		if !(*meshConf).GetSidecarDisabledMTLS() {
-			cnPrefix := proxy.Identity.String()
-			if proxy.SidecarCert == nil {
-				pipyConf.Certificate = nil
-				sidecarCert := s.certManager.GetCertificate(cnPrefix)
-				if sidecarCert == nil {
-					proxy.SidecarCert = nil
-				} else {
-					proxy.SidecarCert = sidecarCert
-				}
+	meshConf := s.catalog.GetConfigurator()
+	if !(*meshConf).GetSidecarDisabledMTLS() {
+		cnPrefix := proxy.Identity.String()
+		if proxy.SidecarCert == nil {
+			pipyConf.Certificate = nil
+			sidecarCert := s.certManager.GetCertificate(cnPrefix)
+			if sidecarCert == nil {
+				proxy.SidecarCert = nil
+			} else {
+				proxy.SidecarCert = sidecarCert
 			}
-			if proxy.SidecarCert == nil || s.certManager.ShouldRotate(proxy.SidecarCert) {
-				pipyConf.Certificate = nil
-				now := time.Now()
-				certValidityPeriod := s.cfg.GetServiceCertValidityPeriod()
-				certExpiration := now.Add(certValidityPeriod)
-				certValidityPeriod = certExpiration.Sub(now)
-
-				var sans []string
-				if len(proxyServices) > 0 {
-					for _, proxySvc := range proxyServices {
-						sans = append(sans, k8s.GetHostnamesForService(proxySvc, true)...)
-					}
+		}
+		if proxy.SidecarCert == nil || s.certManager.ShouldRotate(proxy.SidecarCert) {
+			pipyConf.Certificate = nil
+			now := time.Now()
+			certValidityPeriod := s.cfg.GetServiceCertValidityPeriod()
+			certExpiration := now.Add(certValidityPeriod)
+			certValidityPeriod = certExpiration.Sub(now)
+
+			var sans []string
+			if len(proxyServices) > 0 {
+				for _, proxySvc := range proxyServices {
+					sans = append(sans, k8s.GetHostnamesForService(proxySvc, true)...)
 				}
-				for {
-					sidecarCert, certErr := s.certManager.IssueCertificate(cnPrefix, certificate.Service,
-						certificate.SubjectAlternativeNames(sans...),
-						certificate.ValidityDurationProvided(&certValidityPeriod))
-					if certErr != nil {
-						log.Err(certErr).Msgf("error IssueCertificate for cnPrefix:%s", cnPrefix)
-					} else if !s.certManager.ShouldRotate(sidecarCert) {
-						proxy.SidecarCert = sidecarCert
-						break
-					}
-					time.Sleep(time.Second * 5)
+			}
+			for {
+				sidecarCert, certErr := s.certManager.IssueCertificate(cnPrefix, certificate.Service,
+					certificate.SubjectAlternativeNames(sans...),
+					certificate.ValidityDurationProvided(&certValidityPeriod))
+				if certErr != nil {
+					log.Err(certErr).Msgf("error IssueCertificate for cnPrefix:%s", cnPrefix)
+				} else if !s.certManager.ShouldRotate(sidecarCert) {
+					proxy.SidecarCert = sidecarCert
+					break
 				}
+				time.Sleep(time.Second * 5)
 			}
-		} else {
-			proxy.SidecarCert = nil
 		}
+	} else {
+		proxy.SidecarCert = nil
 	}
 }
 
 func features(s *Server, proxy *pipy.Proxy, pipyConf *PipyConf) {
-	if mc, ok := s.catalog.(*catalog.MeshCatalog); ok {
-		meshConf := mc.GetConfigurator()
-		proxy.MeshConf = meshConf
-		pipyConf.setSidecarLogLevel((*meshConf).GetMeshConfig().Spec.Sidecar.LogLevel)
-		pipyConf.setSidecarTimeout((*meshConf).GetMeshConfig().Spec.Sidecar.SidecarTimeout)
-		pipyConf.setRemoteLoggingLevel((*meshConf).GetMeshConfig().Spec.Observability.RemoteLogging.Level)
-		pipyConf.setEnableSidecarActiveHealthChecks((*meshConf).GetFeatureFlags().EnableSidecarActiveHealthChecks)
-		pipyConf.setEnableEgress((*meshConf).IsEgressEnabled())
-		pipyConf.setHTTP1PerRequestLoadBalancing((*meshConf).GetMeshConfig().Spec.Traffic.HTTP1PerRequestLoadBalancing)
-		pipyConf.setHTTP2PerRequestLoadBalancing((*meshConf).GetMeshConfig().Spec.Traffic.HTTP2PerRequestLoadBalancing)
-		pipyConf.setEnablePermissiveTrafficPolicyMode((*meshConf).IsPermissiveTrafficPolicyMode())
-		pipyConf.setLocalDNSProxy((*meshConf).IsLocalDNSProxyEnabled(), (*meshConf).GetLocalDNSProxyPrimaryUpstream(), (*meshConf).GetLocalDNSProxySecondaryUpstream())
-		clusterProps := (*meshConf).GetMeshConfig().Spec.ClusterSet.Properties
-		if len(clusterProps) > 0 {
-			pipyConf.Spec.ClusterSet = make(map[string]string)
-			for _, prop := range clusterProps {
-				pipyConf.Spec.ClusterSet[prop.Name] = prop.Value
-			}
+	meshConf := s.catalog.GetConfigurator()
+	proxy.MeshConf = meshConf
+	// The sidecar settings which can be overridden are resolved for the pod of the proxy
+	podConf := *meshConf
+	if proxy.PodMetadata != nil {
+		podConf = podConf.ForPod(proxy.PodMetadata.Namespace, proxy.PodMetadata.Labels)
+	}
+	podMeshConfig := podConf.GetMeshConfig()
+	pipyConf.setSidecarLogLevel(podMeshConfig.Spec.Sidecar.LogLevel)
+	pipyConf.setSidecarTimeout(podMeshConfig.Spec.Sidecar.SidecarTimeout)
+	pipyConf.setRemoteLoggingLevel((*meshConf).GetMeshConfig().Spec.Observability.RemoteLogging.Level)
+	pipyConf.setEnableSidecarActiveHealthChecks((*meshConf).GetFeatureFlags().EnableSidecarActiveHealthChecks)
+	pipyConf.setEnableEgress((*meshConf).IsEgressEnabled())
+	pipyConf.setHTTP1PerRequestLoadBalancing(podMeshConfig.Spec.Traffic.HTTP1PerRequestLoadBalancing)
+	pipyConf.setHTTP2PerRequestLoadBalancing(podMeshConfig.Spec.Traffic.HTTP2PerRequestLoadBalancing)
+	pipyConf.setEnablePermissiveTrafficPolicyMode((*meshConf).IsPermissiveTrafficPolicyMode())
+	pipyConf.setLocalDNSProxy((*meshConf).IsLocalDNSProxyEnabled(), (*meshConf).GetLocalDNSProxyPrimaryUpstream(), (*meshConf).GetLocalDNSProxySecondaryUpstream())
+	pipyConf.setRevokedCertificates((*meshConf).GetRevokedCertificates())
+	pipyConf.setUDPPortPolicies(podMeshConfig.Spec.Traffic.UDPInterception)
+	if pod, err := s.kubeController.GetPodForProxy(proxy); err == nil {
+		pipyConf.setDualStack(k8s.HasIPv6PodIP(pod))
+	}
+	clusterProps := (*meshConf).GetMeshConfig().Spec.ClusterSet.Properties
+	if len(clusterProps) > 0 {
+		pipyConf.Spec.ClusterSet = make(map[string]string)
+		for _, prop := range clusterProps {
+			pipyConf.Spec.ClusterSet[prop.Name] = prop.Value
 		}
 	}
 }
@@ -331,7 +353,7 @@ func (job *PipyConfGeneratorJob) publishSidecarConf(repoClient *client.PipyRepoC
 			CommonName: &proxy.SidecarCert.CommonName,
 			CertChain:  string(proxy.SidecarCert.CertChain),
 			PrivateKey: string(proxy.SidecarCert.PrivateKey),
-			IssuingCA:  string(proxy.SidecarCert.IssuingCA),
+			IssuingCA:  string(proxy.SidecarCert.GetTrustedCAs()),
 		}
 	}
 	bytes, jsonErr := json.Marshal(pipyConf)
@@ -0,0 +1,76 @@
+package repo
+
+import (
+	"testing"
+
+	mapset "github.com/deckarep/golang-set"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	tassert "github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openservicemesh/osm/pkg/announcements"
+	"github.com/openservicemesh/osm/pkg/catalog"
+	"github.com/openservicemesh/osm/pkg/identity"
+	"github.com/openservicemesh/osm/pkg/k8s/events"
+	"github.com/openservicemesh/osm/pkg/messaging"
+	"github.com/openservicemesh/osm/pkg/models"
+	"github.com/openservicemesh/osm/pkg/service"
+	"github.com/openservicemesh/osm/pkg/sidecar/providers/pipy"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
+)
+
+func TestDependencies(t *testing.T) {
+	assert := tassert.New(t)
+	mockCtrl := gomock.NewController(t)
+	mockCatalog := catalog.NewMockMeshCataloger(mockCtrl)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	msgBroker := messaging.NewBroker(stop)
+
+	proxyIdentity := identity.K8sServiceAccount{Name: "bookbuyer", Namespace: "ns"}.ToServiceIdentity()
+	proxy := pipy.NewProxy(models.KindSidecar, uuid.New(), proxyIdentity, nil)
+	proxyServices := []service.MeshService{{Name: "bookbuyer", Namespace: "ns"}}
+	outboundPolicy := &trafficpolicy.OutboundMeshTrafficPolicy{}
+
+	s := &Server{
+		catalog:   mockCatalog,
+		msgBroker: msgBroker,
+	}
+
+	// The proxy only depends on the services listed through the MeshCataloger interface
+	mockCatalog.EXPECT().ListProxyDependencies(proxyIdentity, proxyServices, outboundPolicy).Return([]messaging.ProxyDependency{
+		messaging.NewProxyDependency(messaging.ServiceDependency, "ns", "bookbuyer"),
+		messaging.NewProxyDependency(messaging.ServiceDependency, "ns", "bookstore"),
+	}).Times(1)
+	dependencies(s, proxy, proxyServices, outboundPolicy)
+	assert.True(msgBroker.HasProxyDependencies(proxy.UUID.String()))
+
+	// Another proxy depends on the unrelated service, so that the targeted update is dispatched
+	msgBroker.SetProxyDependencies("other", []messaging.ProxyDependency{
+		messaging.NewProxyDependency(messaging.ServiceDependency, "ns", "unrelated"),
+	})
+
+	targetedChan := msgBroker.GetProxyUpdatePubSub().Sub(announcements.ProxyTargetedUpdate.String())
+	defer msgBroker.Unsub(msgBroker.GetProxyUpdatePubSub(), targetedChan)
+
+	// An endpoint change to an unrelated service does not target the proxy
+	msgBroker.GetQueue().Add(events.PubSubMessage{
+		Kind:   announcements.EndpointUpdated,
+		OldObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "ns"}},
+		NewObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "ns"}},
+	})
+	targets := messaging.GetProxyUpdateTargets(<-targetedChan)
+	assert.True(targets.Equal(mapset.NewSet("other")))
+
+	// An endpoint change to an upstream service targets the proxy
+	msgBroker.GetQueue().Add(events.PubSubMessage{
+		Kind:   announcements.EndpointUpdated,
+		OldObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+		NewObj: &corev1.Endpoints{ObjectMeta: metav1.ObjectMeta{Name: "bookstore", Namespace: "ns"}},
+	})
+	targets = messaging.GetProxyUpdateTargets(<-targetedChan)
+	assert.True(targets.Equal(mapset.NewSet(proxy.UUID.String())))
+}
@@ -1,20 +1,25 @@
 package repo
 
 import (
+	"encoding/json"
 	"fmt"
+	"net"
 	"reflect"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	multiclusterv1alpha1 "github.com/openservicemesh/osm/pkg/apis/multicluster/v1alpha1"
 	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/identity"
 	"github.com/openservicemesh/osm/pkg/k8s"
 	"github.com/openservicemesh/osm/pkg/sidecar/providers/pipy/registry"
+	"github.com/openservicemesh/osm/pkg/trafficpolicy"
 	"github.com/openservicemesh/osm/pkg/utils/cidr"
 )
 
@@ -22,6 +27,14 @@ var (
 	addrWithPort, _ = regexp.Compile(`:\d+$`)
 )
 
+const (
+	// grpcContentTypeHeader is the header the gRPC requests are identified by, Pipy header names being lowercase
+	grpcContentTypeHeader Header = "content-type"
+
+	// grpcContentTypeRegexp matches the content types of the gRPC requests, application/grpc and its application/grpc+<format> variants
+	grpcContentTypeRegexp HeaderRegexp = `^application/grpc($|[+;])`
+)
+
 func (plugin *Pluggable) setPlugins(plugins map[string]*runtime.RawExtension) {
 	plugin.Plugins = plugins
 }
@@ -33,6 +46,13 @@ func (p *PipyConf) setSidecarLogLevel(sidecarLogLevel string) (update bool) {
 	return
 }
 
+func (p *PipyConf) setDualStack(dualStack bool) (update bool) {
+	if update = p.Spec.DualStack != dualStack; update {
+		p.Spec.DualStack = dualStack
+	}
+	return
+}
+
 func (p *PipyConf) setSidecarTimeout(sidecarTimeout int) (update bool) {
 	if update = p.Spec.SidecarTimeout != sidecarTimeout; update {
 		p.Spec.SidecarTimeout = sidecarTimeout
@@ -99,6 +119,30 @@ func (p *PipyConf) setEnablePermissiveTrafficPolicyMode(enablePermissiveTrafficP
 	return
 }
 
+func (p *PipyConf) setUDPPortPolicies(udpInterception configv1alpha2.UDPInterceptionSpec) {
+	p.Spec.Traffic.UDPPortPolicies = nil
+	if !udpInterception.Enable {
+		return
+	}
+	for _, policy := range udpInterception.PortPolicies {
+		p.Spec.Traffic.UDPPortPolicies = append(p.Spec.Traffic.UDPPortPolicies, UDPPortPolicy{
+			Port:          Port(policy.Port),
+			Action:        string(policy.Action),
+			EnableMetrics: policy.EnableMetrics,
+		})
+	}
+}
+
+func (p *PipyConf) setRevokedCertificates(revoked []configv1alpha2.RevokedCertificate) {
+	p.RevokedCertificates = nil
+	for _, rc := range revoked {
+		p.RevokedCertificates = append(p.RevokedCertificates, RevokedCertificate{
+			CommonName: rc.CommonName,
+			NotBefore:  rc.NotBefore.UnixMilli(),
+		})
+	}
+}
+
 func (p *PipyConf) isPermissiveTrafficPolicyMode() bool {
 	return p.Spec.Traffic.enablePermissiveTrafficPolicyMode
 }
@@ -196,6 +240,14 @@ func (p *PipyConf) rebalancedForwardClusters() {
 	}
 }
 
+// normalizeIPAddr returns the IPv4 address of the given IPv4-mapped IPv6 address, or the given address otherwise
+func normalizeIPAddr(addr string) string {
+	if ip := net.ParseIP(addr); ip != nil && ip.To4() != nil {
+		return ip.To4().String()
+	}
+	return addr
+}
+
 func (p *PipyConf) copyAllowedEndpoints(kubeController k8s.Controller, proxyRegistry *registry.ProxyRegistry) bool {
 	ready := true
 	p.AllowedEndpoints = make(map[string]string)
@@ -210,9 +262,15 @@ func (p *PipyConf) copyAllowedEndpoints(kubeController k8s.Controller, proxyRegi
 			ready = false
 			continue
 		}
-		p.AllowedEndpoints[proxy.GetAddr()] = fmt.Sprintf("%s.%s", pod.Namespace, pod.Name)
+		podName := fmt.Sprintf("%s.%s", pod.Namespace, pod.Name)
 		if len(proxy.GetAddr()) == 0 {
 			ready = false
+		} else {
+			p.AllowedEndpoints[normalizeIPAddr(proxy.GetAddr())] = podName
+		}
+		// A dual-stack pod connects from any of its IP addresses
+		for _, podIP := range pod.Status.PodIPs {
+			p.AllowedEndpoints[normalizeIPAddr(podIP.IP)] = podName
 		}
 	}
 	if p.Inbound == nil {
@@ -226,7 +284,7 @@ func (p *PipyConf) copyAllowedEndpoints(kubeController k8s.Controller, proxyRegi
 			continue
 		}
 		for ipRange := range trafficMatch.SourceIPRanges {
-			ingressIP := strings.TrimSuffix(string(ipRange), "/32")
+			ingressIP := strings.TrimSuffix(strings.TrimSuffix(string(ipRange), "/32"), "/128")
 			p.AllowedEndpoints[ingressIP] = "Ingress Controller"
 		}
 	}
@@ -278,11 +336,7 @@ func (otm *OutboundTrafficMatch) setProtocol(protocol Protocol) {
 }
 
 func (itm *InboundTrafficMatch) setTCPServiceRateLimit(rateLimit *policyv1alpha1.RateLimitSpec) {
-	if rateLimit == nil || rateLimit.Local == nil {
-		itm.TCPRateLimit = nil
-	} else {
-		itm.TCPRateLimit = newTCPRateLimit(rateLimit.Local)
-	}
+	itm.TCPRateLimit = newTCPRateLimit(rateLimit)
 }
 
 func (itm *InboundTrafficMatch) newTCPServiceRouteRules() *InboundTCPServiceRouteRules {
@@ -413,10 +467,95 @@ func (otp *OutboundTrafficPolicy) newTrafficMatch(port Port, name string) (*Outb
 }
 
 func (hrrs *InboundHTTPRouteRules) setHTTPServiceRateLimit(rateLimit *policyv1alpha1.RateLimitSpec) {
-	if rateLimit == nil || rateLimit.Local == nil {
-		hrrs.HTTPRateLimit = nil
-	} else {
-		hrrs.HTTPRateLimit = newHTTPRateLimit(rateLimit.Local)
+	hrrs.HTTPRateLimit = newHTTPRateLimit(rateLimit)
+}
+
+func (hrrs *InboundHTTPRouteRules) setJWTAuthentication(jwtAuthn *trafficpolicy.JWTAuthentication) {
+	if jwtAuthn == nil {
+		hrrs.JWTAuthentication = nil
+		return
+	}
+
+	hrrs.JWTAuthentication = new(JWTAuthentication)
+	for _, provider := range jwtAuthn.Providers {
+		if len(provider.LocalJWKS) > 0 && !json.Valid([]byte(provider.LocalJWKS)) {
+			log.Error().Msgf("Invalid JWKS for JWT provider %s, skipping it", provider.Name)
+			continue
+		}
+		jwtProvider := &JWTProvider{
+			Issuer:               provider.Issuer,
+			Audiences:            provider.Audiences,
+			JWKS:                 provider.LocalJWKS,
+			JWKSURI:              provider.RemoteJWKSURI,
+			ForwardOriginalToken: provider.ForwardOriginalToken,
+		}
+		for _, claimToHeader := range provider.OutputClaimToHeaders {
+			if jwtProvider.ClaimToHeaders == nil {
+				jwtProvider.ClaimToHeaders = make(map[string]string)
+			}
+			jwtProvider.ClaimToHeaders[claimToHeader.Claim] = claimToHeader.Header
+		}
+		hrrs.JWTAuthentication.Providers = append(hrrs.JWTAuthentication.Providers, jwtProvider)
+	}
+	for _, rule := range jwtAuthn.Rules {
+		jwtRule := &JWTAuthorizationRule{Claims: make(map[string][]string)}
+		for _, claim := range rule.Claims {
+			jwtRule.Claims[claim.Name] = append(jwtRule.Claims[claim.Name], claim.Values...)
+		}
+		hrrs.JWTAuthentication.Rules = append(hrrs.JWTAuthentication.Rules, jwtRule)
+	}
+}
+
+func (hrrs *InboundHTTPRouteRules) setAuthorizationPolicies(trafficTargets []trafficpolicy.TrafficTargetWithRoutes) {
+	hrrs.AuthorizationPolicies = nil
+	for _, trafficTarget := range trafficTargets {
+		authzPolicy := &AuthorizationPolicy{
+			Name:   trafficTarget.Name,
+			Action: string(trafficTarget.Action),
+		}
+		for _, rule := range trafficTarget.AuthorizationRules {
+			authzRule := new(AuthorizationRule)
+			for _, source := range rule.From {
+				authzSource := &AuthorizationSource{
+					Namespaces: source.Namespaces,
+					IPBlocks:   source.IPBlocks,
+				}
+				for _, id := range source.Identities {
+					authzSource.Identities = append(authzSource.Identities, id.String())
+				}
+				authzRule.From = append(authzRule.From, authzSource)
+			}
+			for _, operation := range rule.To {
+				// The server names requested by the downstream are not known to the HTTP chain,
+				// an operation restricted to server names is only enforced by DENY policies
+				// which conservatively ignore the restriction.
+				if len(operation.ServerNames) > 0 && trafficTarget.Action != policyv1alpha1.AuthorizationPolicyActionDeny {
+					continue
+				}
+				authzOperation := &AuthorizationOperation{
+					Ports:   operation.Ports,
+					Methods: operation.Methods,
+					Paths:   operation.Paths,
+				}
+				for _, header := range operation.Headers {
+					if authzOperation.Headers == nil {
+						authzOperation.Headers = make(map[string][]string)
+					}
+					name := strings.ToLower(header.Name)
+					authzOperation.Headers[name] = append(authzOperation.Headers[name], header.Values...)
+					if authzOperation.Headers[name] == nil {
+						authzOperation.Headers[name] = []string{}
+					}
+				}
+				authzRule.To = append(authzRule.To, authzOperation)
+			}
+			if len(rule.To) > 0 && len(authzRule.To) == 0 {
+				// None of the operations of this rule can be matched
+				continue
+			}
+			authzPolicy.Rules = append(authzPolicy.Rules, authzRule)
+		}
+		hrrs.AuthorizationPolicies = append(hrrs.AuthorizationPolicies, authzPolicy)
 	}
 }
 
@@ -459,6 +598,30 @@ func (hrrs *OutboundHTTPRouteRules) newHTTPServiceRouteRule(matchRule *HTTPMatch
 	return routeRule, false
 }
 
+func (ohrr *OutboundHTTPRouteRule) setFaultInjections(faultInjections []*policyv1alpha1.FaultInjectionSpec) {
+	ohrr.FaultInjections = nil
+	for _, spec := range faultInjections {
+		if fault := newFaultInjection(spec); fault != nil {
+			ohrr.FaultInjections = append(ohrr.FaultInjections, fault)
+		}
+	}
+}
+
+func (ohrr *OutboundHTTPRouteRule) setFilters(filters *trafficpolicy.HTTPRouteFilters) {
+	ohrr.Filters = newHTTPRouteFilters(filters)
+}
+
+func (ohrr *OutboundHTTPRouteRule) addMirrorCluster(clusterName ClusterName, percentage uint32) {
+	if ohrr.MirrorClusters == nil {
+		ohrr.MirrorClusters = make(MirrorClusters)
+	}
+	ohrr.MirrorClusters[clusterName] = percentage
+}
+
+func (hrrs *OutboundHTTPRouteRules) setOrdered(ordered bool) {
+	hrrs.ordered = ordered
+}
+
 func (hrrs *OutboundHTTPRouteRules) setEgressForwardGateway(egresssGateway *string) {
 	hrrs.EgressForwardGateway = egresssGateway
 }
@@ -470,6 +633,11 @@ func (hmr *HTTPMatchRule) addHeaderMatch(header Header, headerRegexp HeaderRegex
 	hmr.Headers[header] = headerRegexp
 }
 
+// addGRPCMatch restricts the match to the requests with a gRPC content type
+func (hmr *HTTPMatchRule) addGRPCMatch() {
+	hmr.addHeaderMatch(grpcContentTypeHeader, grpcContentTypeRegexp)
+}
+
 func (hmr *HTTPMatchRule) addMethodMatch(method Method) {
 	if hmr.allowedAnyMethod {
 		return
@@ -505,8 +673,12 @@ func (hrr *HTTPRouteRule) addAllowedService(serviceName ServiceName) {
 	}
 }
 
-func (ihrr *InboundHTTPRouteRule) setRateLimit(rateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec) {
-	ihrr.RateLimit = newHTTPPerRouteRateLimit(rateLimit)
+func (ihrr *InboundHTTPRouteRule) setRateLimit(rateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec, serviceRateLimit *HTTPRateLimit) {
+	ihrr.RateLimit = newHTTPPerRouteRateLimit(rateLimit, serviceRateLimit)
+}
+
+func (ihrr *InboundHTTPRouteRule) setFilters(filters *trafficpolicy.HTTPRouteFilters) {
+	ihrr.Filters = newHTTPRouteFilters(filters)
 }
 
 func (itp *InboundTrafficPolicy) newClusterConfigs(clusterName ClusterName) *WeightedEndpoint {
@@ -552,47 +724,34 @@ func (otp *ClusterConfigs) addWeightedZoneEndpoint(address Address, port Port, w
 }
 
 func (wes *WeightedEndpoints) addWeightedEndpoint(address Address, port Port, weight Weight) {
-	if addrWithPort.MatchString(string(address)) {
-		httpHostPort := HTTPHostPort(address)
-		(*wes)[httpHostPort] = &WeightedZoneEndpoint{
-			Weight: weight,
-		}
-	} else {
-		httpHostPort := HTTPHostPort(fmt.Sprintf("%s:%d", address, port))
-		(*wes)[httpHostPort] = &WeightedZoneEndpoint{
-			Weight: weight,
-		}
+	(*wes)[getHTTPHostPort(address, port)] = &WeightedZoneEndpoint{
+		Weight: weight,
 	}
 }
 
 func (wes *WeightedEndpoints) addWeightedZoneEndpoint(address Address, port Port, weight Weight, cluster, lbType, contextPath string) {
-	if addrWithPort.MatchString(string(address)) {
-		httpHostPort := HTTPHostPort(address)
-		(*wes)[httpHostPort] = &WeightedZoneEndpoint{
-			Weight:      weight,
-			Cluster:     cluster,
-			LBType:      lbType,
-			ContextPath: contextPath,
-		}
-	} else {
-		httpHostPort := HTTPHostPort(fmt.Sprintf("%s:%d", address, port))
-		(*wes)[httpHostPort] = &WeightedZoneEndpoint{
-			Weight:      weight,
-			Cluster:     cluster,
-			LBType:      lbType,
-			ContextPath: contextPath,
-		}
+	(*wes)[getHTTPHostPort(address, port)] = &WeightedZoneEndpoint{
+		Weight:      weight,
+		Cluster:     cluster,
+		LBType:      lbType,
+		ContextPath: contextPath,
 	}
 }
 
 func (we *WeightedEndpoint) addWeightedEndpoint(address Address, port Port, weight Weight) {
+	(*we)[getHTTPHostPort(address, port)] = weight
+}
+
+// getHTTPHostPort returns the host and port of the endpoint with the given address and port, an IPv6 address is
+// enclosed in square brackets, and an address already including a port is returned as is
+func getHTTPHostPort(address Address, port Port) HTTPHostPort {
+	if ip := net.ParseIP(string(address)); ip != nil {
+		return HTTPHostPort(net.JoinHostPort(ip.String(), strconv.Itoa(int(port))))
+	}
 	if addrWithPort.MatchString(string(address)) {
-		httpHostPort := HTTPHostPort(address)
-		(*we)[httpHostPort] = weight
-	} else {
-		httpHostPort := HTTPHostPort(fmt.Sprintf("%s:%d", address, port))
-		(*we)[httpHostPort] = weight
+		return HTTPHostPort(address)
 	}
+	return HTTPHostPort(fmt.Sprintf("%s:%d", address, port))
 }
 
 func (otp *ClusterConfigs) setConnectionSettings(connectionSettings *policyv1alpha1.ConnectionSettingsSpec) {
@@ -638,6 +797,55 @@ func (otp *ClusterConfigs) setConnectionSettings(connectionSettings *policyv1alp
 			otp.ConnectionSettings.HTTP.CircuitBreaking.DegradedResponseContent = connectionSettings.HTTP.CircuitBreaking.DegradedResponseContent
 		}
 	}
+	if connectionSettings.OutlierDetection != nil {
+		otp.ConnectionSettings.OutlierDetection = new(OutlierDetection)
+		otp.ConnectionSettings.OutlierDetection.ConsecutiveErrors = connectionSettings.OutlierDetection.ConsecutiveErrors
+		if connectionSettings.OutlierDetection.Interval != nil {
+			duration := connectionSettings.OutlierDetection.Interval.Seconds()
+			otp.ConnectionSettings.OutlierDetection.Interval = &duration
+		}
+		if connectionSettings.OutlierDetection.BaseEjectionTime != nil {
+			duration := connectionSettings.OutlierDetection.BaseEjectionTime.Seconds()
+			otp.ConnectionSettings.OutlierDetection.BaseEjectionTime = &duration
+		}
+		otp.ConnectionSettings.OutlierDetection.MaxEjectionPercent = connectionSettings.OutlierDetection.MaxEjectionPercent
+	}
+}
+
+func (otp *ClusterConfigs) setLoadBalancer(loadBalancer *policyv1alpha1.LoadBalancerSpec) {
+	if loadBalancer == nil {
+		otp.LoadBalancer = nil
+		return
+	}
+	otp.LoadBalancer = new(LoadBalancer)
+	otp.LoadBalancer.Type = loadBalancer.Type
+	if len(otp.LoadBalancer.Type) == 0 {
+		if loadBalancer.SessionAffinity != nil {
+			otp.LoadBalancer.Type = policyv1alpha1.RingHashLoadBalancer
+		} else {
+			otp.LoadBalancer.Type = policyv1alpha1.RoundRobinLoadBalancer
+		}
+	}
+	if otp.LoadBalancer.Type != policyv1alpha1.RingHashLoadBalancer && otp.LoadBalancer.Type != policyv1alpha1.MaglevLoadBalancer {
+		return
+	}
+	if loadBalancer.SessionAffinity != nil {
+		otp.LoadBalancer.SessionAffinity = new(SessionAffinity)
+		otp.LoadBalancer.SessionAffinity.CookieName = loadBalancer.SessionAffinity.CookieName
+		if len(otp.LoadBalancer.SessionAffinity.CookieName) == 0 {
+			otp.LoadBalancer.SessionAffinity.CookieName = constants.SessionAffinityCookieName
+		}
+		otp.LoadBalancer.SessionAffinity.TTL = constants.SessionAffinityCookieTTL.Seconds()
+		if loadBalancer.SessionAffinity.TTL != nil {
+			otp.LoadBalancer.SessionAffinity.TTL = loadBalancer.SessionAffinity.TTL.Seconds()
+		}
+		return
+	}
+	if loadBalancer.ConsistentHash != nil && !loadBalancer.ConsistentHash.SourceIP {
+		otp.LoadBalancer.ConsistentHash = new(ConsistentHash)
+		otp.LoadBalancer.ConsistentHash.Header = loadBalancer.ConsistentHash.Header
+		otp.LoadBalancer.ConsistentHash.Cookie = loadBalancer.ConsistentHash.Cookie
+	}
 }
 
 func (otp *ClusterConfigs) setRetryPolicy(retryPolicy *policyv1alpha1.RetryPolicySpec) {
@@ -1,6 +1,14 @@
 package repo
 
-import "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+import (
+	"strings"
+	"time"
+
+	"github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
+)
+
+// defaultRateLimitServiceTimeout is the timeout for calls to the rate limit service when unspecified
+const defaultRateLimitServiceTimeout = 20 * time.Millisecond
 
 // TCPRateLimit defines the rate limiting specification for
 // the upstream host.
@@ -12,6 +20,13 @@ type TCPRateLimit struct {
 	// This is applied as a token bucket rate limiter.
 	// +optional
 	Local *TCPLocalRateLimit `json:"Local,omitempty"`
+
+	// Global specified the global rate limiting specification
+	// for the upstream host.
+	// Global rate limiting is enforced by an external rate limiting
+	// service shared by all replicas of the upstream host.
+	// +optional
+	Global *TCPGlobalRateLimit `json:"Global,omitempty"`
 }
 
 // HTTPRateLimit defines the rate limiting specification for
@@ -24,26 +39,59 @@ type HTTPRateLimit struct {
 	// This is applied as a token bucket rate limiter.
 	// +optional
 	Local *HTTPLocalRateLimit `json:"Local,omitempty"`
+
+	// Global specified the global rate limiting specification
+	// for the upstream host.
+	// Global rate limiting is enforced by an external rate limiting
+	// service shared by all replicas of the upstream host.
+	// +optional
+	Global *HTTPGlobalRateLimit `json:"Global,omitempty"`
 }
 
-func newTCPRateLimit(spec *v1alpha1.LocalRateLimitSpec) *TCPRateLimit {
-	if spec == nil || spec.TCP == nil {
+func newTCPRateLimit(spec *v1alpha1.RateLimitSpec) *TCPRateLimit {
+	if spec == nil {
 		return nil
 	}
 
-	lrl := new(TCPRateLimit)
-	lrl.Local = newTCPLocalRateLimit(spec.TCP)
-	return lrl
+	var local *TCPLocalRateLimit
+	if spec.Local != nil {
+		local = newTCPLocalRateLimit(spec.Local.TCP)
+	}
+	var global *TCPGlobalRateLimit
+	if spec.Global != nil {
+		global = newTCPGlobalRateLimit(spec.Global.TCP)
+	}
+	if local == nil && global == nil {
+		return nil
+	}
+
+	rl := new(TCPRateLimit)
+	rl.Local = local
+	rl.Global = global
+	return rl
 }
 
-func newHTTPRateLimit(spec *v1alpha1.LocalRateLimitSpec) *HTTPRateLimit {
-	if spec == nil || spec.HTTP == nil {
+func newHTTPRateLimit(spec *v1alpha1.RateLimitSpec) *HTTPRateLimit {
+	if spec == nil {
 		return nil
 	}
 
-	lrl := new(HTTPRateLimit)
-	lrl.Local = newHTTPLocalRateLimit(spec.HTTP)
-	return lrl
+	var local *HTTPLocalRateLimit
+	if spec.Local != nil {
+		local = newHTTPLocalRateLimit(spec.Local.HTTP)
+	}
+	var global *HTTPGlobalRateLimit
+	if spec.Global != nil {
+		global = newHTTPGlobalRateLimit(spec.Global.HTTP)
+	}
+	if local == nil && global == nil {
+		return nil
+	}
+
+	rl := new(HTTPRateLimit)
+	rl.Local = local
+	rl.Global = global
+	return rl
 }
 
 // TCPLocalRateLimit defines the local rate limiting specification
@@ -157,14 +205,212 @@ type HTTPPerRouteRateLimit struct {
 	// Local defines the local rate limiting specification
 	// applied per HTTP route.
 	Local *HTTPLocalRateLimit `json:"Local,omitempty"`
+
+	// Global defines the global rate limiting specification
+	// applied per HTTP route.
+	Global *HTTPGlobalRateLimit `json:"Global,omitempty"`
 }
 
-func newHTTPPerRouteRateLimit(spec *v1alpha1.HTTPPerRouteRateLimitSpec) *HTTPPerRouteRateLimit {
+func newHTTPPerRouteRateLimit(spec *v1alpha1.HTTPPerRouteRateLimitSpec, serviceRateLimit *HTTPRateLimit) *HTTPPerRouteRateLimit {
 	if spec == nil {
 		return nil
 	}
 
 	rrl := new(HTTPPerRouteRateLimit)
 	rrl.Local = newHTTPLocalRateLimit(spec.Local)
+	// The rate limit service and domain are inherited from the service level global rate limit
+	if spec.Global != nil && serviceRateLimit != nil && serviceRateLimit.Global != nil {
+		rrl.Global = &HTTPGlobalRateLimit{
+			RateLimitService: serviceRateLimit.Global.RateLimitService,
+			Domain:           serviceRateLimit.Global.Domain,
+			Descriptors:      newHTTPRateLimitDescriptors(spec.Global.Descriptors),
+		}
+	}
 	return rrl
 }
+
+// RateLimitService defines the external rate limit service
+// used to enforce global rate limits.
+type RateLimitService struct {
+	// Host defines the FQDN or IP address of the rate limit service.
+	Host string `json:"Host"`
+
+	// Port defines the port of the HTTP/JSON endpoint of the rate limit service.
+	Port uint16 `json:"Port"`
+
+	// Timeout defines the timeout in seconds for calls to the rate limit service.
+	// +optional
+	Timeout *float64 `json:"Timeout,omitempty"`
+
+	// FailureModeDeny defines whether traffic should be denied when the
+	// rate limit service cannot be reached or returns an error.
+	FailureModeDeny bool `json:"FailureModeDeny"`
+}
+
+func newRateLimitService(spec v1alpha1.RateLimitServiceSpec) *RateLimitService {
+	rls := new(RateLimitService)
+	rls.Host = spec.Host
+	rls.Port = spec.JSONPort
+	if rls.Port == 0 {
+		rls.Port = spec.Port
+	}
+	timeout := defaultRateLimitServiceTimeout.Seconds()
+	if spec.Timeout != nil {
+		timeout = spec.Timeout.Seconds()
+	}
+	rls.Timeout = &timeout
+	rls.FailureModeDeny = spec.FailureModeDeny
+	return rls
+}
+
+// RateLimitDescriptorEntryType is the type of a rate limit descriptor entry
+type RateLimitDescriptorEntryType string
+
+const (
+	// GenericKeyDescriptorEntry is the type of a static key/value descriptor entry
+	GenericKeyDescriptorEntry RateLimitDescriptorEntryType = "GenericKey"
+
+	// RequestHeaderDescriptorEntry is the type of a descriptor entry derived from a request header
+	RequestHeaderDescriptorEntry RateLimitDescriptorEntryType = "RequestHeader"
+
+	// RequestPathDescriptorEntry is the type of a descriptor entry derived from the request path
+	RequestPathDescriptorEntry RateLimitDescriptorEntryType = "RequestPath"
+
+	// SourceIdentityDescriptorEntry is the type of a descriptor entry derived from the downstream service identity
+	SourceIdentityDescriptorEntry RateLimitDescriptorEntryType = "SourceIdentity"
+)
+
+// RateLimitDescriptorEntry defines an entry of a rate limit descriptor.
+type RateLimitDescriptorEntry struct {
+	// Type defines how the value of the entry is derived.
+	Type RateLimitDescriptorEntryType `json:"Type"`
+
+	// Key defines the key of the descriptor entry.
+	Key string `json:"Key"`
+
+	// Value defines the value of a static descriptor entry.
+	// +optional
+	Value string `json:"Value,omitempty"`
+
+	// Header defines the name of the request header the value of
+	// the descriptor entry is derived from.
+	// +optional
+	Header string `json:"Header,omitempty"`
+}
+
+// RateLimitDescriptor defines a rate limit descriptor.
+type RateLimitDescriptor struct {
+	// Entries defines the list of entries of the descriptor.
+	Entries []RateLimitDescriptorEntry `json:"Entries"`
+}
+
+func newHTTPRateLimitDescriptors(specs []v1alpha1.HTTPRateLimitDescriptor) []RateLimitDescriptor {
+	var descriptors []RateLimitDescriptor
+	for _, spec := range specs {
+		var descriptor RateLimitDescriptor
+		for _, entry := range spec.Entries {
+			switch {
+			case entry.GenericKey != nil:
+				key := entry.GenericKey.Key
+				if len(key) == 0 {
+					key = `generic_key`
+				}
+				descriptor.Entries = append(descriptor.Entries, RateLimitDescriptorEntry{
+					Type:  GenericKeyDescriptorEntry,
+					Key:   key,
+					Value: entry.GenericKey.Value,
+				})
+			case entry.RequestHeader != nil:
+				descriptor.Entries = append(descriptor.Entries, RateLimitDescriptorEntry{
+					Type:   RequestHeaderDescriptorEntry,
+					Key:    entry.RequestHeader.Key,
+					Header: strings.ToLower(entry.RequestHeader.Name),
+				})
+			case entry.RequestPath != nil:
+				key := entry.RequestPath.Key
+				if len(key) == 0 {
+					key = `path`
+				}
+				descriptor.Entries = append(descriptor.Entries, RateLimitDescriptorEntry{
+					Type: RequestPathDescriptorEntry,
+					Key:  key,
+				})
+			case entry.SourceIdentity != nil:
+				key := entry.SourceIdentity.Key
+				if len(key) == 0 {
+					key = `source_identity`
+				}
+				descriptor.Entries = append(descriptor.Entries, RateLimitDescriptorEntry{
+					Type: SourceIdentityDescriptorEntry,
+					Key:  key,
+				})
+			}
+		}
+		if len(descriptor.Entries) > 0 {
+			descriptors = append(descriptors, descriptor)
+		}
+	}
+	return descriptors
+}
+
+// TCPGlobalRateLimit defines the global rate limiting specification
+// for the upstream host at the TCP level.
+type TCPGlobalRateLimit struct {
+	// RateLimitService defines the rate limit service to query.
+	RateLimitService *RateLimitService `json:"RateLimitService"`
+
+	// Domain defines the rate limit domain configured on the rate limit service.
+	Domain string `json:"Domain"`
+
+	// Descriptors defines the list of descriptors sent to the rate limit
+	// service for each new connection.
+	Descriptors []RateLimitDescriptor `json:"Descriptors"`
+}
+
+func newTCPGlobalRateLimit(spec *v1alpha1.TCPGlobalRateLimitSpec) *TCPGlobalRateLimit {
+	if spec == nil {
+		return nil
+	}
+
+	grl := new(TCPGlobalRateLimit)
+	grl.RateLimitService = newRateLimitService(spec.RateLimitService)
+	grl.Domain = spec.Domain
+	for _, descriptorSpec := range spec.Descriptors {
+		var descriptor RateLimitDescriptor
+		for _, entry := range descriptorSpec.Entries {
+			descriptor.Entries = append(descriptor.Entries, RateLimitDescriptorEntry{
+				Type:  GenericKeyDescriptorEntry,
+				Key:   entry.Key,
+				Value: entry.Value,
+			})
+		}
+		grl.Descriptors = append(grl.Descriptors, descriptor)
+	}
+	return grl
+}
+
+// HTTPGlobalRateLimit defines the global rate limiting specification
+// for the upstream host at the HTTP level.
+type HTTPGlobalRateLimit struct {
+	// RateLimitService defines the rate limit service to query.
+	RateLimitService *RateLimitService `json:"RateLimitService"`
+
+	// Domain defines the rate limit domain configured on the rate limit service.
+	Domain string `json:"Domain"`
+
+	// Descriptors defines the list of descriptors derived from each request
+	// and sent to the rate limit service.
+	Descriptors []RateLimitDescriptor `json:"Descriptors"`
+}
+
+func newHTTPGlobalRateLimit(spec *v1alpha1.HTTPGlobalRateLimitSpec) *HTTPGlobalRateLimit {
+	if spec == nil {
+		return nil
+	}
+
+	grl := new(HTTPGlobalRateLimit)
+	grl.RateLimitService = newRateLimitService(spec.RateLimitService)
+	grl.Domain = spec.Domain
+	grl.Descriptors = newHTTPRateLimitDescriptors(spec.Descriptors)
+	return grl
+}
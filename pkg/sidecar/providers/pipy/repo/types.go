@@ -92,6 +92,10 @@ type Methods []Method
 // WeightedClusters is a wrapper type of map[ClusterName]Weight
 type WeightedClusters map[ClusterName]Weight
 
+// MirrorClusters is a wrapper type of map[ClusterName]uint32, mapping
+// the clusters requests are mirrored to onto the mirrored percentage
+type MirrorClusters map[ClusterName]uint32
+
 // URIPathValue is a uri value wrapper
 type URIPathValue string
 
@@ -193,6 +197,15 @@ type TrafficSpec struct {
 	enablePermissiveTrafficPolicyMode bool
 	HTTP1PerRequestLoadBalancing      bool
 	HTTP2PerRequestLoadBalancing      bool
+	// UDPPortPolicies defines the policies of the outbound UDP ports intercepted by the sidecar
+	UDPPortPolicies []UDPPortPolicy `json:"UDPPortPolicies,omitempty"`
+}
+
+// UDPPortPolicy represents the policy applied to the outbound UDP traffic to a port
+type UDPPortPolicy struct {
+	Port          Port   `json:"Port"`
+	Action        string `json:"Action"`
+	EnableMetrics bool   `json:"EnableMetrics,omitempty"`
 }
 
 // UpstreamDNSServers defines upstream DNS servers for local DNS Proxy.
@@ -223,6 +236,9 @@ type MeshConfigSpec struct {
 	}
 	ClusterSet    map[string]string
 	LocalDNSProxy *LocalDNSProxy `json:"LocalDNSProxy,omitempty"`
+	// DualStack specifies whether the pod of the sidecar has an IPv6 address, so that the sidecar
+	// intercepts both the IPv4 and the IPv6 traffic
+	DualStack bool `json:"DualStack,omitempty"`
 }
 
 // Certificate represents an x509 certificate.
@@ -275,6 +291,7 @@ type WeightedCluster struct {
 type InboundHTTPRouteRule struct {
 	HTTPRouteRule
 	RateLimit *HTTPPerRouteRateLimit `json:"RateLimit"`
+	Filters   *HTTPRouteFilters      `json:"Filters,omitempty"`
 }
 
 // InboundHTTPRouteRuleSlice http route rule array
@@ -284,8 +301,11 @@ type InboundHTTPRouteRuleSlice []*InboundHTTPRouteRule
 type InboundHTTPRouteRules struct {
 	RouteRules InboundHTTPRouteRuleSlice `json:"RouteRules"`
 	Pluggable
-	HTTPRateLimit    *HTTPRateLimit   `json:"RateLimit"`
-	AllowedEndpoints AllowedEndpoints `json:"AllowedEndpoints"`
+	HTTPRateLimit     *HTTPRateLimit     `json:"RateLimit"`
+	AllowedEndpoints  AllowedEndpoints   `json:"AllowedEndpoints"`
+	JWTAuthentication *JWTAuthentication `json:"JWTAuthentication,omitempty"`
+
+	AuthorizationPolicies []*AuthorizationPolicy `json:"AuthorizationPolicies,omitempty"`
 }
 
 // InboundHTTPServiceRouteRules is a wrapper type of map[HTTPRouteRuleName]*InboundHTTPRouteRules
@@ -314,6 +334,9 @@ type InboundTrafficMatches map[Port]*InboundTrafficMatch
 // OutboundHTTPRouteRule http route rule
 type OutboundHTTPRouteRule struct {
 	HTTPRouteRule
+	FaultInjections []*FaultInjection `json:"FaultInjections,omitempty"`
+	MirrorClusters  MirrorClusters    `json:"MirrorClusters,omitempty"`
+	Filters         *HTTPRouteFilters `json:"Filters,omitempty"`
 }
 
 // OutboundHTTPRouteRuleSlice http route rule array
@@ -325,6 +348,9 @@ type OutboundHTTPRouteRules struct {
 	ServiceIdentity      identity.ServiceIdentity
 	EgressForwardGateway *string
 	Pluggable
+
+	// ordered defines if the route rules are already in the order they are evaluated in
+	ordered bool
 }
 
 // OutboundHTTPServiceRouteRules is a wrapper type of map[HTTPRouteRuleName]*HTTPRouteRules
@@ -380,6 +406,28 @@ type ClusterConfigs struct {
 	ConnectionSettings *ConnectionSettings `json:"ConnectionSettings,omitempty"`
 	RetryPolicy        *RetryPolicy        `json:"RetryPolicy,omitempty"`
 	SourceCert         *Certificate        `json:"SourceCert,omitempty"`
+	LoadBalancer       *LoadBalancer       `json:"LoadBalancer,omitempty"`
+	TLS                *EgressTLS          `json:"TLS,omitempty"`
+	External           bool                `json:"External,omitempty"`
+}
+
+// EgressTLS represents the TLS originated to an external cluster
+type EgressTLS struct {
+	// The server name indicated in the TLS handshake
+	SNI string `json:"SNI"`
+
+	// The Subject Alternative Names the certificate of the external cluster must match one of
+	SubjectAltNames []string `json:"SubjectAltNames,omitempty"`
+
+	// The minimum TLS protocol version, one of TLS_AUTO, TLSv1_0, TLSv1_1, TLSv1_2 and TLSv1_3
+	MinProtocolVersion string `json:"MinProtocolVersion,omitempty"`
+
+	// PEM encoded CA bundle the certificate of the external cluster is validated against
+	TrustedCA string `json:"TrustedCA,omitempty"`
+
+	// PEM encoded client Certificate and Key
+	CertChain  string `json:"CertChain,omitempty"`
+	PrivateKey string `json:"PrivateKey,omitempty"`
 }
 
 // EgressGatewayClusterConfigs represents the configs of Egress Gateway Cluster
@@ -415,6 +463,10 @@ type ConnectionSettings struct {
 	// HTTP specifies the HTTP level connection settings.
 	// +optional
 	HTTP *HTTPConnectionSettings `json:"http,omitempty"`
+
+	// OutlierDetection specifies the outlier detection settings.
+	// +optional
+	OutlierDetection *OutlierDetection `json:"OutlierDetection,omitempty"`
 }
 
 // TCPConnectionSettings defines the TCP connection settings for an
@@ -500,6 +552,175 @@ type HTTPConnectionSettings struct {
 	CircuitBreaking *HTTPCircuitBreaking `json:"CircuitBreaking,omitempty"`
 }
 
+// OutlierDetection defines the outlier detection settings for an
+// upstream host.
+type OutlierDetection struct {
+	// ConsecutiveErrors specifies the number of consecutive errors
+	// before an upstream host is ejected.
+	// +optional
+	ConsecutiveErrors *uint32 `json:"ConsecutiveErrors,omitempty"`
+
+	// Interval specifies the time interval in seconds between ejection analysis sweeps.
+	// +optional
+	Interval *float64 `json:"Interval,omitempty"`
+
+	// BaseEjectionTime specifies the base duration in seconds an upstream host is ejected for.
+	// +optional
+	BaseEjectionTime *float64 `json:"BaseEjectionTime,omitempty"`
+
+	// MaxEjectionPercent specifies the maximum percentage of upstream hosts
+	// that can be ejected at the same time.
+	// +optional
+	MaxEjectionPercent *uint32 `json:"MaxEjectionPercent,omitempty"`
+}
+
+// LoadBalancer defines the load balancing settings for an
+// upstream host.
+type LoadBalancer struct {
+	// Type specifies the type of load balancer.
+	Type v1alpha1.LoadBalancerType `json:"Type"`
+
+	// ConsistentHash specifies the key the requests are hashed on.
+	// Requests are hashed on the source IP if not specified.
+	// +optional
+	ConsistentHash *ConsistentHash `json:"ConsistentHash,omitempty"`
+
+	// SessionAffinity specifies the cookie based session affinity settings.
+	// +optional
+	SessionAffinity *SessionAffinity `json:"SessionAffinity,omitempty"`
+}
+
+// ConsistentHash defines the key the requests are hashed on.
+type ConsistentHash struct {
+	// Header specifies the name of the HTTP request header to hash on.
+	// +optional
+	Header string `json:"Header,omitempty"`
+
+	// Cookie specifies the name of the HTTP cookie to hash on.
+	// +optional
+	Cookie string `json:"Cookie,omitempty"`
+}
+
+// SessionAffinity defines the cookie based session affinity settings.
+type SessionAffinity struct {
+	// CookieName specifies the name of the cookie generated by the sidecar.
+	CookieName string `json:"CookieName"`
+
+	// TTL specifies the lifetime in seconds of the generated cookie.
+	TTL float64 `json:"TTL"`
+}
+
+// JWTAuthentication defines the JWT authentication and the claim based
+// authorization of the inbound HTTP requests.
+type JWTAuthentication struct {
+	// Providers specifies the JWT providers trusted by the service.
+	// Requests are rejected if empty.
+	Providers []*JWTProvider `json:"Providers"`
+
+	// Rules specifies the claim based authorization rules, any of which must match.
+	// +optional
+	Rules []*JWTAuthorizationRule `json:"Rules,omitempty"`
+}
+
+// JWTProvider defines a JWT issuer and the key set used to verify its tokens.
+type JWTProvider struct {
+	// Issuer specifies the expected issuer of the token.
+	Issuer string `json:"Issuer"`
+
+	// Audiences specifies the accepted audiences of the token.
+	// +optional
+	Audiences []string `json:"Audiences,omitempty"`
+
+	// JWKS specifies the JSON Web Key Set resolved from a secret.
+	// +optional
+	JWKS string `json:"JWKS,omitempty"`
+
+	// JWKSURI specifies the URI the JSON Web Key Set is fetched from.
+	// +optional
+	JWKSURI string `json:"JWKSURI,omitempty"`
+
+	// ForwardOriginalToken specifies whether the original token is forwarded.
+	ForwardOriginalToken bool `json:"ForwardOriginalToken"`
+
+	// ClaimToHeaders specifies the request headers the claims are copied to, keyed by claim.
+	// +optional
+	ClaimToHeaders map[string]string `json:"ClaimToHeaders,omitempty"`
+}
+
+// JWTAuthorizationRule defines the claims a token must match.
+type JWTAuthorizationRule struct {
+	// Claims specifies the accepted values, keyed by claim.
+	Claims map[string][]string `json:"Claims"`
+}
+
+// AuthorizationPolicy defines the rules allowing, denying or auditing the inbound HTTP requests.
+type AuthorizationPolicy struct {
+	// Name specifies the namespaced name of the policy.
+	Name string `json:"Name"`
+
+	// Action specifies the action applied to the requests matching any of the rules.
+	Action string `json:"Action"`
+
+	// Rules specifies the rules matched against the requests.
+	Rules []*AuthorizationRule `json:"Rules"`
+}
+
+// AuthorizationRule defines the sources and the operations matched by an AuthorizationPolicy.
+type AuthorizationRule struct {
+	// From specifies the sources, any of which must match. Any source matches if empty.
+	// +optional
+	From []*AuthorizationSource `json:"From,omitempty"`
+
+	// To specifies the operations, any of which must match. Any operation matches if empty.
+	// +optional
+	To []*AuthorizationOperation `json:"To,omitempty"`
+}
+
+// AuthorizationSource defines a source of the requests, all of whose specified fields must match.
+type AuthorizationSource struct {
+	// Identities specifies the service identities of the downstream.
+	// +optional
+	Identities []string `json:"Identities,omitempty"`
+
+	// Namespaces specifies the namespaces of the downstream.
+	// +optional
+	Namespaces []string `json:"Namespaces,omitempty"`
+
+	// IPBlocks specifies the source IP ranges of the downstream.
+	// +optional
+	IPBlocks []string `json:"IPBlocks,omitempty"`
+}
+
+// AuthorizationOperation defines an operation of the requests, all of whose specified fields must match.
+type AuthorizationOperation struct {
+	// Ports specifies the destination ports of the requests.
+	// +optional
+	Ports []uint16 `json:"Ports,omitempty"`
+
+	// Methods specifies the methods of the requests.
+	// +optional
+	Methods []string `json:"Methods,omitempty"`
+
+	// Paths specifies the paths of the requests, a trailing '*' matches by prefix.
+	// +optional
+	Paths []string `json:"Paths,omitempty"`
+
+	// Headers specifies the values of the request headers keyed by lowercase name.
+	// A header only needs to be present if its values are empty.
+	// +optional
+	Headers map[string][]string `json:"Headers,omitempty"`
+}
+
+// RevokedCertificate identifies a revoked certificate, which is denied by the inbound TLS termination.
+// The certificates with the same common name issued no later than the revoked one are denied.
+type RevokedCertificate struct {
+	// The CommonName of the certificate
+	CommonName string `json:"CommonName"`
+
+	// NotBefore is the time the certificate is valid from, in milliseconds since the epoch
+	NotBefore int64 `json:"NotBefore"`
+}
+
 // PipyConf is a policy used by pipy sidecar
 type PipyConf struct {
 	Ts               *time.Time
@@ -513,5 +734,7 @@ type PipyConf struct {
 	Chains           map[string][]string      `json:"Chains,omitempty"`
 	DNSResolveDB     map[string][]interface{} `json:"DNSResolveDB,omitempty"`
 
+	RevokedCertificates []RevokedCertificate `json:"RevokedCertificates,omitempty"`
+
 	pluginPolicies map[string]map[string]*map[string]*runtime.RawExtension
 }
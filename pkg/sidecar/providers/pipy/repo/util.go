@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 
+	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 	"github.com/openservicemesh/osm/pkg/catalog"
 	"github.com/openservicemesh/osm/pkg/constants"
 	"github.com/openservicemesh/osm/pkg/endpoint"
@@ -21,8 +22,9 @@ import (
 	"github.com/openservicemesh/osm/pkg/utils"
 )
 
-func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, _ identity.ServiceIdentity, pipyConf *PipyConf, inboundPolicy *trafficpolicy.InboundMeshTrafficPolicy, trustDomain string) {
+func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, serviceIdentity identity.ServiceIdentity, pipyConf *PipyConf, inboundPolicy *trafficpolicy.InboundMeshTrafficPolicy, trustDomain string) {
 	itp := pipyConf.newInboundTrafficPolicy()
+	authorizationTrafficTargets := getAuthorizationTrafficTargets(meshCatalog, serviceIdentity)
 
 	for _, trafficMatch := range inboundPolicy.TrafficMatches {
 		destinationProtocol := strings.ToLower(trafficMatch.DestinationProtocol)
@@ -47,6 +49,8 @@ func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, _ ident
 			ruleName := HTTPRouteRuleName(httpRouteConfig.Name)
 			hsrrs := tm.newHTTPServiceRouteRules(ruleName)
 			hsrrs.setHTTPServiceRateLimit(trafficMatch.RateLimit)
+			hsrrs.setJWTAuthentication(trafficMatch.JWTAuthentication)
+			hsrrs.setAuthorizationPolicies(authorizationTrafficTargets)
 			hsrrs.setPlugins(pipyConf.getTrafficMatchPluginConfigs(trafficMatch.Name))
 			for _, hostname := range httpRouteConfig.Hostnames {
 				tm.addHTTPHostPort2Service(HTTPHostPort(hostname), ruleName)
@@ -70,6 +74,9 @@ func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, _ ident
 				for k, v := range route.HTTPRouteMatch.Headers {
 					httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 				}
+				if route.HTTPRouteMatch.GRPC {
+					httpMatch.addGRPCMatch()
+				}
 				if len(route.HTTPRouteMatch.Methods) == 0 {
 					httpMatch.addMethodMatch("*")
 				} else {
@@ -80,7 +87,8 @@ func generatePipyInboundTrafficPolicy(meshCatalog catalog.MeshCataloger, _ ident
 
 				hsrr, duplicate := hsrrs.newHTTPServiceRouteRule(httpMatch)
 				if !duplicate {
-					hsrr.setRateLimit(rule.Route.RateLimit)
+					hsrr.setRateLimit(rule.Route.RateLimit, hsrrs.HTTPRateLimit)
+					hsrr.setFilters(rule.Route.Filters)
 					for routeCluster := range rule.Route.WeightedClusters.Iter() {
 						weightedCluster := routeCluster.(service.WeightedCluster)
 						hsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName),
@@ -177,6 +185,9 @@ func generatePipyOutboundTrafficRoutePolicy(_ catalog.MeshCataloger, proxyIdenti
 					for k, v := range route.HTTPRouteMatch.Headers {
 						httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 					}
+					if route.HTTPRouteMatch.GRPC {
+						httpMatch.addGRPCMatch()
+					}
 					if len(route.HTTPRouteMatch.Methods) == 0 {
 						httpMatch.addMethodMatch("*")
 					} else {
@@ -186,6 +197,12 @@ func generatePipyOutboundTrafficRoutePolicy(_ catalog.MeshCataloger, proxyIdenti
 					}
 
 					hsrr, _ := hsrrs.newHTTPServiceRouteRule(httpMatch)
+					hsrr.setFaultInjections(route.FaultInjections)
+					hsrr.setFilters(route.Filters)
+					if route.OutboundMatch {
+						// The routes derived from Gateway API routes are sorted by precedence
+						hsrrs.setOrdered(true)
+					}
 					for cluster := range route.WeightedClusters.Iter() {
 						serviceCluster := cluster.(service.WeightedCluster)
 						weightedCluster := new(WeightedCluster)
@@ -196,10 +213,20 @@ func generatePipyOutboundTrafficRoutePolicy(_ catalog.MeshCataloger, proxyIdenti
 						}
 						hsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName), Weight(weightedCluster.Weight))
 					}
+					for _, mirrorCluster := range route.MirrorClusters {
+						if _, exist := dependClusters[mirrorCluster.ClusterName]; !exist {
+							weightedCluster := new(WeightedCluster)
+							weightedCluster.ClusterName = mirrorCluster.ClusterName
+							dependClusters[mirrorCluster.ClusterName] = weightedCluster
+						}
+						hsrr.addMirrorCluster(ClusterName(mirrorCluster.ClusterName), mirrorCluster.Percentage)
+					}
 				}
 			}
 		} else if destinationProtocol == constants.ProtocolTCP ||
-			destinationProtocol == constants.ProtocolTCPServerFirst {
+			destinationProtocol == constants.ProtocolTCPServerFirst ||
+			destinationProtocol == constants.ProtocolUDP {
+			// UDP services are load balanced per port like TCP services by the UDP listener of the sidecar
 			tsrr := tm.newTCPServiceRouteRules()
 			tsrr.setPlugins(pipyConf.getTrafficMatchPluginConfigs(trafficMatch.Name))
 			for _, serviceCluster := range trafficMatch.WeightedClusters {
@@ -290,6 +317,9 @@ func generatePipyEgressTrafficRoutePolicy(meshCatalog catalog.MeshCataloger, _ i
 					for k, v := range route.HTTPRouteMatch.Headers {
 						httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 					}
+					if route.HTTPRouteMatch.GRPC {
+						httpMatch.addGRPCMatch()
+					}
 					if len(route.HTTPRouteMatch.Methods) == 0 {
 						httpMatch.addMethodMatch("*")
 					} else {
@@ -387,34 +417,67 @@ func generatePipyOutboundTrafficBalancePolicy(meshCatalog catalog.MeshCataloger,
 			continue
 		}
 		clusterConfigs := otp.newClusterConfigs(ClusterName(cluster.ClusterName.String()))
-		upstreamEndpoints := getUpstreamEndpoints(meshCatalog, proxyIdentity, cluster.ClusterName)
-		if len(upstreamEndpoints) == 0 {
-			ready = false
-			continue
+		externalService := clusterConfig.ExternalService
+		if externalService != nil {
+			// Services external to the mesh are accessed without mesh mTLS
+			clusterConfigs.External = true
 		}
-		for _, upstreamEndpoint := range upstreamEndpoints {
-			address := Address(upstreamEndpoint.IP.String())
-			port := Port(clusterConfig.Service.Port)
-			if len(upstreamEndpoint.ClusterKey) > 0 {
-				if targetPort := Port(clusterConfig.Service.TargetPort); targetPort > 0 {
-					port = targetPort
+		if externalService != nil && externalService.Spec.Resolution != policyv1alpha1.ExternalServiceResolutionStatic {
+			// The endpoint addresses are hostnames resolved by the sidecar
+			for _, endpointSpec := range getExternalServiceEndpointSpecs(externalService) {
+				weight := Weight(endpointSpec.Weight)
+				if weight == 0 {
+					weight = 1
 				}
+				clusterConfigs.addWeightedEndpoint(Address(endpointSpec.Address), Port(clusterConfig.Service.TargetPort), weight)
 			}
-			weight := Weight(upstreamEndpoint.Weight)
-			clusterConfigs.addWeightedZoneEndpoint(address, port, weight, upstreamEndpoint.ClusterKey, upstreamEndpoint.LBType, upstreamEndpoint.Path)
-			if clusterConfig.UpstreamTrafficSetting != nil {
-				if clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings != nil {
-					clusterConfigs.setConnectionSettings(clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings)
+		} else {
+			upstreamEndpoints := getUpstreamEndpoints(meshCatalog, proxyIdentity, cluster.ClusterName)
+			if len(upstreamEndpoints) == 0 {
+				ready = false
+				continue
+			}
+			for _, upstreamEndpoint := range upstreamEndpoints {
+				address := Address(upstreamEndpoint.IP.String())
+				port := Port(clusterConfig.Service.Port)
+				if len(upstreamEndpoint.ClusterKey) > 0 || externalService != nil {
+					if targetPort := Port(clusterConfig.Service.TargetPort); targetPort > 0 {
+						port = targetPort
+					}
 				}
+				weight := Weight(upstreamEndpoint.Weight)
+				clusterConfigs.addWeightedZoneEndpoint(address, port, weight, upstreamEndpoint.ClusterKey, upstreamEndpoint.LBType, upstreamEndpoint.Path)
 			}
-			if cluster.RetryPolicy != nil {
-				clusterConfigs.setRetryPolicy(cluster.RetryPolicy)
+		}
+		if clusterConfig.UpstreamTrafficSetting != nil {
+			if clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings != nil {
+				clusterConfigs.setConnectionSettings(clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings)
 			}
+			if clusterConfig.UpstreamTrafficSetting.Spec.LoadBalancer != nil {
+				clusterConfigs.setLoadBalancer(clusterConfig.UpstreamTrafficSetting.Spec.LoadBalancer)
+			}
+		}
+		if cluster.RetryPolicy != nil {
+			clusterConfigs.setRetryPolicy(cluster.RetryPolicy)
 		}
 	}
 	return ready
 }
 
+// getExternalServiceEndpointSpecs returns the endpoints of the given external service.
+// The hosts of the external service are used as endpoint addresses when its endpoints are unspecified.
+func getExternalServiceEndpointSpecs(externalService *policyv1alpha1.ExternalService) []policyv1alpha1.ExternalServiceEndpointSpec {
+	if len(externalService.Spec.Endpoints) > 0 {
+		return externalService.Spec.Endpoints
+	}
+
+	var endpointSpecs []policyv1alpha1.ExternalServiceEndpointSpec
+	for _, host := range externalService.Spec.Hosts {
+		endpointSpecs = append(endpointSpecs, policyv1alpha1.ExternalServiceEndpointSpec{Address: host})
+	}
+	return endpointSpecs
+}
+
 func generatePipyIngressTrafficRoutePolicy(_ catalog.MeshCataloger, _ identity.ServiceIdentity, pipyConf *PipyConf, ingressPolicy *trafficpolicy.IngressTrafficPolicy) {
 	if len(ingressPolicy.TrafficMatches) == 0 {
 		return
@@ -486,6 +549,9 @@ func generatePipyIngressTrafficRoutePolicy(_ catalog.MeshCataloger, _ identity.S
 					for k, v := range route.HTTPRouteMatch.Headers {
 						httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 					}
+					if route.HTTPRouteMatch.GRPC {
+						httpMatch.addGRPCMatch()
+					}
 					if len(route.HTTPRouteMatch.Methods) == 0 {
 						httpMatch.addMethodMatch("*")
 					} else {
@@ -495,7 +561,7 @@ func generatePipyIngressTrafficRoutePolicy(_ catalog.MeshCataloger, _ identity.S
 					}
 
 					if hsrr, duplicate := hsrrs.newHTTPServiceRouteRule(httpMatch); !duplicate {
-						hsrr.setRateLimit(rule.Route.RateLimit)
+						hsrr.setRateLimit(rule.Route.RateLimit, hsrrs.HTTPRateLimit)
 						for routeCluster := range rule.Route.WeightedClusters.Iter() {
 							weightedCluster := routeCluster.(service.WeightedCluster)
 							hsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName),
@@ -644,6 +710,9 @@ func generatePipyAccessControlTrafficRoutePolicy(_ catalog.MeshCataloger, _ iden
 					for k, v := range route.HTTPRouteMatch.Headers {
 						httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 					}
+					if route.HTTPRouteMatch.GRPC {
+						httpMatch.addGRPCMatch()
+					}
 					if len(route.HTTPRouteMatch.Methods) == 0 {
 						httpMatch.addMethodMatch("*")
 					} else {
@@ -653,7 +722,7 @@ func generatePipyAccessControlTrafficRoutePolicy(_ catalog.MeshCataloger, _ iden
 					}
 
 					if hsrr, duplicate := hsrrs.newHTTPServiceRouteRule(httpMatch); !duplicate {
-						hsrr.setRateLimit(rule.Route.RateLimit)
+						hsrr.setRateLimit(rule.Route.RateLimit, hsrrs.HTTPRateLimit)
 						for routeCluster := range rule.Route.WeightedClusters.Iter() {
 							weightedCluster := routeCluster.(service.WeightedCluster)
 							hsrr.addWeightedCluster(ClusterName(weightedCluster.ClusterName),
@@ -744,6 +813,9 @@ func generatePipyServiceExportTrafficRoutePolicy(_ catalog.MeshCataloger, _ iden
 					for k, v := range route.HTTPRouteMatch.Headers {
 						httpMatch.addHeaderMatch(Header(k), HeaderRegexp(v))
 					}
+					if route.HTTPRouteMatch.GRPC {
+						httpMatch.addGRPCMatch()
+					}
 					if len(route.HTTPRouteMatch.Methods) == 0 {
 						httpMatch.addMethodMatch("*")
 					} else {
@@ -787,6 +859,18 @@ func generatePipyEgressTrafficBalancePolicy(meshCatalog catalog.MeshCataloger, _
 		address := Address(clusterConfig.Name)
 		port := Port(clusterConfig.Port)
 		weight := Weight(constants.ClusterWeightAcceptAll)
+		if clusterConfig.TLS != nil {
+			// TLS is originated to the target port of the host, which differs from the port in the cluster name
+			address = Address(getHTTPHostPort(Address(clusterConfig.Host), Port(clusterConfig.Port)))
+			clusterConfigs.TLS = &EgressTLS{
+				SNI:                clusterConfig.TLS.SNI,
+				SubjectAltNames:    clusterConfig.TLS.SubjectAltNames,
+				MinProtocolVersion: clusterConfig.TLS.MinProtocolVersion,
+				TrustedCA:          string(clusterConfig.TLS.TrustedCA),
+				CertChain:          string(clusterConfig.TLS.ClientCertificate),
+				PrivateKey:         string(clusterConfig.TLS.ClientPrivateKey),
+			}
+		}
 		clusterConfigs.addWeightedEndpoint(address, port, weight)
 		if clusterConfig.UpstreamTrafficSetting != nil {
 			clusterConfigs.setConnectionSettings(clusterConfig.UpstreamTrafficSetting.Spec.ConnectionSettings)
@@ -982,10 +1066,23 @@ func hostToMeshSvc(cluster string) (service.MeshService, error) {
 }
 
 func getEndpointsForProxyIdentity(meshCatalog catalog.MeshCataloger, proxyIdentity identity.ServiceIdentity) []endpoint.Endpoint {
-	if mc, ok := meshCatalog.(*catalog.MeshCatalog); ok {
-		return mc.ListEndpointsForServiceIdentity(proxyIdentity)
+	return meshCatalog.ListEndpointsForServiceIdentity(proxyIdentity)
+}
+
+func getAuthorizationTrafficTargets(meshCatalog catalog.MeshCataloger, proxyIdentity identity.ServiceIdentity) []trafficpolicy.TrafficTargetWithRoutes {
+	trafficTargets, err := meshCatalog.ListInboundTrafficTargetsWithRoutes(proxyIdentity)
+	if err != nil {
+		log.Error().Err(err).Msgf("Error listing inbound traffic targets for proxy identity %s", proxyIdentity)
+		return nil
 	}
-	return nil
+
+	var authorizationTrafficTargets []trafficpolicy.TrafficTargetWithRoutes
+	for _, trafficTarget := range trafficTargets {
+		if trafficTarget.IsAuthorizationPolicy() {
+			authorizationTrafficTargets = append(authorizationTrafficTargets, trafficTarget)
+		}
+	}
+	return authorizationTrafficTargets
 }
 
 func arrayEqual(a []service.WeightedCluster, set mapset.Set) bool {
@@ -0,0 +1,202 @@
+// Package ratelimit implements a minimal in-process rate limit service used to test global rate limiting.
+// It implements the Envoy rate limit service (RLS) gRPC protocol used by Envoy sidecars, as well as the
+// HTTP/JSON endpoint of the reference rate limit service used by Pipy sidecars.
+package ratelimit
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	xds_ratelimit_common "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	xds_ratelimit "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const (
+	// JSONPath is the path of the HTTP/JSON endpoint of the rate limit service
+	JSONPath = "/json"
+)
+
+// Entry is a descriptor entry a Limit applies to.
+// An empty Value matches any value, with each distinct value being limited separately.
+type Entry struct {
+	Key   string
+	Value string
+}
+
+// Limit defines the number of requests allowed per Unit for requests matching a descriptor
+type Limit struct {
+	Domain          string
+	Entries         []Entry
+	RequestsPerUnit uint32
+	Unit            time.Duration
+}
+
+// Server is an in-process fixed window rate limit service
+type Server struct {
+	limits []Limit
+	now    func() time.Time
+
+	mu       sync.Mutex
+	counters map[string]uint32
+	requests []*xds_ratelimit.RateLimitRequest
+}
+
+// NewServer returns a rate limit service enforcing the given limits
+func NewServer(limits ...Limit) *Server {
+	return &Server{
+		limits:   limits,
+		now:      time.Now,
+		counters: make(map[string]uint32),
+	}
+}
+
+// WithClock overrides the clock used to compute the rate limiting windows
+func (s *Server) WithClock(now func() time.Time) *Server {
+	s.now = now
+	return s
+}
+
+// Requests returns the rate limit requests received by the server so far
+func (s *Server) Requests() []*xds_ratelimit.RateLimitRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*xds_ratelimit.RateLimitRequest(nil), s.requests...)
+}
+
+// ShouldRateLimit implements the Envoy RateLimitServiceServer interface
+func (s *Server) ShouldRateLimit(_ context.Context, req *xds_ratelimit.RateLimitRequest) (*xds_ratelimit.RateLimitResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.requests = append(s.requests, req)
+
+	hits := req.GetHitsAddend()
+	if hits == 0 {
+		hits = 1
+	}
+
+	resp := &xds_ratelimit.RateLimitResponse{
+		OverallCode: xds_ratelimit.RateLimitResponse_OK,
+	}
+	for _, descriptor := range req.GetDescriptors() {
+		status := &xds_ratelimit.RateLimitResponse_DescriptorStatus{
+			Code: xds_ratelimit.RateLimitResponse_OK,
+		}
+		if limit := s.findLimit(req.GetDomain(), descriptor.GetEntries()); limit != nil {
+			key := s.counterKey(req.GetDomain(), descriptor.GetEntries(), limit.Unit)
+			s.counters[key] += hits
+			if s.counters[key] > limit.RequestsPerUnit {
+				status.Code = xds_ratelimit.RateLimitResponse_OVER_LIMIT
+				resp.OverallCode = xds_ratelimit.RateLimitResponse_OVER_LIMIT
+			} else {
+				status.LimitRemaining = limit.RequestsPerUnit - s.counters[key]
+			}
+		}
+		resp.Statuses = append(resp.Statuses, status)
+	}
+
+	return resp, nil
+}
+
+// ServeHTTP implements the HTTP/JSON endpoint of the rate limit service
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != JSONPath {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &xds_ratelimit.RateLimitRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp, err := s.ShouldRateLimit(r.Context(), req)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	out, err := protojson.Marshal(resp)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if resp.OverallCode == xds_ratelimit.RateLimitResponse_OVER_LIMIT {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}
+	_, _ = w.Write(out)
+}
+
+// Serve serves the gRPC protocol on grpcListener and the HTTP/JSON endpoint on httpListener
+// until the returned stop function is called. Either listener may be nil.
+func (s *Server) Serve(grpcListener, httpListener net.Listener) (stop func()) {
+	var grpcServer *grpc.Server
+	if grpcListener != nil {
+		grpcServer = grpc.NewServer()
+		xds_ratelimit.RegisterRateLimitServiceServer(grpcServer, s)
+		go func() { _ = grpcServer.Serve(grpcListener) }()
+	}
+
+	var httpServer *http.Server
+	if httpListener != nil {
+		httpServer = &http.Server{Handler: s, ReadHeaderTimeout: 10 * time.Second}
+		go func() { _ = httpServer.Serve(httpListener) }()
+	}
+
+	return func() {
+		if grpcServer != nil {
+			grpcServer.Stop()
+		}
+		if httpServer != nil {
+			_ = httpServer.Close()
+		}
+	}
+}
+
+func (s *Server) findLimit(domain string, entries []*xds_ratelimit_common.RateLimitDescriptor_Entry) *Limit {
+	for i := range s.limits {
+		limit := &s.limits[i]
+		if limit.Domain != domain || len(limit.Entries) != len(entries) {
+			continue
+		}
+		matched := true
+		for j, entry := range limit.Entries {
+			if entry.Key != entries[j].GetKey() || (entry.Value != "" && entry.Value != entries[j].GetValue()) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return limit
+		}
+	}
+	return nil
+}
+
+func (s *Server) counterKey(domain string, entries []*xds_ratelimit_common.RateLimitDescriptor_Entry, unit time.Duration) string {
+	var sb strings.Builder
+	sb.WriteString(domain)
+	for _, entry := range entries {
+		sb.WriteString("|")
+		sb.WriteString(entry.GetKey())
+		sb.WriteString("=")
+		sb.WriteString(entry.GetValue())
+	}
+	sb.WriteString("|")
+	sb.WriteString(s.now().Truncate(unit).String())
+	return sb.String()
+}
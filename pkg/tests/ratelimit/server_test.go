@@ -0,0 +1,145 @@
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	xds_ratelimit_common "github.com/envoyproxy/go-control-plane/envoy/extensions/common/ratelimit/v3"
+	xds_ratelimit "github.com/envoyproxy/go-control-plane/envoy/service/ratelimit/v3"
+	tassert "github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func newRequest(domain string, entries ...Entry) *xds_ratelimit.RateLimitRequest {
+	descriptor := &xds_ratelimit_common.RateLimitDescriptor{}
+	for _, entry := range entries {
+		descriptor.Entries = append(descriptor.Entries, &xds_ratelimit_common.RateLimitDescriptor_Entry{Key: entry.Key, Value: entry.Value})
+	}
+	return &xds_ratelimit.RateLimitRequest{
+		Domain:      domain,
+		Descriptors: []*xds_ratelimit_common.RateLimitDescriptor{descriptor},
+	}
+}
+
+func TestShouldRateLimit(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewServer(
+		Limit{
+			Domain:          "test",
+			Entries:         []Entry{{Key: "source_identity"}},
+			RequestsPerUnit: 2,
+			Unit:            time.Minute,
+		},
+		Limit{
+			Domain:          "test",
+			Entries:         []Entry{{Key: "generic_key", Value: "foo"}},
+			RequestsPerUnit: 1,
+			Unit:            time.Second,
+		},
+	).WithClock(func() time.Time { return now })
+
+	testCases := []struct {
+		name     string
+		req      *xds_ratelimit.RateLimitRequest
+		advance  time.Duration
+		expected xds_ratelimit.RateLimitResponse_Code
+	}{
+		{
+			name:     "first request for identity is allowed",
+			req:      newRequest("test", Entry{Key: "source_identity", Value: "a.ns"}),
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+		{
+			name:     "second request for identity is allowed",
+			req:      newRequest("test", Entry{Key: "source_identity", Value: "a.ns"}),
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+		{
+			name:     "third request for identity is over limit",
+			req:      newRequest("test", Entry{Key: "source_identity", Value: "a.ns"}),
+			expected: xds_ratelimit.RateLimitResponse_OVER_LIMIT,
+		},
+		{
+			name:     "other identities are limited separately",
+			req:      newRequest("test", Entry{Key: "source_identity", Value: "b.ns"}),
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+		{
+			name:     "identity is allowed again in the next window",
+			req:      newRequest("test", Entry{Key: "source_identity", Value: "a.ns"}),
+			advance:  time.Minute,
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+		{
+			name:     "descriptor without a matching value is not limited",
+			req:      newRequest("test", Entry{Key: "generic_key", Value: "bar"}),
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+		{
+			name:     "descriptor in another domain is not limited",
+			req:      newRequest("other", Entry{Key: "source_identity", Value: "a.ns"}),
+			expected: xds_ratelimit.RateLimitResponse_OK,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			now = now.Add(tc.advance)
+			resp, err := s.ShouldRateLimit(context.Background(), tc.req)
+			assert.NoError(err)
+			assert.Equal(tc.expected, resp.OverallCode)
+			assert.Len(resp.Statuses, len(tc.req.Descriptors))
+		})
+	}
+
+	tassert.Len(t, s.Requests(), len(testCases))
+}
+
+func TestServe(t *testing.T) {
+	assert := tassert.New(t)
+
+	s := NewServer(Limit{
+		Domain:          "test",
+		Entries:         []Entry{{Key: "path", Value: "/get"}},
+		RequestsPerUnit: 1,
+		Unit:            time.Hour,
+	})
+
+	grpcListener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	httpListener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(err)
+	stop := s.Serve(grpcListener, httpListener)
+	defer stop()
+
+	// gRPC
+	conn, err := grpc.Dial(grpcListener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.NoError(err)
+	defer conn.Close() //nolint: errcheck
+
+	client := xds_ratelimit.NewRateLimitServiceClient(conn)
+	resp, err := client.ShouldRateLimit(context.Background(), newRequest("test", Entry{Key: "path", Value: "/get"}))
+	assert.NoError(err)
+	assert.Equal(xds_ratelimit.RateLimitResponse_OK, resp.OverallCode)
+
+	// HTTP/JSON
+	body := []byte(`{"domain":"test","descriptors":[{"entries":[{"key":"path","value":"/get"}]}]}`)
+	httpResp, err := http.Post("http://"+httpListener.Addr().String()+JSONPath, "application/json", bytes.NewReader(body)) //nolint: gosec
+	assert.NoError(err)
+	defer httpResp.Body.Close() //nolint: errcheck
+	assert.Equal(http.StatusTooManyRequests, httpResp.StatusCode)
+
+	var jsonResp struct {
+		OverallCode string `json:"overallCode"`
+	}
+	assert.NoError(json.NewDecoder(httpResp.Body).Decode(&jsonResp))
+	assert.Equal("OVER_LIMIT", jsonResp.OverallCode)
+}
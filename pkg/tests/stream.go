@@ -106,3 +106,34 @@ func (s *XDSServer) SendMsg(_ interface{}) error {
 func (s *XDSServer) RecvMsg(_ interface{}) error {
 	return nil
 }
+
+// DeltaXDSServer implements AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+type DeltaXDSServer struct {
+	XDSServer
+	deltaResponses []*xds_discovery.DeltaDiscoveryResponse
+}
+
+// NewFakeDeltaXDSServer returns a new DeltaXDSServer and implements AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+func NewFakeDeltaXDSServer(cert *x509.Certificate) (xds_discovery.AggregatedDiscoveryService_DeltaAggregatedResourcesServer, *[]*xds_discovery.DeltaDiscoveryResponse) {
+	peerKey := peer.Peer{
+		Addr:     NewMockAddress("9.8.7.6"),
+		AuthInfo: NewMockAuthInfo(cert),
+	}
+	server := DeltaXDSServer{
+		XDSServer: XDSServer{
+			ctx: peer.NewContext(context.TODO(), &peerKey),
+		},
+	}
+	return &server, &server.deltaResponses
+}
+
+// Send implements AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+func (s *DeltaXDSServer) Send(r *xds_discovery.DeltaDiscoveryResponse) error {
+	s.deltaResponses = append(s.deltaResponses, r)
+	return nil
+}
+
+// Recv implements AggregatedDiscoveryService_DeltaAggregatedResourcesServer
+func (s *DeltaXDSServer) Recv() (*xds_discovery.DeltaDiscoveryRequest, error) {
+	return &xds_discovery.DeltaDiscoveryRequest{}, nil
+}
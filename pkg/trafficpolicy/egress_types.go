@@ -50,6 +50,38 @@ type EgressClusterConfig struct {
 
 	// SourceMTLS defines the mTLS specification for the egress source.
 	SourceMTLS *policyv1alpha1.EgressSourceMTLSSpec
+
+	// TLS defines the TLS originated to the external cluster.
+	// If unspecified, traffic is proxied to the external cluster as is.
+	// +optional
+	TLS *EgressTLSConfig
+}
+
+// EgressTLSConfig is the type used to represent the TLS originated to an external cluster.
+type EgressTLSConfig struct {
+	// SNI defines the server name indicated in the TLS handshake
+	SNI string
+
+	// MinProtocolVersion defines the minimum TLS protocol version used to originate TLS
+	// +optional
+	MinProtocolVersion string
+
+	// SubjectAltNames defines the Subject Alternative Names the certificate of the
+	// external cluster must match one of when it is validated
+	SubjectAltNames []string
+
+	// TrustedCA defines the PEM encoded CA bundle the certificate of the external cluster
+	// is validated against. If unspecified, the certificate is not validated.
+	// +optional
+	TrustedCA []byte
+
+	// ClientCertificate defines the PEM encoded client certificate presented to the external cluster
+	// +optional
+	ClientCertificate []byte
+
+	// ClientPrivateKey defines the PEM encoded private key of the client certificate
+	// +optional
+	ClientPrivateKey []byte
 }
 
 // EgressHTTPRouteConfig is the type used to represent an HTTP route configuration along with associated routing rules
@@ -3,7 +3,9 @@ package trafficpolicy
 import (
 	"fmt"
 	"reflect"
+	"regexp"
 	"sort"
+	"strings"
 
 	mapset "github.com/deckarep/golang-set"
 	hashstructure "github.com/mitchellh/hashstructure/v2"
@@ -37,20 +39,82 @@ func NewRouteWeightedCluster(route HTTPRouteMatch, weightedClusters []service.We
 		return routeWC
 	}
 
-	// Apply the corresponding per route rate limit policy for the given
-	// HTTPRouteMatch's path
-	var perRouteRateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec
+	// Apply the corresponding per route rate limit policy, header modifications
+	// and rewrites for the given HTTPRouteMatch's path
 	for _, httpRoute := range upstreamTrafficSetting.Spec.HTTPRoutes {
 		if httpRoute.Path == route.Path {
-			perRouteRateLimit = httpRoute.RateLimit
+			routeWC.RateLimit = httpRoute.RateLimit
+			routeWC.Filters = newHTTPRouteFilters(httpRoute)
 			break
 		}
 	}
-	routeWC.RateLimit = perRouteRateLimit
 
 	return routeWC
 }
 
+// newHTTPRouteFilters returns the HTTPRouteFilters corresponding to the header modifications
+// and rewrites of the given HTTPRouteSpec, or nil if it has none
+func newHTTPRouteFilters(httpRoute policyv1alpha1.HTTPRouteSpec) *HTTPRouteFilters {
+	if httpRoute.RequestHeaders == nil && httpRoute.ResponseHeaders == nil && httpRoute.Rewrite == nil {
+		return nil
+	}
+
+	filters := &HTTPRouteFilters{
+		RequestHeaders:  newHTTPHeaderModifier(httpRoute.RequestHeaders),
+		ResponseHeaders: newHTTPHeaderModifier(httpRoute.ResponseHeaders),
+	}
+
+	if rewrite := httpRoute.Rewrite; rewrite != nil {
+		filters.URLRewrite = &HTTPURLRewrite{
+			Hostname: rewrite.Host,
+		}
+		switch {
+		case rewrite.Prefix != nil:
+			// The route path being a regex, the prefix is replaced using an anchored regex
+			filters.URLRewrite.Path = &HTTPPathModifier{
+				ReplaceRegex: &HTTPRegexReplace{
+					Pattern:      "^" + regexp.QuoteMeta(rewrite.Prefix.Match),
+					Substitution: rewrite.Prefix.Replacement,
+				},
+			}
+		case rewrite.Regex != nil:
+			filters.URLRewrite.Path = &HTTPPathModifier{
+				ReplaceRegex: &HTTPRegexReplace{
+					Pattern:      rewrite.Regex.Pattern,
+					Substitution: rewrite.Regex.Substitution,
+				},
+			}
+		}
+	}
+
+	return filters
+}
+
+func newHTTPHeaderModifier(modifier *policyv1alpha1.HTTPHeaderModifierSpec) *HTTPHeaderModifier {
+	if modifier == nil {
+		return nil
+	}
+
+	// Header names are case-insensitive, and lowercased as for the Gateway API header modifiers
+	headerModifier := &HTTPHeaderModifier{}
+	for _, header := range modifier.Set {
+		if headerModifier.Set == nil {
+			headerModifier.Set = make(map[string]string)
+		}
+		headerModifier.Set[strings.ToLower(header.Name)] = header.Value
+	}
+	for _, header := range modifier.Append {
+		if headerModifier.Add == nil {
+			headerModifier.Add = make(map[string]string)
+		}
+		headerModifier.Add[strings.ToLower(header.Name)] = header.Value
+	}
+	for _, name := range modifier.Remove {
+		headerModifier.Remove = append(headerModifier.Remove, strings.ToLower(name))
+	}
+	return headerModifier
+}
+
 // NewInboundTrafficPolicy takes a name, list of hostnames, UpstreamTrafficSetting, and returns an *InboundTrafficPolicy
 func NewInboundTrafficPolicy(name string, hostnames []string, upstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting) *InboundTrafficPolicy {
 	policy := &InboundTrafficPolicy{
@@ -112,6 +176,23 @@ func (out *OutboundTrafficPolicy) AddRoute(httpRouteMatch HTTPRouteMatch, retryP
 	return nil
 }
 
+// AddRouteWeightedClusters adds the given route to the OutboundTrafficPolicy, unless a route with the same HTTP route match already exists
+func (out *OutboundTrafficPolicy) AddRouteWeightedClusters(route *RouteWeightedClusters) error {
+	for _, existingRoute := range out.Routes {
+		if reflect.DeepEqual(existingRoute.HTTPRouteMatch, route.HTTPRouteMatch) {
+			return fmt.Errorf("Route for HTTP Route Match: %v already exists: %v for outbound traffic policy: %s", existingRoute.HTTPRouteMatch, existingRoute, out.Name)
+		}
+	}
+
+	out.Routes = append(out.Routes, route)
+	return nil
+}
+
+// IsAuthorizationPolicy returns true if the traffic target is derived from an AuthorizationPolicy policy
+func (t TrafficTargetWithRoutes) IsAuthorizationPolicy() bool {
+	return t.Action != ""
+}
+
 // MergeInboundPolicies merges latest InboundTrafficPolicies into a slice of InboundTrafficPolicies that already exists (original)
 // allowPartialHostnamesMatch when set to true merges inbound policies by partially comparing (subset of one another) the hostnames of the original traffic policy to the latest traffic policy
 // A partial match on hostnames should be allowed for the following scenarios :
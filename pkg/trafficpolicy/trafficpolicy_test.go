@@ -219,11 +219,11 @@ func TestAddRoute(t *testing.T) {
 func TestMergeInboundPoliciesWithPartialHostnames(t *testing.T) {
 	testRule1 := Rule{
 		Route:             testRoute,
-		AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+		AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 	}
 	testRule2 := Rule{
 		Route:             testRoute2,
-		AllowedPrincipals: mapset.NewSet(testServiceAccount2.AsPrincipal("cluster.local")),
+		AllowedPrincipals: mapset.NewSet(testServiceAccount2.AsPrincipal("cluster.local", false)),
 	}
 	testRule1Modified := Rule{
 		Route: RouteWeightedClusters{
@@ -307,19 +307,19 @@ func TestMergeRules(t *testing.T) {
 			originalRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 			newRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount2.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount2.AsPrincipal("cluster.local", false)),
 				},
 			},
 			expectedRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local"), testServiceAccount2.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local", false), testServiceAccount2.AsPrincipal("cluster.local", false)),
 				},
 			},
 		},
@@ -328,19 +328,19 @@ func TestMergeRules(t *testing.T) {
 			originalRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 			newRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 			expectedRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 		},
@@ -349,23 +349,23 @@ func TestMergeRules(t *testing.T) {
 			originalRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 			newRules: []*Rule{
 				{
 					Route:             testRoute2,
-					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSet(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 			expectedRules: []*Rule{
 				{
 					Route:             testRoute,
-					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 				{
 					Route:             testRoute2,
-					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local")),
+					AllowedPrincipals: mapset.NewSetWith(testServiceAccount1.AsPrincipal("cluster.local", false)),
 				},
 			},
 		},
@@ -509,6 +509,76 @@ func TestNewRouteWeightedCluster(t *testing.T) {
 				RateLimit:        perRouteRateLimitConfig,
 			},
 		},
+		{
+			name:             "per route header modifications and prefix rewrite",
+			route:            testHTTPRouteMatch,
+			weightedClusters: []service.WeightedCluster{testWeightedCluster},
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					HTTPRoutes: []policyv1alpha1.HTTPRouteSpec{
+						{
+							Path: testHTTPRouteMatch.Path,
+							RequestHeaders: &policyv1alpha1.HTTPHeaderModifierSpec{
+								Set:    []policyv1alpha1.HTTPHeaderValue{{Name: "X-A", Value: "a"}},
+								Append: []policyv1alpha1.HTTPHeaderValue{{Name: "x-b", Value: "b"}},
+								Remove: []string{"X-C"},
+							},
+							Rewrite: &policyv1alpha1.HTTPRewriteSpec{
+								Prefix: &policyv1alpha1.HTTPPrefixRewriteSpec{Match: "/v1.0/", Replacement: "/"},
+								Host:   "bookstore.default",
+							},
+						},
+					},
+				},
+			},
+			expected: &RouteWeightedClusters{
+				HTTPRouteMatch:   testHTTPRouteMatch,
+				WeightedClusters: mapset.NewSet(testWeightedCluster),
+				Filters: &HTTPRouteFilters{
+					RequestHeaders: &HTTPHeaderModifier{
+						Set:    map[string]string{"x-a": "a"},
+						Add:    map[string]string{"x-b": "b"},
+						Remove: []string{"x-c"},
+					},
+					URLRewrite: &HTTPURLRewrite{
+						Hostname: "bookstore.default",
+						Path: &HTTPPathModifier{
+							ReplaceRegex: &HTTPRegexReplace{Pattern: `^/v1\.0/`, Substitution: "/"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:             "per route regex rewrite and response header removal",
+			route:            testHTTPRouteMatch,
+			weightedClusters: []service.WeightedCluster{testWeightedCluster},
+			upstreamTrafficSetting: &policyv1alpha1.UpstreamTrafficSetting{
+				Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+					HTTPRoutes: []policyv1alpha1.HTTPRouteSpec{
+						{
+							Path:            testHTTPRouteMatch.Path,
+							ResponseHeaders: &policyv1alpha1.HTTPHeaderModifierSpec{Remove: []string{"server"}},
+							Rewrite: &policyv1alpha1.HTTPRewriteSpec{
+								Regex: &policyv1alpha1.HTTPRegexRewriteSpec{Pattern: "^/(.*)/books$", Substitution: `/books/\1`},
+							},
+						},
+					},
+				},
+			},
+			expected: &RouteWeightedClusters{
+				HTTPRouteMatch:   testHTTPRouteMatch,
+				WeightedClusters: mapset.NewSet(testWeightedCluster),
+				Filters: &HTTPRouteFilters{
+					ResponseHeaders: &HTTPHeaderModifier{Remove: []string{"server"}},
+					URLRewrite: &HTTPURLRewrite{
+						Path: &HTTPPathModifier{
+							ReplaceRegex: &HTTPRegexReplace{Pattern: "^/(.*)/books$", Substitution: `/books/\1`},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -532,6 +602,26 @@ func TestNewOutboundPolicy(t *testing.T) {
 	assert.Equal(expected, actual)
 }
 
+func TestAddRouteWeightedClusters(t *testing.T) {
+	assert := tassert.New(t)
+
+	policy := NewOutboundTrafficPolicy("name", []string{"hostname"})
+
+	route := testRoute
+	assert.Nil(policy.AddRouteWeightedClusters(&route))
+
+	// The route with the same match is not added, even with different clusters
+	duplicate := RouteWeightedClusters{
+		HTTPRouteMatch:   testHTTPRouteMatch,
+		WeightedClusters: mapset.NewSet(testWeightedCluster2),
+	}
+	assert.NotNil(policy.AddRouteWeightedClusters(&duplicate))
+
+	route2 := testRoute2
+	assert.Nil(policy.AddRouteWeightedClusters(&route2))
+	assert.Equal([]*RouteWeightedClusters{&route, &route2}, policy.Routes)
+}
+
 func TestTotalClustersWeight(t *testing.T) {
 	testCases := []struct {
 		name           string
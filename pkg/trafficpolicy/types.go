@@ -37,6 +37,10 @@ type HTTPRouteMatch struct {
 	PathMatchType PathMatchType     `json:"path_match_type:omitempty"`
 	Methods       []string          `json:"methods:omitempty"`
 	Headers       map[string]string `json:"headers:omitempty"`
+
+	// GRPC defines whether only gRPC requests are matched, the gRPC service and method being
+	// matched on the path of the form /<service>/<method>
+	GRPC bool `json:"grpc:omitempty"`
 }
 
 // HTTPRouteMatchWithWeightedClusters is a struct to represent an HTTP route match comprised of WeightedClusters, HTTPRouteMatches
@@ -61,6 +65,127 @@ type RouteWeightedClusters struct {
 	// for the given HTTPRouteMatch
 	// +optional
 	RateLimit *policyv1alpha1.HTTPPerRouteRateLimitSpec `json:"rate_limit:omitempty"`
+
+	// FaultInjections defines the faults injected into the requests matching
+	// the given HTTPRouteMatch
+	// +optional
+	FaultInjections []*policyv1alpha1.FaultInjectionSpec `json:"fault_injections:omitempty"`
+
+	// MirrorClusters defines the clusters the requests matching the given
+	// HTTPRouteMatch are mirrored to
+	// +optional
+	MirrorClusters []*MirrorCluster `json:"mirror_clusters:omitempty"`
+
+	// LoadBalancer defines the load balancing settings of the upstream
+	// service, used to derive the key consistent hashing is based on
+	// +optional
+	LoadBalancer *policyv1alpha1.LoadBalancerSpec `json:"load_balancer:omitempty"`
+
+	// OutboundMatch defines if the HTTPRouteMatch is honored by the downstream
+	// client, as is the case for the routes derived from Gateway API routes
+	// +optional
+	OutboundMatch bool `json:"outbound_match:omitempty"`
+
+	// Filters defines the modifications applied to the requests matching the
+	// given HTTPRouteMatch and to their responses
+	// +optional
+	Filters *HTTPRouteFilters `json:"filters:omitempty"`
+}
+
+// MirrorCluster is a struct to represent a cluster requests are mirrored to
+type MirrorCluster struct {
+	ClusterName service.ClusterName `json:"cluster_name:omitempty"`
+	Percentage  uint32              `json:"percentage:omitempty"`
+}
+
+// HTTPRouteFilters is a struct to represent the modifications applied to the HTTP requests matching a route
+type HTTPRouteFilters struct {
+	// RequestHeaders defines the modifications of the request headers
+	// +optional
+	RequestHeaders *HTTPHeaderModifier `json:"request_headers:omitempty"`
+
+	// ResponseHeaders defines the modifications of the response headers
+	// +optional
+	ResponseHeaders *HTTPHeaderModifier `json:"response_headers:omitempty"`
+
+	// URLRewrite defines the rewrite of the request URL
+	// +optional
+	URLRewrite *HTTPURLRewrite `json:"url_rewrite:omitempty"`
+
+	// Redirect defines the redirect response returned in place of forwarding the requests
+	// +optional
+	Redirect *HTTPRequestRedirect `json:"redirect:omitempty"`
+}
+
+// HTTPHeaderModifier is a struct to represent the modifications of HTTP headers
+type HTTPHeaderModifier struct {
+	// Set overwrites the given headers
+	Set map[string]string `json:"set:omitempty"`
+
+	// Add appends the given values to the given headers
+	Add map[string]string `json:"add:omitempty"`
+
+	// Remove removes the given headers
+	Remove []string `json:"remove:omitempty"`
+}
+
+// HTTPPathModifier is a struct to represent the modification of an HTTP request path,
+// with only one of its fields set
+type HTTPPathModifier struct {
+	// ReplaceFullPath replaces the whole path
+	ReplaceFullPath *string `json:"replace_full_path:omitempty"`
+
+	// ReplacePrefixMatch replaces the prefix the route matched the path with
+	ReplacePrefixMatch *string `json:"replace_prefix_match:omitempty"`
+
+	// ReplaceRegex replaces the parts of the path matching a regular expression
+	ReplaceRegex *HTTPRegexReplace `json:"replace_regex:omitempty"`
+}
+
+// HTTPRegexReplace is a struct to represent the replacement of the parts of a string
+// matching an RE2 regular expression
+type HTTPRegexReplace struct {
+	// Pattern is the regular expression
+	Pattern string `json:"pattern"`
+
+	// Substitution is the value replacing the matching parts, the capture groups
+	// being referred to as \1, \2, etc.
+	Substitution string `json:"substitution"`
+}
+
+// HTTPURLRewrite is a struct to represent the rewrite of an HTTP request URL
+type HTTPURLRewrite struct {
+	// Hostname rewrites the Host header
+	// +optional
+	Hostname string `json:"hostname:omitempty"`
+
+	// Path rewrites the path
+	// +optional
+	Path *HTTPPathModifier `json:"path:omitempty"`
+}
+
+// HTTPRequestRedirect is a struct to represent an HTTP redirect response,
+// the unset fields being taken from the request URL
+type HTTPRequestRedirect struct {
+	// Scheme is the scheme of the Location header
+	// +optional
+	Scheme string `json:"scheme:omitempty"`
+
+	// Hostname is the hostname of the Location header
+	// +optional
+	Hostname string `json:"hostname:omitempty"`
+
+	// Path is the modification of the request path in the Location header
+	// +optional
+	Path *HTTPPathModifier `json:"path:omitempty"`
+
+	// Port is the port of the Location header
+	// +optional
+	Port int `json:"port:omitempty"`
+
+	// StatusCode is the status code of the response, 302 if unset
+	// +optional
+	StatusCode int `json:"status_code:omitempty"`
 }
 
 // InboundTrafficPolicy is a struct that associates incoming traffic on a set of Hostnames with a list of Rules
@@ -96,6 +221,38 @@ type TrafficTargetWithRoutes struct {
 	Destination     identity.ServiceIdentity   `json:"destination:omitempty"`
 	Sources         []identity.ServiceIdentity `json:"sources:omitempty"`
 	TCPRouteMatches []TCPRouteMatch            `json:"tcp_route_matches:omitempty"`
+
+	// Action is set for the traffic targets derived from AuthorizationPolicy policies, and
+	// defines the action applied to the traffic matching AuthorizationRules.
+	// Traffic targets derived from SMI TrafficTarget policies do not set it.
+	Action policyv1alpha1.AuthorizationPolicyAction `json:"action:omitempty"`
+
+	// AuthorizationRules are the rules of the AuthorizationPolicy policy the traffic target is derived from
+	AuthorizationRules []AuthorizationRule `json:"authorization_rules:omitempty"`
+}
+
+// AuthorizationRule is the type used to represent a rule of an AuthorizationPolicy policy,
+// with its sources resolved relative to the namespace of the policy.
+// A rule matches the traffic if any of its sources and any of its operations match the traffic.
+type AuthorizationRule struct {
+	// From is the list of sources matched by the rule, any source is matched if empty
+	From []AuthorizationSource `json:"from:omitempty"`
+
+	// To is the list of operations matched by the rule, any operation is matched if empty
+	To []policyv1alpha1.AuthorizationOperationSpec `json:"to:omitempty"`
+}
+
+// AuthorizationSource is the type used to represent a source matched by an AuthorizationRule.
+// A source matches the traffic if all of its non empty fields match it.
+type AuthorizationSource struct {
+	// Identities is the list of service identities the traffic originates from
+	Identities []identity.ServiceIdentity `json:"identities:omitempty"`
+
+	// Namespaces is the list of namespaces the traffic originates from
+	Namespaces []string `json:"namespaces:omitempty"`
+
+	// IPBlocks is the list of source IP ranges of the traffic, in CIDR notation
+	IPBlocks []string `json:"ip_blocks:omitempty"`
 }
 
 // OutboundMeshTrafficPolicy is the type used to represent the outbound mesh traffic policy configurations
@@ -165,6 +322,11 @@ type MeshClusterConfig struct {
 
 	// UpstreamTrafficSetting is the traffic setting for the upstream cluster
 	UpstreamTrafficSetting *policyv1alpha1.UpstreamTrafficSetting
+
+	// ExternalService is the ExternalService declaring the upstream service when
+	// the upstream service is external to the mesh
+	// +optional
+	ExternalService *policyv1alpha1.ExternalService
 }
 
 // TrafficMatch is the type used to represent attributes used to match traffic
@@ -205,5 +367,50 @@ type TrafficMatch struct {
 	// +optional
 	RateLimit *policyv1alpha1.RateLimitSpec
 
+	// JWTAuthentication defines the JWT authentication applied to the HTTP requests for this TrafficMatch
+	// +optional
+	JWTAuthentication *JWTAuthentication
+
 	EgressGateWay *string
 }
+
+// JWTAuthentication defines the JWT authentication and the claim based authorization
+// applied to the inbound HTTP requests of an upstream service
+type JWTAuthentication struct {
+	// Providers defines the JWT providers trusted by the upstream service.
+	// Requests are rejected if no provider could be resolved.
+	Providers []*JWTProvider
+
+	// Rules defines the claim based authorization rules, any of which must match.
+	// All the authenticated requests are authorized if unspecified.
+	// +optional
+	Rules []policyv1alpha1.JWTAuthorizationRuleSpec
+}
+
+// JWTProvider defines a JWT issuer and the key set used to verify its tokens
+type JWTProvider struct {
+	// Name defines the unique name of the provider
+	Name string
+
+	// Issuer defines the expected issuer of the token
+	Issuer string
+
+	// Audiences defines the accepted audiences of the token
+	// +optional
+	Audiences []string
+
+	// LocalJWKS defines the JSON Web Key Set resolved from a secret
+	// +optional
+	LocalJWKS string
+
+	// RemoteJWKSURI defines the URI the JSON Web Key Set is fetched from by the sidecar
+	// +optional
+	RemoteJWKSURI string
+
+	// ForwardOriginalToken defines whether the original token is forwarded to the upstream
+	ForwardOriginalToken bool
+
+	// OutputClaimToHeaders defines the claims copied to request headers
+	// +optional
+	OutputClaimToHeaders []policyv1alpha1.ClaimToHeaderSpec
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"net"
 	"sort"
+	"strings"
 )
 
 // IncrIP ip increase
@@ -39,9 +40,12 @@ func Compare(a, b net.IP) int {
 	return bytes.Compare(a, b)
 }
 
-// CompareCIDR returns an integer comparing two CIDR
+// CompareCIDR returns an integer comparing two CIDR, an IPv4 CIDR is less than an IPv6 CIDR
 // The result will be 0 if a==b, -1 if a < b, and +1 if a > b.
 func CompareCIDR(a, b *CIDR) int {
+	if n := compareFamily(a, b); n != 0 {
+		return n
+	}
 	i1, _ := a.ipnet.Mask.Size()
 	j1, _ := b.ipnet.Mask.Size()
 	if i1 == j1 {
@@ -53,9 +57,12 @@ func CompareCIDR(a, b *CIDR) int {
 	return -1
 }
 
-// AscSortCIDRs sort cidr slice order by ip,mask asc
+// AscSortCIDRs sort cidr slice order by family,ip,mask asc
 func AscSortCIDRs(cs []*CIDR) {
 	sort.Slice(cs, func(i, j int) bool {
+		if n := compareFamily(cs[i], cs[j]); n != 0 {
+			return n < 0
+		}
 		i1, _ := cs[i].ipnet.Mask.Size()
 		j1, _ := cs[j].ipnet.Mask.Size()
 		if i1 == j1 {
@@ -66,9 +73,12 @@ func AscSortCIDRs(cs []*CIDR) {
 	})
 }
 
-// DescSortCIDRs sort cidr slice order by ip,mask desc
+// DescSortCIDRs sort cidr slice order by family,ip,mask desc
 func DescSortCIDRs(cs []*CIDR) {
 	sort.Slice(cs, func(i, j int) bool {
+		if n := compareFamily(cs[i], cs[j]); n != 0 {
+			return n > 0
+		}
 		i1, _ := cs[i].ipnet.Mask.Size()
 		j1, _ := cs[j].ipnet.Mask.Size()
 		if i1 == j1 {
@@ -78,3 +88,46 @@ func DescSortCIDRs(cs []*CIDR) {
 		return i1 > j1
 	})
 }
+
+// compareFamily returns an integer comparing the IP families of two CIDR, IPv4 is less than IPv6
+// The result will be 0 if a and b are of the same family, -1 if a is IPv4 and b is IPv6, and +1 otherwise.
+func compareFamily(a, b *CIDR) int {
+	if a.IsIPv4() == b.IsIPv4() {
+		return 0
+	}
+	if a.IsIPv4() {
+		return -1
+	}
+	return 1
+}
+
+// IsIPv6String reports whether s is an IPv6 address or an IPv6 CIDR
+func IsIPv6String(s string) bool {
+	if ip, _, err := net.ParseCIDR(s); err == nil {
+		return ip.To4() == nil
+	}
+	if ip := net.ParseIP(s); ip != nil {
+		return ip.To4() == nil
+	}
+	return strings.Contains(s, ":")
+}
+
+// SplitByFamily splits the given IP addresses or CIDRs into the IPv4 and the IPv6 ones, preserving their order
+func SplitByFamily(ranges []string) (ipv4Ranges, ipv6Ranges []string) {
+	for _, r := range ranges {
+		if IsIPv6String(r) {
+			ipv6Ranges = append(ipv6Ranges, r)
+		} else {
+			ipv4Ranges = append(ipv4Ranges, r)
+		}
+	}
+	return ipv4Ranges, ipv6Ranges
+}
+
+// SingleIPCIDR returns the CIDR matching only the given IP address, with a /32 prefix for IPv4 and /128 for IPv6
+func SingleIPCIDR(ip net.IP) string {
+	if ip.To4() != nil {
+		return ip.String() + "/32"
+	}
+	return ip.String() + "/128"
+}
@@ -0,0 +1,177 @@
+package cidr
+
+import (
+	"net"
+	"testing"
+
+	tassert "github.com/stretchr/testify/assert"
+	trequire "github.com/stretchr/testify/require"
+)
+
+func TestIsIPv6String(t *testing.T) {
+	testCases := []struct {
+		name     string
+		s        string
+		expected bool
+	}{
+		{
+			name:     "IPv4 address",
+			s:        "10.0.0.1",
+			expected: false,
+		},
+		{
+			name:     "IPv4 CIDR",
+			s:        "10.0.0.0/8",
+			expected: false,
+		},
+		{
+			name:     "IPv6 address",
+			s:        "fd00::1",
+			expected: true,
+		},
+		{
+			name:     "IPv6 CIDR",
+			s:        "fd00::/64",
+			expected: true,
+		},
+		{
+			name:     "IPv4-mapped IPv6 address",
+			s:        "::ffff:10.0.0.1",
+			expected: false,
+		},
+		{
+			name:     "Unparsable string with a colon",
+			s:        "fd00::1::2",
+			expected: true,
+		},
+		{
+			name:     "Unparsable string without a colon",
+			s:        "invalid",
+			expected: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, IsIPv6String(tc.s))
+		})
+	}
+}
+
+func TestSplitByFamily(t *testing.T) {
+	testCases := []struct {
+		name         string
+		ranges       []string
+		expectedIPv4 []string
+		expectedIPv6 []string
+	}{
+		{
+			name:         "No ranges",
+			ranges:       nil,
+			expectedIPv4: nil,
+			expectedIPv6: nil,
+		},
+		{
+			name:         "IPv4 ranges only",
+			ranges:       []string{"10.0.0.0/8", "192.168.0.1"},
+			expectedIPv4: []string{"10.0.0.0/8", "192.168.0.1"},
+			expectedIPv6: nil,
+		},
+		{
+			name:         "Mixed ranges preserve their order",
+			ranges:       []string{"fd00::/64", "10.0.0.0/8", "fd00::1", "192.168.0.1"},
+			expectedIPv4: []string{"10.0.0.0/8", "192.168.0.1"},
+			expectedIPv6: []string{"fd00::/64", "fd00::1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			ipv4Ranges, ipv6Ranges := SplitByFamily(tc.ranges)
+			assert.Equal(tc.expectedIPv4, ipv4Ranges)
+			assert.Equal(tc.expectedIPv6, ipv6Ranges)
+		})
+	}
+}
+
+func TestSingleIPCIDR(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ip       net.IP
+		expected string
+	}{
+		{
+			name:     "IPv4 address",
+			ip:       net.ParseIP("10.0.0.1"),
+			expected: "10.0.0.1/32",
+		},
+		{
+			name:     "IPv6 address",
+			ip:       net.ParseIP("fd00::1"),
+			expected: "fd00::1/128",
+		},
+		{
+			name:     "IPv4-mapped IPv6 address",
+			ip:       net.ParseIP("::ffff:10.0.0.1"),
+			expected: "10.0.0.1/32",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			assert.Equal(tc.expected, SingleIPCIDR(tc.ip))
+		})
+	}
+}
+
+func TestCompareFamily(t *testing.T) {
+	testCases := []struct {
+		name     string
+		a        string
+		b        string
+		expected int
+	}{
+		{
+			name:     "Both IPv4",
+			a:        "10.0.0.0/8",
+			b:        "192.168.0.0/16",
+			expected: 0,
+		},
+		{
+			name:     "Both IPv6",
+			a:        "fd00::/64",
+			b:        "fe80::/10",
+			expected: 0,
+		},
+		{
+			name:     "IPv4 is less than IPv6",
+			a:        "10.0.0.0/8",
+			b:        "fd00::/64",
+			expected: -1,
+		},
+		{
+			name:     "IPv6 is greater than IPv4",
+			a:        "fd00::/64",
+			b:        "10.0.0.0/8",
+			expected: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			require := trequire.New(t)
+
+			a, err := ParseCIDR(tc.a)
+			require.NoError(err)
+			b, err := ParseCIDR(tc.b)
+			require.NoError(err)
+
+			assert.Equal(tc.expected, compareFamily(a, b))
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
@@ -18,6 +19,9 @@ const (
 	// ValidatingWebhookName is the name of the validating webhook.
 	ValidatingWebhookName = "osm-validator.k8s.io"
 
+	// MeshConfigValidatingWebhookName is the name of the validating webhook of the MeshConfig.
+	MeshConfigValidatingWebhookName = "osm-meshconfig-validator.k8s.io"
+
 	// ValidatorWebhookSvc is the name of the validator service.
 	ValidatorWebhookSvc = "osm-validator"
 )
@@ -26,7 +30,18 @@ func createOrUpdateValidatingWebhook(clientSet kubernetes.Interface, cert *certi
 	webhookPath := validationAPIPath
 	webhookPort := int32(constants.ValidatorWebhookPort)
 	failurePolicy := admissionregv1.Fail
+	// The MeshConfig is created before the validator is running, so its validation failures are ignored
+	meshConfigFailurePolicy := admissionregv1.Ignore
 	matchPolicy := admissionregv1.Exact
+	sideEffect := admissionregv1.SideEffectClassNoneOnDryRun
+	clientConfig := admissionregv1.WebhookClientConfig{
+		Service: &admissionregv1.ServiceReference{
+			Namespace: osmNamespace,
+			Name:      ValidatorWebhookSvc,
+			Path:      &webhookPath,
+			Port:      &webhookPort,
+		},
+		CABundle: cert.GetTrustedCAs()}
 
 	rules := []admissionregv1.RuleWithOperations{
 		{
@@ -34,7 +49,7 @@ func createOrUpdateValidatingWebhook(clientSet kubernetes.Interface, cert *certi
 			Rule: admissionregv1.Rule{
 				APIGroups:   []string{"policy.openservicemesh.io"},
 				APIVersions: []string{"v1alpha1"},
-				Resources:   []string{"ingressbackends", "egresses", "egressgateways"},
+				Resources:   []string{"ingressbackends", "egresses", "egressgateways", "externalservices", "retries", "upstreamtrafficsettings", "faultinjections", "trafficmirrors", "requestauthentications", "authorizationpolicies"},
 			},
 		},
 		{
@@ -76,15 +91,8 @@ func createOrUpdateValidatingWebhook(clientSet kubernetes.Interface, cert *certi
 		},
 		Webhooks: []admissionregv1.ValidatingWebhook{
 			{
-				Name: ValidatingWebhookName,
-				ClientConfig: admissionregv1.WebhookClientConfig{
-					Service: &admissionregv1.ServiceReference{
-						Namespace: osmNamespace,
-						Name:      ValidatorWebhookSvc,
-						Path:      &webhookPath,
-						Port:      &webhookPort,
-					},
-					CABundle: cert.GetTrustedCAs()},
+				Name:          ValidatingWebhookName,
+				ClientConfig:  clientConfig,
 				FailurePolicy: &failurePolicy,
 				MatchPolicy:   &matchPolicy,
 				NamespaceSelector: &metav1.LabelSelector{
@@ -107,12 +115,33 @@ func createOrUpdateValidatingWebhook(clientSet kubernetes.Interface, cert *certi
 						},
 					},
 				},
-				Rules: rules,
-				SideEffects: func() *admissionregv1.SideEffectClass {
-					sideEffect := admissionregv1.SideEffectClassNoneOnDryRun
-					return &sideEffect
-				}(),
-				AdmissionReviewVersions: []string{"v1"}}},
+				Rules:                   rules,
+				SideEffects:             &sideEffect,
+				AdmissionReviewVersions: []string{"v1"}},
+			{
+				Name:          MeshConfigValidatingWebhookName,
+				ClientConfig:  clientConfig,
+				FailurePolicy: &meshConfigFailurePolicy,
+				MatchPolicy:   &matchPolicy,
+				// The MeshConfig lives in the OSM namespace, which the validating webhook of the policies excludes
+				NamespaceSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						corev1.LabelMetadataName: osmNamespace,
+					},
+				},
+				Rules: []admissionregv1.RuleWithOperations{
+					{
+						Operations: []admissionregv1.OperationType{admissionregv1.Create, admissionregv1.Update},
+						Rule: admissionregv1.Rule{
+							APIGroups:   []string{"config.openservicemesh.io"},
+							APIVersions: []string{"v1alpha2"},
+							Resources:   []string{"meshconfigs"},
+						},
+					},
+				},
+				SideEffects:             &sideEffect,
+				AdmissionReviewVersions: []string{"v1"}},
+		},
 	}
 
 	if _, err := clientSet.AdmissionregistrationV1().ValidatingWebhookConfigurations().Create(context.Background(), &vwhc, metav1.CreateOptions{}); err != nil {
@@ -7,6 +7,7 @@ import (
 
 	tassert "github.com/stretchr/testify/assert"
 	admissionregv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
 
@@ -20,7 +21,7 @@ var (
 		Rule: admissionregv1.Rule{
 			APIGroups:   []string{"policy.openservicemesh.io"},
 			APIVersions: []string{"v1alpha1"},
-			Resources:   []string{"ingressbackends", "egresses", "egressgateways"},
+			Resources:   []string{"ingressbackends", "egresses", "egressgateways", "externalservices", "retries", "upstreamtrafficsettings", "faultinjections", "trafficmirrors", "requestauthentications", "authorizationpolicies"},
 		},
 	}
 
@@ -83,7 +84,7 @@ func TestCreateValidatingWebhook(t *testing.T) {
 			assert.Len(webhooks.Items, 1)
 
 			wh := webhooks.Items[0]
-			assert.Len(wh.Webhooks, 1)
+			assert.Len(wh.Webhooks, 2)
 			assert.Equal(wh.ObjectMeta.Name, webhookName)
 			assert.EqualValues(wh.ObjectMeta.Labels, map[string]string{
 				constants.OSMAppNameLabelKey:     constants.OSMAppNameLabelValue,
@@ -116,6 +117,21 @@ func TestCreateValidatingWebhook(t *testing.T) {
 
 			assert.ElementsMatch(wh.Webhooks[0].Rules, tc.expectedRules)
 			assert.Equal(wh.Webhooks[0].AdmissionReviewVersions, []string{"v1"})
+
+			assert.Equal(wh.Webhooks[1].Name, MeshConfigValidatingWebhookName)
+			assert.Equal(wh.Webhooks[1].ClientConfig, wh.Webhooks[0].ClientConfig)
+			assert.Equal(*wh.Webhooks[1].FailurePolicy, admissionregv1.Ignore)
+			assert.Equal(wh.Webhooks[1].NamespaceSelector.MatchLabels[corev1.LabelMetadataName], osmNamespace)
+			assert.ElementsMatch(wh.Webhooks[1].Rules, []admissionregv1.RuleWithOperations{
+				{
+					Operations: []admissionregv1.OperationType{admissionregv1.Create, admissionregv1.Update},
+					Rule: admissionregv1.Rule{
+						APIGroups:   []string{"config.openservicemesh.io"},
+						APIVersions: []string{"v1alpha2"},
+						Resources:   []string{"meshconfigs"},
+					},
+				},
+			})
 		})
 	}
 }
@@ -11,6 +11,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/client-go/kubernetes"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	pluginv1alpha1 "github.com/openservicemesh/osm/pkg/apis/plugin/v1alpha1"
 	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 
@@ -49,11 +50,18 @@ func NewValidatingWebhook(ctx context.Context, cfg *configurator.Client, webhook
 			policyv1alpha1.SchemeGroupVersion.WithKind("IngressBackend").String():         kv.ingressBackendValidator,
 			policyv1alpha1.SchemeGroupVersion.WithKind("Egress").String():                 egressValidator,
 			policyv1alpha1.SchemeGroupVersion.WithKind("EgressGateway").String():          kv.egressGatewayValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("ExternalService").String():        kv.externalServiceValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("Retry").String():                  retryValidator,
 			policyv1alpha1.SchemeGroupVersion.WithKind("UpstreamTrafficSetting").String(): kv.upstreamTrafficSettingValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("FaultInjection").String():         faultInjectionValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("TrafficMirror").String():          trafficMirrorValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("RequestAuthentication").String():  kv.requestAuthenticationValidator,
+			policyv1alpha1.SchemeGroupVersion.WithKind("AuthorizationPolicy").String():    authorizationPolicyValidator,
 			smiAccess.SchemeGroupVersion.WithKind("TrafficTarget").String():               trafficTargetValidator,
 			pluginv1alpha1.SchemeGroupVersion.WithKind("Plugin").String():                 kv.pluginValidator,
 			pluginv1alpha1.SchemeGroupVersion.WithKind("PluginConfig").String():           kv.pluginConfigValidator,
 			pluginv1alpha1.SchemeGroupVersion.WithKind("PluginChain").String():            kv.pluginChainValidator,
+			configv1alpha2.SchemeGroupVersion.WithKind("MeshConfig").String():             meshConfigValidator,
 		},
 	}
 
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/url"
+	"regexp"
 	"strings"
 
 	mapset "github.com/deckarep/golang-set"
@@ -15,6 +17,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
+	configv1alpha2 "github.com/openservicemesh/osm/pkg/apis/config/v1alpha2"
 	pluginv1alpha1 "github.com/openservicemesh/osm/pkg/apis/plugin/v1alpha1"
 	policyv1alpha1 "github.com/openservicemesh/osm/pkg/apis/policy/v1alpha1"
 
@@ -335,6 +338,114 @@ func egressValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionR
 		return nil, fmt.Errorf("Cannot have more than 1 UpstreamTrafficSetting match")
 	}
 
+	// TLS can only be originated for plain HTTP traffic directed to external hosts
+	for _, port := range egress.Spec.Ports {
+		if port.TLS == nil {
+			continue
+		}
+		if port.Protocol != constants.ProtocolHTTP {
+			return nil, fmt.Errorf("Expected 'ports.protocol' for port %d with TLS origination to be '%s', got: %s", port.Number, constants.ProtocolHTTP, port.Protocol)
+		}
+		if len(egress.Spec.Hosts) == 0 {
+			return nil, fmt.Errorf("Expected 'hosts' to be specified for port %d with TLS origination", port.Number)
+		}
+	}
+
+	return nil, nil
+}
+
+// retryValidator validates the Retry custom resource
+func retryValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	retry := &policyv1alpha1.Retry{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(retry); err != nil {
+		return nil, err
+	}
+
+	if err := validateRetryOn(retry.Spec.RetryPolicy.RetryOn); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// retryOnPolicies are the policies the retries can be configured on besides the HTTP status codes and classes
+var retryOnPolicies = mapset.NewSetFromSlice([]interface{}{
+	"5xx", "gateway-error", "reset", "connect-failure", "envoy-ratelimited", "retriable-4xx",
+	"refused-stream", "retriable-status-codes", "retriable-headers", "http3-post-connect-failure",
+	"cancelled", "deadline-exceeded", "internal", "resource-exhausted", "unavailable",
+})
+
+// retryOnStatusRegex matches the HTTP status codes and classes, such as 503 or 4xx, to retry on
+var retryOnStatusRegex = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+// validateRetryOn validates the comma delimited policies to retry on
+func validateRetryOn(retryOn string) error {
+	if retryOn == "" {
+		return nil
+	}
+
+	for _, policy := range strings.Split(retryOn, ",") {
+		policy = strings.TrimSpace(policy)
+		if !retryOnPolicies.Contains(policy) && !retryOnStatusRegex.MatchString(policy) {
+			return fmt.Errorf("Invalid 'retryPolicy.retryOn' policy '%s'", policy)
+		}
+	}
+
+	return nil
+}
+
+// externalServiceValidator validates the ExternalService custom resource
+func (kc *policyValidator) externalServiceValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	externalService := &policyv1alpha1.ExternalService{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(externalService); err != nil {
+		return nil, err
+	}
+
+	if len(externalService.Spec.Hosts) == 0 {
+		return nil, fmt.Errorf("Expected 'hosts' to be specified")
+	}
+
+	for _, portSpec := range externalService.Spec.Ports {
+		if portSpec.Number < 1 || portSpec.Number > 65535 {
+			return nil, fmt.Errorf("Invalid 'ports.number' value %d, must be in the range 1-65535", portSpec.Number)
+		}
+		if portSpec.TargetPort < 0 || portSpec.TargetPort > 65535 {
+			return nil, fmt.Errorf("Invalid 'ports.targetPort' value %d for port %d, must be in the range 1-65535", portSpec.TargetPort, portSpec.Number)
+		}
+		switch portSpec.Protocol {
+		case constants.ProtocolHTTP, constants.ProtocolGRPC, constants.ProtocolTCP, constants.ProtocolTCPServerFirst:
+			// valid protocols
+
+		default:
+			return nil, fmt.Errorf("Invalid 'ports.protocol' value %s for port %d. Must be one of: %s, %s, %s, %s", portSpec.Protocol, portSpec.Number,
+				constants.ProtocolHTTP, constants.ProtocolGRPC, constants.ProtocolTCP, constants.ProtocolTCPServerFirst)
+		}
+	}
+
+	// An ExternalService is provided as the mesh service of the same namespace and name as a Kubernetes Service
+	svc := service.MeshService{Namespace: req.Namespace, Name: externalService.Name}
+	if kc.kubeController.GetService(svc) != nil {
+		return nil, fmt.Errorf("ExternalService %s conflicts with the Kubernetes Service of the same namespace and name", svc.NamespacedKey())
+	}
+
+	switch externalService.Spec.Resolution {
+	case "", policyv1alpha1.ExternalServiceResolutionDNS:
+		// no additional validation
+
+	case policyv1alpha1.ExternalServiceResolutionStatic:
+		if len(externalService.Spec.Endpoints) == 0 {
+			return nil, fmt.Errorf("Expected 'endpoints' to be specified for '%s' resolution", policyv1alpha1.ExternalServiceResolutionStatic)
+		}
+		for _, endpointSpec := range externalService.Spec.Endpoints {
+			if net.ParseIP(endpointSpec.Address) == nil {
+				return nil, fmt.Errorf("Expected 'endpoints.address' to be an IP address for '%s' resolution, got: %s", policyv1alpha1.ExternalServiceResolutionStatic, endpointSpec.Address)
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("Expected 'resolution' to be one of [%s %s], got: %s", policyv1alpha1.ExternalServiceResolutionDNS, policyv1alpha1.ExternalServiceResolutionStatic, externalService.Spec.Resolution)
+	}
+
 	return nil, nil
 }
 
@@ -365,6 +476,11 @@ func (kc *policyValidator) upstreamTrafficSettingValidator(req *admissionv1.Admi
 				rl.Local.HTTP.ResponseStatusCode)
 		}
 	}
+	if rl != nil && rl.Global != nil {
+		if err := validateGlobalRateLimit(rl.Global); err != nil {
+			return nil, err
+		}
+	}
 	for _, route := range upstreamTrafficSetting.Spec.HTTPRoutes {
 		if route.RateLimit != nil && route.RateLimit.Local != nil {
 			if _, ok := xds_type.StatusCode_name[int32(route.RateLimit.Local.ResponseStatusCode)]; !ok {
@@ -372,6 +488,404 @@ func (kc *policyValidator) upstreamTrafficSettingValidator(req *admissionv1.Admi
 					route.RateLimit.Local.ResponseStatusCode)
 			}
 		}
+		if route.RateLimit != nil && route.RateLimit.Global != nil {
+			// The rate limit service and domain for per route global rate limiting are inherited from the host level config
+			if rl == nil || rl.Global == nil || rl.Global.HTTP == nil {
+				return nil, fmt.Errorf("Global rate limiting for HTTP route %s requires rateLimit.global.http to be configured", route.Path)
+			}
+			if len(route.RateLimit.Global.Descriptors) == 0 {
+				return nil, fmt.Errorf("Global rate limiting for HTTP route %s requires at least one descriptor", route.Path)
+			}
+			if err := validateHTTPRateLimitDescriptors(route.RateLimit.Global.Descriptors); err != nil {
+				return nil, err
+			}
+		}
+		for _, modifier := range []*policyv1alpha1.HTTPHeaderModifierSpec{route.RequestHeaders, route.ResponseHeaders} {
+			if err := validateHTTPHeaderModifier(modifier); err != nil {
+				return nil, fmt.Errorf("Invalid header modification for HTTP route %s: %w", route.Path, err)
+			}
+		}
+		if err := validateHTTPRewrite(route.Rewrite); err != nil {
+			return nil, fmt.Errorf("Invalid rewrite for HTTP route %s: %w", route.Path, err)
+		}
+	}
+
+	if cs := upstreamTrafficSetting.Spec.ConnectionSettings; cs != nil && cs.OutlierDetection != nil {
+		if p := cs.OutlierDetection.MaxEjectionPercent; p != nil && *p > 100 {
+			return nil, fmt.Errorf("Invalid 'connectionSettings.outlierDetection.maxEjectionPercent' value %d, must be in the range 0-100", *p)
+		}
+	}
+
+	if lb := upstreamTrafficSetting.Spec.LoadBalancer; lb != nil {
+		if err := validateLoadBalancer(lb); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// validateLoadBalancer validates the load balancer settings of an UpstreamTrafficSetting
+func validateLoadBalancer(lb *policyv1alpha1.LoadBalancerSpec) error {
+	switch lb.Type {
+	case "", policyv1alpha1.RoundRobinLoadBalancer, policyv1alpha1.LeastRequestLoadBalancer,
+		policyv1alpha1.RingHashLoadBalancer, policyv1alpha1.MaglevLoadBalancer, policyv1alpha1.RandomLoadBalancer:
+		// valid load balancer types
+
+	default:
+		return fmt.Errorf("Invalid 'loadBalancer.type' value specified. Must be one of: %s, %s, %s, %s, %s",
+			policyv1alpha1.RoundRobinLoadBalancer, policyv1alpha1.LeastRequestLoadBalancer,
+			policyv1alpha1.RingHashLoadBalancer, policyv1alpha1.MaglevLoadBalancer, policyv1alpha1.RandomLoadBalancer)
+	}
+
+	isHashType := lb.Type == policyv1alpha1.RingHashLoadBalancer || lb.Type == policyv1alpha1.MaglevLoadBalancer
+	if lb.ConsistentHash != nil {
+		if !isHashType {
+			return fmt.Errorf("'loadBalancer.consistentHash' requires 'loadBalancer.type' to be %s or %s, got: %s",
+				policyv1alpha1.RingHashLoadBalancer, policyv1alpha1.MaglevLoadBalancer, lb.Type)
+		}
+		keys := 0
+		if lb.ConsistentHash.Header != "" {
+			keys++
+		}
+		if lb.ConsistentHash.Cookie != "" {
+			keys++
+		}
+		if lb.ConsistentHash.SourceIP {
+			keys++
+		}
+		if keys != 1 {
+			return fmt.Errorf("Exactly one of 'header', 'cookie' or 'sourceIP' must be specified in 'loadBalancer.consistentHash'")
+		}
+	}
+
+	if lb.SessionAffinity != nil {
+		if lb.Type != "" && !isHashType {
+			return fmt.Errorf("'loadBalancer.sessionAffinity' requires 'loadBalancer.type' to be %s or %s, got: %s",
+				policyv1alpha1.RingHashLoadBalancer, policyv1alpha1.MaglevLoadBalancer, lb.Type)
+		}
+		if lb.ConsistentHash != nil {
+			return fmt.Errorf("'loadBalancer.sessionAffinity' and 'loadBalancer.consistentHash' cannot both be specified")
+		}
+	}
+
+	return nil
+}
+
+// validateGlobalRateLimit validates the global rate limiting config of an UpstreamTrafficSetting
+func validateGlobalRateLimit(global *policyv1alpha1.GlobalRateLimitSpec) error {
+	if global.TCP != nil {
+		if err := validateRateLimitService(global.TCP.RateLimitService, global.TCP.Domain); err != nil {
+			return err
+		}
+		if len(global.TCP.Descriptors) == 0 {
+			return fmt.Errorf("Global TCP rate limiting requires at least one descriptor")
+		}
+		for _, descriptor := range global.TCP.Descriptors {
+			if len(descriptor.Entries) == 0 {
+				return fmt.Errorf("Global rate limiting descriptors must have at least one entry")
+			}
+		}
+	}
+	if global.HTTP != nil {
+		if err := validateRateLimitService(global.HTTP.RateLimitService, global.HTTP.Domain); err != nil {
+			return err
+		}
+		if err := validateHTTPRateLimitDescriptors(global.HTTP.Descriptors); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateRateLimitService validates the rate limit service and domain used for global rate limiting
+func validateRateLimitService(rls policyv1alpha1.RateLimitServiceSpec, domain string) error {
+	if rls.Host == "" || rls.Port == 0 {
+		return fmt.Errorf("Global rate limiting requires the host and port of the rate limit service")
+	}
+	if domain == "" {
+		return fmt.Errorf("Global rate limiting requires a domain")
+	}
+	return nil
+}
+
+// validateHTTPRateLimitDescriptors validates the descriptors used for global HTTP rate limiting.
+// The host level descriptors are optional, the requests being rate limited by the descriptors of their route.
+func validateHTTPRateLimitDescriptors(descriptors []policyv1alpha1.HTTPRateLimitDescriptor) error {
+	for _, descriptor := range descriptors {
+		if len(descriptor.Entries) == 0 {
+			return fmt.Errorf("Global rate limiting descriptors must have at least one entry")
+		}
+		for _, entry := range descriptor.Entries {
+			set := 0
+			if entry.GenericKey != nil {
+				set++
+			}
+			if entry.RequestHeader != nil {
+				set++
+			}
+			if entry.RequestPath != nil {
+				set++
+			}
+			if entry.SourceIdentity != nil {
+				set++
+			}
+			if set != 1 {
+				return fmt.Errorf("Global rate limiting descriptor entries must set exactly one of genericKey, requestHeader, requestPath or sourceIdentity")
+			}
+		}
+	}
+	return nil
+}
+
+// validateHTTPHeaderModifier validates the names of the headers modified by the given modifier.
+// Pseudo-headers and the Host header cannot be modified.
+func validateHTTPHeaderModifier(modifier *policyv1alpha1.HTTPHeaderModifierSpec) error {
+	if modifier == nil {
+		return nil
+	}
+	names := modifier.Remove
+	for _, header := range append(modifier.Set, modifier.Append...) {
+		names = append(names, header.Name)
+	}
+	for _, name := range names {
+		if name == "" || strings.HasPrefix(name, ":") || strings.EqualFold(name, "host") {
+			return fmt.Errorf("header %q cannot be modified", name)
+		}
+	}
+	return nil
+}
+
+func validateHTTPRewrite(rewrite *policyv1alpha1.HTTPRewriteSpec) error {
+	if rewrite == nil {
+		return nil
+	}
+	if rewrite.Prefix != nil && rewrite.Regex != nil {
+		return fmt.Errorf("only one of prefix and regex can be specified")
+	}
+	if rewrite.Prefix != nil && !strings.HasPrefix(rewrite.Prefix.Match, "/") {
+		return fmt.Errorf("prefix %q must start with '/'", rewrite.Prefix.Match)
+	}
+	if rewrite.Regex != nil {
+		if _, err := regexp.Compile(rewrite.Regex.Pattern); err != nil {
+			return fmt.Errorf("invalid regex %q: %w", rewrite.Regex.Pattern, err)
+		}
+	}
+	return nil
+}
+
+// faultInjectionValidator validates the FaultInjection custom resource
+func faultInjectionValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	faultInjection := &policyv1alpha1.FaultInjection{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(faultInjection); err != nil {
+		return nil, err
+	}
+
+	for _, source := range faultInjection.Spec.Sources {
+		if source.Kind != "ServiceAccount" {
+			return nil, fmt.Errorf("Expected 'sources.kind' for source '%s' to be 'ServiceAccount', got: %s", source.Name, source.Kind)
+		}
+	}
+
+	if faultInjection.Spec.Destination.Kind != policyv1alpha1.KindService {
+		return nil, fmt.Errorf("Expected 'destination.kind' to be '%s', got: %s", policyv1alpha1.KindService, faultInjection.Spec.Destination.Kind)
+	}
+
+	if faultInjection.Spec.Delay == nil && faultInjection.Spec.Abort == nil {
+		return nil, fmt.Errorf("At least one of 'delay' or 'abort' must be specified")
+	}
+
+	for _, match := range faultInjection.Spec.Matches {
+		switch match.PathMatchType {
+		case "", policyv1alpha1.PathMatchTypeRegex, policyv1alpha1.PathMatchTypePrefix, policyv1alpha1.PathMatchTypeExact:
+			// valid path match types
+
+		default:
+			return nil, fmt.Errorf("Invalid 'matches.pathMatchType' value specified. Must be one of: %s, %s, %s",
+				policyv1alpha1.PathMatchTypeRegex, policyv1alpha1.PathMatchTypePrefix, policyv1alpha1.PathMatchTypeExact)
+		}
+	}
+
+	if delay := faultInjection.Spec.Delay; delay != nil && delay.Percentage > 100 {
+		return nil, fmt.Errorf("Invalid 'delay.percentage' value %d, must be in the range 0-100", delay.Percentage)
+	}
+
+	if abort := faultInjection.Spec.Abort; abort != nil {
+		if abort.Percentage > 100 {
+			return nil, fmt.Errorf("Invalid 'abort.percentage' value %d, must be in the range 0-100", abort.Percentage)
+		}
+		if abort.HTTPStatus < 200 || abort.HTTPStatus > 599 {
+			return nil, fmt.Errorf("Invalid 'abort.httpStatus' value %d, must be in the range 200-599", abort.HTTPStatus)
+		}
+	}
+
+	return nil, nil
+}
+
+// trafficMirrorValidator validates the TrafficMirror custom resource
+func trafficMirrorValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	trafficMirror := &policyv1alpha1.TrafficMirror{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(trafficMirror); err != nil {
+		return nil, err
+	}
+
+	for _, source := range trafficMirror.Spec.Sources {
+		if source.Kind != "ServiceAccount" {
+			return nil, fmt.Errorf("Expected 'sources.kind' for source '%s' to be 'ServiceAccount', got: %s", source.Name, source.Kind)
+		}
+	}
+
+	if trafficMirror.Spec.Destination.Kind != policyv1alpha1.KindService {
+		return nil, fmt.Errorf("Expected 'destination.kind' to be '%s', got: %s", policyv1alpha1.KindService, trafficMirror.Spec.Destination.Kind)
+	}
+
+	if len(trafficMirror.Spec.Backends) == 0 {
+		return nil, fmt.Errorf("At least one backend must be specified")
+	}
+
+	for _, backend := range trafficMirror.Spec.Backends {
+		if backend.Service == trafficMirror.Spec.Destination.Name {
+			return nil, fmt.Errorf("Backend '%s' cannot be the destination service itself", backend.Service)
+		}
+		if backend.Percentage > 100 {
+			return nil, fmt.Errorf("Invalid 'percentage' value %d for backend '%s', must be in the range 0-100", backend.Percentage, backend.Service)
+		}
+	}
+
+	return nil, nil
+}
+
+// requestAuthenticationValidator validates the RequestAuthentication custom resource
+func (kc *policyValidator) requestAuthenticationValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	requestAuthentication := &policyv1alpha1.RequestAuthentication{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(requestAuthentication); err != nil {
+		return nil, err
+	}
+
+	if requestAuthentication.Spec.Destination.Kind != policyv1alpha1.KindService {
+		return nil, fmt.Errorf("Expected 'destination.kind' to be '%s', got: %s", policyv1alpha1.KindService, requestAuthentication.Spec.Destination.Kind)
+	}
+
+	ns := requestAuthentication.Namespace
+	destination := service.MeshService{Name: requestAuthentication.Spec.Destination.Name, Namespace: ns}
+	if matching := kc.policyClient.GetRequestAuthenticationPolicy(destination); matching != nil && matching.Name != requestAuthentication.Name {
+		// duplicate detected
+		return nil, fmt.Errorf("RequestAuthentication %s/%s conflicts with %s/%s since they have the same destination %s", ns, requestAuthentication.Name, ns, matching.Name, destination.Name)
+	}
+
+	if len(requestAuthentication.Spec.JWTRules) == 0 {
+		return nil, fmt.Errorf("At least one JWT rule must be specified")
+	}
+
+	for _, rule := range requestAuthentication.Spec.JWTRules {
+		if rule.Issuer == "" {
+			return nil, fmt.Errorf("'issuer' must be specified for each JWT rule")
+		}
+		if err := validateJWKS(rule.JWKS); err != nil {
+			return nil, fmt.Errorf("Invalid 'jwks' for issuer '%s': %w", rule.Issuer, err)
+		}
+		for _, claimToHeader := range rule.OutputClaimToHeaders {
+			if claimToHeader.Claim == "" || claimToHeader.Header == "" {
+				return nil, fmt.Errorf("'claim' and 'header' must be specified for each output claim of issuer '%s'", rule.Issuer)
+			}
+		}
+	}
+
+	for _, rule := range requestAuthentication.Spec.Rules {
+		if len(rule.Claims) == 0 {
+			return nil, fmt.Errorf("At least one claim must be specified for each rule")
+		}
+		for _, claim := range rule.Claims {
+			if claim.Name == "" || len(claim.Values) == 0 {
+				return nil, fmt.Errorf("'name' and 'values' must be specified for each claim")
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// validateJWKS validates that exactly one source of the JSON Web Key Set is specified
+func validateJWKS(jwks policyv1alpha1.JWKSSpec) error {
+	if (jwks.SecretRef == nil) == (jwks.URI == "") {
+		return fmt.Errorf("exactly one of 'secretRef' or 'uri' must be specified")
+	}
+	if jwks.SecretRef != nil {
+		if jwks.SecretRef.Name == "" {
+			return fmt.Errorf("'secretRef.name' must be specified")
+		}
+		return nil
+	}
+
+	u, err := url.Parse(jwks.URI)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("'uri' must be an absolute http or https URI, got: %s", jwks.URI)
+	}
+	return nil
+}
+
+// authorizationPolicyValidator validates the AuthorizationPolicy custom resource
+func authorizationPolicyValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	authorizationPolicy := &policyv1alpha1.AuthorizationPolicy{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(authorizationPolicy); err != nil {
+		return nil, err
+	}
+
+	if destination := authorizationPolicy.Spec.Destination; destination != nil {
+		if destination.Kind != "ServiceAccount" {
+			return nil, fmt.Errorf("Expected 'destination.kind' to be 'ServiceAccount', got: %s", destination.Kind)
+		}
+		if destination.Name == "" {
+			return nil, fmt.Errorf("'destination.name' must be specified")
+		}
+	}
+
+	switch authorizationPolicy.Spec.Action {
+	case "", policyv1alpha1.AuthorizationPolicyActionAllow, policyv1alpha1.AuthorizationPolicyActionDeny, policyv1alpha1.AuthorizationPolicyActionAudit:
+	default:
+		return nil, fmt.Errorf("Invalid 'action' %s, must be one of %s, %s or %s", authorizationPolicy.Spec.Action,
+			policyv1alpha1.AuthorizationPolicyActionAllow, policyv1alpha1.AuthorizationPolicyActionDeny, policyv1alpha1.AuthorizationPolicyActionAudit)
+	}
+
+	for _, rule := range authorizationPolicy.Spec.Rules {
+		for _, source := range rule.From {
+			for _, sa := range source.ServiceAccounts {
+				if sa.Name == "" {
+					return nil, fmt.Errorf("'name' must be specified for each service account")
+				}
+			}
+			for _, ipBlock := range source.IPBlocks {
+				if net.ParseIP(ipBlock) != nil {
+					continue
+				}
+				if _, _, err := net.ParseCIDR(ipBlock); err != nil {
+					return nil, fmt.Errorf("Invalid IP block %s, must be an IP address or a CIDR range", ipBlock)
+				}
+			}
+		}
+		for _, operation := range rule.To {
+			for _, port := range operation.Ports {
+				if port == 0 {
+					return nil, fmt.Errorf("Invalid port 0, ports must be in the range 1-65535")
+				}
+			}
+			if len(operation.Paths) > 0 && len(operation.GRPCMethods) > 0 {
+				return nil, fmt.Errorf("'paths' and 'grpcMethods' are mutually exclusive")
+			}
+			for _, grpcMethod := range operation.GRPCMethods {
+				if grpcMethod.Service == "" {
+					return nil, fmt.Errorf("'service' must be specified for each gRPC method")
+				}
+			}
+			for _, header := range operation.Headers {
+				if header.Name == "" {
+					return nil, fmt.Errorf("'name' must be specified for each header")
+				}
+			}
+		}
 	}
 
 	return nil, nil
@@ -444,3 +958,72 @@ func (kc *policyValidator) pluginChainValidator(req *admissionv1.AdmissionReques
 
 	return nil, nil
 }
+
+// meshConfigValidator validates the MeshConfig custom resource
+func meshConfigValidator(req *admissionv1.AdmissionRequest) (*admissionv1.AdmissionResponse, error) {
+	meshConfig := &configv1alpha2.MeshConfig{}
+	if err := json.NewDecoder(bytes.NewBuffer(req.Object.Raw)).Decode(meshConfig); err != nil {
+		return nil, err
+	}
+
+	if err := validateUDPInterception(meshConfig.Spec.Traffic.UDPInterception); err != nil {
+		return nil, err
+	}
+
+	if err := validateTelemetryProtocols(meshConfig); err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// validateTelemetryProtocols validates the protocols the telemetry is exported with are supported by the sidecars,
+// Envoy exporting over OTLP/gRPC only and Pipy over OTLP/HTTP only
+func validateTelemetryProtocols(meshConfig *configv1alpha2.MeshConfig) error {
+	sidecarClass := configurator.GetSidecarClassForMeshConfig(meshConfig)
+
+	var unsupportedProtocol configv1alpha2.TelemetryProtocol
+	switch sidecarClass {
+	case constants.SidecarClassEnvoy:
+		unsupportedProtocol = configv1alpha2.TelemetryProtocolOTLPHTTP
+	case constants.SidecarClassPipy:
+		unsupportedProtocol = configv1alpha2.TelemetryProtocolOTLPGRPC
+	default:
+		return nil
+	}
+
+	observability := meshConfig.Spec.Observability
+	if observability.Tracing.Enable && observability.Tracing.Protocol == unsupportedProtocol {
+		return fmt.Errorf("Invalid 'observability.tracing.protocol' value %s, not supported by the %s sidecar", unsupportedProtocol, sidecarClass)
+	}
+	if observability.RemoteLogging.Enable && observability.RemoteLogging.Protocol == unsupportedProtocol {
+		return fmt.Errorf("Invalid 'observability.remoteLogging.protocol' value %s, not supported by the %s sidecar", unsupportedProtocol, sidecarClass)
+	}
+
+	return nil
+}
+
+// validateUDPInterception validates the UDP port policies, whose ports must be unique
+func validateUDPInterception(udpInterception configv1alpha2.UDPInterceptionSpec) error {
+	ports := mapset.NewSet()
+	for _, portPolicy := range udpInterception.PortPolicies {
+		if portPolicy.Port < 1 || portPolicy.Port > 65535 {
+			return fmt.Errorf("Invalid 'udpInterception.portPolicies.port' value %d, must be in the range 1-65535", portPolicy.Port)
+		}
+
+		switch portPolicy.Action {
+		case configv1alpha2.UDPPortActionAllow, configv1alpha2.UDPPortActionDeny:
+			// valid actions
+
+		default:
+			return fmt.Errorf("Invalid 'udpInterception.portPolicies.action' value %s for port %d. Must be one of: %s, %s",
+				portPolicy.Action, portPolicy.Port, configv1alpha2.UDPPortActionAllow, configv1alpha2.UDPPortActionDeny)
+		}
+
+		if !ports.Add(portPolicy.Port) {
+			return fmt.Errorf("Duplicate 'udpInterception.portPolicies.port' value %d", portPolicy.Port)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/golang/mock/gomock"
 	tassert "github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -1713,50 +1714,28 @@ func TestEgressValidator(t *testing.T) {
 			expResp:   nil,
 			expErrStr: "Cannot have more than 1 UpstreamTrafficSetting match",
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			assert := tassert.New(t)
-
-			resp, err := egressValidator(tc.input)
-			assert.Equal(tc.expResp, resp)
-			if err != nil {
-				assert.Equal(tc.expErrStr, err.Error())
-			}
-		})
-	}
-}
-
-func TestTrafficTargetValidator(t *testing.T) {
-	testCases := []struct {
-		name      string
-		input     *admissionv1.AdmissionRequest
-		expResp   *admissionv1.AdmissionResponse
-		expErrStr string
-	}{
 		{
-			name: "TrafficTarget namespace matches destination namespace",
+			name: "Egress with TLS origination on http port passes",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
-					Group:   "v1alpha3",
-					Version: "access.smi-spec.io",
-					Kind:    "TrafficTarget",
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Egress",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "v1alpha3",
-						"kind": "TrafficTarget",
-						"metadata": {
-							"namespace": "destination-namespace"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "Egress",
 						"spec": {
-							"destination": {
-								"kind": "ServiceAccount",
-								"name": "destination-name",
-								"namespace": "destination-namespace"
-							}
+							"hosts": ["foo.com"],
+							"ports": [
+								{
+								"number": 80,
+								"protocol": "http",
+								"tls": {"targetPort": 443, "minProtocolVersion": "TLSv1_2"}
+								}
+							]
 						}
 					}
 					`),
@@ -1766,34 +1745,64 @@ func TestTrafficTargetValidator(t *testing.T) {
 			expErrStr: "",
 		},
 		{
-			name: "Traffic Target namespace does not match destination namespace",
+			name: "Egress with TLS origination on https port fails",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
-					Group:   "v1alpha3",
-					Version: "access.smi-spec.io",
-					Kind:    "TrafficTarget",
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Egress",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "v1alpha3",
-						"kind": "TrafficTarget",
-						"metadata": {
-							"namespace": "another-namespace"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "Egress",
 						"spec": {
-							"destination": {
-								"kind": "ServiceAccount",
-								"name": "destination-name",
-								"namespace": "destination-namespace"
-							}
+							"hosts": ["foo.com"],
+							"ports": [
+								{
+								"number": 443,
+								"protocol": "https",
+								"tls": {"sni": "foo.com"}
+								}
+							]
 						}
 					}
 					`),
 				},
 			},
 			expResp:   nil,
-			expErrStr: "The traffic target namespace (another-namespace) must match spec.Destination.Namespace (destination-namespace)",
+			expErrStr: "Expected 'ports.protocol' for port 443 with TLS origination to be 'http', got: https",
+		},
+		{
+			name: "Egress with TLS origination without hosts fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Egress",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Egress",
+						"spec": {
+							"ipAddresses": ["1.1.1.1/32"],
+							"ports": [
+								{
+								"number": 80,
+								"protocol": "http",
+								"tls": {"targetPort": 443}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'hosts' to be specified for port 80 with TLS origination",
 		},
 	}
 
@@ -1801,7 +1810,7 @@ func TestTrafficTargetValidator(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			assert := tassert.New(t)
 
-			resp, err := trafficTargetValidator(tc.input)
+			resp, err := egressValidator(tc.input)
 			assert.Equal(tc.expResp, resp)
 			if err != nil {
 				assert.Equal(tc.expErrStr, err.Error())
@@ -1810,252 +1819,2398 @@ func TestTrafficTargetValidator(t *testing.T) {
 	}
 }
 
-func TestUpstreamTrafficSettingValidator(t *testing.T) {
+func TestExternalServiceValidator(t *testing.T) {
 	testCases := []struct {
-		name                            string
-		input                           *admissionv1.AdmissionRequest
-		expResp                         *admissionv1.AdmissionResponse
-		expErrStr                       string
-		existingUpstreamTrafficSettings []*policyv1alpha1.UpstreamTrafficSetting
+		name            string
+		input           *admissionv1.AdmissionRequest
+		existingService bool
+		expResp         *admissionv1.AdmissionResponse
+		expErrStr       string
 	}{
 		{
-			name: "UpstreamTrafficSetting with unique host",
+			name: "ExternalService with DNS resolution passes",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
 						"spec": {
-							"host": "httpbin.test.svc.cluster.local"
+							"hosts": ["httpbin.org"],
+							"ports": [{"number": 80, "protocol": "http"}]
 						}
 					}
 					`),
 				},
 			},
-			expResp:   nil,
-			expErrStr: "",
+			expResp: nil,
 		},
 		{
-			name: "UpstreamTrafficSetting with duplicate host",
+			name: "ExternalService without hosts fails",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
 						"spec": {
-							"host": "httpbin.test.svc.cluster.local"
+							"ports": [{"number": 80, "protocol": "http"}]
 						}
 					}
 					`),
 				},
 			},
-			existingUpstreamTrafficSettings: []*policyv1alpha1.UpstreamTrafficSetting{
-				{
-					TypeMeta: metav1.TypeMeta{
-						Kind:       "UpstreamTrafficSetting",
-						APIVersion: "policy.openservicemesh.io/v1alpha1",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "httpbin1",
-						Namespace: "test",
-					},
-					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
-						Host: "httpbin.test.svc.cluster.local",
-					},
-				},
-			},
 			expResp:   nil,
-			expErrStr: "UpstreamTrafficSetting test/httpbin conflicts with test/httpbin1 since they have the same host httpbin.test.svc.cluster.local",
+			expErrStr: "Expected 'hosts' to be specified",
 		},
 		{
-			name: "success: UpstreamTrafficSetting with duplicate host on update",
+			name: "ExternalService with Static resolution and IP endpoints passes",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
 						"spec": {
-							"host": "httpbin.test.svc.cluster.local"
+							"hosts": ["db.example.com"],
+							"resolution": "Static",
+							"ports": [{"number": 5432, "protocol": "tcp"}],
+							"endpoints": [{"address": "10.0.0.1"}, {"address": "10.0.0.2", "weight": 2}]
 						}
 					}
 					`),
 				},
 			},
-			existingUpstreamTrafficSettings: []*policyv1alpha1.UpstreamTrafficSetting{
-				{
-					TypeMeta: metav1.TypeMeta{
-						Kind:       "UpstreamTrafficSetting",
-						APIVersion: "policy.openservicemesh.io/v1alpha1",
-					},
-					ObjectMeta: metav1.ObjectMeta{
-						Name:      "httpbin",
-						Namespace: "test",
-					},
-					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
-						Host:               "httpbin.test.svc.cluster.local",
-						ConnectionSettings: nil,
-					},
+			expResp: nil,
+		},
+		{
+			name: "ExternalService with Static resolution and no endpoints fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "ExternalService",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
+						"spec": {
+							"hosts": ["db.example.com"],
+							"resolution": "Static",
+							"ports": [{"number": 5432, "protocol": "tcp"}]
+						}
+					}
+					`),
 				},
 			},
 			expResp:   nil,
-			expErrStr: "",
+			expErrStr: "Expected 'endpoints' to be specified for 'Static' resolution",
 		},
 		{
-			name: "UpstreamTrafficSetting with valid rate limiting config",
+			name: "ExternalService with Static resolution and hostname endpoints fails",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
 						"spec": {
-							"host": "httpbin.test.svc.cluster.local",
-							"rateLimit": {
-								"local": {
-									"http": {
-										"responseStatusCode": 429
-									}
-								}
-							},
-							"httpRoutes": [
-								{
-								"rateLimit": {
-									"local": {
-										"responseStatusCode": 503
-									}
-								}
-								}
-							]
+							"hosts": ["db.example.com"],
+							"resolution": "Static",
+							"ports": [{"number": 5432, "protocol": "tcp"}],
+							"endpoints": [{"address": "db-1.example.com"}]
 						}
 					}
 					`),
 				},
 			},
 			expResp:   nil,
-			expErrStr: "",
+			expErrStr: "Expected 'endpoints.address' to be an IP address for 'Static' resolution, got: db-1.example.com",
 		},
 		{
-			name: "UpstreamTrafficSetting with invalid vhost rate limiting HTTP status code",
+			name: "ExternalService with invalid resolution fails",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
 						"spec": {
-							"host": "httpbin.test.svc.cluster.local",
-							"rateLimit": {
-								"local": {
-									"http": {
-										"responseStatusCode": 1
-									}
-								}
-							}
+							"hosts": ["db.example.com"],
+							"resolution": "Invalid",
+							"ports": [{"number": 5432, "protocol": "tcp"}]
 						}
 					}
 					`),
 				},
 			},
 			expResp:   nil,
-			expErrStr: "Invalid responseStatusCode 1. See https://www.envoyproxy.io/docs/envoy/latest/api-v3/type/v3/http_status.proto#enum-type-v3-statuscode for allowed values",
+			expErrStr: "Expected 'resolution' to be one of [DNS Static], got: Invalid",
 		},
 		{
-			name: "UpstreamTrafficSetting with invalid HTTP route rate limiting status code",
+			name: "ExternalService with an out of range port fails",
 			input: &admissionv1.AdmissionRequest{
 				Kind: metav1.GroupVersionKind{
 					Group:   "v1alpha1",
 					Version: "policy.openservicemesh.io",
-					Kind:    "UpstreamTrafficSetting",
+					Kind:    "ExternalService",
 				},
+				Namespace: "test",
 				Object: runtime.RawExtension{
 					Raw: []byte(`
 					{
-						"apiVersion": "policy.openservicemesh.io/v1alpha1",
-						"kind": "UpstreamTrafficSetting",
-						"metadata": {
-							"name": "httpbin",
-							"namespace": "test"
-						},
-						"spec": {
-							"host": "httpbin.test.svc.cluster.local",
-							"rateLimit": {
-								"local": {
-									"http": {
-										"responseStatusCode": 429
-									}
-								}
-							},
-							"httpRoutes": [
-								{
-								"rateLimit": {
-									"local": {
-										"responseStatusCode": 1
-									}
-								}
-								}
-							]
-						}
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
+						"metadata": {"name": "httpbin", "namespace": "test"},
+						"spec": {"hosts": ["httpbin.org"], "ports": [{"number": 65536, "protocol": "http"}]}
 					}
 					`),
 				},
 			},
 			expResp:   nil,
-			expErrStr: "Invalid responseStatusCode 1. See https://www.envoyproxy.io/docs/envoy/latest/api-v3/type/v3/http_status.proto#enum-type-v3-statuscode for allowed values",
+			expErrStr: "Invalid 'ports.number' value 65536, must be in the range 1-65535",
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			assert := tassert.New(t)
-			mockCtrl := gomock.NewController(t)
+		{
+			name: "ExternalService with an out of range target port fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "ExternalService",
+				},
+				Namespace: "test",
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
+						"metadata": {"name": "httpbin", "namespace": "test"},
+						"spec": {"hosts": ["httpbin.org"], "ports": [{"number": 80, "targetPort": 70000, "protocol": "http"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'ports.targetPort' value 70000 for port 80, must be in the range 1-65535",
+		},
+		{
+			name: "ExternalService with an unknown protocol fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "ExternalService",
+				},
+				Namespace: "test",
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
+						"metadata": {"name": "httpbin", "namespace": "test"},
+						"spec": {"hosts": ["httpbin.org"], "ports": [{"number": 80, "protocol": "udp"}]}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'ports.protocol' value udp for port 80. Must be one of: http, grpc, tcp, tcp-server-first",
+		},
+		{
+			name: "ExternalService named after a Kubernetes Service fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "ExternalService",
+				},
+				Namespace: "test",
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "ExternalService",
+						"metadata": {"name": "httpbin", "namespace": "test"},
+						"spec": {"hosts": ["httpbin.org"], "ports": [{"number": 80, "protocol": "http"}]}
+					}
+					`),
+				},
+			},
+			existingService: true,
+			expResp:         nil,
+			expErrStr:       "ExternalService test/httpbin conflicts with the Kubernetes Service of the same namespace and name",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+
+			k8sController := k8s.NewMockController(mockCtrl)
+			var existingService *corev1.Service
+			if tc.existingService {
+				existingService = &corev1.Service{}
+			}
+			k8sController.EXPECT().GetService(gomock.Any()).Return(existingService).AnyTimes()
+
+			pv := &policyValidator{
+				kubeController: k8sController,
+			}
+
+			resp, err := pv.externalServiceValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr != "" {
+				assert.EqualError(err, tc.expErrStr)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestRetryValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "Retry on HTTP policies and status codes passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {
+							"source": {"kind": "ServiceAccount", "name": "client", "namespace": "client"},
+							"destinations": [{"kind": "Service", "name": "server", "namespace": "server"}],
+							"retryPolicy": {"retryOn": "5xx, 4xx, 503,reset,connect-failure"}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Retry on gRPC statuses passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {
+							"source": {"kind": "ServiceAccount", "name": "client", "namespace": "client"},
+							"destinations": [{"kind": "Service", "name": "server", "namespace": "server"}],
+							"retryPolicy": {"retryOn": "cancelled,deadline-exceeded,internal,resource-exhausted,unavailable"}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Retry on an unknown policy fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {
+							"source": {"kind": "ServiceAccount", "name": "client", "namespace": "client"},
+							"destinations": [{"kind": "Service", "name": "server", "namespace": "server"}],
+							"retryPolicy": {"retryOn": "5xx,not-found"}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.retryOn' policy 'not-found'",
+		},
+		{
+			name: "Retry on an invalid status code fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {
+							"source": {"kind": "ServiceAccount", "name": "client", "namespace": "client"},
+							"destinations": [{"kind": "Service", "name": "server", "namespace": "server"}],
+							"retryPolicy": {"retryOn": "5xx,600"}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.retryOn' policy '600'",
+		},
+		{
+			name: "Retry on an empty policy fails",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "Retry",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "Retry",
+						"spec": {
+							"source": {"kind": "ServiceAccount", "name": "client", "namespace": "client"},
+							"destinations": [{"kind": "Service", "name": "server", "namespace": "server"}],
+							"retryPolicy": {"retryOn": "5xx,,reset"}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'retryPolicy.retryOn' policy ''",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := retryValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if tc.expErrStr != "" {
+				assert.EqualError(err, tc.expErrStr)
+			} else {
+				assert.NoError(err)
+			}
+		})
+	}
+}
+
+func TestFaultInjectionValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "Valid fault injection with delay and abort passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"sources": [
+								{
+								"kind": "ServiceAccount",
+								"name": "bookbuyer",
+								"namespace": "bookbuyer"
+								}
+							],
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"matches": [
+								{
+								"path": "/books",
+								"pathMatchType": "Prefix",
+								"methods": ["GET"]
+								}
+							],
+							"delay": {
+								"percentage": 50,
+								"fixedDelay": "2s"
+							},
+							"abort": {
+								"percentage": 10,
+								"httpStatus": 503
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Source with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"sources": [
+								{
+								"kind": "Service",
+								"name": "bookbuyer"
+								}
+							],
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"abort": {
+								"percentage": 10,
+								"httpStatus": 503
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'sources.kind' for source 'bookbuyer' to be 'ServiceAccount', got: Service",
+		},
+		{
+			name: "Destination with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "bookstore"
+							},
+							"abort": {
+								"percentage": 10,
+								"httpStatus": 503
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'destination.kind' to be 'Service', got: ServiceAccount",
+		},
+		{
+			name: "Fault injection without delay or abort errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "At least one of 'delay' or 'abort' must be specified",
+		},
+		{
+			name: "Match with invalid path match type errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"matches": [
+								{
+								"path": "/books",
+								"pathMatchType": "Suffix"
+								}
+							],
+							"abort": {
+								"percentage": 10,
+								"httpStatus": 503
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'matches.pathMatchType' value specified. Must be one of: Regex, Prefix, Exact",
+		},
+		{
+			name: "Delay with invalid percentage errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"delay": {
+								"percentage": 150,
+								"fixedDelay": "1s"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'delay.percentage' value 150, must be in the range 0-100",
+		},
+		{
+			name: "Abort with invalid HTTP status errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "FaultInjection",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "FaultInjection",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"abort": {
+								"percentage": 10,
+								"httpStatus": 99
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'abort.httpStatus' value 99, must be in the range 200-599",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := faultInjectionValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			} else {
+				assert.Empty(tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestTrafficMirrorValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "Valid traffic mirror passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"sources": [
+								{
+								"kind": "ServiceAccount",
+								"name": "bookbuyer",
+								"namespace": "bookbuyer"
+								}
+							],
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"backends": [
+								{
+								"service": "bookstore-v2",
+								"percentage": 20
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Source with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"sources": [
+								{
+								"kind": "Service",
+								"name": "bookbuyer",
+								"namespace": "bookbuyer"
+								}
+							],
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"backends": [
+								{
+								"service": "bookstore-v2",
+								"percentage": 20
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'sources.kind' for source 'bookbuyer' to be 'ServiceAccount', got: Service",
+		},
+		{
+			name: "Destination with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "bookstore"
+							},
+							"backends": [
+								{
+								"service": "bookstore-v2",
+								"percentage": 20
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'destination.kind' to be 'Service', got: ServiceAccount",
+		},
+		{
+			name: "Missing backends errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"backends": []
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "At least one backend must be specified",
+		},
+		{
+			name: "Backend that is the destination errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"backends": [
+								{
+								"service": "bookstore",
+								"percentage": 20
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Backend 'bookstore' cannot be the destination service itself",
+		},
+		{
+			name: "Percentage out of range errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "TrafficMirror",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "TrafficMirror",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"backends": [
+								{
+								"service": "bookstore-v2",
+								"percentage": 101
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'percentage' value 101 for backend 'bookstore-v2', must be in the range 0-100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := trafficMirrorValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			} else {
+				assert.Empty(tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestRequestAuthenticationValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+		existing  *policyv1alpha1.RequestAuthentication
+	}{
+		{
+			name: "Valid request authentication passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"audiences": ["bookstore"],
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								},
+								"outputClaimToHeaders": [
+									{
+									"claim": "sub",
+									"header": "x-jwt-sub"
+									}
+								]
+								},
+								{
+								"issuer": "https://local.example.com",
+								"jwks": {
+									"secretRef": {
+										"name": "jwks"
+									}
+								}
+								}
+							],
+							"rules": [
+								{
+								"claims": [
+									{
+									"name": "groups",
+									"values": ["admin"]
+									}
+								]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Destination with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'destination.kind' to be 'Service', got: ServiceAccount",
+		},
+		{
+			name: "No JWT rules errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": []
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "At least one JWT rule must be specified",
+		},
+		{
+			name: "JWT rule without issuer errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'issuer' must be specified for each JWT rule",
+		},
+		{
+			name: "JWT rule with both secretRef and uri errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"secretRef": {
+										"name": "jwks"
+									},
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'jwks' for issuer 'https://issuer.example.com': exactly one of 'secretRef' or 'uri' must be specified",
+		},
+		{
+			name: "JWT rule without JWKS errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'jwks' for issuer 'https://issuer.example.com': exactly one of 'secretRef' or 'uri' must be specified",
+		},
+		{
+			name: "JWT rule with non http uri errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"uri": "file:///jwks.json"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'jwks' for issuer 'https://issuer.example.com': 'uri' must be an absolute http or https URI, got: file:///jwks.json",
+		},
+		{
+			name: "Output claim without header errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								},
+								"outputClaimToHeaders": [
+									{
+									"claim": "sub"
+									}
+								]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'claim' and 'header' must be specified for each output claim of issuer 'https://issuer.example.com'",
+		},
+		{
+			name: "Claim without values errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								}
+								}
+							],
+							"rules": [
+								{
+								"claims": [
+									{
+									"name": "groups"
+									}
+								]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'name' and 'values' must be specified for each claim",
+		},
+		{
+			name: "Request authentication with a duplicate destination errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "RequestAuthentication",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "RequestAuthentication",
+						"metadata": {
+							"name": "authn-2",
+							"namespace": "bookstore"
+						},
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							},
+							"jwtRules": [
+								{
+								"issuer": "https://issuer.example.com",
+								"jwks": {
+									"uri": "https://issuer.example.com/.well-known/jwks.json"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			existing: &policyv1alpha1.RequestAuthentication{
+				ObjectMeta: metav1.ObjectMeta{Name: "authn-1", Namespace: "bookstore"},
+			},
+			expResp:   nil,
+			expErrStr: "RequestAuthentication bookstore/authn-2 conflicts with bookstore/authn-1 since they have the same destination bookstore",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			mockPolicyClient := policy.NewMockController(mockCtrl)
+			mockPolicyClient.EXPECT().GetRequestAuthenticationPolicy(gomock.Any()).Return(tc.existing).AnyTimes()
+			pv := &policyValidator{
+				policyClient: mockPolicyClient,
+			}
+
+			resp, err := pv.requestAuthenticationValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			} else {
+				assert.Empty(tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestTrafficTargetValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "TrafficTarget namespace matches destination namespace",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha3",
+					Version: "access.smi-spec.io",
+					Kind:    "TrafficTarget",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha3",
+						"kind": "TrafficTarget",
+						"metadata": {
+							"namespace": "destination-namespace"
+						},
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "destination-name",
+								"namespace": "destination-namespace"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Traffic Target namespace does not match destination namespace",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha3",
+					Version: "access.smi-spec.io",
+					Kind:    "TrafficTarget",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha3",
+						"kind": "TrafficTarget",
+						"metadata": {
+							"namespace": "another-namespace"
+						},
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "destination-name",
+								"namespace": "destination-namespace"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "The traffic target namespace (another-namespace) must match spec.Destination.Namespace (destination-namespace)",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := trafficTargetValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			}
+		})
+	}
+}
+
+func TestUpstreamTrafficSettingValidator(t *testing.T) {
+	testCases := []struct {
+		name                            string
+		input                           *admissionv1.AdmissionRequest
+		expResp                         *admissionv1.AdmissionResponse
+		expErrStr                       string
+		existingUpstreamTrafficSettings []*policyv1alpha1.UpstreamTrafficSetting
+	}{
+		{
+			name: "UpstreamTrafficSetting with unique host",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local"
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with duplicate host",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local"
+						}
+					}
+					`),
+				},
+			},
+			existingUpstreamTrafficSettings: []*policyv1alpha1.UpstreamTrafficSetting{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "UpstreamTrafficSetting",
+						APIVersion: "policy.openservicemesh.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "httpbin1",
+						Namespace: "test",
+					},
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						Host: "httpbin.test.svc.cluster.local",
+					},
+				},
+			},
+			expResp:   nil,
+			expErrStr: "UpstreamTrafficSetting test/httpbin conflicts with test/httpbin1 since they have the same host httpbin.test.svc.cluster.local",
+		},
+		{
+			name: "success: UpstreamTrafficSetting with duplicate host on update",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local"
+						}
+					}
+					`),
+				},
+			},
+			existingUpstreamTrafficSettings: []*policyv1alpha1.UpstreamTrafficSetting{
+				{
+					TypeMeta: metav1.TypeMeta{
+						Kind:       "UpstreamTrafficSetting",
+						APIVersion: "policy.openservicemesh.io/v1alpha1",
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "httpbin",
+						Namespace: "test",
+					},
+					Spec: policyv1alpha1.UpstreamTrafficSettingSpec{
+						Host:               "httpbin.test.svc.cluster.local",
+						ConnectionSettings: nil,
+					},
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with valid rate limiting config",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"local": {
+									"http": {
+										"responseStatusCode": 429
+									}
+								}
+							},
+							"httpRoutes": [
+								{
+								"rateLimit": {
+									"local": {
+										"responseStatusCode": 503
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with invalid vhost rate limiting HTTP status code",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"local": {
+									"http": {
+										"responseStatusCode": 1
+									}
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid responseStatusCode 1. See https://www.envoyproxy.io/docs/envoy/latest/api-v3/type/v3/http_status.proto#enum-type-v3-statuscode for allowed values",
+		},
+		{
+			name: "UpstreamTrafficSetting with invalid HTTP route rate limiting status code",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"local": {
+									"http": {
+										"responseStatusCode": 429
+									}
+								}
+							},
+							"httpRoutes": [
+								{
+								"rateLimit": {
+									"local": {
+										"responseStatusCode": 1
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid responseStatusCode 1. See https://www.envoyproxy.io/docs/envoy/latest/api-v3/type/v3/http_status.proto#enum-type-v3-statuscode for allowed values",
+		},
+		{
+			name: "UpstreamTrafficSetting with valid global rate limiting config",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"global": {
+									"tcp": {
+										"rateLimitService": {
+											"host": "ratelimit.ratelimit.svc.cluster.local",
+											"port": 8081
+										},
+										"domain": "test",
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"key": "my_key",
+														"value": "my_value"
+													}
+												]
+											}
+										]
+									},
+									"http": {
+										"rateLimitService": {
+											"host": "ratelimit.ratelimit.svc.cluster.local",
+											"port": 8081,
+											"jsonPort": 8080
+										},
+										"domain": "test",
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"sourceIdentity": {}
+													},
+													{
+														"requestHeader": {
+															"name": "x-user",
+															"key": "user"
+														}
+													}
+												]
+											}
+										]
+									}
+								}
+							},
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rateLimit": {
+									"global": {
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"requestPath": {}
+													}
+												]
+											}
+										]
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with global rate limiting without rate limit service host",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"global": {
+									"http": {
+										"rateLimitService": {
+											"port": 8081
+										},
+										"domain": "test",
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"requestPath": {}
+													}
+												]
+											}
+										]
+									}
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Global rate limiting requires the host and port of the rate limit service",
+		},
+		{
+			name: "UpstreamTrafficSetting with global rate limiting descriptor entry setting multiple fields",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"global": {
+									"http": {
+										"rateLimitService": {
+											"host": "ratelimit.ratelimit.svc.cluster.local",
+											"port": 8081
+										},
+										"domain": "test",
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"requestPath": {},
+														"genericKey": {
+															"value": "foo"
+														}
+													}
+												]
+											}
+										]
+									}
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Global rate limiting descriptor entries must set exactly one of genericKey, requestHeader, requestPath or sourceIdentity",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route global rate limiting without host level config",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rateLimit": {
+									"global": {
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"requestPath": {}
+													}
+												]
+											}
+										]
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Global rate limiting for HTTP route /get requires rateLimit.global.http to be configured",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP global rate limiting without host level descriptors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"global": {
+									"http": {
+										"rateLimitService": {
+											"host": "ratelimit.ratelimit.svc.cluster.local",
+											"port": 8081
+										},
+										"domain": "test"
+									}
+								}
+							},
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rateLimit": {
+									"global": {
+										"descriptors": [
+											{
+												"entries": [
+													{
+														"requestPath": {}
+													}
+												]
+											}
+										]
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route global rate limiting without descriptors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"rateLimit": {
+								"global": {
+									"http": {
+										"rateLimitService": {
+											"host": "ratelimit.ratelimit.svc.cluster.local",
+											"port": 8081
+										},
+										"domain": "test"
+									}
+								}
+							},
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rateLimit": {
+									"global": {
+										"descriptors": []
+									}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Global rate limiting for HTTP route /get requires at least one descriptor",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route header modifications and rewrite",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"requestHeaders": {
+									"set": [{"name": "x-version", "value": "v2"}],
+									"remove": ["x-debug"]
+								},
+								"responseHeaders": {
+									"append": [{"name": "cache-control", "value": "no-cache"}]
+								},
+								"rewrite": {
+									"regex": {"pattern": "^/get/(.*)$", "substitution": "/\\1"},
+									"host": "httpbin.org"
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route prefix and regex rewrites",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rewrite": {
+									"prefix": {"match": "/get", "replacement": "/"},
+									"regex": {"pattern": "^/get", "substitution": "/"}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid rewrite for HTTP route /get: only one of prefix and regex can be specified",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route invalid regex rewrite",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"rewrite": {
+									"regex": {"pattern": "^/get/(.*$", "substitution": "/"}
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid rewrite for HTTP route /get: invalid regex \"^/get/(.*$\": error parsing regexp: missing closing ): `^/get/(.*$`",
+		},
+		{
+			name: "UpstreamTrafficSetting with HTTP route Host header modification",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"httpRoutes": [
+								{
+								"path": "/get",
+								"requestHeaders": {
+									"set": [{"name": "Host", "value": "httpbin.org"}]
+								}
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid header modification for HTTP route /get: header \"Host\" cannot be modified",
+		},
+		{
+			name: "UpstreamTrafficSetting with consistent hash load balancer",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"type": "Maglev",
+								"consistentHash": {
+									"header": "x-user"
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with session affinity",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"sessionAffinity": {
+									"cookieName": "httpbin-session",
+									"ttl": "10m"
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with invalid load balancer type",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"type": "LeastConn"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'loadBalancer.type' value specified. Must be one of: RoundRobin, LeastRequest, RingHash, Maglev, Random",
+		},
+		{
+			name: "UpstreamTrafficSetting with consistent hash for a round robin load balancer",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"type": "RoundRobin",
+								"consistentHash": {
+									"sourceIP": true
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'loadBalancer.consistentHash' requires 'loadBalancer.type' to be RingHash or Maglev, got: RoundRobin",
+		},
+		{
+			name: "UpstreamTrafficSetting with multiple consistent hash keys",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"type": "RingHash",
+								"consistentHash": {
+									"header": "x-user",
+									"sourceIP": true
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Exactly one of 'header', 'cookie' or 'sourceIP' must be specified in 'loadBalancer.consistentHash'",
+		},
+		{
+			name: "UpstreamTrafficSetting with session affinity for a least request load balancer",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"loadBalancer": {
+								"type": "LeastRequest",
+								"sessionAffinity": {}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'loadBalancer.sessionAffinity' requires 'loadBalancer.type' to be RingHash or Maglev, got: LeastRequest",
+		},
+		{
+			name: "UpstreamTrafficSetting with a valid max ejection percent",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"connectionSettings": {
+								"outlierDetection": {
+									"maxEjectionPercent": 100
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "UpstreamTrafficSetting with a max ejection percent over 100",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "UpstreamTrafficSetting",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "policy.openservicemesh.io/v1alpha1",
+						"kind": "UpstreamTrafficSetting",
+						"metadata": {
+							"name": "httpbin",
+							"namespace": "test"
+						},
+						"spec": {
+							"host": "httpbin.test.svc.cluster.local",
+							"connectionSettings": {
+								"outlierDetection": {
+									"maxEjectionPercent": 101
+								}
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'connectionSettings.outlierDetection.maxEjectionPercent' value 101, must be in the range 0-100",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+			mockCtrl := gomock.NewController(t)
 			defer mockCtrl.Finish()
 			stop := make(chan struct{})
 			defer close(stop)
@@ -2103,3 +4258,409 @@ func TestUpstreamTrafficSettingValidator(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthorizationPolicyValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     *admissionv1.AdmissionRequest
+		expResp   *admissionv1.AdmissionResponse
+		expErrStr string
+	}{
+		{
+			name: "Valid authorization policy passes",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"destination": {
+								"kind": "ServiceAccount",
+								"name": "bookstore"
+							},
+							"action": "DENY",
+							"rules": [
+								{
+									"from": [
+										{
+										"serviceAccounts": [{"name": "bookbuyer", "namespace": "bookbuyer"}],
+										"ipBlocks": ["10.0.0.0/8", "192.168.1.1"]
+										}
+									],
+									"to": [
+										{
+										"ports": [8080],
+										"methods": ["POST"],
+										"paths": ["/admin/*"],
+										"headers": [{"name": "x-debug"}]
+										}
+									]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "",
+		},
+		{
+			name: "Destination with invalid kind errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"destination": {
+								"kind": "Service",
+								"name": "bookstore"
+							}
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Expected 'destination.kind' to be 'ServiceAccount', got: Service",
+		},
+		{
+			name: "Invalid action errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"action": "REJECT"
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid 'action' REJECT, must be one of ALLOW, DENY or AUDIT",
+		},
+		{
+			name: "Service account without name errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"from": [{"serviceAccounts": [{"namespace": "bookbuyer"}]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'name' must be specified for each service account",
+		},
+		{
+			name: "Invalid IP block errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"from": [{"ipBlocks": ["10.0.0.0/33"]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid IP block 10.0.0.0/33, must be an IP address or a CIDR range",
+		},
+		{
+			name: "Zero port errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"to": [{"ports": [0]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "Invalid port 0, ports must be in the range 1-65535",
+		},
+		{
+			name: "Header without name errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"to": [{"headers": [{"values": ["true"]}]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'name' must be specified for each header",
+		},
+		{
+			name: "gRPC method without service errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"to": [{"grpcMethods": [{"method": "SayHello"}]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'service' must be specified for each gRPC method",
+		},
+		{
+			name: "Paths and gRPC methods in the same operation errors",
+			input: &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "v1alpha1",
+					Version: "policy.openservicemesh.io",
+					Kind:    "AuthorizationPolicy",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`
+					{
+						"apiVersion": "v1alpha1",
+						"kind": "AuthorizationPolicy",
+						"spec": {
+							"rules": [
+								{
+									"to": [{"paths": ["/health"], "grpcMethods": [{"service": "helloworld.Greeter"}]}]
+								}
+							]
+						}
+					}
+					`),
+				},
+			},
+			expResp:   nil,
+			expErrStr: "'paths' and 'grpcMethods' are mutually exclusive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			resp, err := authorizationPolicyValidator(tc.input)
+			assert.Equal(tc.expResp, resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			} else {
+				assert.Empty(tc.expErrStr)
+			}
+		})
+	}
+}
+
+func TestMeshConfigValidator(t *testing.T) {
+	testCases := []struct {
+		name      string
+		spec      string
+		expErrStr string
+	}{
+		{
+			name: "Valid UDP port policies pass",
+			spec: `{
+				"traffic": {
+					"udpInterception": {
+						"enable": true,
+						"portPolicies": [
+							{"port": 53, "action": "Deny"},
+							{"port": 514, "action": "Allow", "enableMetrics": true}
+						]
+					}
+				}
+			}`,
+		},
+		{
+			name: "UDP port policy with an out of range port fails",
+			spec: `{
+				"traffic": {
+					"udpInterception": {
+						"portPolicies": [
+							{"port": 65536, "action": "Allow"}
+						]
+					}
+				}
+			}`,
+			expErrStr: "Invalid 'udpInterception.portPolicies.port' value 65536, must be in the range 1-65535",
+		},
+		{
+			name: "UDP port policy with an unknown action fails",
+			spec: `{
+				"traffic": {
+					"udpInterception": {
+						"portPolicies": [
+							{"port": 514, "action": "Drop"}
+						]
+					}
+				}
+			}`,
+			expErrStr: "Invalid 'udpInterception.portPolicies.action' value Drop for port 514. Must be one of: Allow, Deny",
+		},
+		{
+			name: "UDP port policies with a duplicate port fail",
+			spec: `{
+				"traffic": {
+					"udpInterception": {
+						"portPolicies": [
+							{"port": 514, "action": "Allow"},
+							{"port": 514, "action": "Deny"}
+						]
+					}
+				}
+			}`,
+			expErrStr: "Duplicate 'udpInterception.portPolicies.port' value 514",
+		},
+		{
+			name: "Envoy sidecars exporting the spans over OTLP/gRPC pass",
+			spec: `{
+				"sidecar": {"sidecarClass": "envoy"},
+				"observability": {
+					"tracing": {"enable": true, "protocol": "otlp-grpc"}
+				}
+			}`,
+		},
+		{
+			name: "Envoy sidecars exporting the spans over OTLP/HTTP fail",
+			spec: `{
+				"sidecar": {"sidecarClass": "envoy"},
+				"observability": {
+					"tracing": {"enable": true, "protocol": "otlp-http"}
+				}
+			}`,
+			expErrStr: "Invalid 'observability.tracing.protocol' value otlp-http, not supported by the envoy sidecar",
+		},
+		{
+			name: "Pipy sidecars exporting the access logs over OTLP/gRPC fail",
+			spec: `{
+				"sidecar": {"sidecarClass": "pipy"},
+				"observability": {
+					"remoteLogging": {"enable": true, "protocol": "otlp-grpc"}
+				}
+			}`,
+			expErrStr: "Invalid 'observability.remoteLogging.protocol' value otlp-grpc, not supported by the pipy sidecar",
+		},
+		{
+			name: "Disabled tracing with an unsupported protocol passes",
+			spec: `{
+				"sidecar": {"sidecarClass": "pipy"},
+				"observability": {
+					"tracing": {"enable": false, "protocol": "otlp-grpc"}
+				}
+			}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert := tassert.New(t)
+
+			req := &admissionv1.AdmissionRequest{
+				Kind: metav1.GroupVersionKind{
+					Group:   "config.openservicemesh.io",
+					Version: "v1alpha2",
+					Kind:    "MeshConfig",
+				},
+				Object: runtime.RawExtension{
+					Raw: []byte(`{"apiVersion": "config.openservicemesh.io/v1alpha2", "kind": "MeshConfig", "spec": ` + tc.spec + `}`),
+				},
+			}
+
+			resp, err := meshConfigValidator(req)
+			assert.Nil(resp)
+			if err != nil {
+				assert.Equal(tc.expErrStr, err.Error())
+			} else {
+				assert.Empty(tc.expErrStr)
+			}
+		})
+	}
+}
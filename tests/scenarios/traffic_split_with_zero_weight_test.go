@@ -130,7 +130,7 @@ func TestRDSRespose(t *testing.T) {
 							AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 								Name:      tests.BookbuyerServiceAccountName,
 								Namespace: tests.Namespace,
-							}.AsPrincipal("cluster.local")),
+							}.AsPrincipal("cluster.local", false)),
 						},
 						{
 							Route: trafficpolicy.RouteWeightedClusters{
@@ -143,7 +143,7 @@ func TestRDSRespose(t *testing.T) {
 							AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 								Name:      tests.BookbuyerServiceAccountName,
 								Namespace: tests.Namespace,
-							}.AsPrincipal("cluster.local")),
+							}.AsPrincipal("cluster.local", false)),
 						},
 					},
 				},
@@ -173,7 +173,7 @@ func TestRDSRespose(t *testing.T) {
 							AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 								Name:      tests.BookbuyerServiceAccountName,
 								Namespace: tests.Namespace,
-							}.AsPrincipal("cluster.local")),
+							}.AsPrincipal("cluster.local", false)),
 						},
 						{
 							Route: trafficpolicy.RouteWeightedClusters{
@@ -186,7 +186,7 @@ func TestRDSRespose(t *testing.T) {
 							AllowedPrincipals: mapset.NewSet(identity.K8sServiceAccount{
 								Name:      tests.BookbuyerServiceAccountName,
 								Namespace: tests.Namespace,
-							}.AsPrincipal("cluster.local")),
+							}.AsPrincipal("cluster.local", false)),
 						},
 					},
 				},